@@ -11,6 +11,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"github.com/google/uuid"
 	"github.com/hideaki1979/cc-chat-app/apps/api/ent/chatroom"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/message"
 )
 
 // ChatRoom is the model entity for the ChatRoom schema.
@@ -20,8 +21,26 @@ type ChatRoom struct {
 	ID uuid.UUID `json:"id,omitempty"`
 	// チャットルーム名
 	Name string `json:"name,omitempty"`
+	// ルームの説明
+	Description *string `json:"description,omitempty"`
+	// ルームのトピック（変更時はシステムメッセージを記録）
+	Topic *string `json:"topic,omitempty"`
+	// ルームのアイコン画像URL
+	AvatarURL *string `json:"avatar_url,omitempty"`
 	// グループチャットかどうか
 	IsGroupChat bool `json:"is_group_chat,omitempty"`
+	// 1対1のダイレクトメッセージの識別キー（2人のユーザーIDを昇順に連結）。同じ2人のDMを重複させない
+	DmKey *string `json:"dm_key,omitempty"`
+	// 公開設定（private: 招待のみ、public: 誰でも参加可能、request_to_join: 参加申請の承認が必要）
+	Visibility chatroom.Visibility `json:"visibility,omitempty"`
+	// メッセージ編集可能時間（秒）。nullはサーバーのデフォルト、0は編集不可、-1は無期限
+	EditWindowSeconds *int `json:"edit_window_seconds,omitempty"`
+	// アーカイブ日時（最後のメンバーが退出した際に設定）
+	ArchivedAt *time.Time `json:"archived_at,omitempty"`
+	// 最新メッセージの送信日時（スレッドの返信・論理削除されたメッセージを除く）。Messageのフックで更新
+	LastMessageAt *time.Time `json:"last_message_at,omitempty"`
+	// 最新メッセージID（スレッドの返信・論理削除されたメッセージを除く）。Messageのフックで更新
+	LastMessageID *uuid.UUID `json:"last_message_id,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -38,9 +57,17 @@ type ChatRoomEdges struct {
 	RoomMembers []*RoomMember `json:"room_members,omitempty"`
 	// Messages holds the value of the messages edge.
 	Messages []*Message `json:"messages,omitempty"`
+	// LastMessage holds the value of the last_message edge.
+	LastMessage *Message `json:"last_message,omitempty"`
+	// MessageReads holds the value of the message_reads edge.
+	MessageReads []*MessageRead `json:"message_reads,omitempty"`
+	// Invites holds the value of the invites edge.
+	Invites []*Invite `json:"invites,omitempty"`
+	// JoinRequests holds the value of the join_requests edge.
+	JoinRequests []*JoinRequest `json:"join_requests,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [6]bool
 }
 
 // RoomMembersOrErr returns the RoomMembers value or an error if the edge
@@ -61,16 +88,58 @@ func (e ChatRoomEdges) MessagesOrErr() ([]*Message, error) {
 	return nil, &NotLoadedError{edge: "messages"}
 }
 
+// LastMessageOrErr returns the LastMessage value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ChatRoomEdges) LastMessageOrErr() (*Message, error) {
+	if e.LastMessage != nil {
+		return e.LastMessage, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: message.Label}
+	}
+	return nil, &NotLoadedError{edge: "last_message"}
+}
+
+// MessageReadsOrErr returns the MessageReads value or an error if the edge
+// was not loaded in eager-loading.
+func (e ChatRoomEdges) MessageReadsOrErr() ([]*MessageRead, error) {
+	if e.loadedTypes[3] {
+		return e.MessageReads, nil
+	}
+	return nil, &NotLoadedError{edge: "message_reads"}
+}
+
+// InvitesOrErr returns the Invites value or an error if the edge
+// was not loaded in eager-loading.
+func (e ChatRoomEdges) InvitesOrErr() ([]*Invite, error) {
+	if e.loadedTypes[4] {
+		return e.Invites, nil
+	}
+	return nil, &NotLoadedError{edge: "invites"}
+}
+
+// JoinRequestsOrErr returns the JoinRequests value or an error if the edge
+// was not loaded in eager-loading.
+func (e ChatRoomEdges) JoinRequestsOrErr() ([]*JoinRequest, error) {
+	if e.loadedTypes[5] {
+		return e.JoinRequests, nil
+	}
+	return nil, &NotLoadedError{edge: "join_requests"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*ChatRoom) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case chatroom.FieldLastMessageID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
 		case chatroom.FieldIsGroupChat:
 			values[i] = new(sql.NullBool)
-		case chatroom.FieldName:
+		case chatroom.FieldEditWindowSeconds:
+			values[i] = new(sql.NullInt64)
+		case chatroom.FieldName, chatroom.FieldDescription, chatroom.FieldTopic, chatroom.FieldAvatarURL, chatroom.FieldDmKey, chatroom.FieldVisibility:
 			values[i] = new(sql.NullString)
-		case chatroom.FieldCreatedAt, chatroom.FieldUpdatedAt:
+		case chatroom.FieldArchivedAt, chatroom.FieldLastMessageAt, chatroom.FieldCreatedAt, chatroom.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
 		case chatroom.FieldID:
 			values[i] = new(uuid.UUID)
@@ -101,12 +170,74 @@ func (cr *ChatRoom) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				cr.Name = value.String
 			}
+		case chatroom.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				cr.Description = new(string)
+				*cr.Description = value.String
+			}
+		case chatroom.FieldTopic:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field topic", values[i])
+			} else if value.Valid {
+				cr.Topic = new(string)
+				*cr.Topic = value.String
+			}
+		case chatroom.FieldAvatarURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field avatar_url", values[i])
+			} else if value.Valid {
+				cr.AvatarURL = new(string)
+				*cr.AvatarURL = value.String
+			}
 		case chatroom.FieldIsGroupChat:
 			if value, ok := values[i].(*sql.NullBool); !ok {
 				return fmt.Errorf("unexpected type %T for field is_group_chat", values[i])
 			} else if value.Valid {
 				cr.IsGroupChat = value.Bool
 			}
+		case chatroom.FieldDmKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field dm_key", values[i])
+			} else if value.Valid {
+				cr.DmKey = new(string)
+				*cr.DmKey = value.String
+			}
+		case chatroom.FieldVisibility:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field visibility", values[i])
+			} else if value.Valid {
+				cr.Visibility = chatroom.Visibility(value.String)
+			}
+		case chatroom.FieldEditWindowSeconds:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field edit_window_seconds", values[i])
+			} else if value.Valid {
+				cr.EditWindowSeconds = new(int)
+				*cr.EditWindowSeconds = int(value.Int64)
+			}
+		case chatroom.FieldArchivedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field archived_at", values[i])
+			} else if value.Valid {
+				cr.ArchivedAt = new(time.Time)
+				*cr.ArchivedAt = value.Time
+			}
+		case chatroom.FieldLastMessageAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_message_at", values[i])
+			} else if value.Valid {
+				cr.LastMessageAt = new(time.Time)
+				*cr.LastMessageAt = value.Time
+			}
+		case chatroom.FieldLastMessageID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field last_message_id", values[i])
+			} else if value.Valid {
+				cr.LastMessageID = new(uuid.UUID)
+				*cr.LastMessageID = *value.S.(*uuid.UUID)
+			}
 		case chatroom.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -142,6 +273,26 @@ func (cr *ChatRoom) QueryMessages() *MessageQuery {
 	return NewChatRoomClient(cr.config).QueryMessages(cr)
 }
 
+// QueryLastMessage queries the "last_message" edge of the ChatRoom entity.
+func (cr *ChatRoom) QueryLastMessage() *MessageQuery {
+	return NewChatRoomClient(cr.config).QueryLastMessage(cr)
+}
+
+// QueryMessageReads queries the "message_reads" edge of the ChatRoom entity.
+func (cr *ChatRoom) QueryMessageReads() *MessageReadQuery {
+	return NewChatRoomClient(cr.config).QueryMessageReads(cr)
+}
+
+// QueryInvites queries the "invites" edge of the ChatRoom entity.
+func (cr *ChatRoom) QueryInvites() *InviteQuery {
+	return NewChatRoomClient(cr.config).QueryInvites(cr)
+}
+
+// QueryJoinRequests queries the "join_requests" edge of the ChatRoom entity.
+func (cr *ChatRoom) QueryJoinRequests() *JoinRequestQuery {
+	return NewChatRoomClient(cr.config).QueryJoinRequests(cr)
+}
+
 // Update returns a builder for updating this ChatRoom.
 // Note that you need to call ChatRoom.Unwrap() before calling this method if this ChatRoom
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -168,9 +319,52 @@ func (cr *ChatRoom) String() string {
 	builder.WriteString("name=")
 	builder.WriteString(cr.Name)
 	builder.WriteString(", ")
+	if v := cr.Description; v != nil {
+		builder.WriteString("description=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := cr.Topic; v != nil {
+		builder.WriteString("topic=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := cr.AvatarURL; v != nil {
+		builder.WriteString("avatar_url=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
 	builder.WriteString("is_group_chat=")
 	builder.WriteString(fmt.Sprintf("%v", cr.IsGroupChat))
 	builder.WriteString(", ")
+	if v := cr.DmKey; v != nil {
+		builder.WriteString("dm_key=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("visibility=")
+	builder.WriteString(fmt.Sprintf("%v", cr.Visibility))
+	builder.WriteString(", ")
+	if v := cr.EditWindowSeconds; v != nil {
+		builder.WriteString("edit_window_seconds=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := cr.ArchivedAt; v != nil {
+		builder.WriteString("archived_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := cr.LastMessageAt; v != nil {
+		builder.WriteString("last_message_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := cr.LastMessageID; v != nil {
+		builder.WriteString("last_message_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(cr.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
@@ -3,6 +3,7 @@
 package chatroom
 
 import (
+	"fmt"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
@@ -17,8 +18,26 @@ const (
 	FieldID = "id"
 	// FieldName holds the string denoting the name field in the database.
 	FieldName = "name"
+	// FieldDescription holds the string denoting the description field in the database.
+	FieldDescription = "description"
+	// FieldTopic holds the string denoting the topic field in the database.
+	FieldTopic = "topic"
+	// FieldAvatarURL holds the string denoting the avatar_url field in the database.
+	FieldAvatarURL = "avatar_url"
 	// FieldIsGroupChat holds the string denoting the is_group_chat field in the database.
 	FieldIsGroupChat = "is_group_chat"
+	// FieldDmKey holds the string denoting the dm_key field in the database.
+	FieldDmKey = "dm_key"
+	// FieldVisibility holds the string denoting the visibility field in the database.
+	FieldVisibility = "visibility"
+	// FieldEditWindowSeconds holds the string denoting the edit_window_seconds field in the database.
+	FieldEditWindowSeconds = "edit_window_seconds"
+	// FieldArchivedAt holds the string denoting the archived_at field in the database.
+	FieldArchivedAt = "archived_at"
+	// FieldLastMessageAt holds the string denoting the last_message_at field in the database.
+	FieldLastMessageAt = "last_message_at"
+	// FieldLastMessageID holds the string denoting the last_message_id field in the database.
+	FieldLastMessageID = "last_message_id"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
@@ -27,6 +46,14 @@ const (
 	EdgeRoomMembers = "room_members"
 	// EdgeMessages holds the string denoting the messages edge name in mutations.
 	EdgeMessages = "messages"
+	// EdgeLastMessage holds the string denoting the last_message edge name in mutations.
+	EdgeLastMessage = "last_message"
+	// EdgeMessageReads holds the string denoting the message_reads edge name in mutations.
+	EdgeMessageReads = "message_reads"
+	// EdgeInvites holds the string denoting the invites edge name in mutations.
+	EdgeInvites = "invites"
+	// EdgeJoinRequests holds the string denoting the join_requests edge name in mutations.
+	EdgeJoinRequests = "join_requests"
 	// Table holds the table name of the chatroom in the database.
 	Table = "chat_rooms"
 	// RoomMembersTable is the table that holds the room_members relation/edge.
@@ -43,13 +70,50 @@ const (
 	MessagesInverseTable = "messages"
 	// MessagesColumn is the table column denoting the messages relation/edge.
 	MessagesColumn = "room_id"
+	// LastMessageTable is the table that holds the last_message relation/edge.
+	LastMessageTable = "chat_rooms"
+	// LastMessageInverseTable is the table name for the Message entity.
+	// It exists in this package in order to avoid circular dependency with the "message" package.
+	LastMessageInverseTable = "messages"
+	// LastMessageColumn is the table column denoting the last_message relation/edge.
+	LastMessageColumn = "last_message_id"
+	// MessageReadsTable is the table that holds the message_reads relation/edge.
+	MessageReadsTable = "message_reads"
+	// MessageReadsInverseTable is the table name for the MessageRead entity.
+	// It exists in this package in order to avoid circular dependency with the "messageread" package.
+	MessageReadsInverseTable = "message_reads"
+	// MessageReadsColumn is the table column denoting the message_reads relation/edge.
+	MessageReadsColumn = "room_id"
+	// InvitesTable is the table that holds the invites relation/edge.
+	InvitesTable = "invites"
+	// InvitesInverseTable is the table name for the Invite entity.
+	// It exists in this package in order to avoid circular dependency with the "invite" package.
+	InvitesInverseTable = "invites"
+	// InvitesColumn is the table column denoting the invites relation/edge.
+	InvitesColumn = "room_id"
+	// JoinRequestsTable is the table that holds the join_requests relation/edge.
+	JoinRequestsTable = "join_requests"
+	// JoinRequestsInverseTable is the table name for the JoinRequest entity.
+	// It exists in this package in order to avoid circular dependency with the "joinrequest" package.
+	JoinRequestsInverseTable = "join_requests"
+	// JoinRequestsColumn is the table column denoting the join_requests relation/edge.
+	JoinRequestsColumn = "room_id"
 )
 
 // Columns holds all SQL columns for chatroom fields.
 var Columns = []string{
 	FieldID,
 	FieldName,
+	FieldDescription,
+	FieldTopic,
+	FieldAvatarURL,
 	FieldIsGroupChat,
+	FieldDmKey,
+	FieldVisibility,
+	FieldEditWindowSeconds,
+	FieldArchivedAt,
+	FieldLastMessageAt,
+	FieldLastMessageID,
 	FieldCreatedAt,
 	FieldUpdatedAt,
 }
@@ -69,6 +133,8 @@ var (
 	NameValidator func(string) error
 	// DefaultIsGroupChat holds the default value on creation for the "is_group_chat" field.
 	DefaultIsGroupChat bool
+	// EditWindowSecondsValidator is a validator for the "edit_window_seconds" field. It is called by the builders before save.
+	EditWindowSecondsValidator func(int) error
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
@@ -79,6 +145,33 @@ var (
 	DefaultID func() uuid.UUID
 )
 
+// Visibility defines the type for the "visibility" enum field.
+type Visibility string
+
+// VisibilityPrivate is the default value of the Visibility enum.
+const DefaultVisibility = VisibilityPrivate
+
+// Visibility values.
+const (
+	VisibilityPrivate       Visibility = "private"
+	VisibilityPublic        Visibility = "public"
+	VisibilityRequestToJoin Visibility = "request_to_join"
+)
+
+func (v Visibility) String() string {
+	return string(v)
+}
+
+// VisibilityValidator is a validator for the "visibility" field enum values. It is called by the builders before save.
+func VisibilityValidator(v Visibility) error {
+	switch v {
+	case VisibilityPrivate, VisibilityPublic, VisibilityRequestToJoin:
+		return nil
+	default:
+		return fmt.Errorf("chatroom: invalid enum value for visibility field: %q", v)
+	}
+}
+
 // OrderOption defines the ordering options for the ChatRoom queries.
 type OrderOption func(*sql.Selector)
 
@@ -92,11 +185,56 @@ func ByName(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldName, opts...).ToFunc()
 }
 
+// ByDescription orders the results by the description field.
+func ByDescription(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDescription, opts...).ToFunc()
+}
+
+// ByTopic orders the results by the topic field.
+func ByTopic(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTopic, opts...).ToFunc()
+}
+
+// ByAvatarURL orders the results by the avatar_url field.
+func ByAvatarURL(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAvatarURL, opts...).ToFunc()
+}
+
 // ByIsGroupChat orders the results by the is_group_chat field.
 func ByIsGroupChat(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldIsGroupChat, opts...).ToFunc()
 }
 
+// ByDmKey orders the results by the dm_key field.
+func ByDmKey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDmKey, opts...).ToFunc()
+}
+
+// ByVisibility orders the results by the visibility field.
+func ByVisibility(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVisibility, opts...).ToFunc()
+}
+
+// ByEditWindowSeconds orders the results by the edit_window_seconds field.
+func ByEditWindowSeconds(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEditWindowSeconds, opts...).ToFunc()
+}
+
+// ByArchivedAt orders the results by the archived_at field.
+func ByArchivedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldArchivedAt, opts...).ToFunc()
+}
+
+// ByLastMessageAt orders the results by the last_message_at field.
+func ByLastMessageAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastMessageAt, opts...).ToFunc()
+}
+
+// ByLastMessageID orders the results by the last_message_id field.
+func ByLastMessageID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastMessageID, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
@@ -134,6 +272,55 @@ func ByMessages(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newMessagesStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByLastMessageField orders the results by last_message field.
+func ByLastMessageField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newLastMessageStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByMessageReadsCount orders the results by message_reads count.
+func ByMessageReadsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newMessageReadsStep(), opts...)
+	}
+}
+
+// ByMessageReads orders the results by message_reads terms.
+func ByMessageReads(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMessageReadsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByInvitesCount orders the results by invites count.
+func ByInvitesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newInvitesStep(), opts...)
+	}
+}
+
+// ByInvites orders the results by invites terms.
+func ByInvites(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newInvitesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByJoinRequestsCount orders the results by join_requests count.
+func ByJoinRequestsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newJoinRequestsStep(), opts...)
+	}
+}
+
+// ByJoinRequests orders the results by join_requests terms.
+func ByJoinRequests(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newJoinRequestsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newRoomMembersStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -148,3 +335,31 @@ func newMessagesStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, MessagesTable, MessagesColumn),
 	)
 }
+func newLastMessageStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(LastMessageInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, LastMessageTable, LastMessageColumn),
+	)
+}
+func newMessageReadsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(MessageReadsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, MessageReadsTable, MessageReadsColumn),
+	)
+}
+func newInvitesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(InvitesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, InvitesTable, InvitesColumn),
+	)
+}
+func newJoinRequestsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(JoinRequestsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, JoinRequestsTable, JoinRequestsColumn),
+	)
+}
@@ -61,11 +61,51 @@ func Name(v string) predicate.ChatRoom {
 	return predicate.ChatRoom(sql.FieldEQ(FieldName, v))
 }
 
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.ChatRoom {
+	return predicate.ChatRoom(sql.FieldEQ(FieldDescription, v))
+}
+
+// Topic applies equality check predicate on the "topic" field. It's identical to TopicEQ.
+func Topic(v string) predicate.ChatRoom {
+	return predicate.ChatRoom(sql.FieldEQ(FieldTopic, v))
+}
+
+// AvatarURL applies equality check predicate on the "avatar_url" field. It's identical to AvatarURLEQ.
+func AvatarURL(v string) predicate.ChatRoom {
+	return predicate.ChatRoom(sql.FieldEQ(FieldAvatarURL, v))
+}
+
 // IsGroupChat applies equality check predicate on the "is_group_chat" field. It's identical to IsGroupChatEQ.
 func IsGroupChat(v bool) predicate.ChatRoom {
 	return predicate.ChatRoom(sql.FieldEQ(FieldIsGroupChat, v))
 }
 
+// DmKey applies equality check predicate on the "dm_key" field. It's identical to DmKeyEQ.
+func DmKey(v string) predicate.ChatRoom {
+	return predicate.ChatRoom(sql.FieldEQ(FieldDmKey, v))
+}
+
+// EditWindowSeconds applies equality check predicate on the "edit_window_seconds" field. It's identical to EditWindowSecondsEQ.
+func EditWindowSeconds(v int) predicate.ChatRoom {
+	return predicate.ChatRoom(sql.FieldEQ(FieldEditWindowSeconds, v))
+}
+
+// ArchivedAt applies equality check predicate on the "archived_at" field. It's identical to ArchivedAtEQ.
+func ArchivedAt(v time.Time) predicate.ChatRoom {
+	return predicate.ChatRoom(sql.FieldEQ(FieldArchivedAt, v))
+}
+
+// LastMessageAt applies equality check predicate on the "last_message_at" field. It's identical to LastMessageAtEQ.
+func LastMessageAt(v time.Time) predicate.ChatRoom {
+	return predicate.ChatRoom(sql.FieldEQ(FieldLastMessageAt, v))
+}
+
+// LastMessageID applies equality check predicate on the "last_message_id" field. It's identical to LastMessageIDEQ.
+func LastMessageID(v uuid.UUID) predicate.ChatRoom {
+	return predicate.ChatRoom(sql.FieldEQ(FieldLastMessageID, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.ChatRoom {
 	return predicate.ChatRoom(sql.FieldEQ(FieldCreatedAt, v))
@@ -141,6 +181,231 @@ func NameContainsFold(v string) predicate.ChatRoom {
 	return predicate.ChatRoom(sql.FieldContainsFold(FieldName, v))
 }
 
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.ChatRoom {
+	return predicate.ChatRoom(sql.FieldEQ(FieldDescription, v))
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.ChatRoom {
+	return predicate.ChatRoom(sql.FieldNEQ(FieldDescription, v))
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.ChatRoom {
+	return predicate.ChatRoom(sql.FieldIn(FieldDescription, vs...))
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.ChatRoom {
+	return predicate.ChatRoom(sql.FieldNotIn(FieldDescription, vs...))
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.ChatRoom {
+	return predicate.ChatRoom(sql.FieldGT(FieldDescription, v))
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.ChatRoom {
+	return predicate.ChatRoom(sql.FieldGTE(FieldDescription, v))
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.ChatRoom {
+	return predicate.ChatRoom(sql.FieldLT(FieldDescription, v))
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.ChatRoom {
+	return predicate.ChatRoom(sql.FieldLTE(FieldDescription, v))
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.ChatRoom {
+	return predicate.ChatRoom(sql.FieldContains(FieldDescription, v))
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.ChatRoom {
+	return predicate.ChatRoom(sql.FieldHasPrefix(FieldDescription, v))
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.ChatRoom {
+	return predicate.ChatRoom(sql.FieldHasSuffix(FieldDescription, v))
+}
+
+// DescriptionIsNil applies the IsNil predicate on the "description" field.
+func DescriptionIsNil() predicate.ChatRoom {
+	return predicate.ChatRoom(sql.FieldIsNull(FieldDescription))
+}
+
+// DescriptionNotNil applies the NotNil predicate on the "description" field.
+func DescriptionNotNil() predicate.ChatRoom {
+	return predicate.ChatRoom(sql.FieldNotNull(FieldDescription))
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.ChatRoom {
+	return predicate.ChatRoom(sql.FieldEqualFold(FieldDescription, v))
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.ChatRoom {
+	return predicate.ChatRoom(sql.FieldContainsFold(FieldDescription, v))
+}
+
+// TopicEQ applies the EQ predicate on the "topic" field.
+func TopicEQ(v string) predicate.ChatRoom {
+	return predicate.ChatRoom(sql.FieldEQ(FieldTopic, v))
+}
+
+// TopicNEQ applies the NEQ predicate on the "topic" field.
+func TopicNEQ(v string) predicate.ChatRoom {
+	return predicate.ChatRoom(sql.FieldNEQ(FieldTopic, v))
+}
+
+// TopicIn applies the In predicate on the "topic" field.
+func TopicIn(vs ...string) predicate.ChatRoom {
+	return predicate.ChatRoom(sql.FieldIn(FieldTopic, vs...))
+}
+
+// TopicNotIn applies the NotIn predicate on the "topic" field.
+func TopicNotIn(vs ...string) predicate.ChatRoom {
+	return predicate.ChatRoom(sql.FieldNotIn(FieldTopic, vs...))
+}
+
+// TopicGT applies the GT predicate on the "topic" field.
+func TopicGT(v string) predicate.ChatRoom {
+	return predicate.ChatRoom(sql.FieldGT(FieldTopic, v))
+}
+
+// TopicGTE applies the GTE predicate on the "topic" field.
+func TopicGTE(v string) predicate.ChatRoom {
+	return predicate.ChatRoom(sql.FieldGTE(FieldTopic, v))
+}
+
+// TopicLT applies the LT predicate on the "topic" field.
+func TopicLT(v string) predicate.ChatRoom {
+	return predicate.ChatRoom(sql.FieldLT(FieldTopic, v))
+}
+
+// TopicLTE applies the LTE predicate on the "topic" field.
+func TopicLTE(v string) predicate.ChatRoom {
+	return predicate.ChatRoom(sql.FieldLTE(FieldTopic, v))
+}
+
+// TopicContains applies the Contains predicate on the "topic" field.
+func TopicContains(v string) predicate.ChatRoom {
+	return predicate.ChatRoom(sql.FieldContains(FieldTopic, v))
+}
+
+// TopicHasPrefix applies the HasPrefix predicate on the "topic" field.
+func TopicHasPrefix(v string) predicate.ChatRoom {
+	return predicate.ChatRoom(sql.FieldHasPrefix(FieldTopic, v))
+}
+
+// TopicHasSuffix applies the HasSuffix predicate on the "topic" field.
+func TopicHasSuffix(v string) predicate.ChatRoom {
+	return predicate.ChatRoom(sql.FieldHasSuffix(FieldTopic, v))
+}
+
+// TopicIsNil applies the IsNil predicate on the "topic" field.
+func TopicIsNil() predicate.ChatRoom {
+	return predicate.ChatRoom(sql.FieldIsNull(FieldTopic))
+}
+
+// TopicNotNil applies the NotNil predicate on the "topic" field.
+func TopicNotNil() predicate.ChatRoom {
+	return predicate.ChatRoom(sql.FieldNotNull(FieldTopic))
+}
+
+// TopicEqualFold applies the EqualFold predicate on the "topic" field.
+func TopicEqualFold(v string) predicate.ChatRoom {
+	return predicate.ChatRoom(sql.FieldEqualFold(FieldTopic, v))
+}
+
+// TopicContainsFold applies the ContainsFold predicate on the "topic" field.
+func TopicContainsFold(v string) predicate.ChatRoom {
+	return predicate.ChatRoom(sql.FieldContainsFold(FieldTopic, v))
+}
+
+// AvatarURLEQ applies the EQ predicate on the "avatar_url" field.
+func AvatarURLEQ(v string) predicate.ChatRoom {
+	return predicate.ChatRoom(sql.FieldEQ(FieldAvatarURL, v))
+}
+
+// AvatarURLNEQ applies the NEQ predicate on the "avatar_url" field.
+func AvatarURLNEQ(v string) predicate.ChatRoom {
+	return predicate.ChatRoom(sql.FieldNEQ(FieldAvatarURL, v))
+}
+
+// AvatarURLIn applies the In predicate on the "avatar_url" field.
+func AvatarURLIn(vs ...string) predicate.ChatRoom {
+	return predicate.ChatRoom(sql.FieldIn(FieldAvatarURL, vs...))
+}
+
+// AvatarURLNotIn applies the NotIn predicate on the "avatar_url" field.
+func AvatarURLNotIn(vs ...string) predicate.ChatRoom {
+	return predicate.ChatRoom(sql.FieldNotIn(FieldAvatarURL, vs...))
+}
+
+// AvatarURLGT applies the GT predicate on the "avatar_url" field.
+func AvatarURLGT(v string) predicate.ChatRoom {
+	return predicate.ChatRoom(sql.FieldGT(FieldAvatarURL, v))
+}
+
+// AvatarURLGTE applies the GTE predicate on the "avatar_url" field.
+func AvatarURLGTE(v string) predicate.ChatRoom {
+	return predicate.ChatRoom(sql.FieldGTE(FieldAvatarURL, v))
+}
+
+// AvatarURLLT applies the LT predicate on the "avatar_url" field.
+func AvatarURLLT(v string) predicate.ChatRoom {
+	return predicate.ChatRoom(sql.FieldLT(FieldAvatarURL, v))
+}
+
+// AvatarURLLTE applies the LTE predicate on the "avatar_url" field.
+func AvatarURLLTE(v string) predicate.ChatRoom {
+	return predicate.ChatRoom(sql.FieldLTE(FieldAvatarURL, v))
+}
+
+// AvatarURLContains applies the Contains predicate on the "avatar_url" field.
+func AvatarURLContains(v string) predicate.ChatRoom {
+	return predicate.ChatRoom(sql.FieldContains(FieldAvatarURL, v))
+}
+
+// AvatarURLHasPrefix applies the HasPrefix predicate on the "avatar_url" field.
+func AvatarURLHasPrefix(v string) predicate.ChatRoom {
+	return predicate.ChatRoom(sql.FieldHasPrefix(FieldAvatarURL, v))
+}
+
+// AvatarURLHasSuffix applies the HasSuffix predicate on the "avatar_url" field.
+func AvatarURLHasSuffix(v string) predicate.ChatRoom {
+	return predicate.ChatRoom(sql.FieldHasSuffix(FieldAvatarURL, v))
+}
+
+// AvatarURLIsNil applies the IsNil predicate on the "avatar_url" field.
+func AvatarURLIsNil() predicate.ChatRoom {
+	return predicate.ChatRoom(sql.FieldIsNull(FieldAvatarURL))
+}
+
+// AvatarURLNotNil applies the NotNil predicate on the "avatar_url" field.
+func AvatarURLNotNil() predicate.ChatRoom {
+	return predicate.ChatRoom(sql.FieldNotNull(FieldAvatarURL))
+}
+
+// AvatarURLEqualFold applies the EqualFold predicate on the "avatar_url" field.
+func AvatarURLEqualFold(v string) predicate.ChatRoom {
+	return predicate.ChatRoom(sql.FieldEqualFold(FieldAvatarURL, v))
+}
+
+// AvatarURLContainsFold applies the ContainsFold predicate on the "avatar_url" field.
+func AvatarURLContainsFold(v string) predicate.ChatRoom {
+	return predicate.ChatRoom(sql.FieldContainsFold(FieldAvatarURL, v))
+}
+
 // IsGroupChatEQ applies the EQ predicate on the "is_group_chat" field.
 func IsGroupChatEQ(v bool) predicate.ChatRoom {
 	return predicate.ChatRoom(sql.FieldEQ(FieldIsGroupChat, v))
@@ -151,6 +416,281 @@ func IsGroupChatNEQ(v bool) predicate.ChatRoom {
 	return predicate.ChatRoom(sql.FieldNEQ(FieldIsGroupChat, v))
 }
 
+// DmKeyEQ applies the EQ predicate on the "dm_key" field.
+func DmKeyEQ(v string) predicate.ChatRoom {
+	return predicate.ChatRoom(sql.FieldEQ(FieldDmKey, v))
+}
+
+// DmKeyNEQ applies the NEQ predicate on the "dm_key" field.
+func DmKeyNEQ(v string) predicate.ChatRoom {
+	return predicate.ChatRoom(sql.FieldNEQ(FieldDmKey, v))
+}
+
+// DmKeyIn applies the In predicate on the "dm_key" field.
+func DmKeyIn(vs ...string) predicate.ChatRoom {
+	return predicate.ChatRoom(sql.FieldIn(FieldDmKey, vs...))
+}
+
+// DmKeyNotIn applies the NotIn predicate on the "dm_key" field.
+func DmKeyNotIn(vs ...string) predicate.ChatRoom {
+	return predicate.ChatRoom(sql.FieldNotIn(FieldDmKey, vs...))
+}
+
+// DmKeyGT applies the GT predicate on the "dm_key" field.
+func DmKeyGT(v string) predicate.ChatRoom {
+	return predicate.ChatRoom(sql.FieldGT(FieldDmKey, v))
+}
+
+// DmKeyGTE applies the GTE predicate on the "dm_key" field.
+func DmKeyGTE(v string) predicate.ChatRoom {
+	return predicate.ChatRoom(sql.FieldGTE(FieldDmKey, v))
+}
+
+// DmKeyLT applies the LT predicate on the "dm_key" field.
+func DmKeyLT(v string) predicate.ChatRoom {
+	return predicate.ChatRoom(sql.FieldLT(FieldDmKey, v))
+}
+
+// DmKeyLTE applies the LTE predicate on the "dm_key" field.
+func DmKeyLTE(v string) predicate.ChatRoom {
+	return predicate.ChatRoom(sql.FieldLTE(FieldDmKey, v))
+}
+
+// DmKeyContains applies the Contains predicate on the "dm_key" field.
+func DmKeyContains(v string) predicate.ChatRoom {
+	return predicate.ChatRoom(sql.FieldContains(FieldDmKey, v))
+}
+
+// DmKeyHasPrefix applies the HasPrefix predicate on the "dm_key" field.
+func DmKeyHasPrefix(v string) predicate.ChatRoom {
+	return predicate.ChatRoom(sql.FieldHasPrefix(FieldDmKey, v))
+}
+
+// DmKeyHasSuffix applies the HasSuffix predicate on the "dm_key" field.
+func DmKeyHasSuffix(v string) predicate.ChatRoom {
+	return predicate.ChatRoom(sql.FieldHasSuffix(FieldDmKey, v))
+}
+
+// DmKeyIsNil applies the IsNil predicate on the "dm_key" field.
+func DmKeyIsNil() predicate.ChatRoom {
+	return predicate.ChatRoom(sql.FieldIsNull(FieldDmKey))
+}
+
+// DmKeyNotNil applies the NotNil predicate on the "dm_key" field.
+func DmKeyNotNil() predicate.ChatRoom {
+	return predicate.ChatRoom(sql.FieldNotNull(FieldDmKey))
+}
+
+// DmKeyEqualFold applies the EqualFold predicate on the "dm_key" field.
+func DmKeyEqualFold(v string) predicate.ChatRoom {
+	return predicate.ChatRoom(sql.FieldEqualFold(FieldDmKey, v))
+}
+
+// DmKeyContainsFold applies the ContainsFold predicate on the "dm_key" field.
+func DmKeyContainsFold(v string) predicate.ChatRoom {
+	return predicate.ChatRoom(sql.FieldContainsFold(FieldDmKey, v))
+}
+
+// VisibilityEQ applies the EQ predicate on the "visibility" field.
+func VisibilityEQ(v Visibility) predicate.ChatRoom {
+	return predicate.ChatRoom(sql.FieldEQ(FieldVisibility, v))
+}
+
+// VisibilityNEQ applies the NEQ predicate on the "visibility" field.
+func VisibilityNEQ(v Visibility) predicate.ChatRoom {
+	return predicate.ChatRoom(sql.FieldNEQ(FieldVisibility, v))
+}
+
+// VisibilityIn applies the In predicate on the "visibility" field.
+func VisibilityIn(vs ...Visibility) predicate.ChatRoom {
+	return predicate.ChatRoom(sql.FieldIn(FieldVisibility, vs...))
+}
+
+// VisibilityNotIn applies the NotIn predicate on the "visibility" field.
+func VisibilityNotIn(vs ...Visibility) predicate.ChatRoom {
+	return predicate.ChatRoom(sql.FieldNotIn(FieldVisibility, vs...))
+}
+
+// EditWindowSecondsEQ applies the EQ predicate on the "edit_window_seconds" field.
+func EditWindowSecondsEQ(v int) predicate.ChatRoom {
+	return predicate.ChatRoom(sql.FieldEQ(FieldEditWindowSeconds, v))
+}
+
+// EditWindowSecondsNEQ applies the NEQ predicate on the "edit_window_seconds" field.
+func EditWindowSecondsNEQ(v int) predicate.ChatRoom {
+	return predicate.ChatRoom(sql.FieldNEQ(FieldEditWindowSeconds, v))
+}
+
+// EditWindowSecondsIn applies the In predicate on the "edit_window_seconds" field.
+func EditWindowSecondsIn(vs ...int) predicate.ChatRoom {
+	return predicate.ChatRoom(sql.FieldIn(FieldEditWindowSeconds, vs...))
+}
+
+// EditWindowSecondsNotIn applies the NotIn predicate on the "edit_window_seconds" field.
+func EditWindowSecondsNotIn(vs ...int) predicate.ChatRoom {
+	return predicate.ChatRoom(sql.FieldNotIn(FieldEditWindowSeconds, vs...))
+}
+
+// EditWindowSecondsGT applies the GT predicate on the "edit_window_seconds" field.
+func EditWindowSecondsGT(v int) predicate.ChatRoom {
+	return predicate.ChatRoom(sql.FieldGT(FieldEditWindowSeconds, v))
+}
+
+// EditWindowSecondsGTE applies the GTE predicate on the "edit_window_seconds" field.
+func EditWindowSecondsGTE(v int) predicate.ChatRoom {
+	return predicate.ChatRoom(sql.FieldGTE(FieldEditWindowSeconds, v))
+}
+
+// EditWindowSecondsLT applies the LT predicate on the "edit_window_seconds" field.
+func EditWindowSecondsLT(v int) predicate.ChatRoom {
+	return predicate.ChatRoom(sql.FieldLT(FieldEditWindowSeconds, v))
+}
+
+// EditWindowSecondsLTE applies the LTE predicate on the "edit_window_seconds" field.
+func EditWindowSecondsLTE(v int) predicate.ChatRoom {
+	return predicate.ChatRoom(sql.FieldLTE(FieldEditWindowSeconds, v))
+}
+
+// EditWindowSecondsIsNil applies the IsNil predicate on the "edit_window_seconds" field.
+func EditWindowSecondsIsNil() predicate.ChatRoom {
+	return predicate.ChatRoom(sql.FieldIsNull(FieldEditWindowSeconds))
+}
+
+// EditWindowSecondsNotNil applies the NotNil predicate on the "edit_window_seconds" field.
+func EditWindowSecondsNotNil() predicate.ChatRoom {
+	return predicate.ChatRoom(sql.FieldNotNull(FieldEditWindowSeconds))
+}
+
+// ArchivedAtEQ applies the EQ predicate on the "archived_at" field.
+func ArchivedAtEQ(v time.Time) predicate.ChatRoom {
+	return predicate.ChatRoom(sql.FieldEQ(FieldArchivedAt, v))
+}
+
+// ArchivedAtNEQ applies the NEQ predicate on the "archived_at" field.
+func ArchivedAtNEQ(v time.Time) predicate.ChatRoom {
+	return predicate.ChatRoom(sql.FieldNEQ(FieldArchivedAt, v))
+}
+
+// ArchivedAtIn applies the In predicate on the "archived_at" field.
+func ArchivedAtIn(vs ...time.Time) predicate.ChatRoom {
+	return predicate.ChatRoom(sql.FieldIn(FieldArchivedAt, vs...))
+}
+
+// ArchivedAtNotIn applies the NotIn predicate on the "archived_at" field.
+func ArchivedAtNotIn(vs ...time.Time) predicate.ChatRoom {
+	return predicate.ChatRoom(sql.FieldNotIn(FieldArchivedAt, vs...))
+}
+
+// ArchivedAtGT applies the GT predicate on the "archived_at" field.
+func ArchivedAtGT(v time.Time) predicate.ChatRoom {
+	return predicate.ChatRoom(sql.FieldGT(FieldArchivedAt, v))
+}
+
+// ArchivedAtGTE applies the GTE predicate on the "archived_at" field.
+func ArchivedAtGTE(v time.Time) predicate.ChatRoom {
+	return predicate.ChatRoom(sql.FieldGTE(FieldArchivedAt, v))
+}
+
+// ArchivedAtLT applies the LT predicate on the "archived_at" field.
+func ArchivedAtLT(v time.Time) predicate.ChatRoom {
+	return predicate.ChatRoom(sql.FieldLT(FieldArchivedAt, v))
+}
+
+// ArchivedAtLTE applies the LTE predicate on the "archived_at" field.
+func ArchivedAtLTE(v time.Time) predicate.ChatRoom {
+	return predicate.ChatRoom(sql.FieldLTE(FieldArchivedAt, v))
+}
+
+// ArchivedAtIsNil applies the IsNil predicate on the "archived_at" field.
+func ArchivedAtIsNil() predicate.ChatRoom {
+	return predicate.ChatRoom(sql.FieldIsNull(FieldArchivedAt))
+}
+
+// ArchivedAtNotNil applies the NotNil predicate on the "archived_at" field.
+func ArchivedAtNotNil() predicate.ChatRoom {
+	return predicate.ChatRoom(sql.FieldNotNull(FieldArchivedAt))
+}
+
+// LastMessageAtEQ applies the EQ predicate on the "last_message_at" field.
+func LastMessageAtEQ(v time.Time) predicate.ChatRoom {
+	return predicate.ChatRoom(sql.FieldEQ(FieldLastMessageAt, v))
+}
+
+// LastMessageAtNEQ applies the NEQ predicate on the "last_message_at" field.
+func LastMessageAtNEQ(v time.Time) predicate.ChatRoom {
+	return predicate.ChatRoom(sql.FieldNEQ(FieldLastMessageAt, v))
+}
+
+// LastMessageAtIn applies the In predicate on the "last_message_at" field.
+func LastMessageAtIn(vs ...time.Time) predicate.ChatRoom {
+	return predicate.ChatRoom(sql.FieldIn(FieldLastMessageAt, vs...))
+}
+
+// LastMessageAtNotIn applies the NotIn predicate on the "last_message_at" field.
+func LastMessageAtNotIn(vs ...time.Time) predicate.ChatRoom {
+	return predicate.ChatRoom(sql.FieldNotIn(FieldLastMessageAt, vs...))
+}
+
+// LastMessageAtGT applies the GT predicate on the "last_message_at" field.
+func LastMessageAtGT(v time.Time) predicate.ChatRoom {
+	return predicate.ChatRoom(sql.FieldGT(FieldLastMessageAt, v))
+}
+
+// LastMessageAtGTE applies the GTE predicate on the "last_message_at" field.
+func LastMessageAtGTE(v time.Time) predicate.ChatRoom {
+	return predicate.ChatRoom(sql.FieldGTE(FieldLastMessageAt, v))
+}
+
+// LastMessageAtLT applies the LT predicate on the "last_message_at" field.
+func LastMessageAtLT(v time.Time) predicate.ChatRoom {
+	return predicate.ChatRoom(sql.FieldLT(FieldLastMessageAt, v))
+}
+
+// LastMessageAtLTE applies the LTE predicate on the "last_message_at" field.
+func LastMessageAtLTE(v time.Time) predicate.ChatRoom {
+	return predicate.ChatRoom(sql.FieldLTE(FieldLastMessageAt, v))
+}
+
+// LastMessageAtIsNil applies the IsNil predicate on the "last_message_at" field.
+func LastMessageAtIsNil() predicate.ChatRoom {
+	return predicate.ChatRoom(sql.FieldIsNull(FieldLastMessageAt))
+}
+
+// LastMessageAtNotNil applies the NotNil predicate on the "last_message_at" field.
+func LastMessageAtNotNil() predicate.ChatRoom {
+	return predicate.ChatRoom(sql.FieldNotNull(FieldLastMessageAt))
+}
+
+// LastMessageIDEQ applies the EQ predicate on the "last_message_id" field.
+func LastMessageIDEQ(v uuid.UUID) predicate.ChatRoom {
+	return predicate.ChatRoom(sql.FieldEQ(FieldLastMessageID, v))
+}
+
+// LastMessageIDNEQ applies the NEQ predicate on the "last_message_id" field.
+func LastMessageIDNEQ(v uuid.UUID) predicate.ChatRoom {
+	return predicate.ChatRoom(sql.FieldNEQ(FieldLastMessageID, v))
+}
+
+// LastMessageIDIn applies the In predicate on the "last_message_id" field.
+func LastMessageIDIn(vs ...uuid.UUID) predicate.ChatRoom {
+	return predicate.ChatRoom(sql.FieldIn(FieldLastMessageID, vs...))
+}
+
+// LastMessageIDNotIn applies the NotIn predicate on the "last_message_id" field.
+func LastMessageIDNotIn(vs ...uuid.UUID) predicate.ChatRoom {
+	return predicate.ChatRoom(sql.FieldNotIn(FieldLastMessageID, vs...))
+}
+
+// LastMessageIDIsNil applies the IsNil predicate on the "last_message_id" field.
+func LastMessageIDIsNil() predicate.ChatRoom {
+	return predicate.ChatRoom(sql.FieldIsNull(FieldLastMessageID))
+}
+
+// LastMessageIDNotNil applies the NotNil predicate on the "last_message_id" field.
+func LastMessageIDNotNil() predicate.ChatRoom {
+	return predicate.ChatRoom(sql.FieldNotNull(FieldLastMessageID))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.ChatRoom {
 	return predicate.ChatRoom(sql.FieldEQ(FieldCreatedAt, v))
@@ -277,6 +817,98 @@ func HasMessagesWith(preds ...predicate.Message) predicate.ChatRoom {
 	})
 }
 
+// HasLastMessage applies the HasEdge predicate on the "last_message" edge.
+func HasLastMessage() predicate.ChatRoom {
+	return predicate.ChatRoom(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, LastMessageTable, LastMessageColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasLastMessageWith applies the HasEdge predicate on the "last_message" edge with a given conditions (other predicates).
+func HasLastMessageWith(preds ...predicate.Message) predicate.ChatRoom {
+	return predicate.ChatRoom(func(s *sql.Selector) {
+		step := newLastMessageStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasMessageReads applies the HasEdge predicate on the "message_reads" edge.
+func HasMessageReads() predicate.ChatRoom {
+	return predicate.ChatRoom(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, MessageReadsTable, MessageReadsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMessageReadsWith applies the HasEdge predicate on the "message_reads" edge with a given conditions (other predicates).
+func HasMessageReadsWith(preds ...predicate.MessageRead) predicate.ChatRoom {
+	return predicate.ChatRoom(func(s *sql.Selector) {
+		step := newMessageReadsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasInvites applies the HasEdge predicate on the "invites" edge.
+func HasInvites() predicate.ChatRoom {
+	return predicate.ChatRoom(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, InvitesTable, InvitesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasInvitesWith applies the HasEdge predicate on the "invites" edge with a given conditions (other predicates).
+func HasInvitesWith(preds ...predicate.Invite) predicate.ChatRoom {
+	return predicate.ChatRoom(func(s *sql.Selector) {
+		step := newInvitesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasJoinRequests applies the HasEdge predicate on the "join_requests" edge.
+func HasJoinRequests() predicate.ChatRoom {
+	return predicate.ChatRoom(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, JoinRequestsTable, JoinRequestsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasJoinRequestsWith applies the HasEdge predicate on the "join_requests" edge with a given conditions (other predicates).
+func HasJoinRequestsWith(preds ...predicate.JoinRequest) predicate.ChatRoom {
+	return predicate.ChatRoom(func(s *sql.Selector) {
+		step := newJoinRequestsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.ChatRoom) predicate.ChatRoom {
 	return predicate.ChatRoom(sql.AndPredicates(predicates...))
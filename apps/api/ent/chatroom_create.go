@@ -12,7 +12,10 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
 	"github.com/hideaki1979/cc-chat-app/apps/api/ent/chatroom"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/invite"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/joinrequest"
 	"github.com/hideaki1979/cc-chat-app/apps/api/ent/message"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/messageread"
 	"github.com/hideaki1979/cc-chat-app/apps/api/ent/roommember"
 )
 
@@ -29,6 +32,48 @@ func (crc *ChatRoomCreate) SetName(s string) *ChatRoomCreate {
 	return crc
 }
 
+// SetDescription sets the "description" field.
+func (crc *ChatRoomCreate) SetDescription(s string) *ChatRoomCreate {
+	crc.mutation.SetDescription(s)
+	return crc
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (crc *ChatRoomCreate) SetNillableDescription(s *string) *ChatRoomCreate {
+	if s != nil {
+		crc.SetDescription(*s)
+	}
+	return crc
+}
+
+// SetTopic sets the "topic" field.
+func (crc *ChatRoomCreate) SetTopic(s string) *ChatRoomCreate {
+	crc.mutation.SetTopic(s)
+	return crc
+}
+
+// SetNillableTopic sets the "topic" field if the given value is not nil.
+func (crc *ChatRoomCreate) SetNillableTopic(s *string) *ChatRoomCreate {
+	if s != nil {
+		crc.SetTopic(*s)
+	}
+	return crc
+}
+
+// SetAvatarURL sets the "avatar_url" field.
+func (crc *ChatRoomCreate) SetAvatarURL(s string) *ChatRoomCreate {
+	crc.mutation.SetAvatarURL(s)
+	return crc
+}
+
+// SetNillableAvatarURL sets the "avatar_url" field if the given value is not nil.
+func (crc *ChatRoomCreate) SetNillableAvatarURL(s *string) *ChatRoomCreate {
+	if s != nil {
+		crc.SetAvatarURL(*s)
+	}
+	return crc
+}
+
 // SetIsGroupChat sets the "is_group_chat" field.
 func (crc *ChatRoomCreate) SetIsGroupChat(b bool) *ChatRoomCreate {
 	crc.mutation.SetIsGroupChat(b)
@@ -43,6 +88,90 @@ func (crc *ChatRoomCreate) SetNillableIsGroupChat(b *bool) *ChatRoomCreate {
 	return crc
 }
 
+// SetDmKey sets the "dm_key" field.
+func (crc *ChatRoomCreate) SetDmKey(s string) *ChatRoomCreate {
+	crc.mutation.SetDmKey(s)
+	return crc
+}
+
+// SetNillableDmKey sets the "dm_key" field if the given value is not nil.
+func (crc *ChatRoomCreate) SetNillableDmKey(s *string) *ChatRoomCreate {
+	if s != nil {
+		crc.SetDmKey(*s)
+	}
+	return crc
+}
+
+// SetVisibility sets the "visibility" field.
+func (crc *ChatRoomCreate) SetVisibility(c chatroom.Visibility) *ChatRoomCreate {
+	crc.mutation.SetVisibility(c)
+	return crc
+}
+
+// SetNillableVisibility sets the "visibility" field if the given value is not nil.
+func (crc *ChatRoomCreate) SetNillableVisibility(c *chatroom.Visibility) *ChatRoomCreate {
+	if c != nil {
+		crc.SetVisibility(*c)
+	}
+	return crc
+}
+
+// SetEditWindowSeconds sets the "edit_window_seconds" field.
+func (crc *ChatRoomCreate) SetEditWindowSeconds(i int) *ChatRoomCreate {
+	crc.mutation.SetEditWindowSeconds(i)
+	return crc
+}
+
+// SetNillableEditWindowSeconds sets the "edit_window_seconds" field if the given value is not nil.
+func (crc *ChatRoomCreate) SetNillableEditWindowSeconds(i *int) *ChatRoomCreate {
+	if i != nil {
+		crc.SetEditWindowSeconds(*i)
+	}
+	return crc
+}
+
+// SetArchivedAt sets the "archived_at" field.
+func (crc *ChatRoomCreate) SetArchivedAt(t time.Time) *ChatRoomCreate {
+	crc.mutation.SetArchivedAt(t)
+	return crc
+}
+
+// SetNillableArchivedAt sets the "archived_at" field if the given value is not nil.
+func (crc *ChatRoomCreate) SetNillableArchivedAt(t *time.Time) *ChatRoomCreate {
+	if t != nil {
+		crc.SetArchivedAt(*t)
+	}
+	return crc
+}
+
+// SetLastMessageAt sets the "last_message_at" field.
+func (crc *ChatRoomCreate) SetLastMessageAt(t time.Time) *ChatRoomCreate {
+	crc.mutation.SetLastMessageAt(t)
+	return crc
+}
+
+// SetNillableLastMessageAt sets the "last_message_at" field if the given value is not nil.
+func (crc *ChatRoomCreate) SetNillableLastMessageAt(t *time.Time) *ChatRoomCreate {
+	if t != nil {
+		crc.SetLastMessageAt(*t)
+	}
+	return crc
+}
+
+// SetLastMessageID sets the "last_message_id" field.
+func (crc *ChatRoomCreate) SetLastMessageID(u uuid.UUID) *ChatRoomCreate {
+	crc.mutation.SetLastMessageID(u)
+	return crc
+}
+
+// SetNillableLastMessageID sets the "last_message_id" field if the given value is not nil.
+func (crc *ChatRoomCreate) SetNillableLastMessageID(u *uuid.UUID) *ChatRoomCreate {
+	if u != nil {
+		crc.SetLastMessageID(*u)
+	}
+	return crc
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (crc *ChatRoomCreate) SetCreatedAt(t time.Time) *ChatRoomCreate {
 	crc.mutation.SetCreatedAt(t)
@@ -115,6 +244,56 @@ func (crc *ChatRoomCreate) AddMessages(m ...*Message) *ChatRoomCreate {
 	return crc.AddMessageIDs(ids...)
 }
 
+// SetLastMessage sets the "last_message" edge to the Message entity.
+func (crc *ChatRoomCreate) SetLastMessage(m *Message) *ChatRoomCreate {
+	return crc.SetLastMessageID(m.ID)
+}
+
+// AddMessageReadIDs adds the "message_reads" edge to the MessageRead entity by IDs.
+func (crc *ChatRoomCreate) AddMessageReadIDs(ids ...int64) *ChatRoomCreate {
+	crc.mutation.AddMessageReadIDs(ids...)
+	return crc
+}
+
+// AddMessageReads adds the "message_reads" edges to the MessageRead entity.
+func (crc *ChatRoomCreate) AddMessageReads(m ...*MessageRead) *ChatRoomCreate {
+	ids := make([]int64, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return crc.AddMessageReadIDs(ids...)
+}
+
+// AddInviteIDs adds the "invites" edge to the Invite entity by IDs.
+func (crc *ChatRoomCreate) AddInviteIDs(ids ...uuid.UUID) *ChatRoomCreate {
+	crc.mutation.AddInviteIDs(ids...)
+	return crc
+}
+
+// AddInvites adds the "invites" edges to the Invite entity.
+func (crc *ChatRoomCreate) AddInvites(i ...*Invite) *ChatRoomCreate {
+	ids := make([]uuid.UUID, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return crc.AddInviteIDs(ids...)
+}
+
+// AddJoinRequestIDs adds the "join_requests" edge to the JoinRequest entity by IDs.
+func (crc *ChatRoomCreate) AddJoinRequestIDs(ids ...int64) *ChatRoomCreate {
+	crc.mutation.AddJoinRequestIDs(ids...)
+	return crc
+}
+
+// AddJoinRequests adds the "join_requests" edges to the JoinRequest entity.
+func (crc *ChatRoomCreate) AddJoinRequests(j ...*JoinRequest) *ChatRoomCreate {
+	ids := make([]int64, len(j))
+	for i := range j {
+		ids[i] = j[i].ID
+	}
+	return crc.AddJoinRequestIDs(ids...)
+}
+
 // Mutation returns the ChatRoomMutation object of the builder.
 func (crc *ChatRoomCreate) Mutation() *ChatRoomMutation {
 	return crc.mutation
@@ -154,6 +333,10 @@ func (crc *ChatRoomCreate) defaults() {
 		v := chatroom.DefaultIsGroupChat
 		crc.mutation.SetIsGroupChat(v)
 	}
+	if _, ok := crc.mutation.Visibility(); !ok {
+		v := chatroom.DefaultVisibility
+		crc.mutation.SetVisibility(v)
+	}
 	if _, ok := crc.mutation.CreatedAt(); !ok {
 		v := chatroom.DefaultCreatedAt()
 		crc.mutation.SetCreatedAt(v)
@@ -181,6 +364,19 @@ func (crc *ChatRoomCreate) check() error {
 	if _, ok := crc.mutation.IsGroupChat(); !ok {
 		return &ValidationError{Name: "is_group_chat", err: errors.New(`ent: missing required field "ChatRoom.is_group_chat"`)}
 	}
+	if _, ok := crc.mutation.Visibility(); !ok {
+		return &ValidationError{Name: "visibility", err: errors.New(`ent: missing required field "ChatRoom.visibility"`)}
+	}
+	if v, ok := crc.mutation.Visibility(); ok {
+		if err := chatroom.VisibilityValidator(v); err != nil {
+			return &ValidationError{Name: "visibility", err: fmt.Errorf(`ent: validator failed for field "ChatRoom.visibility": %w`, err)}
+		}
+	}
+	if v, ok := crc.mutation.EditWindowSeconds(); ok {
+		if err := chatroom.EditWindowSecondsValidator(v); err != nil {
+			return &ValidationError{Name: "edit_window_seconds", err: fmt.Errorf(`ent: validator failed for field "ChatRoom.edit_window_seconds": %w`, err)}
+		}
+	}
 	if _, ok := crc.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ChatRoom.created_at"`)}
 	}
@@ -226,10 +422,42 @@ func (crc *ChatRoomCreate) createSpec() (*ChatRoom, *sqlgraph.CreateSpec) {
 		_spec.SetField(chatroom.FieldName, field.TypeString, value)
 		_node.Name = value
 	}
+	if value, ok := crc.mutation.Description(); ok {
+		_spec.SetField(chatroom.FieldDescription, field.TypeString, value)
+		_node.Description = &value
+	}
+	if value, ok := crc.mutation.Topic(); ok {
+		_spec.SetField(chatroom.FieldTopic, field.TypeString, value)
+		_node.Topic = &value
+	}
+	if value, ok := crc.mutation.AvatarURL(); ok {
+		_spec.SetField(chatroom.FieldAvatarURL, field.TypeString, value)
+		_node.AvatarURL = &value
+	}
 	if value, ok := crc.mutation.IsGroupChat(); ok {
 		_spec.SetField(chatroom.FieldIsGroupChat, field.TypeBool, value)
 		_node.IsGroupChat = value
 	}
+	if value, ok := crc.mutation.DmKey(); ok {
+		_spec.SetField(chatroom.FieldDmKey, field.TypeString, value)
+		_node.DmKey = &value
+	}
+	if value, ok := crc.mutation.Visibility(); ok {
+		_spec.SetField(chatroom.FieldVisibility, field.TypeEnum, value)
+		_node.Visibility = value
+	}
+	if value, ok := crc.mutation.EditWindowSeconds(); ok {
+		_spec.SetField(chatroom.FieldEditWindowSeconds, field.TypeInt, value)
+		_node.EditWindowSeconds = &value
+	}
+	if value, ok := crc.mutation.ArchivedAt(); ok {
+		_spec.SetField(chatroom.FieldArchivedAt, field.TypeTime, value)
+		_node.ArchivedAt = &value
+	}
+	if value, ok := crc.mutation.LastMessageAt(); ok {
+		_spec.SetField(chatroom.FieldLastMessageAt, field.TypeTime, value)
+		_node.LastMessageAt = &value
+	}
 	if value, ok := crc.mutation.CreatedAt(); ok {
 		_spec.SetField(chatroom.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -270,6 +498,71 @@ func (crc *ChatRoomCreate) createSpec() (*ChatRoom, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := crc.mutation.LastMessageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   chatroom.LastMessageTable,
+			Columns: []string{chatroom.LastMessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.LastMessageID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := crc.mutation.MessageReadsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   chatroom.MessageReadsTable,
+			Columns: []string{chatroom.MessageReadsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messageread.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := crc.mutation.InvitesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   chatroom.InvitesTable,
+			Columns: []string{chatroom.InvitesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invite.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := crc.mutation.JoinRequestsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   chatroom.JoinRequestsTable,
+			Columns: []string{chatroom.JoinRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(joinrequest.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
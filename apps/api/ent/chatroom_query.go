@@ -9,12 +9,16 @@ import (
 	"math"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
 	"github.com/hideaki1979/cc-chat-app/apps/api/ent/chatroom"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/invite"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/joinrequest"
 	"github.com/hideaki1979/cc-chat-app/apps/api/ent/message"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/messageread"
 	"github.com/hideaki1979/cc-chat-app/apps/api/ent/predicate"
 	"github.com/hideaki1979/cc-chat-app/apps/api/ent/roommember"
 )
@@ -22,12 +26,17 @@ import (
 // ChatRoomQuery is the builder for querying ChatRoom entities.
 type ChatRoomQuery struct {
 	config
-	ctx             *QueryContext
-	order           []chatroom.OrderOption
-	inters          []Interceptor
-	predicates      []predicate.ChatRoom
-	withRoomMembers *RoomMemberQuery
-	withMessages    *MessageQuery
+	ctx              *QueryContext
+	order            []chatroom.OrderOption
+	inters           []Interceptor
+	predicates       []predicate.ChatRoom
+	withRoomMembers  *RoomMemberQuery
+	withMessages     *MessageQuery
+	withLastMessage  *MessageQuery
+	withMessageReads *MessageReadQuery
+	withInvites      *InviteQuery
+	withJoinRequests *JoinRequestQuery
+	modifiers        []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -108,6 +117,94 @@ func (crq *ChatRoomQuery) QueryMessages() *MessageQuery {
 	return query
 }
 
+// QueryLastMessage chains the current query on the "last_message" edge.
+func (crq *ChatRoomQuery) QueryLastMessage() *MessageQuery {
+	query := (&MessageClient{config: crq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := crq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := crq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(chatroom.Table, chatroom.FieldID, selector),
+			sqlgraph.To(message.Table, message.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, chatroom.LastMessageTable, chatroom.LastMessageColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(crq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryMessageReads chains the current query on the "message_reads" edge.
+func (crq *ChatRoomQuery) QueryMessageReads() *MessageReadQuery {
+	query := (&MessageReadClient{config: crq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := crq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := crq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(chatroom.Table, chatroom.FieldID, selector),
+			sqlgraph.To(messageread.Table, messageread.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, chatroom.MessageReadsTable, chatroom.MessageReadsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(crq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryInvites chains the current query on the "invites" edge.
+func (crq *ChatRoomQuery) QueryInvites() *InviteQuery {
+	query := (&InviteClient{config: crq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := crq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := crq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(chatroom.Table, chatroom.FieldID, selector),
+			sqlgraph.To(invite.Table, invite.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, chatroom.InvitesTable, chatroom.InvitesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(crq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryJoinRequests chains the current query on the "join_requests" edge.
+func (crq *ChatRoomQuery) QueryJoinRequests() *JoinRequestQuery {
+	query := (&JoinRequestClient{config: crq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := crq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := crq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(chatroom.Table, chatroom.FieldID, selector),
+			sqlgraph.To(joinrequest.Table, joinrequest.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, chatroom.JoinRequestsTable, chatroom.JoinRequestsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(crq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first ChatRoom entity from the query.
 // Returns a *NotFoundError when no ChatRoom was found.
 func (crq *ChatRoomQuery) First(ctx context.Context) (*ChatRoom, error) {
@@ -295,13 +392,17 @@ func (crq *ChatRoomQuery) Clone() *ChatRoomQuery {
 		return nil
 	}
 	return &ChatRoomQuery{
-		config:          crq.config,
-		ctx:             crq.ctx.Clone(),
-		order:           append([]chatroom.OrderOption{}, crq.order...),
-		inters:          append([]Interceptor{}, crq.inters...),
-		predicates:      append([]predicate.ChatRoom{}, crq.predicates...),
-		withRoomMembers: crq.withRoomMembers.Clone(),
-		withMessages:    crq.withMessages.Clone(),
+		config:           crq.config,
+		ctx:              crq.ctx.Clone(),
+		order:            append([]chatroom.OrderOption{}, crq.order...),
+		inters:           append([]Interceptor{}, crq.inters...),
+		predicates:       append([]predicate.ChatRoom{}, crq.predicates...),
+		withRoomMembers:  crq.withRoomMembers.Clone(),
+		withMessages:     crq.withMessages.Clone(),
+		withLastMessage:  crq.withLastMessage.Clone(),
+		withMessageReads: crq.withMessageReads.Clone(),
+		withInvites:      crq.withInvites.Clone(),
+		withJoinRequests: crq.withJoinRequests.Clone(),
 		// clone intermediate query.
 		sql:  crq.sql.Clone(),
 		path: crq.path,
@@ -330,6 +431,50 @@ func (crq *ChatRoomQuery) WithMessages(opts ...func(*MessageQuery)) *ChatRoomQue
 	return crq
 }
 
+// WithLastMessage tells the query-builder to eager-load the nodes that are connected to
+// the "last_message" edge. The optional arguments are used to configure the query builder of the edge.
+func (crq *ChatRoomQuery) WithLastMessage(opts ...func(*MessageQuery)) *ChatRoomQuery {
+	query := (&MessageClient{config: crq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	crq.withLastMessage = query
+	return crq
+}
+
+// WithMessageReads tells the query-builder to eager-load the nodes that are connected to
+// the "message_reads" edge. The optional arguments are used to configure the query builder of the edge.
+func (crq *ChatRoomQuery) WithMessageReads(opts ...func(*MessageReadQuery)) *ChatRoomQuery {
+	query := (&MessageReadClient{config: crq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	crq.withMessageReads = query
+	return crq
+}
+
+// WithInvites tells the query-builder to eager-load the nodes that are connected to
+// the "invites" edge. The optional arguments are used to configure the query builder of the edge.
+func (crq *ChatRoomQuery) WithInvites(opts ...func(*InviteQuery)) *ChatRoomQuery {
+	query := (&InviteClient{config: crq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	crq.withInvites = query
+	return crq
+}
+
+// WithJoinRequests tells the query-builder to eager-load the nodes that are connected to
+// the "join_requests" edge. The optional arguments are used to configure the query builder of the edge.
+func (crq *ChatRoomQuery) WithJoinRequests(opts ...func(*JoinRequestQuery)) *ChatRoomQuery {
+	query := (&JoinRequestClient{config: crq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	crq.withJoinRequests = query
+	return crq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -408,9 +553,13 @@ func (crq *ChatRoomQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Ch
 	var (
 		nodes       = []*ChatRoom{}
 		_spec       = crq.querySpec()
-		loadedTypes = [2]bool{
+		loadedTypes = [6]bool{
 			crq.withRoomMembers != nil,
 			crq.withMessages != nil,
+			crq.withLastMessage != nil,
+			crq.withMessageReads != nil,
+			crq.withInvites != nil,
+			crq.withJoinRequests != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -422,6 +571,9 @@ func (crq *ChatRoomQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Ch
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(crq.modifiers) > 0 {
+		_spec.Modifiers = crq.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -445,6 +597,33 @@ func (crq *ChatRoomQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Ch
 			return nil, err
 		}
 	}
+	if query := crq.withLastMessage; query != nil {
+		if err := crq.loadLastMessage(ctx, query, nodes, nil,
+			func(n *ChatRoom, e *Message) { n.Edges.LastMessage = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := crq.withMessageReads; query != nil {
+		if err := crq.loadMessageReads(ctx, query, nodes,
+			func(n *ChatRoom) { n.Edges.MessageReads = []*MessageRead{} },
+			func(n *ChatRoom, e *MessageRead) { n.Edges.MessageReads = append(n.Edges.MessageReads, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := crq.withInvites; query != nil {
+		if err := crq.loadInvites(ctx, query, nodes,
+			func(n *ChatRoom) { n.Edges.Invites = []*Invite{} },
+			func(n *ChatRoom, e *Invite) { n.Edges.Invites = append(n.Edges.Invites, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := crq.withJoinRequests; query != nil {
+		if err := crq.loadJoinRequests(ctx, query, nodes,
+			func(n *ChatRoom) { n.Edges.JoinRequests = []*JoinRequest{} },
+			func(n *ChatRoom, e *JoinRequest) { n.Edges.JoinRequests = append(n.Edges.JoinRequests, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -508,9 +687,134 @@ func (crq *ChatRoomQuery) loadMessages(ctx context.Context, query *MessageQuery,
 	}
 	return nil
 }
+func (crq *ChatRoomQuery) loadLastMessage(ctx context.Context, query *MessageQuery, nodes []*ChatRoom, init func(*ChatRoom), assign func(*ChatRoom, *Message)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*ChatRoom)
+	for i := range nodes {
+		if nodes[i].LastMessageID == nil {
+			continue
+		}
+		fk := *nodes[i].LastMessageID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(message.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "last_message_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (crq *ChatRoomQuery) loadMessageReads(ctx context.Context, query *MessageReadQuery, nodes []*ChatRoom, init func(*ChatRoom), assign func(*ChatRoom, *MessageRead)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*ChatRoom)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(messageread.FieldRoomID)
+	}
+	query.Where(predicate.MessageRead(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(chatroom.MessageReadsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.RoomID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "room_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (crq *ChatRoomQuery) loadInvites(ctx context.Context, query *InviteQuery, nodes []*ChatRoom, init func(*ChatRoom), assign func(*ChatRoom, *Invite)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*ChatRoom)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(invite.FieldRoomID)
+	}
+	query.Where(predicate.Invite(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(chatroom.InvitesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.RoomID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "room_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (crq *ChatRoomQuery) loadJoinRequests(ctx context.Context, query *JoinRequestQuery, nodes []*ChatRoom, init func(*ChatRoom), assign func(*ChatRoom, *JoinRequest)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*ChatRoom)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(joinrequest.FieldRoomID)
+	}
+	query.Where(predicate.JoinRequest(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(chatroom.JoinRequestsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.RoomID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "room_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (crq *ChatRoomQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := crq.querySpec()
+	if len(crq.modifiers) > 0 {
+		_spec.Modifiers = crq.modifiers
+	}
 	_spec.Node.Columns = crq.ctx.Fields
 	if len(crq.ctx.Fields) > 0 {
 		_spec.Unique = crq.ctx.Unique != nil && *crq.ctx.Unique
@@ -534,6 +838,9 @@ func (crq *ChatRoomQuery) querySpec() *sqlgraph.QuerySpec {
 				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
 			}
 		}
+		if crq.withLastMessage != nil {
+			_spec.Node.AddColumnOnce(chatroom.FieldLastMessageID)
+		}
 	}
 	if ps := crq.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -573,6 +880,9 @@ func (crq *ChatRoomQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if crq.ctx.Unique != nil && *crq.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range crq.modifiers {
+		m(selector)
+	}
 	for _, p := range crq.predicates {
 		p(selector)
 	}
@@ -590,6 +900,32 @@ func (crq *ChatRoomQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (crq *ChatRoomQuery) ForUpdate(opts ...sql.LockOption) *ChatRoomQuery {
+	if crq.driver.Dialect() == dialect.Postgres {
+		crq.Unique(false)
+	}
+	crq.modifiers = append(crq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return crq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (crq *ChatRoomQuery) ForShare(opts ...sql.LockOption) *ChatRoomQuery {
+	if crq.driver.Dialect() == dialect.Postgres {
+		crq.Unique(false)
+	}
+	crq.modifiers = append(crq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return crq
+}
+
 // ChatRoomGroupBy is the group-by builder for ChatRoom entities.
 type ChatRoomGroupBy struct {
 	selector
@@ -13,7 +13,10 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
 	"github.com/hideaki1979/cc-chat-app/apps/api/ent/chatroom"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/invite"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/joinrequest"
 	"github.com/hideaki1979/cc-chat-app/apps/api/ent/message"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/messageread"
 	"github.com/hideaki1979/cc-chat-app/apps/api/ent/predicate"
 	"github.com/hideaki1979/cc-chat-app/apps/api/ent/roommember"
 )
@@ -45,6 +48,66 @@ func (cru *ChatRoomUpdate) SetNillableName(s *string) *ChatRoomUpdate {
 	return cru
 }
 
+// SetDescription sets the "description" field.
+func (cru *ChatRoomUpdate) SetDescription(s string) *ChatRoomUpdate {
+	cru.mutation.SetDescription(s)
+	return cru
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (cru *ChatRoomUpdate) SetNillableDescription(s *string) *ChatRoomUpdate {
+	if s != nil {
+		cru.SetDescription(*s)
+	}
+	return cru
+}
+
+// ClearDescription clears the value of the "description" field.
+func (cru *ChatRoomUpdate) ClearDescription() *ChatRoomUpdate {
+	cru.mutation.ClearDescription()
+	return cru
+}
+
+// SetTopic sets the "topic" field.
+func (cru *ChatRoomUpdate) SetTopic(s string) *ChatRoomUpdate {
+	cru.mutation.SetTopic(s)
+	return cru
+}
+
+// SetNillableTopic sets the "topic" field if the given value is not nil.
+func (cru *ChatRoomUpdate) SetNillableTopic(s *string) *ChatRoomUpdate {
+	if s != nil {
+		cru.SetTopic(*s)
+	}
+	return cru
+}
+
+// ClearTopic clears the value of the "topic" field.
+func (cru *ChatRoomUpdate) ClearTopic() *ChatRoomUpdate {
+	cru.mutation.ClearTopic()
+	return cru
+}
+
+// SetAvatarURL sets the "avatar_url" field.
+func (cru *ChatRoomUpdate) SetAvatarURL(s string) *ChatRoomUpdate {
+	cru.mutation.SetAvatarURL(s)
+	return cru
+}
+
+// SetNillableAvatarURL sets the "avatar_url" field if the given value is not nil.
+func (cru *ChatRoomUpdate) SetNillableAvatarURL(s *string) *ChatRoomUpdate {
+	if s != nil {
+		cru.SetAvatarURL(*s)
+	}
+	return cru
+}
+
+// ClearAvatarURL clears the value of the "avatar_url" field.
+func (cru *ChatRoomUpdate) ClearAvatarURL() *ChatRoomUpdate {
+	cru.mutation.ClearAvatarURL()
+	return cru
+}
+
 // SetIsGroupChat sets the "is_group_chat" field.
 func (cru *ChatRoomUpdate) SetIsGroupChat(b bool) *ChatRoomUpdate {
 	cru.mutation.SetIsGroupChat(b)
@@ -59,6 +122,107 @@ func (cru *ChatRoomUpdate) SetNillableIsGroupChat(b *bool) *ChatRoomUpdate {
 	return cru
 }
 
+// SetVisibility sets the "visibility" field.
+func (cru *ChatRoomUpdate) SetVisibility(c chatroom.Visibility) *ChatRoomUpdate {
+	cru.mutation.SetVisibility(c)
+	return cru
+}
+
+// SetNillableVisibility sets the "visibility" field if the given value is not nil.
+func (cru *ChatRoomUpdate) SetNillableVisibility(c *chatroom.Visibility) *ChatRoomUpdate {
+	if c != nil {
+		cru.SetVisibility(*c)
+	}
+	return cru
+}
+
+// SetEditWindowSeconds sets the "edit_window_seconds" field.
+func (cru *ChatRoomUpdate) SetEditWindowSeconds(i int) *ChatRoomUpdate {
+	cru.mutation.ResetEditWindowSeconds()
+	cru.mutation.SetEditWindowSeconds(i)
+	return cru
+}
+
+// SetNillableEditWindowSeconds sets the "edit_window_seconds" field if the given value is not nil.
+func (cru *ChatRoomUpdate) SetNillableEditWindowSeconds(i *int) *ChatRoomUpdate {
+	if i != nil {
+		cru.SetEditWindowSeconds(*i)
+	}
+	return cru
+}
+
+// AddEditWindowSeconds adds i to the "edit_window_seconds" field.
+func (cru *ChatRoomUpdate) AddEditWindowSeconds(i int) *ChatRoomUpdate {
+	cru.mutation.AddEditWindowSeconds(i)
+	return cru
+}
+
+// ClearEditWindowSeconds clears the value of the "edit_window_seconds" field.
+func (cru *ChatRoomUpdate) ClearEditWindowSeconds() *ChatRoomUpdate {
+	cru.mutation.ClearEditWindowSeconds()
+	return cru
+}
+
+// SetArchivedAt sets the "archived_at" field.
+func (cru *ChatRoomUpdate) SetArchivedAt(t time.Time) *ChatRoomUpdate {
+	cru.mutation.SetArchivedAt(t)
+	return cru
+}
+
+// SetNillableArchivedAt sets the "archived_at" field if the given value is not nil.
+func (cru *ChatRoomUpdate) SetNillableArchivedAt(t *time.Time) *ChatRoomUpdate {
+	if t != nil {
+		cru.SetArchivedAt(*t)
+	}
+	return cru
+}
+
+// ClearArchivedAt clears the value of the "archived_at" field.
+func (cru *ChatRoomUpdate) ClearArchivedAt() *ChatRoomUpdate {
+	cru.mutation.ClearArchivedAt()
+	return cru
+}
+
+// SetLastMessageAt sets the "last_message_at" field.
+func (cru *ChatRoomUpdate) SetLastMessageAt(t time.Time) *ChatRoomUpdate {
+	cru.mutation.SetLastMessageAt(t)
+	return cru
+}
+
+// SetNillableLastMessageAt sets the "last_message_at" field if the given value is not nil.
+func (cru *ChatRoomUpdate) SetNillableLastMessageAt(t *time.Time) *ChatRoomUpdate {
+	if t != nil {
+		cru.SetLastMessageAt(*t)
+	}
+	return cru
+}
+
+// ClearLastMessageAt clears the value of the "last_message_at" field.
+func (cru *ChatRoomUpdate) ClearLastMessageAt() *ChatRoomUpdate {
+	cru.mutation.ClearLastMessageAt()
+	return cru
+}
+
+// SetLastMessageID sets the "last_message_id" field.
+func (cru *ChatRoomUpdate) SetLastMessageID(u uuid.UUID) *ChatRoomUpdate {
+	cru.mutation.SetLastMessageID(u)
+	return cru
+}
+
+// SetNillableLastMessageID sets the "last_message_id" field if the given value is not nil.
+func (cru *ChatRoomUpdate) SetNillableLastMessageID(u *uuid.UUID) *ChatRoomUpdate {
+	if u != nil {
+		cru.SetLastMessageID(*u)
+	}
+	return cru
+}
+
+// ClearLastMessageID clears the value of the "last_message_id" field.
+func (cru *ChatRoomUpdate) ClearLastMessageID() *ChatRoomUpdate {
+	cru.mutation.ClearLastMessageID()
+	return cru
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (cru *ChatRoomUpdate) SetUpdatedAt(t time.Time) *ChatRoomUpdate {
 	cru.mutation.SetUpdatedAt(t)
@@ -95,6 +259,56 @@ func (cru *ChatRoomUpdate) AddMessages(m ...*Message) *ChatRoomUpdate {
 	return cru.AddMessageIDs(ids...)
 }
 
+// SetLastMessage sets the "last_message" edge to the Message entity.
+func (cru *ChatRoomUpdate) SetLastMessage(m *Message) *ChatRoomUpdate {
+	return cru.SetLastMessageID(m.ID)
+}
+
+// AddMessageReadIDs adds the "message_reads" edge to the MessageRead entity by IDs.
+func (cru *ChatRoomUpdate) AddMessageReadIDs(ids ...int64) *ChatRoomUpdate {
+	cru.mutation.AddMessageReadIDs(ids...)
+	return cru
+}
+
+// AddMessageReads adds the "message_reads" edges to the MessageRead entity.
+func (cru *ChatRoomUpdate) AddMessageReads(m ...*MessageRead) *ChatRoomUpdate {
+	ids := make([]int64, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return cru.AddMessageReadIDs(ids...)
+}
+
+// AddInviteIDs adds the "invites" edge to the Invite entity by IDs.
+func (cru *ChatRoomUpdate) AddInviteIDs(ids ...uuid.UUID) *ChatRoomUpdate {
+	cru.mutation.AddInviteIDs(ids...)
+	return cru
+}
+
+// AddInvites adds the "invites" edges to the Invite entity.
+func (cru *ChatRoomUpdate) AddInvites(i ...*Invite) *ChatRoomUpdate {
+	ids := make([]uuid.UUID, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return cru.AddInviteIDs(ids...)
+}
+
+// AddJoinRequestIDs adds the "join_requests" edge to the JoinRequest entity by IDs.
+func (cru *ChatRoomUpdate) AddJoinRequestIDs(ids ...int64) *ChatRoomUpdate {
+	cru.mutation.AddJoinRequestIDs(ids...)
+	return cru
+}
+
+// AddJoinRequests adds the "join_requests" edges to the JoinRequest entity.
+func (cru *ChatRoomUpdate) AddJoinRequests(j ...*JoinRequest) *ChatRoomUpdate {
+	ids := make([]int64, len(j))
+	for i := range j {
+		ids[i] = j[i].ID
+	}
+	return cru.AddJoinRequestIDs(ids...)
+}
+
 // Mutation returns the ChatRoomMutation object of the builder.
 func (cru *ChatRoomUpdate) Mutation() *ChatRoomMutation {
 	return cru.mutation
@@ -142,6 +356,75 @@ func (cru *ChatRoomUpdate) RemoveMessages(m ...*Message) *ChatRoomUpdate {
 	return cru.RemoveMessageIDs(ids...)
 }
 
+// ClearLastMessage clears the "last_message" edge to the Message entity.
+func (cru *ChatRoomUpdate) ClearLastMessage() *ChatRoomUpdate {
+	cru.mutation.ClearLastMessage()
+	return cru
+}
+
+// ClearMessageReads clears all "message_reads" edges to the MessageRead entity.
+func (cru *ChatRoomUpdate) ClearMessageReads() *ChatRoomUpdate {
+	cru.mutation.ClearMessageReads()
+	return cru
+}
+
+// RemoveMessageReadIDs removes the "message_reads" edge to MessageRead entities by IDs.
+func (cru *ChatRoomUpdate) RemoveMessageReadIDs(ids ...int64) *ChatRoomUpdate {
+	cru.mutation.RemoveMessageReadIDs(ids...)
+	return cru
+}
+
+// RemoveMessageReads removes "message_reads" edges to MessageRead entities.
+func (cru *ChatRoomUpdate) RemoveMessageReads(m ...*MessageRead) *ChatRoomUpdate {
+	ids := make([]int64, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return cru.RemoveMessageReadIDs(ids...)
+}
+
+// ClearInvites clears all "invites" edges to the Invite entity.
+func (cru *ChatRoomUpdate) ClearInvites() *ChatRoomUpdate {
+	cru.mutation.ClearInvites()
+	return cru
+}
+
+// RemoveInviteIDs removes the "invites" edge to Invite entities by IDs.
+func (cru *ChatRoomUpdate) RemoveInviteIDs(ids ...uuid.UUID) *ChatRoomUpdate {
+	cru.mutation.RemoveInviteIDs(ids...)
+	return cru
+}
+
+// RemoveInvites removes "invites" edges to Invite entities.
+func (cru *ChatRoomUpdate) RemoveInvites(i ...*Invite) *ChatRoomUpdate {
+	ids := make([]uuid.UUID, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return cru.RemoveInviteIDs(ids...)
+}
+
+// ClearJoinRequests clears all "join_requests" edges to the JoinRequest entity.
+func (cru *ChatRoomUpdate) ClearJoinRequests() *ChatRoomUpdate {
+	cru.mutation.ClearJoinRequests()
+	return cru
+}
+
+// RemoveJoinRequestIDs removes the "join_requests" edge to JoinRequest entities by IDs.
+func (cru *ChatRoomUpdate) RemoveJoinRequestIDs(ids ...int64) *ChatRoomUpdate {
+	cru.mutation.RemoveJoinRequestIDs(ids...)
+	return cru
+}
+
+// RemoveJoinRequests removes "join_requests" edges to JoinRequest entities.
+func (cru *ChatRoomUpdate) RemoveJoinRequests(j ...*JoinRequest) *ChatRoomUpdate {
+	ids := make([]int64, len(j))
+	for i := range j {
+		ids[i] = j[i].ID
+	}
+	return cru.RemoveJoinRequestIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (cru *ChatRoomUpdate) Save(ctx context.Context) (int, error) {
 	cru.defaults()
@@ -185,6 +468,16 @@ func (cru *ChatRoomUpdate) check() error {
 			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "ChatRoom.name": %w`, err)}
 		}
 	}
+	if v, ok := cru.mutation.Visibility(); ok {
+		if err := chatroom.VisibilityValidator(v); err != nil {
+			return &ValidationError{Name: "visibility", err: fmt.Errorf(`ent: validator failed for field "ChatRoom.visibility": %w`, err)}
+		}
+	}
+	if v, ok := cru.mutation.EditWindowSeconds(); ok {
+		if err := chatroom.EditWindowSecondsValidator(v); err != nil {
+			return &ValidationError{Name: "edit_window_seconds", err: fmt.Errorf(`ent: validator failed for field "ChatRoom.edit_window_seconds": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -203,9 +496,54 @@ func (cru *ChatRoomUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := cru.mutation.Name(); ok {
 		_spec.SetField(chatroom.FieldName, field.TypeString, value)
 	}
+	if value, ok := cru.mutation.Description(); ok {
+		_spec.SetField(chatroom.FieldDescription, field.TypeString, value)
+	}
+	if cru.mutation.DescriptionCleared() {
+		_spec.ClearField(chatroom.FieldDescription, field.TypeString)
+	}
+	if value, ok := cru.mutation.Topic(); ok {
+		_spec.SetField(chatroom.FieldTopic, field.TypeString, value)
+	}
+	if cru.mutation.TopicCleared() {
+		_spec.ClearField(chatroom.FieldTopic, field.TypeString)
+	}
+	if value, ok := cru.mutation.AvatarURL(); ok {
+		_spec.SetField(chatroom.FieldAvatarURL, field.TypeString, value)
+	}
+	if cru.mutation.AvatarURLCleared() {
+		_spec.ClearField(chatroom.FieldAvatarURL, field.TypeString)
+	}
 	if value, ok := cru.mutation.IsGroupChat(); ok {
 		_spec.SetField(chatroom.FieldIsGroupChat, field.TypeBool, value)
 	}
+	if cru.mutation.DmKeyCleared() {
+		_spec.ClearField(chatroom.FieldDmKey, field.TypeString)
+	}
+	if value, ok := cru.mutation.Visibility(); ok {
+		_spec.SetField(chatroom.FieldVisibility, field.TypeEnum, value)
+	}
+	if value, ok := cru.mutation.EditWindowSeconds(); ok {
+		_spec.SetField(chatroom.FieldEditWindowSeconds, field.TypeInt, value)
+	}
+	if value, ok := cru.mutation.AddedEditWindowSeconds(); ok {
+		_spec.AddField(chatroom.FieldEditWindowSeconds, field.TypeInt, value)
+	}
+	if cru.mutation.EditWindowSecondsCleared() {
+		_spec.ClearField(chatroom.FieldEditWindowSeconds, field.TypeInt)
+	}
+	if value, ok := cru.mutation.ArchivedAt(); ok {
+		_spec.SetField(chatroom.FieldArchivedAt, field.TypeTime, value)
+	}
+	if cru.mutation.ArchivedAtCleared() {
+		_spec.ClearField(chatroom.FieldArchivedAt, field.TypeTime)
+	}
+	if value, ok := cru.mutation.LastMessageAt(); ok {
+		_spec.SetField(chatroom.FieldLastMessageAt, field.TypeTime, value)
+	}
+	if cru.mutation.LastMessageAtCleared() {
+		_spec.ClearField(chatroom.FieldLastMessageAt, field.TypeTime)
+	}
 	if value, ok := cru.mutation.UpdatedAt(); ok {
 		_spec.SetField(chatroom.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -299,6 +637,170 @@ func (cru *ChatRoomUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if cru.mutation.LastMessageCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   chatroom.LastMessageTable,
+			Columns: []string{chatroom.LastMessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cru.mutation.LastMessageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   chatroom.LastMessageTable,
+			Columns: []string{chatroom.LastMessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cru.mutation.MessageReadsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   chatroom.MessageReadsTable,
+			Columns: []string{chatroom.MessageReadsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messageread.FieldID, field.TypeInt64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cru.mutation.RemovedMessageReadsIDs(); len(nodes) > 0 && !cru.mutation.MessageReadsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   chatroom.MessageReadsTable,
+			Columns: []string{chatroom.MessageReadsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messageread.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cru.mutation.MessageReadsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   chatroom.MessageReadsTable,
+			Columns: []string{chatroom.MessageReadsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messageread.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cru.mutation.InvitesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   chatroom.InvitesTable,
+			Columns: []string{chatroom.InvitesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invite.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cru.mutation.RemovedInvitesIDs(); len(nodes) > 0 && !cru.mutation.InvitesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   chatroom.InvitesTable,
+			Columns: []string{chatroom.InvitesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invite.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cru.mutation.InvitesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   chatroom.InvitesTable,
+			Columns: []string{chatroom.InvitesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invite.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cru.mutation.JoinRequestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   chatroom.JoinRequestsTable,
+			Columns: []string{chatroom.JoinRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(joinrequest.FieldID, field.TypeInt64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cru.mutation.RemovedJoinRequestsIDs(); len(nodes) > 0 && !cru.mutation.JoinRequestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   chatroom.JoinRequestsTable,
+			Columns: []string{chatroom.JoinRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(joinrequest.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cru.mutation.JoinRequestsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   chatroom.JoinRequestsTable,
+			Columns: []string{chatroom.JoinRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(joinrequest.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, cru.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{chatroom.Label}
@@ -319,20 +821,80 @@ type ChatRoomUpdateOne struct {
 	mutation *ChatRoomMutation
 }
 
-// SetName sets the "name" field.
-func (cruo *ChatRoomUpdateOne) SetName(s string) *ChatRoomUpdateOne {
-	cruo.mutation.SetName(s)
+// SetName sets the "name" field.
+func (cruo *ChatRoomUpdateOne) SetName(s string) *ChatRoomUpdateOne {
+	cruo.mutation.SetName(s)
+	return cruo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (cruo *ChatRoomUpdateOne) SetNillableName(s *string) *ChatRoomUpdateOne {
+	if s != nil {
+		cruo.SetName(*s)
+	}
+	return cruo
+}
+
+// SetDescription sets the "description" field.
+func (cruo *ChatRoomUpdateOne) SetDescription(s string) *ChatRoomUpdateOne {
+	cruo.mutation.SetDescription(s)
+	return cruo
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (cruo *ChatRoomUpdateOne) SetNillableDescription(s *string) *ChatRoomUpdateOne {
+	if s != nil {
+		cruo.SetDescription(*s)
+	}
+	return cruo
+}
+
+// ClearDescription clears the value of the "description" field.
+func (cruo *ChatRoomUpdateOne) ClearDescription() *ChatRoomUpdateOne {
+	cruo.mutation.ClearDescription()
+	return cruo
+}
+
+// SetTopic sets the "topic" field.
+func (cruo *ChatRoomUpdateOne) SetTopic(s string) *ChatRoomUpdateOne {
+	cruo.mutation.SetTopic(s)
+	return cruo
+}
+
+// SetNillableTopic sets the "topic" field if the given value is not nil.
+func (cruo *ChatRoomUpdateOne) SetNillableTopic(s *string) *ChatRoomUpdateOne {
+	if s != nil {
+		cruo.SetTopic(*s)
+	}
+	return cruo
+}
+
+// ClearTopic clears the value of the "topic" field.
+func (cruo *ChatRoomUpdateOne) ClearTopic() *ChatRoomUpdateOne {
+	cruo.mutation.ClearTopic()
 	return cruo
 }
 
-// SetNillableName sets the "name" field if the given value is not nil.
-func (cruo *ChatRoomUpdateOne) SetNillableName(s *string) *ChatRoomUpdateOne {
+// SetAvatarURL sets the "avatar_url" field.
+func (cruo *ChatRoomUpdateOne) SetAvatarURL(s string) *ChatRoomUpdateOne {
+	cruo.mutation.SetAvatarURL(s)
+	return cruo
+}
+
+// SetNillableAvatarURL sets the "avatar_url" field if the given value is not nil.
+func (cruo *ChatRoomUpdateOne) SetNillableAvatarURL(s *string) *ChatRoomUpdateOne {
 	if s != nil {
-		cruo.SetName(*s)
+		cruo.SetAvatarURL(*s)
 	}
 	return cruo
 }
 
+// ClearAvatarURL clears the value of the "avatar_url" field.
+func (cruo *ChatRoomUpdateOne) ClearAvatarURL() *ChatRoomUpdateOne {
+	cruo.mutation.ClearAvatarURL()
+	return cruo
+}
+
 // SetIsGroupChat sets the "is_group_chat" field.
 func (cruo *ChatRoomUpdateOne) SetIsGroupChat(b bool) *ChatRoomUpdateOne {
 	cruo.mutation.SetIsGroupChat(b)
@@ -347,6 +909,107 @@ func (cruo *ChatRoomUpdateOne) SetNillableIsGroupChat(b *bool) *ChatRoomUpdateOn
 	return cruo
 }
 
+// SetVisibility sets the "visibility" field.
+func (cruo *ChatRoomUpdateOne) SetVisibility(c chatroom.Visibility) *ChatRoomUpdateOne {
+	cruo.mutation.SetVisibility(c)
+	return cruo
+}
+
+// SetNillableVisibility sets the "visibility" field if the given value is not nil.
+func (cruo *ChatRoomUpdateOne) SetNillableVisibility(c *chatroom.Visibility) *ChatRoomUpdateOne {
+	if c != nil {
+		cruo.SetVisibility(*c)
+	}
+	return cruo
+}
+
+// SetEditWindowSeconds sets the "edit_window_seconds" field.
+func (cruo *ChatRoomUpdateOne) SetEditWindowSeconds(i int) *ChatRoomUpdateOne {
+	cruo.mutation.ResetEditWindowSeconds()
+	cruo.mutation.SetEditWindowSeconds(i)
+	return cruo
+}
+
+// SetNillableEditWindowSeconds sets the "edit_window_seconds" field if the given value is not nil.
+func (cruo *ChatRoomUpdateOne) SetNillableEditWindowSeconds(i *int) *ChatRoomUpdateOne {
+	if i != nil {
+		cruo.SetEditWindowSeconds(*i)
+	}
+	return cruo
+}
+
+// AddEditWindowSeconds adds i to the "edit_window_seconds" field.
+func (cruo *ChatRoomUpdateOne) AddEditWindowSeconds(i int) *ChatRoomUpdateOne {
+	cruo.mutation.AddEditWindowSeconds(i)
+	return cruo
+}
+
+// ClearEditWindowSeconds clears the value of the "edit_window_seconds" field.
+func (cruo *ChatRoomUpdateOne) ClearEditWindowSeconds() *ChatRoomUpdateOne {
+	cruo.mutation.ClearEditWindowSeconds()
+	return cruo
+}
+
+// SetArchivedAt sets the "archived_at" field.
+func (cruo *ChatRoomUpdateOne) SetArchivedAt(t time.Time) *ChatRoomUpdateOne {
+	cruo.mutation.SetArchivedAt(t)
+	return cruo
+}
+
+// SetNillableArchivedAt sets the "archived_at" field if the given value is not nil.
+func (cruo *ChatRoomUpdateOne) SetNillableArchivedAt(t *time.Time) *ChatRoomUpdateOne {
+	if t != nil {
+		cruo.SetArchivedAt(*t)
+	}
+	return cruo
+}
+
+// ClearArchivedAt clears the value of the "archived_at" field.
+func (cruo *ChatRoomUpdateOne) ClearArchivedAt() *ChatRoomUpdateOne {
+	cruo.mutation.ClearArchivedAt()
+	return cruo
+}
+
+// SetLastMessageAt sets the "last_message_at" field.
+func (cruo *ChatRoomUpdateOne) SetLastMessageAt(t time.Time) *ChatRoomUpdateOne {
+	cruo.mutation.SetLastMessageAt(t)
+	return cruo
+}
+
+// SetNillableLastMessageAt sets the "last_message_at" field if the given value is not nil.
+func (cruo *ChatRoomUpdateOne) SetNillableLastMessageAt(t *time.Time) *ChatRoomUpdateOne {
+	if t != nil {
+		cruo.SetLastMessageAt(*t)
+	}
+	return cruo
+}
+
+// ClearLastMessageAt clears the value of the "last_message_at" field.
+func (cruo *ChatRoomUpdateOne) ClearLastMessageAt() *ChatRoomUpdateOne {
+	cruo.mutation.ClearLastMessageAt()
+	return cruo
+}
+
+// SetLastMessageID sets the "last_message_id" field.
+func (cruo *ChatRoomUpdateOne) SetLastMessageID(u uuid.UUID) *ChatRoomUpdateOne {
+	cruo.mutation.SetLastMessageID(u)
+	return cruo
+}
+
+// SetNillableLastMessageID sets the "last_message_id" field if the given value is not nil.
+func (cruo *ChatRoomUpdateOne) SetNillableLastMessageID(u *uuid.UUID) *ChatRoomUpdateOne {
+	if u != nil {
+		cruo.SetLastMessageID(*u)
+	}
+	return cruo
+}
+
+// ClearLastMessageID clears the value of the "last_message_id" field.
+func (cruo *ChatRoomUpdateOne) ClearLastMessageID() *ChatRoomUpdateOne {
+	cruo.mutation.ClearLastMessageID()
+	return cruo
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (cruo *ChatRoomUpdateOne) SetUpdatedAt(t time.Time) *ChatRoomUpdateOne {
 	cruo.mutation.SetUpdatedAt(t)
@@ -383,6 +1046,56 @@ func (cruo *ChatRoomUpdateOne) AddMessages(m ...*Message) *ChatRoomUpdateOne {
 	return cruo.AddMessageIDs(ids...)
 }
 
+// SetLastMessage sets the "last_message" edge to the Message entity.
+func (cruo *ChatRoomUpdateOne) SetLastMessage(m *Message) *ChatRoomUpdateOne {
+	return cruo.SetLastMessageID(m.ID)
+}
+
+// AddMessageReadIDs adds the "message_reads" edge to the MessageRead entity by IDs.
+func (cruo *ChatRoomUpdateOne) AddMessageReadIDs(ids ...int64) *ChatRoomUpdateOne {
+	cruo.mutation.AddMessageReadIDs(ids...)
+	return cruo
+}
+
+// AddMessageReads adds the "message_reads" edges to the MessageRead entity.
+func (cruo *ChatRoomUpdateOne) AddMessageReads(m ...*MessageRead) *ChatRoomUpdateOne {
+	ids := make([]int64, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return cruo.AddMessageReadIDs(ids...)
+}
+
+// AddInviteIDs adds the "invites" edge to the Invite entity by IDs.
+func (cruo *ChatRoomUpdateOne) AddInviteIDs(ids ...uuid.UUID) *ChatRoomUpdateOne {
+	cruo.mutation.AddInviteIDs(ids...)
+	return cruo
+}
+
+// AddInvites adds the "invites" edges to the Invite entity.
+func (cruo *ChatRoomUpdateOne) AddInvites(i ...*Invite) *ChatRoomUpdateOne {
+	ids := make([]uuid.UUID, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return cruo.AddInviteIDs(ids...)
+}
+
+// AddJoinRequestIDs adds the "join_requests" edge to the JoinRequest entity by IDs.
+func (cruo *ChatRoomUpdateOne) AddJoinRequestIDs(ids ...int64) *ChatRoomUpdateOne {
+	cruo.mutation.AddJoinRequestIDs(ids...)
+	return cruo
+}
+
+// AddJoinRequests adds the "join_requests" edges to the JoinRequest entity.
+func (cruo *ChatRoomUpdateOne) AddJoinRequests(j ...*JoinRequest) *ChatRoomUpdateOne {
+	ids := make([]int64, len(j))
+	for i := range j {
+		ids[i] = j[i].ID
+	}
+	return cruo.AddJoinRequestIDs(ids...)
+}
+
 // Mutation returns the ChatRoomMutation object of the builder.
 func (cruo *ChatRoomUpdateOne) Mutation() *ChatRoomMutation {
 	return cruo.mutation
@@ -430,6 +1143,75 @@ func (cruo *ChatRoomUpdateOne) RemoveMessages(m ...*Message) *ChatRoomUpdateOne
 	return cruo.RemoveMessageIDs(ids...)
 }
 
+// ClearLastMessage clears the "last_message" edge to the Message entity.
+func (cruo *ChatRoomUpdateOne) ClearLastMessage() *ChatRoomUpdateOne {
+	cruo.mutation.ClearLastMessage()
+	return cruo
+}
+
+// ClearMessageReads clears all "message_reads" edges to the MessageRead entity.
+func (cruo *ChatRoomUpdateOne) ClearMessageReads() *ChatRoomUpdateOne {
+	cruo.mutation.ClearMessageReads()
+	return cruo
+}
+
+// RemoveMessageReadIDs removes the "message_reads" edge to MessageRead entities by IDs.
+func (cruo *ChatRoomUpdateOne) RemoveMessageReadIDs(ids ...int64) *ChatRoomUpdateOne {
+	cruo.mutation.RemoveMessageReadIDs(ids...)
+	return cruo
+}
+
+// RemoveMessageReads removes "message_reads" edges to MessageRead entities.
+func (cruo *ChatRoomUpdateOne) RemoveMessageReads(m ...*MessageRead) *ChatRoomUpdateOne {
+	ids := make([]int64, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return cruo.RemoveMessageReadIDs(ids...)
+}
+
+// ClearInvites clears all "invites" edges to the Invite entity.
+func (cruo *ChatRoomUpdateOne) ClearInvites() *ChatRoomUpdateOne {
+	cruo.mutation.ClearInvites()
+	return cruo
+}
+
+// RemoveInviteIDs removes the "invites" edge to Invite entities by IDs.
+func (cruo *ChatRoomUpdateOne) RemoveInviteIDs(ids ...uuid.UUID) *ChatRoomUpdateOne {
+	cruo.mutation.RemoveInviteIDs(ids...)
+	return cruo
+}
+
+// RemoveInvites removes "invites" edges to Invite entities.
+func (cruo *ChatRoomUpdateOne) RemoveInvites(i ...*Invite) *ChatRoomUpdateOne {
+	ids := make([]uuid.UUID, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return cruo.RemoveInviteIDs(ids...)
+}
+
+// ClearJoinRequests clears all "join_requests" edges to the JoinRequest entity.
+func (cruo *ChatRoomUpdateOne) ClearJoinRequests() *ChatRoomUpdateOne {
+	cruo.mutation.ClearJoinRequests()
+	return cruo
+}
+
+// RemoveJoinRequestIDs removes the "join_requests" edge to JoinRequest entities by IDs.
+func (cruo *ChatRoomUpdateOne) RemoveJoinRequestIDs(ids ...int64) *ChatRoomUpdateOne {
+	cruo.mutation.RemoveJoinRequestIDs(ids...)
+	return cruo
+}
+
+// RemoveJoinRequests removes "join_requests" edges to JoinRequest entities.
+func (cruo *ChatRoomUpdateOne) RemoveJoinRequests(j ...*JoinRequest) *ChatRoomUpdateOne {
+	ids := make([]int64, len(j))
+	for i := range j {
+		ids[i] = j[i].ID
+	}
+	return cruo.RemoveJoinRequestIDs(ids...)
+}
+
 // Where appends a list predicates to the ChatRoomUpdate builder.
 func (cruo *ChatRoomUpdateOne) Where(ps ...predicate.ChatRoom) *ChatRoomUpdateOne {
 	cruo.mutation.Where(ps...)
@@ -486,6 +1268,16 @@ func (cruo *ChatRoomUpdateOne) check() error {
 			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "ChatRoom.name": %w`, err)}
 		}
 	}
+	if v, ok := cruo.mutation.Visibility(); ok {
+		if err := chatroom.VisibilityValidator(v); err != nil {
+			return &ValidationError{Name: "visibility", err: fmt.Errorf(`ent: validator failed for field "ChatRoom.visibility": %w`, err)}
+		}
+	}
+	if v, ok := cruo.mutation.EditWindowSeconds(); ok {
+		if err := chatroom.EditWindowSecondsValidator(v); err != nil {
+			return &ValidationError{Name: "edit_window_seconds", err: fmt.Errorf(`ent: validator failed for field "ChatRoom.edit_window_seconds": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -521,9 +1313,54 @@ func (cruo *ChatRoomUpdateOne) sqlSave(ctx context.Context) (_node *ChatRoom, er
 	if value, ok := cruo.mutation.Name(); ok {
 		_spec.SetField(chatroom.FieldName, field.TypeString, value)
 	}
+	if value, ok := cruo.mutation.Description(); ok {
+		_spec.SetField(chatroom.FieldDescription, field.TypeString, value)
+	}
+	if cruo.mutation.DescriptionCleared() {
+		_spec.ClearField(chatroom.FieldDescription, field.TypeString)
+	}
+	if value, ok := cruo.mutation.Topic(); ok {
+		_spec.SetField(chatroom.FieldTopic, field.TypeString, value)
+	}
+	if cruo.mutation.TopicCleared() {
+		_spec.ClearField(chatroom.FieldTopic, field.TypeString)
+	}
+	if value, ok := cruo.mutation.AvatarURL(); ok {
+		_spec.SetField(chatroom.FieldAvatarURL, field.TypeString, value)
+	}
+	if cruo.mutation.AvatarURLCleared() {
+		_spec.ClearField(chatroom.FieldAvatarURL, field.TypeString)
+	}
 	if value, ok := cruo.mutation.IsGroupChat(); ok {
 		_spec.SetField(chatroom.FieldIsGroupChat, field.TypeBool, value)
 	}
+	if cruo.mutation.DmKeyCleared() {
+		_spec.ClearField(chatroom.FieldDmKey, field.TypeString)
+	}
+	if value, ok := cruo.mutation.Visibility(); ok {
+		_spec.SetField(chatroom.FieldVisibility, field.TypeEnum, value)
+	}
+	if value, ok := cruo.mutation.EditWindowSeconds(); ok {
+		_spec.SetField(chatroom.FieldEditWindowSeconds, field.TypeInt, value)
+	}
+	if value, ok := cruo.mutation.AddedEditWindowSeconds(); ok {
+		_spec.AddField(chatroom.FieldEditWindowSeconds, field.TypeInt, value)
+	}
+	if cruo.mutation.EditWindowSecondsCleared() {
+		_spec.ClearField(chatroom.FieldEditWindowSeconds, field.TypeInt)
+	}
+	if value, ok := cruo.mutation.ArchivedAt(); ok {
+		_spec.SetField(chatroom.FieldArchivedAt, field.TypeTime, value)
+	}
+	if cruo.mutation.ArchivedAtCleared() {
+		_spec.ClearField(chatroom.FieldArchivedAt, field.TypeTime)
+	}
+	if value, ok := cruo.mutation.LastMessageAt(); ok {
+		_spec.SetField(chatroom.FieldLastMessageAt, field.TypeTime, value)
+	}
+	if cruo.mutation.LastMessageAtCleared() {
+		_spec.ClearField(chatroom.FieldLastMessageAt, field.TypeTime)
+	}
 	if value, ok := cruo.mutation.UpdatedAt(); ok {
 		_spec.SetField(chatroom.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -617,6 +1454,170 @@ func (cruo *ChatRoomUpdateOne) sqlSave(ctx context.Context) (_node *ChatRoom, er
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if cruo.mutation.LastMessageCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   chatroom.LastMessageTable,
+			Columns: []string{chatroom.LastMessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cruo.mutation.LastMessageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   chatroom.LastMessageTable,
+			Columns: []string{chatroom.LastMessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cruo.mutation.MessageReadsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   chatroom.MessageReadsTable,
+			Columns: []string{chatroom.MessageReadsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messageread.FieldID, field.TypeInt64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cruo.mutation.RemovedMessageReadsIDs(); len(nodes) > 0 && !cruo.mutation.MessageReadsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   chatroom.MessageReadsTable,
+			Columns: []string{chatroom.MessageReadsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messageread.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cruo.mutation.MessageReadsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   chatroom.MessageReadsTable,
+			Columns: []string{chatroom.MessageReadsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messageread.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cruo.mutation.InvitesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   chatroom.InvitesTable,
+			Columns: []string{chatroom.InvitesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invite.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cruo.mutation.RemovedInvitesIDs(); len(nodes) > 0 && !cruo.mutation.InvitesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   chatroom.InvitesTable,
+			Columns: []string{chatroom.InvitesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invite.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cruo.mutation.InvitesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   chatroom.InvitesTable,
+			Columns: []string{chatroom.InvitesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invite.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cruo.mutation.JoinRequestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   chatroom.JoinRequestsTable,
+			Columns: []string{chatroom.JoinRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(joinrequest.FieldID, field.TypeInt64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cruo.mutation.RemovedJoinRequestsIDs(); len(nodes) > 0 && !cruo.mutation.JoinRequestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   chatroom.JoinRequestsTable,
+			Columns: []string{chatroom.JoinRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(joinrequest.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cruo.mutation.JoinRequestsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   chatroom.JoinRequestsTable,
+			Columns: []string{chatroom.JoinRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(joinrequest.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &ChatRoom{config: cruo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
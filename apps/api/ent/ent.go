@@ -13,8 +13,18 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/hideaki1979/cc-chat-app/apps/api/ent/chatroom"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/invite"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/joinrequest"
 	"github.com/hideaki1979/cc-chat-app/apps/api/ent/message"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/messagereaction"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/messageread"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/messagerevision"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/revokedtoken"
 	"github.com/hideaki1979/cc-chat-app/apps/api/ent/roommember"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/rotatedrefreshtoken"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/securityevent"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/session"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/streamticket"
 	"github.com/hideaki1979/cc-chat-app/apps/api/ent/user"
 )
 
@@ -76,10 +86,20 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			chatroom.Table:   chatroom.ValidColumn,
-			message.Table:    message.ValidColumn,
-			roommember.Table: roommember.ValidColumn,
-			user.Table:       user.ValidColumn,
+			chatroom.Table:            chatroom.ValidColumn,
+			invite.Table:              invite.ValidColumn,
+			joinrequest.Table:         joinrequest.ValidColumn,
+			message.Table:             message.ValidColumn,
+			messagereaction.Table:     messagereaction.ValidColumn,
+			messageread.Table:         messageread.ValidColumn,
+			messagerevision.Table:     messagerevision.ValidColumn,
+			revokedtoken.Table:        revokedtoken.ValidColumn,
+			roommember.Table:          roommember.ValidColumn,
+			rotatedrefreshtoken.Table: rotatedrefreshtoken.ValidColumn,
+			securityevent.Table:       securityevent.ValidColumn,
+			session.Table:             session.ValidColumn,
+			streamticket.Table:        streamticket.ValidColumn,
+			user.Table:                user.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
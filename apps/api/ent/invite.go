@@ -0,0 +1,238 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/chatroom"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/invite"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/user"
+)
+
+// Invite is the model entity for the Invite schema.
+type Invite struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// 招待先のチャットルームID
+	RoomID uuid.UUID `json:"room_id,omitempty"`
+	// 招待を作成したユーザーID
+	CreatorID uuid.UUID `json:"creator_id,omitempty"`
+	// 招待トークンハッシュ
+	TokenHash []byte `json:"-"`
+	// 有効期限
+	ExpiresAt time.Time `json:"expires_at,omitempty"`
+	// 最大使用回数（nullは無制限）
+	MaxUses *int `json:"max_uses,omitempty"`
+	// 使用回数
+	UseCount int `json:"use_count,omitempty"`
+	// 無効化されたかどうか
+	Revoked bool `json:"revoked,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the InviteQuery when eager-loading is set.
+	Edges        InviteEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// InviteEdges holds the relations/edges for other nodes in the graph.
+type InviteEdges struct {
+	// Room holds the value of the room edge.
+	Room *ChatRoom `json:"room,omitempty"`
+	// Creator holds the value of the creator edge.
+	Creator *User `json:"creator,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// RoomOrErr returns the Room value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e InviteEdges) RoomOrErr() (*ChatRoom, error) {
+	if e.Room != nil {
+		return e.Room, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: chatroom.Label}
+	}
+	return nil, &NotLoadedError{edge: "room"}
+}
+
+// CreatorOrErr returns the Creator value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e InviteEdges) CreatorOrErr() (*User, error) {
+	if e.Creator != nil {
+		return e.Creator, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "creator"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Invite) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case invite.FieldTokenHash:
+			values[i] = new([]byte)
+		case invite.FieldRevoked:
+			values[i] = new(sql.NullBool)
+		case invite.FieldMaxUses, invite.FieldUseCount:
+			values[i] = new(sql.NullInt64)
+		case invite.FieldExpiresAt, invite.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case invite.FieldID, invite.FieldRoomID, invite.FieldCreatorID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Invite fields.
+func (i *Invite) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for j := range columns {
+		switch columns[j] {
+		case invite.FieldID:
+			if value, ok := values[j].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[j])
+			} else if value != nil {
+				i.ID = *value
+			}
+		case invite.FieldRoomID:
+			if value, ok := values[j].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field room_id", values[j])
+			} else if value != nil {
+				i.RoomID = *value
+			}
+		case invite.FieldCreatorID:
+			if value, ok := values[j].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field creator_id", values[j])
+			} else if value != nil {
+				i.CreatorID = *value
+			}
+		case invite.FieldTokenHash:
+			if value, ok := values[j].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field token_hash", values[j])
+			} else if value != nil {
+				i.TokenHash = *value
+			}
+		case invite.FieldExpiresAt:
+			if value, ok := values[j].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[j])
+			} else if value.Valid {
+				i.ExpiresAt = value.Time
+			}
+		case invite.FieldMaxUses:
+			if value, ok := values[j].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_uses", values[j])
+			} else if value.Valid {
+				i.MaxUses = new(int)
+				*i.MaxUses = int(value.Int64)
+			}
+		case invite.FieldUseCount:
+			if value, ok := values[j].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field use_count", values[j])
+			} else if value.Valid {
+				i.UseCount = int(value.Int64)
+			}
+		case invite.FieldRevoked:
+			if value, ok := values[j].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field revoked", values[j])
+			} else if value.Valid {
+				i.Revoked = value.Bool
+			}
+		case invite.FieldCreatedAt:
+			if value, ok := values[j].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[j])
+			} else if value.Valid {
+				i.CreatedAt = value.Time
+			}
+		default:
+			i.selectValues.Set(columns[j], values[j])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Invite.
+// This includes values selected through modifiers, order, etc.
+func (i *Invite) Value(name string) (ent.Value, error) {
+	return i.selectValues.Get(name)
+}
+
+// QueryRoom queries the "room" edge of the Invite entity.
+func (i *Invite) QueryRoom() *ChatRoomQuery {
+	return NewInviteClient(i.config).QueryRoom(i)
+}
+
+// QueryCreator queries the "creator" edge of the Invite entity.
+func (i *Invite) QueryCreator() *UserQuery {
+	return NewInviteClient(i.config).QueryCreator(i)
+}
+
+// Update returns a builder for updating this Invite.
+// Note that you need to call Invite.Unwrap() before calling this method if this Invite
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (i *Invite) Update() *InviteUpdateOne {
+	return NewInviteClient(i.config).UpdateOne(i)
+}
+
+// Unwrap unwraps the Invite entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (i *Invite) Unwrap() *Invite {
+	_tx, ok := i.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Invite is not a transactional entity")
+	}
+	i.config.driver = _tx.drv
+	return i
+}
+
+// String implements the fmt.Stringer.
+func (i *Invite) String() string {
+	var builder strings.Builder
+	builder.WriteString("Invite(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", i.ID))
+	builder.WriteString("room_id=")
+	builder.WriteString(fmt.Sprintf("%v", i.RoomID))
+	builder.WriteString(", ")
+	builder.WriteString("creator_id=")
+	builder.WriteString(fmt.Sprintf("%v", i.CreatorID))
+	builder.WriteString(", ")
+	builder.WriteString("token_hash=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("expires_at=")
+	builder.WriteString(i.ExpiresAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := i.MaxUses; v != nil {
+		builder.WriteString("max_uses=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("use_count=")
+	builder.WriteString(fmt.Sprintf("%v", i.UseCount))
+	builder.WriteString(", ")
+	builder.WriteString("revoked=")
+	builder.WriteString(fmt.Sprintf("%v", i.Revoked))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(i.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Invites is a parsable slice of Invite.
+type Invites []*Invite
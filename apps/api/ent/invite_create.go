@@ -0,0 +1,405 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/chatroom"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/invite"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/user"
+)
+
+// InviteCreate is the builder for creating a Invite entity.
+type InviteCreate struct {
+	config
+	mutation *InviteMutation
+	hooks    []Hook
+}
+
+// SetRoomID sets the "room_id" field.
+func (ic *InviteCreate) SetRoomID(u uuid.UUID) *InviteCreate {
+	ic.mutation.SetRoomID(u)
+	return ic
+}
+
+// SetCreatorID sets the "creator_id" field.
+func (ic *InviteCreate) SetCreatorID(u uuid.UUID) *InviteCreate {
+	ic.mutation.SetCreatorID(u)
+	return ic
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (ic *InviteCreate) SetTokenHash(b []byte) *InviteCreate {
+	ic.mutation.SetTokenHash(b)
+	return ic
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (ic *InviteCreate) SetExpiresAt(t time.Time) *InviteCreate {
+	ic.mutation.SetExpiresAt(t)
+	return ic
+}
+
+// SetMaxUses sets the "max_uses" field.
+func (ic *InviteCreate) SetMaxUses(i int) *InviteCreate {
+	ic.mutation.SetMaxUses(i)
+	return ic
+}
+
+// SetNillableMaxUses sets the "max_uses" field if the given value is not nil.
+func (ic *InviteCreate) SetNillableMaxUses(i *int) *InviteCreate {
+	if i != nil {
+		ic.SetMaxUses(*i)
+	}
+	return ic
+}
+
+// SetUseCount sets the "use_count" field.
+func (ic *InviteCreate) SetUseCount(i int) *InviteCreate {
+	ic.mutation.SetUseCount(i)
+	return ic
+}
+
+// SetNillableUseCount sets the "use_count" field if the given value is not nil.
+func (ic *InviteCreate) SetNillableUseCount(i *int) *InviteCreate {
+	if i != nil {
+		ic.SetUseCount(*i)
+	}
+	return ic
+}
+
+// SetRevoked sets the "revoked" field.
+func (ic *InviteCreate) SetRevoked(b bool) *InviteCreate {
+	ic.mutation.SetRevoked(b)
+	return ic
+}
+
+// SetNillableRevoked sets the "revoked" field if the given value is not nil.
+func (ic *InviteCreate) SetNillableRevoked(b *bool) *InviteCreate {
+	if b != nil {
+		ic.SetRevoked(*b)
+	}
+	return ic
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (ic *InviteCreate) SetCreatedAt(t time.Time) *InviteCreate {
+	ic.mutation.SetCreatedAt(t)
+	return ic
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ic *InviteCreate) SetNillableCreatedAt(t *time.Time) *InviteCreate {
+	if t != nil {
+		ic.SetCreatedAt(*t)
+	}
+	return ic
+}
+
+// SetID sets the "id" field.
+func (ic *InviteCreate) SetID(u uuid.UUID) *InviteCreate {
+	ic.mutation.SetID(u)
+	return ic
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (ic *InviteCreate) SetNillableID(u *uuid.UUID) *InviteCreate {
+	if u != nil {
+		ic.SetID(*u)
+	}
+	return ic
+}
+
+// SetRoom sets the "room" edge to the ChatRoom entity.
+func (ic *InviteCreate) SetRoom(c *ChatRoom) *InviteCreate {
+	return ic.SetRoomID(c.ID)
+}
+
+// SetCreator sets the "creator" edge to the User entity.
+func (ic *InviteCreate) SetCreator(u *User) *InviteCreate {
+	return ic.SetCreatorID(u.ID)
+}
+
+// Mutation returns the InviteMutation object of the builder.
+func (ic *InviteCreate) Mutation() *InviteMutation {
+	return ic.mutation
+}
+
+// Save creates the Invite in the database.
+func (ic *InviteCreate) Save(ctx context.Context) (*Invite, error) {
+	ic.defaults()
+	return withHooks(ctx, ic.sqlSave, ic.mutation, ic.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ic *InviteCreate) SaveX(ctx context.Context) *Invite {
+	v, err := ic.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ic *InviteCreate) Exec(ctx context.Context) error {
+	_, err := ic.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ic *InviteCreate) ExecX(ctx context.Context) {
+	if err := ic.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ic *InviteCreate) defaults() {
+	if _, ok := ic.mutation.UseCount(); !ok {
+		v := invite.DefaultUseCount
+		ic.mutation.SetUseCount(v)
+	}
+	if _, ok := ic.mutation.Revoked(); !ok {
+		v := invite.DefaultRevoked
+		ic.mutation.SetRevoked(v)
+	}
+	if _, ok := ic.mutation.CreatedAt(); !ok {
+		v := invite.DefaultCreatedAt()
+		ic.mutation.SetCreatedAt(v)
+	}
+	if _, ok := ic.mutation.ID(); !ok {
+		v := invite.DefaultID()
+		ic.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ic *InviteCreate) check() error {
+	if _, ok := ic.mutation.RoomID(); !ok {
+		return &ValidationError{Name: "room_id", err: errors.New(`ent: missing required field "Invite.room_id"`)}
+	}
+	if _, ok := ic.mutation.CreatorID(); !ok {
+		return &ValidationError{Name: "creator_id", err: errors.New(`ent: missing required field "Invite.creator_id"`)}
+	}
+	if _, ok := ic.mutation.TokenHash(); !ok {
+		return &ValidationError{Name: "token_hash", err: errors.New(`ent: missing required field "Invite.token_hash"`)}
+	}
+	if v, ok := ic.mutation.TokenHash(); ok {
+		if err := invite.TokenHashValidator(v); err != nil {
+			return &ValidationError{Name: "token_hash", err: fmt.Errorf(`ent: validator failed for field "Invite.token_hash": %w`, err)}
+		}
+	}
+	if _, ok := ic.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "Invite.expires_at"`)}
+	}
+	if v, ok := ic.mutation.MaxUses(); ok {
+		if err := invite.MaxUsesValidator(v); err != nil {
+			return &ValidationError{Name: "max_uses", err: fmt.Errorf(`ent: validator failed for field "Invite.max_uses": %w`, err)}
+		}
+	}
+	if _, ok := ic.mutation.UseCount(); !ok {
+		return &ValidationError{Name: "use_count", err: errors.New(`ent: missing required field "Invite.use_count"`)}
+	}
+	if v, ok := ic.mutation.UseCount(); ok {
+		if err := invite.UseCountValidator(v); err != nil {
+			return &ValidationError{Name: "use_count", err: fmt.Errorf(`ent: validator failed for field "Invite.use_count": %w`, err)}
+		}
+	}
+	if _, ok := ic.mutation.Revoked(); !ok {
+		return &ValidationError{Name: "revoked", err: errors.New(`ent: missing required field "Invite.revoked"`)}
+	}
+	if _, ok := ic.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Invite.created_at"`)}
+	}
+	if len(ic.mutation.RoomIDs()) == 0 {
+		return &ValidationError{Name: "room", err: errors.New(`ent: missing required edge "Invite.room"`)}
+	}
+	if len(ic.mutation.CreatorIDs()) == 0 {
+		return &ValidationError{Name: "creator", err: errors.New(`ent: missing required edge "Invite.creator"`)}
+	}
+	return nil
+}
+
+func (ic *InviteCreate) sqlSave(ctx context.Context) (*Invite, error) {
+	if err := ic.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := ic.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ic.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	ic.mutation.id = &_node.ID
+	ic.mutation.done = true
+	return _node, nil
+}
+
+func (ic *InviteCreate) createSpec() (*Invite, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Invite{config: ic.config}
+		_spec = sqlgraph.NewCreateSpec(invite.Table, sqlgraph.NewFieldSpec(invite.FieldID, field.TypeUUID))
+	)
+	if id, ok := ic.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := ic.mutation.TokenHash(); ok {
+		_spec.SetField(invite.FieldTokenHash, field.TypeBytes, value)
+		_node.TokenHash = value
+	}
+	if value, ok := ic.mutation.ExpiresAt(); ok {
+		_spec.SetField(invite.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	if value, ok := ic.mutation.MaxUses(); ok {
+		_spec.SetField(invite.FieldMaxUses, field.TypeInt, value)
+		_node.MaxUses = &value
+	}
+	if value, ok := ic.mutation.UseCount(); ok {
+		_spec.SetField(invite.FieldUseCount, field.TypeInt, value)
+		_node.UseCount = value
+	}
+	if value, ok := ic.mutation.Revoked(); ok {
+		_spec.SetField(invite.FieldRevoked, field.TypeBool, value)
+		_node.Revoked = value
+	}
+	if value, ok := ic.mutation.CreatedAt(); ok {
+		_spec.SetField(invite.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := ic.mutation.RoomIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invite.RoomTable,
+			Columns: []string{invite.RoomColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(chatroom.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.RoomID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ic.mutation.CreatorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invite.CreatorTable,
+			Columns: []string{invite.CreatorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.CreatorID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// InviteCreateBulk is the builder for creating many Invite entities in bulk.
+type InviteCreateBulk struct {
+	config
+	err      error
+	builders []*InviteCreate
+}
+
+// Save creates the Invite entities in the database.
+func (icb *InviteCreateBulk) Save(ctx context.Context) ([]*Invite, error) {
+	if icb.err != nil {
+		return nil, icb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(icb.builders))
+	nodes := make([]*Invite, len(icb.builders))
+	mutators := make([]Mutator, len(icb.builders))
+	for i := range icb.builders {
+		func(i int, root context.Context) {
+			builder := icb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*InviteMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, icb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, icb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, icb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (icb *InviteCreateBulk) SaveX(ctx context.Context) []*Invite {
+	v, err := icb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (icb *InviteCreateBulk) Exec(ctx context.Context) error {
+	_, err := icb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (icb *InviteCreateBulk) ExecX(ctx context.Context) {
+	if err := icb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,665 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/chatroom"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/invite"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/predicate"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/user"
+)
+
+// InviteUpdate is the builder for updating Invite entities.
+type InviteUpdate struct {
+	config
+	hooks    []Hook
+	mutation *InviteMutation
+}
+
+// Where appends a list predicates to the InviteUpdate builder.
+func (iu *InviteUpdate) Where(ps ...predicate.Invite) *InviteUpdate {
+	iu.mutation.Where(ps...)
+	return iu
+}
+
+// SetRoomID sets the "room_id" field.
+func (iu *InviteUpdate) SetRoomID(u uuid.UUID) *InviteUpdate {
+	iu.mutation.SetRoomID(u)
+	return iu
+}
+
+// SetNillableRoomID sets the "room_id" field if the given value is not nil.
+func (iu *InviteUpdate) SetNillableRoomID(u *uuid.UUID) *InviteUpdate {
+	if u != nil {
+		iu.SetRoomID(*u)
+	}
+	return iu
+}
+
+// SetCreatorID sets the "creator_id" field.
+func (iu *InviteUpdate) SetCreatorID(u uuid.UUID) *InviteUpdate {
+	iu.mutation.SetCreatorID(u)
+	return iu
+}
+
+// SetNillableCreatorID sets the "creator_id" field if the given value is not nil.
+func (iu *InviteUpdate) SetNillableCreatorID(u *uuid.UUID) *InviteUpdate {
+	if u != nil {
+		iu.SetCreatorID(*u)
+	}
+	return iu
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (iu *InviteUpdate) SetTokenHash(b []byte) *InviteUpdate {
+	iu.mutation.SetTokenHash(b)
+	return iu
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (iu *InviteUpdate) SetExpiresAt(t time.Time) *InviteUpdate {
+	iu.mutation.SetExpiresAt(t)
+	return iu
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (iu *InviteUpdate) SetNillableExpiresAt(t *time.Time) *InviteUpdate {
+	if t != nil {
+		iu.SetExpiresAt(*t)
+	}
+	return iu
+}
+
+// SetMaxUses sets the "max_uses" field.
+func (iu *InviteUpdate) SetMaxUses(i int) *InviteUpdate {
+	iu.mutation.ResetMaxUses()
+	iu.mutation.SetMaxUses(i)
+	return iu
+}
+
+// SetNillableMaxUses sets the "max_uses" field if the given value is not nil.
+func (iu *InviteUpdate) SetNillableMaxUses(i *int) *InviteUpdate {
+	if i != nil {
+		iu.SetMaxUses(*i)
+	}
+	return iu
+}
+
+// AddMaxUses adds i to the "max_uses" field.
+func (iu *InviteUpdate) AddMaxUses(i int) *InviteUpdate {
+	iu.mutation.AddMaxUses(i)
+	return iu
+}
+
+// ClearMaxUses clears the value of the "max_uses" field.
+func (iu *InviteUpdate) ClearMaxUses() *InviteUpdate {
+	iu.mutation.ClearMaxUses()
+	return iu
+}
+
+// SetUseCount sets the "use_count" field.
+func (iu *InviteUpdate) SetUseCount(i int) *InviteUpdate {
+	iu.mutation.ResetUseCount()
+	iu.mutation.SetUseCount(i)
+	return iu
+}
+
+// SetNillableUseCount sets the "use_count" field if the given value is not nil.
+func (iu *InviteUpdate) SetNillableUseCount(i *int) *InviteUpdate {
+	if i != nil {
+		iu.SetUseCount(*i)
+	}
+	return iu
+}
+
+// AddUseCount adds i to the "use_count" field.
+func (iu *InviteUpdate) AddUseCount(i int) *InviteUpdate {
+	iu.mutation.AddUseCount(i)
+	return iu
+}
+
+// SetRevoked sets the "revoked" field.
+func (iu *InviteUpdate) SetRevoked(b bool) *InviteUpdate {
+	iu.mutation.SetRevoked(b)
+	return iu
+}
+
+// SetNillableRevoked sets the "revoked" field if the given value is not nil.
+func (iu *InviteUpdate) SetNillableRevoked(b *bool) *InviteUpdate {
+	if b != nil {
+		iu.SetRevoked(*b)
+	}
+	return iu
+}
+
+// SetRoom sets the "room" edge to the ChatRoom entity.
+func (iu *InviteUpdate) SetRoom(c *ChatRoom) *InviteUpdate {
+	return iu.SetRoomID(c.ID)
+}
+
+// SetCreator sets the "creator" edge to the User entity.
+func (iu *InviteUpdate) SetCreator(u *User) *InviteUpdate {
+	return iu.SetCreatorID(u.ID)
+}
+
+// Mutation returns the InviteMutation object of the builder.
+func (iu *InviteUpdate) Mutation() *InviteMutation {
+	return iu.mutation
+}
+
+// ClearRoom clears the "room" edge to the ChatRoom entity.
+func (iu *InviteUpdate) ClearRoom() *InviteUpdate {
+	iu.mutation.ClearRoom()
+	return iu
+}
+
+// ClearCreator clears the "creator" edge to the User entity.
+func (iu *InviteUpdate) ClearCreator() *InviteUpdate {
+	iu.mutation.ClearCreator()
+	return iu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (iu *InviteUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, iu.sqlSave, iu.mutation, iu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (iu *InviteUpdate) SaveX(ctx context.Context) int {
+	affected, err := iu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (iu *InviteUpdate) Exec(ctx context.Context) error {
+	_, err := iu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iu *InviteUpdate) ExecX(ctx context.Context) {
+	if err := iu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (iu *InviteUpdate) check() error {
+	if v, ok := iu.mutation.TokenHash(); ok {
+		if err := invite.TokenHashValidator(v); err != nil {
+			return &ValidationError{Name: "token_hash", err: fmt.Errorf(`ent: validator failed for field "Invite.token_hash": %w`, err)}
+		}
+	}
+	if v, ok := iu.mutation.MaxUses(); ok {
+		if err := invite.MaxUsesValidator(v); err != nil {
+			return &ValidationError{Name: "max_uses", err: fmt.Errorf(`ent: validator failed for field "Invite.max_uses": %w`, err)}
+		}
+	}
+	if v, ok := iu.mutation.UseCount(); ok {
+		if err := invite.UseCountValidator(v); err != nil {
+			return &ValidationError{Name: "use_count", err: fmt.Errorf(`ent: validator failed for field "Invite.use_count": %w`, err)}
+		}
+	}
+	if iu.mutation.RoomCleared() && len(iu.mutation.RoomIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Invite.room"`)
+	}
+	if iu.mutation.CreatorCleared() && len(iu.mutation.CreatorIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Invite.creator"`)
+	}
+	return nil
+}
+
+func (iu *InviteUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := iu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(invite.Table, invite.Columns, sqlgraph.NewFieldSpec(invite.FieldID, field.TypeUUID))
+	if ps := iu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := iu.mutation.TokenHash(); ok {
+		_spec.SetField(invite.FieldTokenHash, field.TypeBytes, value)
+	}
+	if value, ok := iu.mutation.ExpiresAt(); ok {
+		_spec.SetField(invite.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := iu.mutation.MaxUses(); ok {
+		_spec.SetField(invite.FieldMaxUses, field.TypeInt, value)
+	}
+	if value, ok := iu.mutation.AddedMaxUses(); ok {
+		_spec.AddField(invite.FieldMaxUses, field.TypeInt, value)
+	}
+	if iu.mutation.MaxUsesCleared() {
+		_spec.ClearField(invite.FieldMaxUses, field.TypeInt)
+	}
+	if value, ok := iu.mutation.UseCount(); ok {
+		_spec.SetField(invite.FieldUseCount, field.TypeInt, value)
+	}
+	if value, ok := iu.mutation.AddedUseCount(); ok {
+		_spec.AddField(invite.FieldUseCount, field.TypeInt, value)
+	}
+	if value, ok := iu.mutation.Revoked(); ok {
+		_spec.SetField(invite.FieldRevoked, field.TypeBool, value)
+	}
+	if iu.mutation.RoomCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invite.RoomTable,
+			Columns: []string{invite.RoomColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(chatroom.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iu.mutation.RoomIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invite.RoomTable,
+			Columns: []string{invite.RoomColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(chatroom.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if iu.mutation.CreatorCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invite.CreatorTable,
+			Columns: []string{invite.CreatorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iu.mutation.CreatorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invite.CreatorTable,
+			Columns: []string{invite.CreatorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, iu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{invite.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	iu.mutation.done = true
+	return n, nil
+}
+
+// InviteUpdateOne is the builder for updating a single Invite entity.
+type InviteUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *InviteMutation
+}
+
+// SetRoomID sets the "room_id" field.
+func (iuo *InviteUpdateOne) SetRoomID(u uuid.UUID) *InviteUpdateOne {
+	iuo.mutation.SetRoomID(u)
+	return iuo
+}
+
+// SetNillableRoomID sets the "room_id" field if the given value is not nil.
+func (iuo *InviteUpdateOne) SetNillableRoomID(u *uuid.UUID) *InviteUpdateOne {
+	if u != nil {
+		iuo.SetRoomID(*u)
+	}
+	return iuo
+}
+
+// SetCreatorID sets the "creator_id" field.
+func (iuo *InviteUpdateOne) SetCreatorID(u uuid.UUID) *InviteUpdateOne {
+	iuo.mutation.SetCreatorID(u)
+	return iuo
+}
+
+// SetNillableCreatorID sets the "creator_id" field if the given value is not nil.
+func (iuo *InviteUpdateOne) SetNillableCreatorID(u *uuid.UUID) *InviteUpdateOne {
+	if u != nil {
+		iuo.SetCreatorID(*u)
+	}
+	return iuo
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (iuo *InviteUpdateOne) SetTokenHash(b []byte) *InviteUpdateOne {
+	iuo.mutation.SetTokenHash(b)
+	return iuo
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (iuo *InviteUpdateOne) SetExpiresAt(t time.Time) *InviteUpdateOne {
+	iuo.mutation.SetExpiresAt(t)
+	return iuo
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (iuo *InviteUpdateOne) SetNillableExpiresAt(t *time.Time) *InviteUpdateOne {
+	if t != nil {
+		iuo.SetExpiresAt(*t)
+	}
+	return iuo
+}
+
+// SetMaxUses sets the "max_uses" field.
+func (iuo *InviteUpdateOne) SetMaxUses(i int) *InviteUpdateOne {
+	iuo.mutation.ResetMaxUses()
+	iuo.mutation.SetMaxUses(i)
+	return iuo
+}
+
+// SetNillableMaxUses sets the "max_uses" field if the given value is not nil.
+func (iuo *InviteUpdateOne) SetNillableMaxUses(i *int) *InviteUpdateOne {
+	if i != nil {
+		iuo.SetMaxUses(*i)
+	}
+	return iuo
+}
+
+// AddMaxUses adds i to the "max_uses" field.
+func (iuo *InviteUpdateOne) AddMaxUses(i int) *InviteUpdateOne {
+	iuo.mutation.AddMaxUses(i)
+	return iuo
+}
+
+// ClearMaxUses clears the value of the "max_uses" field.
+func (iuo *InviteUpdateOne) ClearMaxUses() *InviteUpdateOne {
+	iuo.mutation.ClearMaxUses()
+	return iuo
+}
+
+// SetUseCount sets the "use_count" field.
+func (iuo *InviteUpdateOne) SetUseCount(i int) *InviteUpdateOne {
+	iuo.mutation.ResetUseCount()
+	iuo.mutation.SetUseCount(i)
+	return iuo
+}
+
+// SetNillableUseCount sets the "use_count" field if the given value is not nil.
+func (iuo *InviteUpdateOne) SetNillableUseCount(i *int) *InviteUpdateOne {
+	if i != nil {
+		iuo.SetUseCount(*i)
+	}
+	return iuo
+}
+
+// AddUseCount adds i to the "use_count" field.
+func (iuo *InviteUpdateOne) AddUseCount(i int) *InviteUpdateOne {
+	iuo.mutation.AddUseCount(i)
+	return iuo
+}
+
+// SetRevoked sets the "revoked" field.
+func (iuo *InviteUpdateOne) SetRevoked(b bool) *InviteUpdateOne {
+	iuo.mutation.SetRevoked(b)
+	return iuo
+}
+
+// SetNillableRevoked sets the "revoked" field if the given value is not nil.
+func (iuo *InviteUpdateOne) SetNillableRevoked(b *bool) *InviteUpdateOne {
+	if b != nil {
+		iuo.SetRevoked(*b)
+	}
+	return iuo
+}
+
+// SetRoom sets the "room" edge to the ChatRoom entity.
+func (iuo *InviteUpdateOne) SetRoom(c *ChatRoom) *InviteUpdateOne {
+	return iuo.SetRoomID(c.ID)
+}
+
+// SetCreator sets the "creator" edge to the User entity.
+func (iuo *InviteUpdateOne) SetCreator(u *User) *InviteUpdateOne {
+	return iuo.SetCreatorID(u.ID)
+}
+
+// Mutation returns the InviteMutation object of the builder.
+func (iuo *InviteUpdateOne) Mutation() *InviteMutation {
+	return iuo.mutation
+}
+
+// ClearRoom clears the "room" edge to the ChatRoom entity.
+func (iuo *InviteUpdateOne) ClearRoom() *InviteUpdateOne {
+	iuo.mutation.ClearRoom()
+	return iuo
+}
+
+// ClearCreator clears the "creator" edge to the User entity.
+func (iuo *InviteUpdateOne) ClearCreator() *InviteUpdateOne {
+	iuo.mutation.ClearCreator()
+	return iuo
+}
+
+// Where appends a list predicates to the InviteUpdate builder.
+func (iuo *InviteUpdateOne) Where(ps ...predicate.Invite) *InviteUpdateOne {
+	iuo.mutation.Where(ps...)
+	return iuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (iuo *InviteUpdateOne) Select(field string, fields ...string) *InviteUpdateOne {
+	iuo.fields = append([]string{field}, fields...)
+	return iuo
+}
+
+// Save executes the query and returns the updated Invite entity.
+func (iuo *InviteUpdateOne) Save(ctx context.Context) (*Invite, error) {
+	return withHooks(ctx, iuo.sqlSave, iuo.mutation, iuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (iuo *InviteUpdateOne) SaveX(ctx context.Context) *Invite {
+	node, err := iuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (iuo *InviteUpdateOne) Exec(ctx context.Context) error {
+	_, err := iuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iuo *InviteUpdateOne) ExecX(ctx context.Context) {
+	if err := iuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (iuo *InviteUpdateOne) check() error {
+	if v, ok := iuo.mutation.TokenHash(); ok {
+		if err := invite.TokenHashValidator(v); err != nil {
+			return &ValidationError{Name: "token_hash", err: fmt.Errorf(`ent: validator failed for field "Invite.token_hash": %w`, err)}
+		}
+	}
+	if v, ok := iuo.mutation.MaxUses(); ok {
+		if err := invite.MaxUsesValidator(v); err != nil {
+			return &ValidationError{Name: "max_uses", err: fmt.Errorf(`ent: validator failed for field "Invite.max_uses": %w`, err)}
+		}
+	}
+	if v, ok := iuo.mutation.UseCount(); ok {
+		if err := invite.UseCountValidator(v); err != nil {
+			return &ValidationError{Name: "use_count", err: fmt.Errorf(`ent: validator failed for field "Invite.use_count": %w`, err)}
+		}
+	}
+	if iuo.mutation.RoomCleared() && len(iuo.mutation.RoomIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Invite.room"`)
+	}
+	if iuo.mutation.CreatorCleared() && len(iuo.mutation.CreatorIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Invite.creator"`)
+	}
+	return nil
+}
+
+func (iuo *InviteUpdateOne) sqlSave(ctx context.Context) (_node *Invite, err error) {
+	if err := iuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(invite.Table, invite.Columns, sqlgraph.NewFieldSpec(invite.FieldID, field.TypeUUID))
+	id, ok := iuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Invite.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := iuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, invite.FieldID)
+		for _, f := range fields {
+			if !invite.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != invite.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := iuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := iuo.mutation.TokenHash(); ok {
+		_spec.SetField(invite.FieldTokenHash, field.TypeBytes, value)
+	}
+	if value, ok := iuo.mutation.ExpiresAt(); ok {
+		_spec.SetField(invite.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := iuo.mutation.MaxUses(); ok {
+		_spec.SetField(invite.FieldMaxUses, field.TypeInt, value)
+	}
+	if value, ok := iuo.mutation.AddedMaxUses(); ok {
+		_spec.AddField(invite.FieldMaxUses, field.TypeInt, value)
+	}
+	if iuo.mutation.MaxUsesCleared() {
+		_spec.ClearField(invite.FieldMaxUses, field.TypeInt)
+	}
+	if value, ok := iuo.mutation.UseCount(); ok {
+		_spec.SetField(invite.FieldUseCount, field.TypeInt, value)
+	}
+	if value, ok := iuo.mutation.AddedUseCount(); ok {
+		_spec.AddField(invite.FieldUseCount, field.TypeInt, value)
+	}
+	if value, ok := iuo.mutation.Revoked(); ok {
+		_spec.SetField(invite.FieldRevoked, field.TypeBool, value)
+	}
+	if iuo.mutation.RoomCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invite.RoomTable,
+			Columns: []string{invite.RoomColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(chatroom.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iuo.mutation.RoomIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invite.RoomTable,
+			Columns: []string{invite.RoomColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(chatroom.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if iuo.mutation.CreatorCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invite.CreatorTable,
+			Columns: []string{invite.CreatorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iuo.mutation.CreatorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invite.CreatorTable,
+			Columns: []string{invite.CreatorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Invite{config: iuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, iuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{invite.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	iuo.mutation.done = true
+	return _node, nil
+}
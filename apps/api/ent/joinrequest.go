@@ -0,0 +1,220 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/chatroom"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/joinrequest"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/user"
+)
+
+// JoinRequest is the model entity for the JoinRequest schema.
+type JoinRequest struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int64 `json:"id,omitempty"`
+	// 参加を申請したチャットルームID
+	RoomID uuid.UUID `json:"room_id,omitempty"`
+	// 申請したユーザーID
+	UserID uuid.UUID `json:"user_id,omitempty"`
+	// 申請状態
+	Status joinrequest.Status `json:"status,omitempty"`
+	// 承認・却下したユーザーID
+	DecidedBy *uuid.UUID `json:"decided_by,omitempty"`
+	// 承認・却下日時
+	DecidedAt *time.Time `json:"decided_at,omitempty"`
+	// 申請日時（再申請時に更新）
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the JoinRequestQuery when eager-loading is set.
+	Edges        JoinRequestEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// JoinRequestEdges holds the relations/edges for other nodes in the graph.
+type JoinRequestEdges struct {
+	// Room holds the value of the room edge.
+	Room *ChatRoom `json:"room,omitempty"`
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// RoomOrErr returns the Room value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e JoinRequestEdges) RoomOrErr() (*ChatRoom, error) {
+	if e.Room != nil {
+		return e.Room, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: chatroom.Label}
+	}
+	return nil, &NotLoadedError{edge: "room"}
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e JoinRequestEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*JoinRequest) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case joinrequest.FieldDecidedBy:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case joinrequest.FieldID:
+			values[i] = new(sql.NullInt64)
+		case joinrequest.FieldStatus:
+			values[i] = new(sql.NullString)
+		case joinrequest.FieldDecidedAt, joinrequest.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case joinrequest.FieldRoomID, joinrequest.FieldUserID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the JoinRequest fields.
+func (jr *JoinRequest) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case joinrequest.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			jr.ID = int64(value.Int64)
+		case joinrequest.FieldRoomID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field room_id", values[i])
+			} else if value != nil {
+				jr.RoomID = *value
+			}
+		case joinrequest.FieldUserID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value != nil {
+				jr.UserID = *value
+			}
+		case joinrequest.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				jr.Status = joinrequest.Status(value.String)
+			}
+		case joinrequest.FieldDecidedBy:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field decided_by", values[i])
+			} else if value.Valid {
+				jr.DecidedBy = new(uuid.UUID)
+				*jr.DecidedBy = *value.S.(*uuid.UUID)
+			}
+		case joinrequest.FieldDecidedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field decided_at", values[i])
+			} else if value.Valid {
+				jr.DecidedAt = new(time.Time)
+				*jr.DecidedAt = value.Time
+			}
+		case joinrequest.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				jr.CreatedAt = value.Time
+			}
+		default:
+			jr.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the JoinRequest.
+// This includes values selected through modifiers, order, etc.
+func (jr *JoinRequest) Value(name string) (ent.Value, error) {
+	return jr.selectValues.Get(name)
+}
+
+// QueryRoom queries the "room" edge of the JoinRequest entity.
+func (jr *JoinRequest) QueryRoom() *ChatRoomQuery {
+	return NewJoinRequestClient(jr.config).QueryRoom(jr)
+}
+
+// QueryUser queries the "user" edge of the JoinRequest entity.
+func (jr *JoinRequest) QueryUser() *UserQuery {
+	return NewJoinRequestClient(jr.config).QueryUser(jr)
+}
+
+// Update returns a builder for updating this JoinRequest.
+// Note that you need to call JoinRequest.Unwrap() before calling this method if this JoinRequest
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (jr *JoinRequest) Update() *JoinRequestUpdateOne {
+	return NewJoinRequestClient(jr.config).UpdateOne(jr)
+}
+
+// Unwrap unwraps the JoinRequest entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (jr *JoinRequest) Unwrap() *JoinRequest {
+	_tx, ok := jr.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: JoinRequest is not a transactional entity")
+	}
+	jr.config.driver = _tx.drv
+	return jr
+}
+
+// String implements the fmt.Stringer.
+func (jr *JoinRequest) String() string {
+	var builder strings.Builder
+	builder.WriteString("JoinRequest(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", jr.ID))
+	builder.WriteString("room_id=")
+	builder.WriteString(fmt.Sprintf("%v", jr.RoomID))
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", jr.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", jr.Status))
+	builder.WriteString(", ")
+	if v := jr.DecidedBy; v != nil {
+		builder.WriteString("decided_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := jr.DecidedAt; v != nil {
+		builder.WriteString("decided_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(jr.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// JoinRequests is a parsable slice of JoinRequest.
+type JoinRequests []*JoinRequest
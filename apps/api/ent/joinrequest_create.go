@@ -0,0 +1,356 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/chatroom"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/joinrequest"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/user"
+)
+
+// JoinRequestCreate is the builder for creating a JoinRequest entity.
+type JoinRequestCreate struct {
+	config
+	mutation *JoinRequestMutation
+	hooks    []Hook
+}
+
+// SetRoomID sets the "room_id" field.
+func (jrc *JoinRequestCreate) SetRoomID(u uuid.UUID) *JoinRequestCreate {
+	jrc.mutation.SetRoomID(u)
+	return jrc
+}
+
+// SetUserID sets the "user_id" field.
+func (jrc *JoinRequestCreate) SetUserID(u uuid.UUID) *JoinRequestCreate {
+	jrc.mutation.SetUserID(u)
+	return jrc
+}
+
+// SetStatus sets the "status" field.
+func (jrc *JoinRequestCreate) SetStatus(j joinrequest.Status) *JoinRequestCreate {
+	jrc.mutation.SetStatus(j)
+	return jrc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (jrc *JoinRequestCreate) SetNillableStatus(j *joinrequest.Status) *JoinRequestCreate {
+	if j != nil {
+		jrc.SetStatus(*j)
+	}
+	return jrc
+}
+
+// SetDecidedBy sets the "decided_by" field.
+func (jrc *JoinRequestCreate) SetDecidedBy(u uuid.UUID) *JoinRequestCreate {
+	jrc.mutation.SetDecidedBy(u)
+	return jrc
+}
+
+// SetNillableDecidedBy sets the "decided_by" field if the given value is not nil.
+func (jrc *JoinRequestCreate) SetNillableDecidedBy(u *uuid.UUID) *JoinRequestCreate {
+	if u != nil {
+		jrc.SetDecidedBy(*u)
+	}
+	return jrc
+}
+
+// SetDecidedAt sets the "decided_at" field.
+func (jrc *JoinRequestCreate) SetDecidedAt(t time.Time) *JoinRequestCreate {
+	jrc.mutation.SetDecidedAt(t)
+	return jrc
+}
+
+// SetNillableDecidedAt sets the "decided_at" field if the given value is not nil.
+func (jrc *JoinRequestCreate) SetNillableDecidedAt(t *time.Time) *JoinRequestCreate {
+	if t != nil {
+		jrc.SetDecidedAt(*t)
+	}
+	return jrc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (jrc *JoinRequestCreate) SetCreatedAt(t time.Time) *JoinRequestCreate {
+	jrc.mutation.SetCreatedAt(t)
+	return jrc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (jrc *JoinRequestCreate) SetNillableCreatedAt(t *time.Time) *JoinRequestCreate {
+	if t != nil {
+		jrc.SetCreatedAt(*t)
+	}
+	return jrc
+}
+
+// SetID sets the "id" field.
+func (jrc *JoinRequestCreate) SetID(i int64) *JoinRequestCreate {
+	jrc.mutation.SetID(i)
+	return jrc
+}
+
+// SetRoom sets the "room" edge to the ChatRoom entity.
+func (jrc *JoinRequestCreate) SetRoom(c *ChatRoom) *JoinRequestCreate {
+	return jrc.SetRoomID(c.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (jrc *JoinRequestCreate) SetUser(u *User) *JoinRequestCreate {
+	return jrc.SetUserID(u.ID)
+}
+
+// Mutation returns the JoinRequestMutation object of the builder.
+func (jrc *JoinRequestCreate) Mutation() *JoinRequestMutation {
+	return jrc.mutation
+}
+
+// Save creates the JoinRequest in the database.
+func (jrc *JoinRequestCreate) Save(ctx context.Context) (*JoinRequest, error) {
+	jrc.defaults()
+	return withHooks(ctx, jrc.sqlSave, jrc.mutation, jrc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (jrc *JoinRequestCreate) SaveX(ctx context.Context) *JoinRequest {
+	v, err := jrc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (jrc *JoinRequestCreate) Exec(ctx context.Context) error {
+	_, err := jrc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (jrc *JoinRequestCreate) ExecX(ctx context.Context) {
+	if err := jrc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (jrc *JoinRequestCreate) defaults() {
+	if _, ok := jrc.mutation.Status(); !ok {
+		v := joinrequest.DefaultStatus
+		jrc.mutation.SetStatus(v)
+	}
+	if _, ok := jrc.mutation.CreatedAt(); !ok {
+		v := joinrequest.DefaultCreatedAt()
+		jrc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (jrc *JoinRequestCreate) check() error {
+	if _, ok := jrc.mutation.RoomID(); !ok {
+		return &ValidationError{Name: "room_id", err: errors.New(`ent: missing required field "JoinRequest.room_id"`)}
+	}
+	if _, ok := jrc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "JoinRequest.user_id"`)}
+	}
+	if _, ok := jrc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "JoinRequest.status"`)}
+	}
+	if v, ok := jrc.mutation.Status(); ok {
+		if err := joinrequest.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "JoinRequest.status": %w`, err)}
+		}
+	}
+	if _, ok := jrc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "JoinRequest.created_at"`)}
+	}
+	if v, ok := jrc.mutation.ID(); ok {
+		if err := joinrequest.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "JoinRequest.id": %w`, err)}
+		}
+	}
+	if len(jrc.mutation.RoomIDs()) == 0 {
+		return &ValidationError{Name: "room", err: errors.New(`ent: missing required edge "JoinRequest.room"`)}
+	}
+	if len(jrc.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "JoinRequest.user"`)}
+	}
+	return nil
+}
+
+func (jrc *JoinRequestCreate) sqlSave(ctx context.Context) (*JoinRequest, error) {
+	if err := jrc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := jrc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, jrc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int64(id)
+	}
+	jrc.mutation.id = &_node.ID
+	jrc.mutation.done = true
+	return _node, nil
+}
+
+func (jrc *JoinRequestCreate) createSpec() (*JoinRequest, *sqlgraph.CreateSpec) {
+	var (
+		_node = &JoinRequest{config: jrc.config}
+		_spec = sqlgraph.NewCreateSpec(joinrequest.Table, sqlgraph.NewFieldSpec(joinrequest.FieldID, field.TypeInt64))
+	)
+	if id, ok := jrc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := jrc.mutation.Status(); ok {
+		_spec.SetField(joinrequest.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := jrc.mutation.DecidedBy(); ok {
+		_spec.SetField(joinrequest.FieldDecidedBy, field.TypeUUID, value)
+		_node.DecidedBy = &value
+	}
+	if value, ok := jrc.mutation.DecidedAt(); ok {
+		_spec.SetField(joinrequest.FieldDecidedAt, field.TypeTime, value)
+		_node.DecidedAt = &value
+	}
+	if value, ok := jrc.mutation.CreatedAt(); ok {
+		_spec.SetField(joinrequest.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := jrc.mutation.RoomIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   joinrequest.RoomTable,
+			Columns: []string{joinrequest.RoomColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(chatroom.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.RoomID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := jrc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   joinrequest.UserTable,
+			Columns: []string{joinrequest.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// JoinRequestCreateBulk is the builder for creating many JoinRequest entities in bulk.
+type JoinRequestCreateBulk struct {
+	config
+	err      error
+	builders []*JoinRequestCreate
+}
+
+// Save creates the JoinRequest entities in the database.
+func (jrcb *JoinRequestCreateBulk) Save(ctx context.Context) ([]*JoinRequest, error) {
+	if jrcb.err != nil {
+		return nil, jrcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(jrcb.builders))
+	nodes := make([]*JoinRequest, len(jrcb.builders))
+	mutators := make([]Mutator, len(jrcb.builders))
+	for i := range jrcb.builders {
+		func(i int, root context.Context) {
+			builder := jrcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*JoinRequestMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, jrcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, jrcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, jrcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (jrcb *JoinRequestCreateBulk) SaveX(ctx context.Context) []*JoinRequest {
+	v, err := jrcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (jrcb *JoinRequestCreateBulk) Exec(ctx context.Context) error {
+	_, err := jrcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (jrcb *JoinRequestCreateBulk) ExecX(ctx context.Context) {
+	if err := jrcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/joinrequest"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/predicate"
+)
+
+// JoinRequestDelete is the builder for deleting a JoinRequest entity.
+type JoinRequestDelete struct {
+	config
+	hooks    []Hook
+	mutation *JoinRequestMutation
+}
+
+// Where appends a list predicates to the JoinRequestDelete builder.
+func (jrd *JoinRequestDelete) Where(ps ...predicate.JoinRequest) *JoinRequestDelete {
+	jrd.mutation.Where(ps...)
+	return jrd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (jrd *JoinRequestDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, jrd.sqlExec, jrd.mutation, jrd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (jrd *JoinRequestDelete) ExecX(ctx context.Context) int {
+	n, err := jrd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (jrd *JoinRequestDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(joinrequest.Table, sqlgraph.NewFieldSpec(joinrequest.FieldID, field.TypeInt64))
+	if ps := jrd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, jrd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	jrd.mutation.done = true
+	return affected, err
+}
+
+// JoinRequestDeleteOne is the builder for deleting a single JoinRequest entity.
+type JoinRequestDeleteOne struct {
+	jrd *JoinRequestDelete
+}
+
+// Where appends a list predicates to the JoinRequestDelete builder.
+func (jrdo *JoinRequestDeleteOne) Where(ps ...predicate.JoinRequest) *JoinRequestDeleteOne {
+	jrdo.jrd.mutation.Where(ps...)
+	return jrdo
+}
+
+// Exec executes the deletion query.
+func (jrdo *JoinRequestDeleteOne) Exec(ctx context.Context) error {
+	n, err := jrdo.jrd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{joinrequest.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (jrdo *JoinRequestDeleteOne) ExecX(ctx context.Context) {
+	if err := jrdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
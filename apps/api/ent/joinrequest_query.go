@@ -0,0 +1,719 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/chatroom"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/joinrequest"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/predicate"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/user"
+)
+
+// JoinRequestQuery is the builder for querying JoinRequest entities.
+type JoinRequestQuery struct {
+	config
+	ctx        *QueryContext
+	order      []joinrequest.OrderOption
+	inters     []Interceptor
+	predicates []predicate.JoinRequest
+	withRoom   *ChatRoomQuery
+	withUser   *UserQuery
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the JoinRequestQuery builder.
+func (jrq *JoinRequestQuery) Where(ps ...predicate.JoinRequest) *JoinRequestQuery {
+	jrq.predicates = append(jrq.predicates, ps...)
+	return jrq
+}
+
+// Limit the number of records to be returned by this query.
+func (jrq *JoinRequestQuery) Limit(limit int) *JoinRequestQuery {
+	jrq.ctx.Limit = &limit
+	return jrq
+}
+
+// Offset to start from.
+func (jrq *JoinRequestQuery) Offset(offset int) *JoinRequestQuery {
+	jrq.ctx.Offset = &offset
+	return jrq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (jrq *JoinRequestQuery) Unique(unique bool) *JoinRequestQuery {
+	jrq.ctx.Unique = &unique
+	return jrq
+}
+
+// Order specifies how the records should be ordered.
+func (jrq *JoinRequestQuery) Order(o ...joinrequest.OrderOption) *JoinRequestQuery {
+	jrq.order = append(jrq.order, o...)
+	return jrq
+}
+
+// QueryRoom chains the current query on the "room" edge.
+func (jrq *JoinRequestQuery) QueryRoom() *ChatRoomQuery {
+	query := (&ChatRoomClient{config: jrq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := jrq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := jrq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(joinrequest.Table, joinrequest.FieldID, selector),
+			sqlgraph.To(chatroom.Table, chatroom.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, joinrequest.RoomTable, joinrequest.RoomColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(jrq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryUser chains the current query on the "user" edge.
+func (jrq *JoinRequestQuery) QueryUser() *UserQuery {
+	query := (&UserClient{config: jrq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := jrq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := jrq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(joinrequest.Table, joinrequest.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, joinrequest.UserTable, joinrequest.UserColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(jrq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first JoinRequest entity from the query.
+// Returns a *NotFoundError when no JoinRequest was found.
+func (jrq *JoinRequestQuery) First(ctx context.Context) (*JoinRequest, error) {
+	nodes, err := jrq.Limit(1).All(setContextOp(ctx, jrq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{joinrequest.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (jrq *JoinRequestQuery) FirstX(ctx context.Context) *JoinRequest {
+	node, err := jrq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first JoinRequest ID from the query.
+// Returns a *NotFoundError when no JoinRequest ID was found.
+func (jrq *JoinRequestQuery) FirstID(ctx context.Context) (id int64, err error) {
+	var ids []int64
+	if ids, err = jrq.Limit(1).IDs(setContextOp(ctx, jrq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{joinrequest.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (jrq *JoinRequestQuery) FirstIDX(ctx context.Context) int64 {
+	id, err := jrq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single JoinRequest entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one JoinRequest entity is found.
+// Returns a *NotFoundError when no JoinRequest entities are found.
+func (jrq *JoinRequestQuery) Only(ctx context.Context) (*JoinRequest, error) {
+	nodes, err := jrq.Limit(2).All(setContextOp(ctx, jrq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{joinrequest.Label}
+	default:
+		return nil, &NotSingularError{joinrequest.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (jrq *JoinRequestQuery) OnlyX(ctx context.Context) *JoinRequest {
+	node, err := jrq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only JoinRequest ID in the query.
+// Returns a *NotSingularError when more than one JoinRequest ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (jrq *JoinRequestQuery) OnlyID(ctx context.Context) (id int64, err error) {
+	var ids []int64
+	if ids, err = jrq.Limit(2).IDs(setContextOp(ctx, jrq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{joinrequest.Label}
+	default:
+		err = &NotSingularError{joinrequest.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (jrq *JoinRequestQuery) OnlyIDX(ctx context.Context) int64 {
+	id, err := jrq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of JoinRequests.
+func (jrq *JoinRequestQuery) All(ctx context.Context) ([]*JoinRequest, error) {
+	ctx = setContextOp(ctx, jrq.ctx, ent.OpQueryAll)
+	if err := jrq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*JoinRequest, *JoinRequestQuery]()
+	return withInterceptors[[]*JoinRequest](ctx, jrq, qr, jrq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (jrq *JoinRequestQuery) AllX(ctx context.Context) []*JoinRequest {
+	nodes, err := jrq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of JoinRequest IDs.
+func (jrq *JoinRequestQuery) IDs(ctx context.Context) (ids []int64, err error) {
+	if jrq.ctx.Unique == nil && jrq.path != nil {
+		jrq.Unique(true)
+	}
+	ctx = setContextOp(ctx, jrq.ctx, ent.OpQueryIDs)
+	if err = jrq.Select(joinrequest.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (jrq *JoinRequestQuery) IDsX(ctx context.Context) []int64 {
+	ids, err := jrq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (jrq *JoinRequestQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, jrq.ctx, ent.OpQueryCount)
+	if err := jrq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, jrq, querierCount[*JoinRequestQuery](), jrq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (jrq *JoinRequestQuery) CountX(ctx context.Context) int {
+	count, err := jrq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (jrq *JoinRequestQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, jrq.ctx, ent.OpQueryExist)
+	switch _, err := jrq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (jrq *JoinRequestQuery) ExistX(ctx context.Context) bool {
+	exist, err := jrq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the JoinRequestQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (jrq *JoinRequestQuery) Clone() *JoinRequestQuery {
+	if jrq == nil {
+		return nil
+	}
+	return &JoinRequestQuery{
+		config:     jrq.config,
+		ctx:        jrq.ctx.Clone(),
+		order:      append([]joinrequest.OrderOption{}, jrq.order...),
+		inters:     append([]Interceptor{}, jrq.inters...),
+		predicates: append([]predicate.JoinRequest{}, jrq.predicates...),
+		withRoom:   jrq.withRoom.Clone(),
+		withUser:   jrq.withUser.Clone(),
+		// clone intermediate query.
+		sql:  jrq.sql.Clone(),
+		path: jrq.path,
+	}
+}
+
+// WithRoom tells the query-builder to eager-load the nodes that are connected to
+// the "room" edge. The optional arguments are used to configure the query builder of the edge.
+func (jrq *JoinRequestQuery) WithRoom(opts ...func(*ChatRoomQuery)) *JoinRequestQuery {
+	query := (&ChatRoomClient{config: jrq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	jrq.withRoom = query
+	return jrq
+}
+
+// WithUser tells the query-builder to eager-load the nodes that are connected to
+// the "user" edge. The optional arguments are used to configure the query builder of the edge.
+func (jrq *JoinRequestQuery) WithUser(opts ...func(*UserQuery)) *JoinRequestQuery {
+	query := (&UserClient{config: jrq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	jrq.withUser = query
+	return jrq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		RoomID uuid.UUID `json:"room_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.JoinRequest.Query().
+//		GroupBy(joinrequest.FieldRoomID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (jrq *JoinRequestQuery) GroupBy(field string, fields ...string) *JoinRequestGroupBy {
+	jrq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &JoinRequestGroupBy{build: jrq}
+	grbuild.flds = &jrq.ctx.Fields
+	grbuild.label = joinrequest.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		RoomID uuid.UUID `json:"room_id,omitempty"`
+//	}
+//
+//	client.JoinRequest.Query().
+//		Select(joinrequest.FieldRoomID).
+//		Scan(ctx, &v)
+func (jrq *JoinRequestQuery) Select(fields ...string) *JoinRequestSelect {
+	jrq.ctx.Fields = append(jrq.ctx.Fields, fields...)
+	sbuild := &JoinRequestSelect{JoinRequestQuery: jrq}
+	sbuild.label = joinrequest.Label
+	sbuild.flds, sbuild.scan = &jrq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a JoinRequestSelect configured with the given aggregations.
+func (jrq *JoinRequestQuery) Aggregate(fns ...AggregateFunc) *JoinRequestSelect {
+	return jrq.Select().Aggregate(fns...)
+}
+
+func (jrq *JoinRequestQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range jrq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, jrq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range jrq.ctx.Fields {
+		if !joinrequest.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if jrq.path != nil {
+		prev, err := jrq.path(ctx)
+		if err != nil {
+			return err
+		}
+		jrq.sql = prev
+	}
+	return nil
+}
+
+func (jrq *JoinRequestQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*JoinRequest, error) {
+	var (
+		nodes       = []*JoinRequest{}
+		_spec       = jrq.querySpec()
+		loadedTypes = [2]bool{
+			jrq.withRoom != nil,
+			jrq.withUser != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*JoinRequest).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &JoinRequest{config: jrq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(jrq.modifiers) > 0 {
+		_spec.Modifiers = jrq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, jrq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := jrq.withRoom; query != nil {
+		if err := jrq.loadRoom(ctx, query, nodes, nil,
+			func(n *JoinRequest, e *ChatRoom) { n.Edges.Room = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := jrq.withUser; query != nil {
+		if err := jrq.loadUser(ctx, query, nodes, nil,
+			func(n *JoinRequest, e *User) { n.Edges.User = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (jrq *JoinRequestQuery) loadRoom(ctx context.Context, query *ChatRoomQuery, nodes []*JoinRequest, init func(*JoinRequest), assign func(*JoinRequest, *ChatRoom)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*JoinRequest)
+	for i := range nodes {
+		fk := nodes[i].RoomID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(chatroom.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "room_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (jrq *JoinRequestQuery) loadUser(ctx context.Context, query *UserQuery, nodes []*JoinRequest, init func(*JoinRequest), assign func(*JoinRequest, *User)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*JoinRequest)
+	for i := range nodes {
+		fk := nodes[i].UserID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "user_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (jrq *JoinRequestQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := jrq.querySpec()
+	if len(jrq.modifiers) > 0 {
+		_spec.Modifiers = jrq.modifiers
+	}
+	_spec.Node.Columns = jrq.ctx.Fields
+	if len(jrq.ctx.Fields) > 0 {
+		_spec.Unique = jrq.ctx.Unique != nil && *jrq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, jrq.driver, _spec)
+}
+
+func (jrq *JoinRequestQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(joinrequest.Table, joinrequest.Columns, sqlgraph.NewFieldSpec(joinrequest.FieldID, field.TypeInt64))
+	_spec.From = jrq.sql
+	if unique := jrq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if jrq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := jrq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, joinrequest.FieldID)
+		for i := range fields {
+			if fields[i] != joinrequest.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if jrq.withRoom != nil {
+			_spec.Node.AddColumnOnce(joinrequest.FieldRoomID)
+		}
+		if jrq.withUser != nil {
+			_spec.Node.AddColumnOnce(joinrequest.FieldUserID)
+		}
+	}
+	if ps := jrq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := jrq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := jrq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := jrq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (jrq *JoinRequestQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(jrq.driver.Dialect())
+	t1 := builder.Table(joinrequest.Table)
+	columns := jrq.ctx.Fields
+	if len(columns) == 0 {
+		columns = joinrequest.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if jrq.sql != nil {
+		selector = jrq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if jrq.ctx.Unique != nil && *jrq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range jrq.modifiers {
+		m(selector)
+	}
+	for _, p := range jrq.predicates {
+		p(selector)
+	}
+	for _, p := range jrq.order {
+		p(selector)
+	}
+	if offset := jrq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := jrq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (jrq *JoinRequestQuery) ForUpdate(opts ...sql.LockOption) *JoinRequestQuery {
+	if jrq.driver.Dialect() == dialect.Postgres {
+		jrq.Unique(false)
+	}
+	jrq.modifiers = append(jrq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return jrq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (jrq *JoinRequestQuery) ForShare(opts ...sql.LockOption) *JoinRequestQuery {
+	if jrq.driver.Dialect() == dialect.Postgres {
+		jrq.Unique(false)
+	}
+	jrq.modifiers = append(jrq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return jrq
+}
+
+// JoinRequestGroupBy is the group-by builder for JoinRequest entities.
+type JoinRequestGroupBy struct {
+	selector
+	build *JoinRequestQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (jrgb *JoinRequestGroupBy) Aggregate(fns ...AggregateFunc) *JoinRequestGroupBy {
+	jrgb.fns = append(jrgb.fns, fns...)
+	return jrgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (jrgb *JoinRequestGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, jrgb.build.ctx, ent.OpQueryGroupBy)
+	if err := jrgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*JoinRequestQuery, *JoinRequestGroupBy](ctx, jrgb.build, jrgb, jrgb.build.inters, v)
+}
+
+func (jrgb *JoinRequestGroupBy) sqlScan(ctx context.Context, root *JoinRequestQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(jrgb.fns))
+	for _, fn := range jrgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*jrgb.flds)+len(jrgb.fns))
+		for _, f := range *jrgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*jrgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := jrgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// JoinRequestSelect is the builder for selecting fields of JoinRequest entities.
+type JoinRequestSelect struct {
+	*JoinRequestQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (jrs *JoinRequestSelect) Aggregate(fns ...AggregateFunc) *JoinRequestSelect {
+	jrs.fns = append(jrs.fns, fns...)
+	return jrs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (jrs *JoinRequestSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, jrs.ctx, ent.OpQuerySelect)
+	if err := jrs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*JoinRequestQuery, *JoinRequestSelect](ctx, jrs.JoinRequestQuery, jrs, jrs.inters, v)
+}
+
+func (jrs *JoinRequestSelect) sqlScan(ctx context.Context, root *JoinRequestQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(jrs.fns))
+	for _, fn := range jrs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*jrs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := jrs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,607 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/chatroom"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/joinrequest"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/predicate"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/user"
+)
+
+// JoinRequestUpdate is the builder for updating JoinRequest entities.
+type JoinRequestUpdate struct {
+	config
+	hooks    []Hook
+	mutation *JoinRequestMutation
+}
+
+// Where appends a list predicates to the JoinRequestUpdate builder.
+func (jru *JoinRequestUpdate) Where(ps ...predicate.JoinRequest) *JoinRequestUpdate {
+	jru.mutation.Where(ps...)
+	return jru
+}
+
+// SetRoomID sets the "room_id" field.
+func (jru *JoinRequestUpdate) SetRoomID(u uuid.UUID) *JoinRequestUpdate {
+	jru.mutation.SetRoomID(u)
+	return jru
+}
+
+// SetNillableRoomID sets the "room_id" field if the given value is not nil.
+func (jru *JoinRequestUpdate) SetNillableRoomID(u *uuid.UUID) *JoinRequestUpdate {
+	if u != nil {
+		jru.SetRoomID(*u)
+	}
+	return jru
+}
+
+// SetUserID sets the "user_id" field.
+func (jru *JoinRequestUpdate) SetUserID(u uuid.UUID) *JoinRequestUpdate {
+	jru.mutation.SetUserID(u)
+	return jru
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (jru *JoinRequestUpdate) SetNillableUserID(u *uuid.UUID) *JoinRequestUpdate {
+	if u != nil {
+		jru.SetUserID(*u)
+	}
+	return jru
+}
+
+// SetStatus sets the "status" field.
+func (jru *JoinRequestUpdate) SetStatus(j joinrequest.Status) *JoinRequestUpdate {
+	jru.mutation.SetStatus(j)
+	return jru
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (jru *JoinRequestUpdate) SetNillableStatus(j *joinrequest.Status) *JoinRequestUpdate {
+	if j != nil {
+		jru.SetStatus(*j)
+	}
+	return jru
+}
+
+// SetDecidedBy sets the "decided_by" field.
+func (jru *JoinRequestUpdate) SetDecidedBy(u uuid.UUID) *JoinRequestUpdate {
+	jru.mutation.SetDecidedBy(u)
+	return jru
+}
+
+// SetNillableDecidedBy sets the "decided_by" field if the given value is not nil.
+func (jru *JoinRequestUpdate) SetNillableDecidedBy(u *uuid.UUID) *JoinRequestUpdate {
+	if u != nil {
+		jru.SetDecidedBy(*u)
+	}
+	return jru
+}
+
+// ClearDecidedBy clears the value of the "decided_by" field.
+func (jru *JoinRequestUpdate) ClearDecidedBy() *JoinRequestUpdate {
+	jru.mutation.ClearDecidedBy()
+	return jru
+}
+
+// SetDecidedAt sets the "decided_at" field.
+func (jru *JoinRequestUpdate) SetDecidedAt(t time.Time) *JoinRequestUpdate {
+	jru.mutation.SetDecidedAt(t)
+	return jru
+}
+
+// SetNillableDecidedAt sets the "decided_at" field if the given value is not nil.
+func (jru *JoinRequestUpdate) SetNillableDecidedAt(t *time.Time) *JoinRequestUpdate {
+	if t != nil {
+		jru.SetDecidedAt(*t)
+	}
+	return jru
+}
+
+// ClearDecidedAt clears the value of the "decided_at" field.
+func (jru *JoinRequestUpdate) ClearDecidedAt() *JoinRequestUpdate {
+	jru.mutation.ClearDecidedAt()
+	return jru
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (jru *JoinRequestUpdate) SetCreatedAt(t time.Time) *JoinRequestUpdate {
+	jru.mutation.SetCreatedAt(t)
+	return jru
+}
+
+// SetRoom sets the "room" edge to the ChatRoom entity.
+func (jru *JoinRequestUpdate) SetRoom(c *ChatRoom) *JoinRequestUpdate {
+	return jru.SetRoomID(c.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (jru *JoinRequestUpdate) SetUser(u *User) *JoinRequestUpdate {
+	return jru.SetUserID(u.ID)
+}
+
+// Mutation returns the JoinRequestMutation object of the builder.
+func (jru *JoinRequestUpdate) Mutation() *JoinRequestMutation {
+	return jru.mutation
+}
+
+// ClearRoom clears the "room" edge to the ChatRoom entity.
+func (jru *JoinRequestUpdate) ClearRoom() *JoinRequestUpdate {
+	jru.mutation.ClearRoom()
+	return jru
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (jru *JoinRequestUpdate) ClearUser() *JoinRequestUpdate {
+	jru.mutation.ClearUser()
+	return jru
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (jru *JoinRequestUpdate) Save(ctx context.Context) (int, error) {
+	jru.defaults()
+	return withHooks(ctx, jru.sqlSave, jru.mutation, jru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (jru *JoinRequestUpdate) SaveX(ctx context.Context) int {
+	affected, err := jru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (jru *JoinRequestUpdate) Exec(ctx context.Context) error {
+	_, err := jru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (jru *JoinRequestUpdate) ExecX(ctx context.Context) {
+	if err := jru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (jru *JoinRequestUpdate) defaults() {
+	if _, ok := jru.mutation.CreatedAt(); !ok {
+		v := joinrequest.UpdateDefaultCreatedAt()
+		jru.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (jru *JoinRequestUpdate) check() error {
+	if v, ok := jru.mutation.Status(); ok {
+		if err := joinrequest.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "JoinRequest.status": %w`, err)}
+		}
+	}
+	if jru.mutation.RoomCleared() && len(jru.mutation.RoomIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "JoinRequest.room"`)
+	}
+	if jru.mutation.UserCleared() && len(jru.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "JoinRequest.user"`)
+	}
+	return nil
+}
+
+func (jru *JoinRequestUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := jru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(joinrequest.Table, joinrequest.Columns, sqlgraph.NewFieldSpec(joinrequest.FieldID, field.TypeInt64))
+	if ps := jru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := jru.mutation.Status(); ok {
+		_spec.SetField(joinrequest.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := jru.mutation.DecidedBy(); ok {
+		_spec.SetField(joinrequest.FieldDecidedBy, field.TypeUUID, value)
+	}
+	if jru.mutation.DecidedByCleared() {
+		_spec.ClearField(joinrequest.FieldDecidedBy, field.TypeUUID)
+	}
+	if value, ok := jru.mutation.DecidedAt(); ok {
+		_spec.SetField(joinrequest.FieldDecidedAt, field.TypeTime, value)
+	}
+	if jru.mutation.DecidedAtCleared() {
+		_spec.ClearField(joinrequest.FieldDecidedAt, field.TypeTime)
+	}
+	if value, ok := jru.mutation.CreatedAt(); ok {
+		_spec.SetField(joinrequest.FieldCreatedAt, field.TypeTime, value)
+	}
+	if jru.mutation.RoomCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   joinrequest.RoomTable,
+			Columns: []string{joinrequest.RoomColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(chatroom.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := jru.mutation.RoomIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   joinrequest.RoomTable,
+			Columns: []string{joinrequest.RoomColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(chatroom.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if jru.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   joinrequest.UserTable,
+			Columns: []string{joinrequest.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := jru.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   joinrequest.UserTable,
+			Columns: []string{joinrequest.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, jru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{joinrequest.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	jru.mutation.done = true
+	return n, nil
+}
+
+// JoinRequestUpdateOne is the builder for updating a single JoinRequest entity.
+type JoinRequestUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *JoinRequestMutation
+}
+
+// SetRoomID sets the "room_id" field.
+func (jruo *JoinRequestUpdateOne) SetRoomID(u uuid.UUID) *JoinRequestUpdateOne {
+	jruo.mutation.SetRoomID(u)
+	return jruo
+}
+
+// SetNillableRoomID sets the "room_id" field if the given value is not nil.
+func (jruo *JoinRequestUpdateOne) SetNillableRoomID(u *uuid.UUID) *JoinRequestUpdateOne {
+	if u != nil {
+		jruo.SetRoomID(*u)
+	}
+	return jruo
+}
+
+// SetUserID sets the "user_id" field.
+func (jruo *JoinRequestUpdateOne) SetUserID(u uuid.UUID) *JoinRequestUpdateOne {
+	jruo.mutation.SetUserID(u)
+	return jruo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (jruo *JoinRequestUpdateOne) SetNillableUserID(u *uuid.UUID) *JoinRequestUpdateOne {
+	if u != nil {
+		jruo.SetUserID(*u)
+	}
+	return jruo
+}
+
+// SetStatus sets the "status" field.
+func (jruo *JoinRequestUpdateOne) SetStatus(j joinrequest.Status) *JoinRequestUpdateOne {
+	jruo.mutation.SetStatus(j)
+	return jruo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (jruo *JoinRequestUpdateOne) SetNillableStatus(j *joinrequest.Status) *JoinRequestUpdateOne {
+	if j != nil {
+		jruo.SetStatus(*j)
+	}
+	return jruo
+}
+
+// SetDecidedBy sets the "decided_by" field.
+func (jruo *JoinRequestUpdateOne) SetDecidedBy(u uuid.UUID) *JoinRequestUpdateOne {
+	jruo.mutation.SetDecidedBy(u)
+	return jruo
+}
+
+// SetNillableDecidedBy sets the "decided_by" field if the given value is not nil.
+func (jruo *JoinRequestUpdateOne) SetNillableDecidedBy(u *uuid.UUID) *JoinRequestUpdateOne {
+	if u != nil {
+		jruo.SetDecidedBy(*u)
+	}
+	return jruo
+}
+
+// ClearDecidedBy clears the value of the "decided_by" field.
+func (jruo *JoinRequestUpdateOne) ClearDecidedBy() *JoinRequestUpdateOne {
+	jruo.mutation.ClearDecidedBy()
+	return jruo
+}
+
+// SetDecidedAt sets the "decided_at" field.
+func (jruo *JoinRequestUpdateOne) SetDecidedAt(t time.Time) *JoinRequestUpdateOne {
+	jruo.mutation.SetDecidedAt(t)
+	return jruo
+}
+
+// SetNillableDecidedAt sets the "decided_at" field if the given value is not nil.
+func (jruo *JoinRequestUpdateOne) SetNillableDecidedAt(t *time.Time) *JoinRequestUpdateOne {
+	if t != nil {
+		jruo.SetDecidedAt(*t)
+	}
+	return jruo
+}
+
+// ClearDecidedAt clears the value of the "decided_at" field.
+func (jruo *JoinRequestUpdateOne) ClearDecidedAt() *JoinRequestUpdateOne {
+	jruo.mutation.ClearDecidedAt()
+	return jruo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (jruo *JoinRequestUpdateOne) SetCreatedAt(t time.Time) *JoinRequestUpdateOne {
+	jruo.mutation.SetCreatedAt(t)
+	return jruo
+}
+
+// SetRoom sets the "room" edge to the ChatRoom entity.
+func (jruo *JoinRequestUpdateOne) SetRoom(c *ChatRoom) *JoinRequestUpdateOne {
+	return jruo.SetRoomID(c.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (jruo *JoinRequestUpdateOne) SetUser(u *User) *JoinRequestUpdateOne {
+	return jruo.SetUserID(u.ID)
+}
+
+// Mutation returns the JoinRequestMutation object of the builder.
+func (jruo *JoinRequestUpdateOne) Mutation() *JoinRequestMutation {
+	return jruo.mutation
+}
+
+// ClearRoom clears the "room" edge to the ChatRoom entity.
+func (jruo *JoinRequestUpdateOne) ClearRoom() *JoinRequestUpdateOne {
+	jruo.mutation.ClearRoom()
+	return jruo
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (jruo *JoinRequestUpdateOne) ClearUser() *JoinRequestUpdateOne {
+	jruo.mutation.ClearUser()
+	return jruo
+}
+
+// Where appends a list predicates to the JoinRequestUpdate builder.
+func (jruo *JoinRequestUpdateOne) Where(ps ...predicate.JoinRequest) *JoinRequestUpdateOne {
+	jruo.mutation.Where(ps...)
+	return jruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (jruo *JoinRequestUpdateOne) Select(field string, fields ...string) *JoinRequestUpdateOne {
+	jruo.fields = append([]string{field}, fields...)
+	return jruo
+}
+
+// Save executes the query and returns the updated JoinRequest entity.
+func (jruo *JoinRequestUpdateOne) Save(ctx context.Context) (*JoinRequest, error) {
+	jruo.defaults()
+	return withHooks(ctx, jruo.sqlSave, jruo.mutation, jruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (jruo *JoinRequestUpdateOne) SaveX(ctx context.Context) *JoinRequest {
+	node, err := jruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (jruo *JoinRequestUpdateOne) Exec(ctx context.Context) error {
+	_, err := jruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (jruo *JoinRequestUpdateOne) ExecX(ctx context.Context) {
+	if err := jruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (jruo *JoinRequestUpdateOne) defaults() {
+	if _, ok := jruo.mutation.CreatedAt(); !ok {
+		v := joinrequest.UpdateDefaultCreatedAt()
+		jruo.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (jruo *JoinRequestUpdateOne) check() error {
+	if v, ok := jruo.mutation.Status(); ok {
+		if err := joinrequest.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "JoinRequest.status": %w`, err)}
+		}
+	}
+	if jruo.mutation.RoomCleared() && len(jruo.mutation.RoomIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "JoinRequest.room"`)
+	}
+	if jruo.mutation.UserCleared() && len(jruo.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "JoinRequest.user"`)
+	}
+	return nil
+}
+
+func (jruo *JoinRequestUpdateOne) sqlSave(ctx context.Context) (_node *JoinRequest, err error) {
+	if err := jruo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(joinrequest.Table, joinrequest.Columns, sqlgraph.NewFieldSpec(joinrequest.FieldID, field.TypeInt64))
+	id, ok := jruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "JoinRequest.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := jruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, joinrequest.FieldID)
+		for _, f := range fields {
+			if !joinrequest.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != joinrequest.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := jruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := jruo.mutation.Status(); ok {
+		_spec.SetField(joinrequest.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := jruo.mutation.DecidedBy(); ok {
+		_spec.SetField(joinrequest.FieldDecidedBy, field.TypeUUID, value)
+	}
+	if jruo.mutation.DecidedByCleared() {
+		_spec.ClearField(joinrequest.FieldDecidedBy, field.TypeUUID)
+	}
+	if value, ok := jruo.mutation.DecidedAt(); ok {
+		_spec.SetField(joinrequest.FieldDecidedAt, field.TypeTime, value)
+	}
+	if jruo.mutation.DecidedAtCleared() {
+		_spec.ClearField(joinrequest.FieldDecidedAt, field.TypeTime)
+	}
+	if value, ok := jruo.mutation.CreatedAt(); ok {
+		_spec.SetField(joinrequest.FieldCreatedAt, field.TypeTime, value)
+	}
+	if jruo.mutation.RoomCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   joinrequest.RoomTable,
+			Columns: []string{joinrequest.RoomColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(chatroom.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := jruo.mutation.RoomIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   joinrequest.RoomTable,
+			Columns: []string{joinrequest.RoomColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(chatroom.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if jruo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   joinrequest.UserTable,
+			Columns: []string{joinrequest.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := jruo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   joinrequest.UserTable,
+			Columns: []string{joinrequest.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &JoinRequest{config: jruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, jruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{joinrequest.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	jruo.mutation.done = true
+	return _node, nil
+}
@@ -3,6 +3,7 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -26,6 +27,10 @@ type Message struct {
 	UserID uuid.UUID `json:"user_id,omitempty"`
 	// メッセージ内容
 	Content string `json:"content,omitempty"`
+	// メッセージ種別（user: ユーザーの投稿、system: ルームの変更を記録するシステムメッセージ、bot: ボットの投稿）
+	Type message.Type `json:"type,omitempty"`
+	// システムメッセージの構造化データ（イベント種別・対象ユーザーなど）
+	Payload json.RawMessage `json:"payload,omitempty"`
 	// 添付ファイルURL
 	FileURL *string `json:"file_url,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
@@ -34,6 +39,16 @@ type Message struct {
 	UpdatedAt time.Time `json:"updated_at,omitempty"`
 	// 論理削除日時
 	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// スレッドの親メッセージID
+	ParentID *uuid.UUID `json:"parent_id,omitempty"`
+	// 引用表示する返信先メッセージID（スレッドの親とは別に保持する）
+	ReplyToID *uuid.UUID `json:"reply_to_id,omitempty"`
+	// スレッドの返信数（論理削除された返信を除く）
+	ReplyCount int `json:"reply_count,omitempty"`
+	// スレッドの最終返信日時
+	LastReplyAt *time.Time `json:"last_reply_at,omitempty"`
+	// 最終編集日時（未編集の場合はnull）
+	EditedAt *time.Time `json:"edited_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the MessageQuery when eager-loading is set.
 	Edges        MessageEdges `json:"edges"`
@@ -46,9 +61,23 @@ type MessageEdges struct {
 	Room *ChatRoom `json:"room,omitempty"`
 	// Sender holds the value of the sender edge.
 	Sender *User `json:"sender,omitempty"`
+	// Reads holds the value of the reads edge.
+	Reads []*MessageRead `json:"reads,omitempty"`
+	// Reactions holds the value of the reactions edge.
+	Reactions []*MessageReaction `json:"reactions,omitempty"`
+	// Revisions holds the value of the revisions edge.
+	Revisions []*MessageRevision `json:"revisions,omitempty"`
+	// Parent holds the value of the parent edge.
+	Parent *Message `json:"parent,omitempty"`
+	// Replies holds the value of the replies edge.
+	Replies []*Message `json:"replies,omitempty"`
+	// ReplyTo holds the value of the reply_to edge.
+	ReplyTo *Message `json:"reply_to,omitempty"`
+	// Quotes holds the value of the quotes edge.
+	Quotes []*Message `json:"quotes,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [9]bool
 }
 
 // RoomOrErr returns the Room value or an error if the edge
@@ -73,14 +102,87 @@ func (e MessageEdges) SenderOrErr() (*User, error) {
 	return nil, &NotLoadedError{edge: "sender"}
 }
 
+// ReadsOrErr returns the Reads value or an error if the edge
+// was not loaded in eager-loading.
+func (e MessageEdges) ReadsOrErr() ([]*MessageRead, error) {
+	if e.loadedTypes[2] {
+		return e.Reads, nil
+	}
+	return nil, &NotLoadedError{edge: "reads"}
+}
+
+// ReactionsOrErr returns the Reactions value or an error if the edge
+// was not loaded in eager-loading.
+func (e MessageEdges) ReactionsOrErr() ([]*MessageReaction, error) {
+	if e.loadedTypes[3] {
+		return e.Reactions, nil
+	}
+	return nil, &NotLoadedError{edge: "reactions"}
+}
+
+// RevisionsOrErr returns the Revisions value or an error if the edge
+// was not loaded in eager-loading.
+func (e MessageEdges) RevisionsOrErr() ([]*MessageRevision, error) {
+	if e.loadedTypes[4] {
+		return e.Revisions, nil
+	}
+	return nil, &NotLoadedError{edge: "revisions"}
+}
+
+// ParentOrErr returns the Parent value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e MessageEdges) ParentOrErr() (*Message, error) {
+	if e.Parent != nil {
+		return e.Parent, nil
+	} else if e.loadedTypes[5] {
+		return nil, &NotFoundError{label: message.Label}
+	}
+	return nil, &NotLoadedError{edge: "parent"}
+}
+
+// RepliesOrErr returns the Replies value or an error if the edge
+// was not loaded in eager-loading.
+func (e MessageEdges) RepliesOrErr() ([]*Message, error) {
+	if e.loadedTypes[6] {
+		return e.Replies, nil
+	}
+	return nil, &NotLoadedError{edge: "replies"}
+}
+
+// ReplyToOrErr returns the ReplyTo value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e MessageEdges) ReplyToOrErr() (*Message, error) {
+	if e.ReplyTo != nil {
+		return e.ReplyTo, nil
+	} else if e.loadedTypes[7] {
+		return nil, &NotFoundError{label: message.Label}
+	}
+	return nil, &NotLoadedError{edge: "reply_to"}
+}
+
+// QuotesOrErr returns the Quotes value or an error if the edge
+// was not loaded in eager-loading.
+func (e MessageEdges) QuotesOrErr() ([]*Message, error) {
+	if e.loadedTypes[8] {
+		return e.Quotes, nil
+	}
+	return nil, &NotLoadedError{edge: "quotes"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Message) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case message.FieldContent, message.FieldFileURL:
+		case message.FieldParentID, message.FieldReplyToID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case message.FieldPayload:
+			values[i] = new([]byte)
+		case message.FieldReplyCount:
+			values[i] = new(sql.NullInt64)
+		case message.FieldContent, message.FieldType, message.FieldFileURL:
 			values[i] = new(sql.NullString)
-		case message.FieldCreatedAt, message.FieldUpdatedAt, message.FieldDeletedAt:
+		case message.FieldCreatedAt, message.FieldUpdatedAt, message.FieldDeletedAt, message.FieldLastReplyAt, message.FieldEditedAt:
 			values[i] = new(sql.NullTime)
 		case message.FieldID, message.FieldRoomID, message.FieldUserID:
 			values[i] = new(uuid.UUID)
@@ -123,6 +225,20 @@ func (m *Message) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				m.Content = value.String
 			}
+		case message.FieldType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field type", values[i])
+			} else if value.Valid {
+				m.Type = message.Type(value.String)
+			}
+		case message.FieldPayload:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field payload", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &m.Payload); err != nil {
+					return fmt.Errorf("unmarshal field payload: %w", err)
+				}
+			}
 		case message.FieldFileURL:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field file_url", values[i])
@@ -149,6 +265,40 @@ func (m *Message) assignValues(columns []string, values []any) error {
 				m.DeletedAt = new(time.Time)
 				*m.DeletedAt = value.Time
 			}
+		case message.FieldParentID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field parent_id", values[i])
+			} else if value.Valid {
+				m.ParentID = new(uuid.UUID)
+				*m.ParentID = *value.S.(*uuid.UUID)
+			}
+		case message.FieldReplyToID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field reply_to_id", values[i])
+			} else if value.Valid {
+				m.ReplyToID = new(uuid.UUID)
+				*m.ReplyToID = *value.S.(*uuid.UUID)
+			}
+		case message.FieldReplyCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field reply_count", values[i])
+			} else if value.Valid {
+				m.ReplyCount = int(value.Int64)
+			}
+		case message.FieldLastReplyAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_reply_at", values[i])
+			} else if value.Valid {
+				m.LastReplyAt = new(time.Time)
+				*m.LastReplyAt = value.Time
+			}
+		case message.FieldEditedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field edited_at", values[i])
+			} else if value.Valid {
+				m.EditedAt = new(time.Time)
+				*m.EditedAt = value.Time
+			}
 		default:
 			m.selectValues.Set(columns[i], values[i])
 		}
@@ -172,6 +322,41 @@ func (m *Message) QuerySender() *UserQuery {
 	return NewMessageClient(m.config).QuerySender(m)
 }
 
+// QueryReads queries the "reads" edge of the Message entity.
+func (m *Message) QueryReads() *MessageReadQuery {
+	return NewMessageClient(m.config).QueryReads(m)
+}
+
+// QueryReactions queries the "reactions" edge of the Message entity.
+func (m *Message) QueryReactions() *MessageReactionQuery {
+	return NewMessageClient(m.config).QueryReactions(m)
+}
+
+// QueryRevisions queries the "revisions" edge of the Message entity.
+func (m *Message) QueryRevisions() *MessageRevisionQuery {
+	return NewMessageClient(m.config).QueryRevisions(m)
+}
+
+// QueryParent queries the "parent" edge of the Message entity.
+func (m *Message) QueryParent() *MessageQuery {
+	return NewMessageClient(m.config).QueryParent(m)
+}
+
+// QueryReplies queries the "replies" edge of the Message entity.
+func (m *Message) QueryReplies() *MessageQuery {
+	return NewMessageClient(m.config).QueryReplies(m)
+}
+
+// QueryReplyTo queries the "reply_to" edge of the Message entity.
+func (m *Message) QueryReplyTo() *MessageQuery {
+	return NewMessageClient(m.config).QueryReplyTo(m)
+}
+
+// QueryQuotes queries the "quotes" edge of the Message entity.
+func (m *Message) QueryQuotes() *MessageQuery {
+	return NewMessageClient(m.config).QueryQuotes(m)
+}
+
 // Update returns a builder for updating this Message.
 // Note that you need to call Message.Unwrap() before calling this method if this Message
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -204,6 +389,12 @@ func (m *Message) String() string {
 	builder.WriteString("content=")
 	builder.WriteString(m.Content)
 	builder.WriteString(", ")
+	builder.WriteString("type=")
+	builder.WriteString(fmt.Sprintf("%v", m.Type))
+	builder.WriteString(", ")
+	builder.WriteString("payload=")
+	builder.WriteString(fmt.Sprintf("%v", m.Payload))
+	builder.WriteString(", ")
 	if v := m.FileURL; v != nil {
 		builder.WriteString("file_url=")
 		builder.WriteString(*v)
@@ -219,6 +410,29 @@ func (m *Message) String() string {
 		builder.WriteString("deleted_at=")
 		builder.WriteString(v.Format(time.ANSIC))
 	}
+	builder.WriteString(", ")
+	if v := m.ParentID; v != nil {
+		builder.WriteString("parent_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := m.ReplyToID; v != nil {
+		builder.WriteString("reply_to_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("reply_count=")
+	builder.WriteString(fmt.Sprintf("%v", m.ReplyCount))
+	builder.WriteString(", ")
+	if v := m.LastReplyAt; v != nil {
+		builder.WriteString("last_reply_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := m.EditedAt; v != nil {
+		builder.WriteString("edited_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -4,6 +4,7 @@ package ent
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"time"
@@ -13,6 +14,9 @@ import (
 	"github.com/google/uuid"
 	"github.com/hideaki1979/cc-chat-app/apps/api/ent/chatroom"
 	"github.com/hideaki1979/cc-chat-app/apps/api/ent/message"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/messagereaction"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/messageread"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/messagerevision"
 	"github.com/hideaki1979/cc-chat-app/apps/api/ent/user"
 )
 
@@ -41,6 +45,26 @@ func (mc *MessageCreate) SetContent(s string) *MessageCreate {
 	return mc
 }
 
+// SetType sets the "type" field.
+func (mc *MessageCreate) SetType(m message.Type) *MessageCreate {
+	mc.mutation.SetType(m)
+	return mc
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (mc *MessageCreate) SetNillableType(m *message.Type) *MessageCreate {
+	if m != nil {
+		mc.SetType(*m)
+	}
+	return mc
+}
+
+// SetPayload sets the "payload" field.
+func (mc *MessageCreate) SetPayload(jm json.RawMessage) *MessageCreate {
+	mc.mutation.SetPayload(jm)
+	return mc
+}
+
 // SetFileURL sets the "file_url" field.
 func (mc *MessageCreate) SetFileURL(s string) *MessageCreate {
 	mc.mutation.SetFileURL(s)
@@ -97,6 +121,76 @@ func (mc *MessageCreate) SetNillableDeletedAt(t *time.Time) *MessageCreate {
 	return mc
 }
 
+// SetParentID sets the "parent_id" field.
+func (mc *MessageCreate) SetParentID(u uuid.UUID) *MessageCreate {
+	mc.mutation.SetParentID(u)
+	return mc
+}
+
+// SetNillableParentID sets the "parent_id" field if the given value is not nil.
+func (mc *MessageCreate) SetNillableParentID(u *uuid.UUID) *MessageCreate {
+	if u != nil {
+		mc.SetParentID(*u)
+	}
+	return mc
+}
+
+// SetReplyToID sets the "reply_to_id" field.
+func (mc *MessageCreate) SetReplyToID(u uuid.UUID) *MessageCreate {
+	mc.mutation.SetReplyToID(u)
+	return mc
+}
+
+// SetNillableReplyToID sets the "reply_to_id" field if the given value is not nil.
+func (mc *MessageCreate) SetNillableReplyToID(u *uuid.UUID) *MessageCreate {
+	if u != nil {
+		mc.SetReplyToID(*u)
+	}
+	return mc
+}
+
+// SetReplyCount sets the "reply_count" field.
+func (mc *MessageCreate) SetReplyCount(i int) *MessageCreate {
+	mc.mutation.SetReplyCount(i)
+	return mc
+}
+
+// SetNillableReplyCount sets the "reply_count" field if the given value is not nil.
+func (mc *MessageCreate) SetNillableReplyCount(i *int) *MessageCreate {
+	if i != nil {
+		mc.SetReplyCount(*i)
+	}
+	return mc
+}
+
+// SetLastReplyAt sets the "last_reply_at" field.
+func (mc *MessageCreate) SetLastReplyAt(t time.Time) *MessageCreate {
+	mc.mutation.SetLastReplyAt(t)
+	return mc
+}
+
+// SetNillableLastReplyAt sets the "last_reply_at" field if the given value is not nil.
+func (mc *MessageCreate) SetNillableLastReplyAt(t *time.Time) *MessageCreate {
+	if t != nil {
+		mc.SetLastReplyAt(*t)
+	}
+	return mc
+}
+
+// SetEditedAt sets the "edited_at" field.
+func (mc *MessageCreate) SetEditedAt(t time.Time) *MessageCreate {
+	mc.mutation.SetEditedAt(t)
+	return mc
+}
+
+// SetNillableEditedAt sets the "edited_at" field if the given value is not nil.
+func (mc *MessageCreate) SetNillableEditedAt(t *time.Time) *MessageCreate {
+	if t != nil {
+		mc.SetEditedAt(*t)
+	}
+	return mc
+}
+
 // SetID sets the "id" field.
 func (mc *MessageCreate) SetID(u uuid.UUID) *MessageCreate {
 	mc.mutation.SetID(u)
@@ -127,6 +221,91 @@ func (mc *MessageCreate) SetSender(u *User) *MessageCreate {
 	return mc.SetSenderID(u.ID)
 }
 
+// AddReadIDs adds the "reads" edge to the MessageRead entity by IDs.
+func (mc *MessageCreate) AddReadIDs(ids ...int64) *MessageCreate {
+	mc.mutation.AddReadIDs(ids...)
+	return mc
+}
+
+// AddReads adds the "reads" edges to the MessageRead entity.
+func (mc *MessageCreate) AddReads(m ...*MessageRead) *MessageCreate {
+	ids := make([]int64, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return mc.AddReadIDs(ids...)
+}
+
+// AddReactionIDs adds the "reactions" edge to the MessageReaction entity by IDs.
+func (mc *MessageCreate) AddReactionIDs(ids ...int64) *MessageCreate {
+	mc.mutation.AddReactionIDs(ids...)
+	return mc
+}
+
+// AddReactions adds the "reactions" edges to the MessageReaction entity.
+func (mc *MessageCreate) AddReactions(m ...*MessageReaction) *MessageCreate {
+	ids := make([]int64, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return mc.AddReactionIDs(ids...)
+}
+
+// AddRevisionIDs adds the "revisions" edge to the MessageRevision entity by IDs.
+func (mc *MessageCreate) AddRevisionIDs(ids ...int64) *MessageCreate {
+	mc.mutation.AddRevisionIDs(ids...)
+	return mc
+}
+
+// AddRevisions adds the "revisions" edges to the MessageRevision entity.
+func (mc *MessageCreate) AddRevisions(m ...*MessageRevision) *MessageCreate {
+	ids := make([]int64, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return mc.AddRevisionIDs(ids...)
+}
+
+// SetParent sets the "parent" edge to the Message entity.
+func (mc *MessageCreate) SetParent(m *Message) *MessageCreate {
+	return mc.SetParentID(m.ID)
+}
+
+// AddReplyIDs adds the "replies" edge to the Message entity by IDs.
+func (mc *MessageCreate) AddReplyIDs(ids ...uuid.UUID) *MessageCreate {
+	mc.mutation.AddReplyIDs(ids...)
+	return mc
+}
+
+// AddReplies adds the "replies" edges to the Message entity.
+func (mc *MessageCreate) AddReplies(m ...*Message) *MessageCreate {
+	ids := make([]uuid.UUID, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return mc.AddReplyIDs(ids...)
+}
+
+// SetReplyTo sets the "reply_to" edge to the Message entity.
+func (mc *MessageCreate) SetReplyTo(m *Message) *MessageCreate {
+	return mc.SetReplyToID(m.ID)
+}
+
+// AddQuoteIDs adds the "quotes" edge to the Message entity by IDs.
+func (mc *MessageCreate) AddQuoteIDs(ids ...uuid.UUID) *MessageCreate {
+	mc.mutation.AddQuoteIDs(ids...)
+	return mc
+}
+
+// AddQuotes adds the "quotes" edges to the Message entity.
+func (mc *MessageCreate) AddQuotes(m ...*Message) *MessageCreate {
+	ids := make([]uuid.UUID, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return mc.AddQuoteIDs(ids...)
+}
+
 // Mutation returns the MessageMutation object of the builder.
 func (mc *MessageCreate) Mutation() *MessageMutation {
 	return mc.mutation
@@ -134,7 +313,9 @@ func (mc *MessageCreate) Mutation() *MessageMutation {
 
 // Save creates the Message in the database.
 func (mc *MessageCreate) Save(ctx context.Context) (*Message, error) {
-	mc.defaults()
+	if err := mc.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, mc.sqlSave, mc.mutation, mc.hooks)
 }
 
@@ -161,19 +342,37 @@ func (mc *MessageCreate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (mc *MessageCreate) defaults() {
+func (mc *MessageCreate) defaults() error {
+	if _, ok := mc.mutation.GetType(); !ok {
+		v := message.DefaultType
+		mc.mutation.SetType(v)
+	}
 	if _, ok := mc.mutation.CreatedAt(); !ok {
+		if message.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized message.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
 		v := message.DefaultCreatedAt()
 		mc.mutation.SetCreatedAt(v)
 	}
 	if _, ok := mc.mutation.UpdatedAt(); !ok {
+		if message.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized message.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := message.DefaultUpdatedAt()
 		mc.mutation.SetUpdatedAt(v)
 	}
+	if _, ok := mc.mutation.ReplyCount(); !ok {
+		v := message.DefaultReplyCount
+		mc.mutation.SetReplyCount(v)
+	}
 	if _, ok := mc.mutation.ID(); !ok {
+		if message.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized message.DefaultID (forgotten import ent/runtime?)")
+		}
 		v := message.DefaultID()
 		mc.mutation.SetID(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -187,12 +386,23 @@ func (mc *MessageCreate) check() error {
 	if _, ok := mc.mutation.Content(); !ok {
 		return &ValidationError{Name: "content", err: errors.New(`ent: missing required field "Message.content"`)}
 	}
+	if _, ok := mc.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "Message.type"`)}
+	}
+	if v, ok := mc.mutation.GetType(); ok {
+		if err := message.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Message.type": %w`, err)}
+		}
+	}
 	if _, ok := mc.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Message.created_at"`)}
 	}
 	if _, ok := mc.mutation.UpdatedAt(); !ok {
 		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Message.updated_at"`)}
 	}
+	if _, ok := mc.mutation.ReplyCount(); !ok {
+		return &ValidationError{Name: "reply_count", err: errors.New(`ent: missing required field "Message.reply_count"`)}
+	}
 	if len(mc.mutation.RoomIDs()) == 0 {
 		return &ValidationError{Name: "room", err: errors.New(`ent: missing required edge "Message.room"`)}
 	}
@@ -238,6 +448,14 @@ func (mc *MessageCreate) createSpec() (*Message, *sqlgraph.CreateSpec) {
 		_spec.SetField(message.FieldContent, field.TypeString, value)
 		_node.Content = value
 	}
+	if value, ok := mc.mutation.GetType(); ok {
+		_spec.SetField(message.FieldType, field.TypeEnum, value)
+		_node.Type = value
+	}
+	if value, ok := mc.mutation.Payload(); ok {
+		_spec.SetField(message.FieldPayload, field.TypeJSON, value)
+		_node.Payload = value
+	}
 	if value, ok := mc.mutation.FileURL(); ok {
 		_spec.SetField(message.FieldFileURL, field.TypeString, value)
 		_node.FileURL = &value
@@ -254,6 +472,18 @@ func (mc *MessageCreate) createSpec() (*Message, *sqlgraph.CreateSpec) {
 		_spec.SetField(message.FieldDeletedAt, field.TypeTime, value)
 		_node.DeletedAt = &value
 	}
+	if value, ok := mc.mutation.ReplyCount(); ok {
+		_spec.SetField(message.FieldReplyCount, field.TypeInt, value)
+		_node.ReplyCount = value
+	}
+	if value, ok := mc.mutation.LastReplyAt(); ok {
+		_spec.SetField(message.FieldLastReplyAt, field.TypeTime, value)
+		_node.LastReplyAt = &value
+	}
+	if value, ok := mc.mutation.EditedAt(); ok {
+		_spec.SetField(message.FieldEditedAt, field.TypeTime, value)
+		_node.EditedAt = &value
+	}
 	if nodes := mc.mutation.RoomIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -288,6 +518,120 @@ func (mc *MessageCreate) createSpec() (*Message, *sqlgraph.CreateSpec) {
 		_node.UserID = nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := mc.mutation.ReadsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.ReadsTable,
+			Columns: []string{message.ReadsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messageread.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := mc.mutation.ReactionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.ReactionsTable,
+			Columns: []string{message.ReactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagereaction.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := mc.mutation.RevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.RevisionsTable,
+			Columns: []string{message.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagerevision.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := mc.mutation.ParentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   message.ParentTable,
+			Columns: []string{message.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ParentID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := mc.mutation.RepliesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.RepliesTable,
+			Columns: []string{message.RepliesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := mc.mutation.ReplyToIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   message.ReplyToTable,
+			Columns: []string{message.ReplyToColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ReplyToID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := mc.mutation.QuotesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.QuotesTable,
+			Columns: []string{message.QuotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -4,16 +4,21 @@ package ent
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
 	"github.com/hideaki1979/cc-chat-app/apps/api/ent/chatroom"
 	"github.com/hideaki1979/cc-chat-app/apps/api/ent/message"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/messagereaction"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/messageread"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/messagerevision"
 	"github.com/hideaki1979/cc-chat-app/apps/api/ent/predicate"
 	"github.com/hideaki1979/cc-chat-app/apps/api/ent/user"
 )
@@ -73,6 +78,38 @@ func (mu *MessageUpdate) SetNillableContent(s *string) *MessageUpdate {
 	return mu
 }
 
+// SetType sets the "type" field.
+func (mu *MessageUpdate) SetType(m message.Type) *MessageUpdate {
+	mu.mutation.SetType(m)
+	return mu
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (mu *MessageUpdate) SetNillableType(m *message.Type) *MessageUpdate {
+	if m != nil {
+		mu.SetType(*m)
+	}
+	return mu
+}
+
+// SetPayload sets the "payload" field.
+func (mu *MessageUpdate) SetPayload(jm json.RawMessage) *MessageUpdate {
+	mu.mutation.SetPayload(jm)
+	return mu
+}
+
+// AppendPayload appends jm to the "payload" field.
+func (mu *MessageUpdate) AppendPayload(jm json.RawMessage) *MessageUpdate {
+	mu.mutation.AppendPayload(jm)
+	return mu
+}
+
+// ClearPayload clears the value of the "payload" field.
+func (mu *MessageUpdate) ClearPayload() *MessageUpdate {
+	mu.mutation.ClearPayload()
+	return mu
+}
+
 // SetFileURL sets the "file_url" field.
 func (mu *MessageUpdate) SetFileURL(s string) *MessageUpdate {
 	mu.mutation.SetFileURL(s)
@@ -119,6 +156,107 @@ func (mu *MessageUpdate) ClearDeletedAt() *MessageUpdate {
 	return mu
 }
 
+// SetParentID sets the "parent_id" field.
+func (mu *MessageUpdate) SetParentID(u uuid.UUID) *MessageUpdate {
+	mu.mutation.SetParentID(u)
+	return mu
+}
+
+// SetNillableParentID sets the "parent_id" field if the given value is not nil.
+func (mu *MessageUpdate) SetNillableParentID(u *uuid.UUID) *MessageUpdate {
+	if u != nil {
+		mu.SetParentID(*u)
+	}
+	return mu
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (mu *MessageUpdate) ClearParentID() *MessageUpdate {
+	mu.mutation.ClearParentID()
+	return mu
+}
+
+// SetReplyToID sets the "reply_to_id" field.
+func (mu *MessageUpdate) SetReplyToID(u uuid.UUID) *MessageUpdate {
+	mu.mutation.SetReplyToID(u)
+	return mu
+}
+
+// SetNillableReplyToID sets the "reply_to_id" field if the given value is not nil.
+func (mu *MessageUpdate) SetNillableReplyToID(u *uuid.UUID) *MessageUpdate {
+	if u != nil {
+		mu.SetReplyToID(*u)
+	}
+	return mu
+}
+
+// ClearReplyToID clears the value of the "reply_to_id" field.
+func (mu *MessageUpdate) ClearReplyToID() *MessageUpdate {
+	mu.mutation.ClearReplyToID()
+	return mu
+}
+
+// SetReplyCount sets the "reply_count" field.
+func (mu *MessageUpdate) SetReplyCount(i int) *MessageUpdate {
+	mu.mutation.ResetReplyCount()
+	mu.mutation.SetReplyCount(i)
+	return mu
+}
+
+// SetNillableReplyCount sets the "reply_count" field if the given value is not nil.
+func (mu *MessageUpdate) SetNillableReplyCount(i *int) *MessageUpdate {
+	if i != nil {
+		mu.SetReplyCount(*i)
+	}
+	return mu
+}
+
+// AddReplyCount adds i to the "reply_count" field.
+func (mu *MessageUpdate) AddReplyCount(i int) *MessageUpdate {
+	mu.mutation.AddReplyCount(i)
+	return mu
+}
+
+// SetLastReplyAt sets the "last_reply_at" field.
+func (mu *MessageUpdate) SetLastReplyAt(t time.Time) *MessageUpdate {
+	mu.mutation.SetLastReplyAt(t)
+	return mu
+}
+
+// SetNillableLastReplyAt sets the "last_reply_at" field if the given value is not nil.
+func (mu *MessageUpdate) SetNillableLastReplyAt(t *time.Time) *MessageUpdate {
+	if t != nil {
+		mu.SetLastReplyAt(*t)
+	}
+	return mu
+}
+
+// ClearLastReplyAt clears the value of the "last_reply_at" field.
+func (mu *MessageUpdate) ClearLastReplyAt() *MessageUpdate {
+	mu.mutation.ClearLastReplyAt()
+	return mu
+}
+
+// SetEditedAt sets the "edited_at" field.
+func (mu *MessageUpdate) SetEditedAt(t time.Time) *MessageUpdate {
+	mu.mutation.SetEditedAt(t)
+	return mu
+}
+
+// SetNillableEditedAt sets the "edited_at" field if the given value is not nil.
+func (mu *MessageUpdate) SetNillableEditedAt(t *time.Time) *MessageUpdate {
+	if t != nil {
+		mu.SetEditedAt(*t)
+	}
+	return mu
+}
+
+// ClearEditedAt clears the value of the "edited_at" field.
+func (mu *MessageUpdate) ClearEditedAt() *MessageUpdate {
+	mu.mutation.ClearEditedAt()
+	return mu
+}
+
 // SetRoom sets the "room" edge to the ChatRoom entity.
 func (mu *MessageUpdate) SetRoom(c *ChatRoom) *MessageUpdate {
 	return mu.SetRoomID(c.ID)
@@ -135,6 +273,91 @@ func (mu *MessageUpdate) SetSender(u *User) *MessageUpdate {
 	return mu.SetSenderID(u.ID)
 }
 
+// AddReadIDs adds the "reads" edge to the MessageRead entity by IDs.
+func (mu *MessageUpdate) AddReadIDs(ids ...int64) *MessageUpdate {
+	mu.mutation.AddReadIDs(ids...)
+	return mu
+}
+
+// AddReads adds the "reads" edges to the MessageRead entity.
+func (mu *MessageUpdate) AddReads(m ...*MessageRead) *MessageUpdate {
+	ids := make([]int64, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return mu.AddReadIDs(ids...)
+}
+
+// AddReactionIDs adds the "reactions" edge to the MessageReaction entity by IDs.
+func (mu *MessageUpdate) AddReactionIDs(ids ...int64) *MessageUpdate {
+	mu.mutation.AddReactionIDs(ids...)
+	return mu
+}
+
+// AddReactions adds the "reactions" edges to the MessageReaction entity.
+func (mu *MessageUpdate) AddReactions(m ...*MessageReaction) *MessageUpdate {
+	ids := make([]int64, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return mu.AddReactionIDs(ids...)
+}
+
+// AddRevisionIDs adds the "revisions" edge to the MessageRevision entity by IDs.
+func (mu *MessageUpdate) AddRevisionIDs(ids ...int64) *MessageUpdate {
+	mu.mutation.AddRevisionIDs(ids...)
+	return mu
+}
+
+// AddRevisions adds the "revisions" edges to the MessageRevision entity.
+func (mu *MessageUpdate) AddRevisions(m ...*MessageRevision) *MessageUpdate {
+	ids := make([]int64, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return mu.AddRevisionIDs(ids...)
+}
+
+// SetParent sets the "parent" edge to the Message entity.
+func (mu *MessageUpdate) SetParent(m *Message) *MessageUpdate {
+	return mu.SetParentID(m.ID)
+}
+
+// AddReplyIDs adds the "replies" edge to the Message entity by IDs.
+func (mu *MessageUpdate) AddReplyIDs(ids ...uuid.UUID) *MessageUpdate {
+	mu.mutation.AddReplyIDs(ids...)
+	return mu
+}
+
+// AddReplies adds the "replies" edges to the Message entity.
+func (mu *MessageUpdate) AddReplies(m ...*Message) *MessageUpdate {
+	ids := make([]uuid.UUID, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return mu.AddReplyIDs(ids...)
+}
+
+// SetReplyTo sets the "reply_to" edge to the Message entity.
+func (mu *MessageUpdate) SetReplyTo(m *Message) *MessageUpdate {
+	return mu.SetReplyToID(m.ID)
+}
+
+// AddQuoteIDs adds the "quotes" edge to the Message entity by IDs.
+func (mu *MessageUpdate) AddQuoteIDs(ids ...uuid.UUID) *MessageUpdate {
+	mu.mutation.AddQuoteIDs(ids...)
+	return mu
+}
+
+// AddQuotes adds the "quotes" edges to the Message entity.
+func (mu *MessageUpdate) AddQuotes(m ...*Message) *MessageUpdate {
+	ids := make([]uuid.UUID, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return mu.AddQuoteIDs(ids...)
+}
+
 // Mutation returns the MessageMutation object of the builder.
 func (mu *MessageUpdate) Mutation() *MessageMutation {
 	return mu.mutation
@@ -152,9 +375,128 @@ func (mu *MessageUpdate) ClearSender() *MessageUpdate {
 	return mu
 }
 
+// ClearReads clears all "reads" edges to the MessageRead entity.
+func (mu *MessageUpdate) ClearReads() *MessageUpdate {
+	mu.mutation.ClearReads()
+	return mu
+}
+
+// RemoveReadIDs removes the "reads" edge to MessageRead entities by IDs.
+func (mu *MessageUpdate) RemoveReadIDs(ids ...int64) *MessageUpdate {
+	mu.mutation.RemoveReadIDs(ids...)
+	return mu
+}
+
+// RemoveReads removes "reads" edges to MessageRead entities.
+func (mu *MessageUpdate) RemoveReads(m ...*MessageRead) *MessageUpdate {
+	ids := make([]int64, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return mu.RemoveReadIDs(ids...)
+}
+
+// ClearReactions clears all "reactions" edges to the MessageReaction entity.
+func (mu *MessageUpdate) ClearReactions() *MessageUpdate {
+	mu.mutation.ClearReactions()
+	return mu
+}
+
+// RemoveReactionIDs removes the "reactions" edge to MessageReaction entities by IDs.
+func (mu *MessageUpdate) RemoveReactionIDs(ids ...int64) *MessageUpdate {
+	mu.mutation.RemoveReactionIDs(ids...)
+	return mu
+}
+
+// RemoveReactions removes "reactions" edges to MessageReaction entities.
+func (mu *MessageUpdate) RemoveReactions(m ...*MessageReaction) *MessageUpdate {
+	ids := make([]int64, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return mu.RemoveReactionIDs(ids...)
+}
+
+// ClearRevisions clears all "revisions" edges to the MessageRevision entity.
+func (mu *MessageUpdate) ClearRevisions() *MessageUpdate {
+	mu.mutation.ClearRevisions()
+	return mu
+}
+
+// RemoveRevisionIDs removes the "revisions" edge to MessageRevision entities by IDs.
+func (mu *MessageUpdate) RemoveRevisionIDs(ids ...int64) *MessageUpdate {
+	mu.mutation.RemoveRevisionIDs(ids...)
+	return mu
+}
+
+// RemoveRevisions removes "revisions" edges to MessageRevision entities.
+func (mu *MessageUpdate) RemoveRevisions(m ...*MessageRevision) *MessageUpdate {
+	ids := make([]int64, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return mu.RemoveRevisionIDs(ids...)
+}
+
+// ClearParent clears the "parent" edge to the Message entity.
+func (mu *MessageUpdate) ClearParent() *MessageUpdate {
+	mu.mutation.ClearParent()
+	return mu
+}
+
+// ClearReplies clears all "replies" edges to the Message entity.
+func (mu *MessageUpdate) ClearReplies() *MessageUpdate {
+	mu.mutation.ClearReplies()
+	return mu
+}
+
+// RemoveReplyIDs removes the "replies" edge to Message entities by IDs.
+func (mu *MessageUpdate) RemoveReplyIDs(ids ...uuid.UUID) *MessageUpdate {
+	mu.mutation.RemoveReplyIDs(ids...)
+	return mu
+}
+
+// RemoveReplies removes "replies" edges to Message entities.
+func (mu *MessageUpdate) RemoveReplies(m ...*Message) *MessageUpdate {
+	ids := make([]uuid.UUID, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return mu.RemoveReplyIDs(ids...)
+}
+
+// ClearReplyTo clears the "reply_to" edge to the Message entity.
+func (mu *MessageUpdate) ClearReplyTo() *MessageUpdate {
+	mu.mutation.ClearReplyTo()
+	return mu
+}
+
+// ClearQuotes clears all "quotes" edges to the Message entity.
+func (mu *MessageUpdate) ClearQuotes() *MessageUpdate {
+	mu.mutation.ClearQuotes()
+	return mu
+}
+
+// RemoveQuoteIDs removes the "quotes" edge to Message entities by IDs.
+func (mu *MessageUpdate) RemoveQuoteIDs(ids ...uuid.UUID) *MessageUpdate {
+	mu.mutation.RemoveQuoteIDs(ids...)
+	return mu
+}
+
+// RemoveQuotes removes "quotes" edges to Message entities.
+func (mu *MessageUpdate) RemoveQuotes(m ...*Message) *MessageUpdate {
+	ids := make([]uuid.UUID, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return mu.RemoveQuoteIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (mu *MessageUpdate) Save(ctx context.Context) (int, error) {
-	mu.defaults()
+	if err := mu.defaults(); err != nil {
+		return 0, err
+	}
 	return withHooks(ctx, mu.sqlSave, mu.mutation, mu.hooks)
 }
 
@@ -181,15 +523,24 @@ func (mu *MessageUpdate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (mu *MessageUpdate) defaults() {
+func (mu *MessageUpdate) defaults() error {
 	if _, ok := mu.mutation.UpdatedAt(); !ok {
+		if message.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized message.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := message.UpdateDefaultUpdatedAt()
 		mu.mutation.SetUpdatedAt(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
 func (mu *MessageUpdate) check() error {
+	if v, ok := mu.mutation.GetType(); ok {
+		if err := message.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Message.type": %w`, err)}
+		}
+	}
 	if mu.mutation.RoomCleared() && len(mu.mutation.RoomIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Message.room"`)
 	}
@@ -214,6 +565,20 @@ func (mu *MessageUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := mu.mutation.Content(); ok {
 		_spec.SetField(message.FieldContent, field.TypeString, value)
 	}
+	if value, ok := mu.mutation.GetType(); ok {
+		_spec.SetField(message.FieldType, field.TypeEnum, value)
+	}
+	if value, ok := mu.mutation.Payload(); ok {
+		_spec.SetField(message.FieldPayload, field.TypeJSON, value)
+	}
+	if value, ok := mu.mutation.AppendedPayload(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, message.FieldPayload, value)
+		})
+	}
+	if mu.mutation.PayloadCleared() {
+		_spec.ClearField(message.FieldPayload, field.TypeJSON)
+	}
 	if value, ok := mu.mutation.FileURL(); ok {
 		_spec.SetField(message.FieldFileURL, field.TypeString, value)
 	}
@@ -229,6 +594,24 @@ func (mu *MessageUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if mu.mutation.DeletedAtCleared() {
 		_spec.ClearField(message.FieldDeletedAt, field.TypeTime)
 	}
+	if value, ok := mu.mutation.ReplyCount(); ok {
+		_spec.SetField(message.FieldReplyCount, field.TypeInt, value)
+	}
+	if value, ok := mu.mutation.AddedReplyCount(); ok {
+		_spec.AddField(message.FieldReplyCount, field.TypeInt, value)
+	}
+	if value, ok := mu.mutation.LastReplyAt(); ok {
+		_spec.SetField(message.FieldLastReplyAt, field.TypeTime, value)
+	}
+	if mu.mutation.LastReplyAtCleared() {
+		_spec.ClearField(message.FieldLastReplyAt, field.TypeTime)
+	}
+	if value, ok := mu.mutation.EditedAt(); ok {
+		_spec.SetField(message.FieldEditedAt, field.TypeTime, value)
+	}
+	if mu.mutation.EditedAtCleared() {
+		_spec.ClearField(message.FieldEditedAt, field.TypeTime)
+	}
 	if mu.mutation.RoomCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -287,147 +670,765 @@ func (mu *MessageUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if n, err = sqlgraph.UpdateNodes(ctx, mu.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{message.Label}
-		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+	if mu.mutation.ReadsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.ReadsTable,
+			Columns: []string{message.ReadsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messageread.FieldID, field.TypeInt64),
+			},
 		}
-		return 0, err
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	mu.mutation.done = true
-	return n, nil
-}
-
-// MessageUpdateOne is the builder for updating a single Message entity.
-type MessageUpdateOne struct {
-	config
-	fields   []string
-	hooks    []Hook
-	mutation *MessageMutation
-}
-
-// SetRoomID sets the "room_id" field.
-func (muo *MessageUpdateOne) SetRoomID(u uuid.UUID) *MessageUpdateOne {
-	muo.mutation.SetRoomID(u)
-	return muo
-}
-
-// SetNillableRoomID sets the "room_id" field if the given value is not nil.
-func (muo *MessageUpdateOne) SetNillableRoomID(u *uuid.UUID) *MessageUpdateOne {
-	if u != nil {
-		muo.SetRoomID(*u)
+	if nodes := mu.mutation.RemovedReadsIDs(); len(nodes) > 0 && !mu.mutation.ReadsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.ReadsTable,
+			Columns: []string{message.ReadsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messageread.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return muo
-}
-
-// SetUserID sets the "user_id" field.
-func (muo *MessageUpdateOne) SetUserID(u uuid.UUID) *MessageUpdateOne {
-	muo.mutation.SetUserID(u)
-	return muo
-}
-
-// SetNillableUserID sets the "user_id" field if the given value is not nil.
-func (muo *MessageUpdateOne) SetNillableUserID(u *uuid.UUID) *MessageUpdateOne {
-	if u != nil {
-		muo.SetUserID(*u)
+	if nodes := mu.mutation.ReadsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.ReadsTable,
+			Columns: []string{message.ReadsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messageread.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return muo
-}
-
-// SetContent sets the "content" field.
-func (muo *MessageUpdateOne) SetContent(s string) *MessageUpdateOne {
-	muo.mutation.SetContent(s)
-	return muo
-}
-
-// SetNillableContent sets the "content" field if the given value is not nil.
-func (muo *MessageUpdateOne) SetNillableContent(s *string) *MessageUpdateOne {
-	if s != nil {
-		muo.SetContent(*s)
+	if mu.mutation.ReactionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.ReactionsTable,
+			Columns: []string{message.ReactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagereaction.FieldID, field.TypeInt64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return muo
-}
+	if nodes := mu.mutation.RemovedReactionsIDs(); len(nodes) > 0 && !mu.mutation.ReactionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.ReactionsTable,
+			Columns: []string{message.ReactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagereaction.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.ReactionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.ReactionsTable,
+			Columns: []string{message.ReactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagereaction.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if mu.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.RevisionsTable,
+			Columns: []string{message.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagerevision.FieldID, field.TypeInt64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.RemovedRevisionsIDs(); len(nodes) > 0 && !mu.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.RevisionsTable,
+			Columns: []string{message.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagerevision.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.RevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.RevisionsTable,
+			Columns: []string{message.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagerevision.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if mu.mutation.ParentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   message.ParentTable,
+			Columns: []string{message.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.ParentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   message.ParentTable,
+			Columns: []string{message.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if mu.mutation.RepliesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.RepliesTable,
+			Columns: []string{message.RepliesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.RemovedRepliesIDs(); len(nodes) > 0 && !mu.mutation.RepliesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.RepliesTable,
+			Columns: []string{message.RepliesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.RepliesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.RepliesTable,
+			Columns: []string{message.RepliesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if mu.mutation.ReplyToCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   message.ReplyToTable,
+			Columns: []string{message.ReplyToColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.ReplyToIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   message.ReplyToTable,
+			Columns: []string{message.ReplyToColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if mu.mutation.QuotesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.QuotesTable,
+			Columns: []string{message.QuotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.RemovedQuotesIDs(); len(nodes) > 0 && !mu.mutation.QuotesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.QuotesTable,
+			Columns: []string{message.QuotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.QuotesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.QuotesTable,
+			Columns: []string{message.QuotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, mu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{message.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	mu.mutation.done = true
+	return n, nil
+}
+
+// MessageUpdateOne is the builder for updating a single Message entity.
+type MessageUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *MessageMutation
+}
+
+// SetRoomID sets the "room_id" field.
+func (muo *MessageUpdateOne) SetRoomID(u uuid.UUID) *MessageUpdateOne {
+	muo.mutation.SetRoomID(u)
+	return muo
+}
+
+// SetNillableRoomID sets the "room_id" field if the given value is not nil.
+func (muo *MessageUpdateOne) SetNillableRoomID(u *uuid.UUID) *MessageUpdateOne {
+	if u != nil {
+		muo.SetRoomID(*u)
+	}
+	return muo
+}
+
+// SetUserID sets the "user_id" field.
+func (muo *MessageUpdateOne) SetUserID(u uuid.UUID) *MessageUpdateOne {
+	muo.mutation.SetUserID(u)
+	return muo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (muo *MessageUpdateOne) SetNillableUserID(u *uuid.UUID) *MessageUpdateOne {
+	if u != nil {
+		muo.SetUserID(*u)
+	}
+	return muo
+}
+
+// SetContent sets the "content" field.
+func (muo *MessageUpdateOne) SetContent(s string) *MessageUpdateOne {
+	muo.mutation.SetContent(s)
+	return muo
+}
+
+// SetNillableContent sets the "content" field if the given value is not nil.
+func (muo *MessageUpdateOne) SetNillableContent(s *string) *MessageUpdateOne {
+	if s != nil {
+		muo.SetContent(*s)
+	}
+	return muo
+}
+
+// SetType sets the "type" field.
+func (muo *MessageUpdateOne) SetType(m message.Type) *MessageUpdateOne {
+	muo.mutation.SetType(m)
+	return muo
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (muo *MessageUpdateOne) SetNillableType(m *message.Type) *MessageUpdateOne {
+	if m != nil {
+		muo.SetType(*m)
+	}
+	return muo
+}
+
+// SetPayload sets the "payload" field.
+func (muo *MessageUpdateOne) SetPayload(jm json.RawMessage) *MessageUpdateOne {
+	muo.mutation.SetPayload(jm)
+	return muo
+}
+
+// AppendPayload appends jm to the "payload" field.
+func (muo *MessageUpdateOne) AppendPayload(jm json.RawMessage) *MessageUpdateOne {
+	muo.mutation.AppendPayload(jm)
+	return muo
+}
+
+// ClearPayload clears the value of the "payload" field.
+func (muo *MessageUpdateOne) ClearPayload() *MessageUpdateOne {
+	muo.mutation.ClearPayload()
+	return muo
+}
+
+// SetFileURL sets the "file_url" field.
+func (muo *MessageUpdateOne) SetFileURL(s string) *MessageUpdateOne {
+	muo.mutation.SetFileURL(s)
+	return muo
+}
+
+// SetNillableFileURL sets the "file_url" field if the given value is not nil.
+func (muo *MessageUpdateOne) SetNillableFileURL(s *string) *MessageUpdateOne {
+	if s != nil {
+		muo.SetFileURL(*s)
+	}
+	return muo
+}
+
+// ClearFileURL clears the value of the "file_url" field.
+func (muo *MessageUpdateOne) ClearFileURL() *MessageUpdateOne {
+	muo.mutation.ClearFileURL()
+	return muo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (muo *MessageUpdateOne) SetUpdatedAt(t time.Time) *MessageUpdateOne {
+	muo.mutation.SetUpdatedAt(t)
+	return muo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (muo *MessageUpdateOne) SetDeletedAt(t time.Time) *MessageUpdateOne {
+	muo.mutation.SetDeletedAt(t)
+	return muo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (muo *MessageUpdateOne) SetNillableDeletedAt(t *time.Time) *MessageUpdateOne {
+	if t != nil {
+		muo.SetDeletedAt(*t)
+	}
+	return muo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (muo *MessageUpdateOne) ClearDeletedAt() *MessageUpdateOne {
+	muo.mutation.ClearDeletedAt()
+	return muo
+}
+
+// SetParentID sets the "parent_id" field.
+func (muo *MessageUpdateOne) SetParentID(u uuid.UUID) *MessageUpdateOne {
+	muo.mutation.SetParentID(u)
+	return muo
+}
+
+// SetNillableParentID sets the "parent_id" field if the given value is not nil.
+func (muo *MessageUpdateOne) SetNillableParentID(u *uuid.UUID) *MessageUpdateOne {
+	if u != nil {
+		muo.SetParentID(*u)
+	}
+	return muo
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (muo *MessageUpdateOne) ClearParentID() *MessageUpdateOne {
+	muo.mutation.ClearParentID()
+	return muo
+}
+
+// SetReplyToID sets the "reply_to_id" field.
+func (muo *MessageUpdateOne) SetReplyToID(u uuid.UUID) *MessageUpdateOne {
+	muo.mutation.SetReplyToID(u)
+	return muo
+}
+
+// SetNillableReplyToID sets the "reply_to_id" field if the given value is not nil.
+func (muo *MessageUpdateOne) SetNillableReplyToID(u *uuid.UUID) *MessageUpdateOne {
+	if u != nil {
+		muo.SetReplyToID(*u)
+	}
+	return muo
+}
+
+// ClearReplyToID clears the value of the "reply_to_id" field.
+func (muo *MessageUpdateOne) ClearReplyToID() *MessageUpdateOne {
+	muo.mutation.ClearReplyToID()
+	return muo
+}
+
+// SetReplyCount sets the "reply_count" field.
+func (muo *MessageUpdateOne) SetReplyCount(i int) *MessageUpdateOne {
+	muo.mutation.ResetReplyCount()
+	muo.mutation.SetReplyCount(i)
+	return muo
+}
+
+// SetNillableReplyCount sets the "reply_count" field if the given value is not nil.
+func (muo *MessageUpdateOne) SetNillableReplyCount(i *int) *MessageUpdateOne {
+	if i != nil {
+		muo.SetReplyCount(*i)
+	}
+	return muo
+}
+
+// AddReplyCount adds i to the "reply_count" field.
+func (muo *MessageUpdateOne) AddReplyCount(i int) *MessageUpdateOne {
+	muo.mutation.AddReplyCount(i)
+	return muo
+}
+
+// SetLastReplyAt sets the "last_reply_at" field.
+func (muo *MessageUpdateOne) SetLastReplyAt(t time.Time) *MessageUpdateOne {
+	muo.mutation.SetLastReplyAt(t)
+	return muo
+}
+
+// SetNillableLastReplyAt sets the "last_reply_at" field if the given value is not nil.
+func (muo *MessageUpdateOne) SetNillableLastReplyAt(t *time.Time) *MessageUpdateOne {
+	if t != nil {
+		muo.SetLastReplyAt(*t)
+	}
+	return muo
+}
+
+// ClearLastReplyAt clears the value of the "last_reply_at" field.
+func (muo *MessageUpdateOne) ClearLastReplyAt() *MessageUpdateOne {
+	muo.mutation.ClearLastReplyAt()
+	return muo
+}
+
+// SetEditedAt sets the "edited_at" field.
+func (muo *MessageUpdateOne) SetEditedAt(t time.Time) *MessageUpdateOne {
+	muo.mutation.SetEditedAt(t)
+	return muo
+}
+
+// SetNillableEditedAt sets the "edited_at" field if the given value is not nil.
+func (muo *MessageUpdateOne) SetNillableEditedAt(t *time.Time) *MessageUpdateOne {
+	if t != nil {
+		muo.SetEditedAt(*t)
+	}
+	return muo
+}
+
+// ClearEditedAt clears the value of the "edited_at" field.
+func (muo *MessageUpdateOne) ClearEditedAt() *MessageUpdateOne {
+	muo.mutation.ClearEditedAt()
+	return muo
+}
+
+// SetRoom sets the "room" edge to the ChatRoom entity.
+func (muo *MessageUpdateOne) SetRoom(c *ChatRoom) *MessageUpdateOne {
+	return muo.SetRoomID(c.ID)
+}
+
+// SetSenderID sets the "sender" edge to the User entity by ID.
+func (muo *MessageUpdateOne) SetSenderID(id uuid.UUID) *MessageUpdateOne {
+	muo.mutation.SetSenderID(id)
+	return muo
+}
+
+// SetSender sets the "sender" edge to the User entity.
+func (muo *MessageUpdateOne) SetSender(u *User) *MessageUpdateOne {
+	return muo.SetSenderID(u.ID)
+}
+
+// AddReadIDs adds the "reads" edge to the MessageRead entity by IDs.
+func (muo *MessageUpdateOne) AddReadIDs(ids ...int64) *MessageUpdateOne {
+	muo.mutation.AddReadIDs(ids...)
+	return muo
+}
+
+// AddReads adds the "reads" edges to the MessageRead entity.
+func (muo *MessageUpdateOne) AddReads(m ...*MessageRead) *MessageUpdateOne {
+	ids := make([]int64, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return muo.AddReadIDs(ids...)
+}
+
+// AddReactionIDs adds the "reactions" edge to the MessageReaction entity by IDs.
+func (muo *MessageUpdateOne) AddReactionIDs(ids ...int64) *MessageUpdateOne {
+	muo.mutation.AddReactionIDs(ids...)
+	return muo
+}
+
+// AddReactions adds the "reactions" edges to the MessageReaction entity.
+func (muo *MessageUpdateOne) AddReactions(m ...*MessageReaction) *MessageUpdateOne {
+	ids := make([]int64, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return muo.AddReactionIDs(ids...)
+}
+
+// AddRevisionIDs adds the "revisions" edge to the MessageRevision entity by IDs.
+func (muo *MessageUpdateOne) AddRevisionIDs(ids ...int64) *MessageUpdateOne {
+	muo.mutation.AddRevisionIDs(ids...)
+	return muo
+}
+
+// AddRevisions adds the "revisions" edges to the MessageRevision entity.
+func (muo *MessageUpdateOne) AddRevisions(m ...*MessageRevision) *MessageUpdateOne {
+	ids := make([]int64, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return muo.AddRevisionIDs(ids...)
+}
+
+// SetParent sets the "parent" edge to the Message entity.
+func (muo *MessageUpdateOne) SetParent(m *Message) *MessageUpdateOne {
+	return muo.SetParentID(m.ID)
+}
+
+// AddReplyIDs adds the "replies" edge to the Message entity by IDs.
+func (muo *MessageUpdateOne) AddReplyIDs(ids ...uuid.UUID) *MessageUpdateOne {
+	muo.mutation.AddReplyIDs(ids...)
+	return muo
+}
+
+// AddReplies adds the "replies" edges to the Message entity.
+func (muo *MessageUpdateOne) AddReplies(m ...*Message) *MessageUpdateOne {
+	ids := make([]uuid.UUID, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return muo.AddReplyIDs(ids...)
+}
+
+// SetReplyTo sets the "reply_to" edge to the Message entity.
+func (muo *MessageUpdateOne) SetReplyTo(m *Message) *MessageUpdateOne {
+	return muo.SetReplyToID(m.ID)
+}
+
+// AddQuoteIDs adds the "quotes" edge to the Message entity by IDs.
+func (muo *MessageUpdateOne) AddQuoteIDs(ids ...uuid.UUID) *MessageUpdateOne {
+	muo.mutation.AddQuoteIDs(ids...)
+	return muo
+}
+
+// AddQuotes adds the "quotes" edges to the Message entity.
+func (muo *MessageUpdateOne) AddQuotes(m ...*Message) *MessageUpdateOne {
+	ids := make([]uuid.UUID, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return muo.AddQuoteIDs(ids...)
+}
+
+// Mutation returns the MessageMutation object of the builder.
+func (muo *MessageUpdateOne) Mutation() *MessageMutation {
+	return muo.mutation
+}
+
+// ClearRoom clears the "room" edge to the ChatRoom entity.
+func (muo *MessageUpdateOne) ClearRoom() *MessageUpdateOne {
+	muo.mutation.ClearRoom()
+	return muo
+}
 
-// SetFileURL sets the "file_url" field.
-func (muo *MessageUpdateOne) SetFileURL(s string) *MessageUpdateOne {
-	muo.mutation.SetFileURL(s)
+// ClearSender clears the "sender" edge to the User entity.
+func (muo *MessageUpdateOne) ClearSender() *MessageUpdateOne {
+	muo.mutation.ClearSender()
 	return muo
 }
 
-// SetNillableFileURL sets the "file_url" field if the given value is not nil.
-func (muo *MessageUpdateOne) SetNillableFileURL(s *string) *MessageUpdateOne {
-	if s != nil {
-		muo.SetFileURL(*s)
-	}
+// ClearReads clears all "reads" edges to the MessageRead entity.
+func (muo *MessageUpdateOne) ClearReads() *MessageUpdateOne {
+	muo.mutation.ClearReads()
 	return muo
 }
 
-// ClearFileURL clears the value of the "file_url" field.
-func (muo *MessageUpdateOne) ClearFileURL() *MessageUpdateOne {
-	muo.mutation.ClearFileURL()
+// RemoveReadIDs removes the "reads" edge to MessageRead entities by IDs.
+func (muo *MessageUpdateOne) RemoveReadIDs(ids ...int64) *MessageUpdateOne {
+	muo.mutation.RemoveReadIDs(ids...)
 	return muo
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (muo *MessageUpdateOne) SetUpdatedAt(t time.Time) *MessageUpdateOne {
-	muo.mutation.SetUpdatedAt(t)
+// RemoveReads removes "reads" edges to MessageRead entities.
+func (muo *MessageUpdateOne) RemoveReads(m ...*MessageRead) *MessageUpdateOne {
+	ids := make([]int64, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return muo.RemoveReadIDs(ids...)
+}
+
+// ClearReactions clears all "reactions" edges to the MessageReaction entity.
+func (muo *MessageUpdateOne) ClearReactions() *MessageUpdateOne {
+	muo.mutation.ClearReactions()
 	return muo
 }
 
-// SetDeletedAt sets the "deleted_at" field.
-func (muo *MessageUpdateOne) SetDeletedAt(t time.Time) *MessageUpdateOne {
-	muo.mutation.SetDeletedAt(t)
+// RemoveReactionIDs removes the "reactions" edge to MessageReaction entities by IDs.
+func (muo *MessageUpdateOne) RemoveReactionIDs(ids ...int64) *MessageUpdateOne {
+	muo.mutation.RemoveReactionIDs(ids...)
 	return muo
 }
 
-// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
-func (muo *MessageUpdateOne) SetNillableDeletedAt(t *time.Time) *MessageUpdateOne {
-	if t != nil {
-		muo.SetDeletedAt(*t)
+// RemoveReactions removes "reactions" edges to MessageReaction entities.
+func (muo *MessageUpdateOne) RemoveReactions(m ...*MessageReaction) *MessageUpdateOne {
+	ids := make([]int64, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
 	}
+	return muo.RemoveReactionIDs(ids...)
+}
+
+// ClearRevisions clears all "revisions" edges to the MessageRevision entity.
+func (muo *MessageUpdateOne) ClearRevisions() *MessageUpdateOne {
+	muo.mutation.ClearRevisions()
 	return muo
 }
 
-// ClearDeletedAt clears the value of the "deleted_at" field.
-func (muo *MessageUpdateOne) ClearDeletedAt() *MessageUpdateOne {
-	muo.mutation.ClearDeletedAt()
+// RemoveRevisionIDs removes the "revisions" edge to MessageRevision entities by IDs.
+func (muo *MessageUpdateOne) RemoveRevisionIDs(ids ...int64) *MessageUpdateOne {
+	muo.mutation.RemoveRevisionIDs(ids...)
 	return muo
 }
 
-// SetRoom sets the "room" edge to the ChatRoom entity.
-func (muo *MessageUpdateOne) SetRoom(c *ChatRoom) *MessageUpdateOne {
-	return muo.SetRoomID(c.ID)
+// RemoveRevisions removes "revisions" edges to MessageRevision entities.
+func (muo *MessageUpdateOne) RemoveRevisions(m ...*MessageRevision) *MessageUpdateOne {
+	ids := make([]int64, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return muo.RemoveRevisionIDs(ids...)
 }
 
-// SetSenderID sets the "sender" edge to the User entity by ID.
-func (muo *MessageUpdateOne) SetSenderID(id uuid.UUID) *MessageUpdateOne {
-	muo.mutation.SetSenderID(id)
+// ClearParent clears the "parent" edge to the Message entity.
+func (muo *MessageUpdateOne) ClearParent() *MessageUpdateOne {
+	muo.mutation.ClearParent()
 	return muo
 }
 
-// SetSender sets the "sender" edge to the User entity.
-func (muo *MessageUpdateOne) SetSender(u *User) *MessageUpdateOne {
-	return muo.SetSenderID(u.ID)
+// ClearReplies clears all "replies" edges to the Message entity.
+func (muo *MessageUpdateOne) ClearReplies() *MessageUpdateOne {
+	muo.mutation.ClearReplies()
+	return muo
 }
 
-// Mutation returns the MessageMutation object of the builder.
-func (muo *MessageUpdateOne) Mutation() *MessageMutation {
-	return muo.mutation
+// RemoveReplyIDs removes the "replies" edge to Message entities by IDs.
+func (muo *MessageUpdateOne) RemoveReplyIDs(ids ...uuid.UUID) *MessageUpdateOne {
+	muo.mutation.RemoveReplyIDs(ids...)
+	return muo
 }
 
-// ClearRoom clears the "room" edge to the ChatRoom entity.
-func (muo *MessageUpdateOne) ClearRoom() *MessageUpdateOne {
-	muo.mutation.ClearRoom()
+// RemoveReplies removes "replies" edges to Message entities.
+func (muo *MessageUpdateOne) RemoveReplies(m ...*Message) *MessageUpdateOne {
+	ids := make([]uuid.UUID, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return muo.RemoveReplyIDs(ids...)
+}
+
+// ClearReplyTo clears the "reply_to" edge to the Message entity.
+func (muo *MessageUpdateOne) ClearReplyTo() *MessageUpdateOne {
+	muo.mutation.ClearReplyTo()
 	return muo
 }
 
-// ClearSender clears the "sender" edge to the User entity.
-func (muo *MessageUpdateOne) ClearSender() *MessageUpdateOne {
-	muo.mutation.ClearSender()
+// ClearQuotes clears all "quotes" edges to the Message entity.
+func (muo *MessageUpdateOne) ClearQuotes() *MessageUpdateOne {
+	muo.mutation.ClearQuotes()
+	return muo
+}
+
+// RemoveQuoteIDs removes the "quotes" edge to Message entities by IDs.
+func (muo *MessageUpdateOne) RemoveQuoteIDs(ids ...uuid.UUID) *MessageUpdateOne {
+	muo.mutation.RemoveQuoteIDs(ids...)
 	return muo
 }
 
+// RemoveQuotes removes "quotes" edges to Message entities.
+func (muo *MessageUpdateOne) RemoveQuotes(m ...*Message) *MessageUpdateOne {
+	ids := make([]uuid.UUID, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return muo.RemoveQuoteIDs(ids...)
+}
+
 // Where appends a list predicates to the MessageUpdate builder.
 func (muo *MessageUpdateOne) Where(ps ...predicate.Message) *MessageUpdateOne {
 	muo.mutation.Where(ps...)
@@ -443,7 +1444,9 @@ func (muo *MessageUpdateOne) Select(field string, fields ...string) *MessageUpda
 
 // Save executes the query and returns the updated Message entity.
 func (muo *MessageUpdateOne) Save(ctx context.Context) (*Message, error) {
-	muo.defaults()
+	if err := muo.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, muo.sqlSave, muo.mutation, muo.hooks)
 }
 
@@ -470,15 +1473,24 @@ func (muo *MessageUpdateOne) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (muo *MessageUpdateOne) defaults() {
+func (muo *MessageUpdateOne) defaults() error {
 	if _, ok := muo.mutation.UpdatedAt(); !ok {
+		if message.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized message.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := message.UpdateDefaultUpdatedAt()
 		muo.mutation.SetUpdatedAt(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
 func (muo *MessageUpdateOne) check() error {
+	if v, ok := muo.mutation.GetType(); ok {
+		if err := message.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Message.type": %w`, err)}
+		}
+	}
 	if muo.mutation.RoomCleared() && len(muo.mutation.RoomIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Message.room"`)
 	}
@@ -520,6 +1532,20 @@ func (muo *MessageUpdateOne) sqlSave(ctx context.Context) (_node *Message, err e
 	if value, ok := muo.mutation.Content(); ok {
 		_spec.SetField(message.FieldContent, field.TypeString, value)
 	}
+	if value, ok := muo.mutation.GetType(); ok {
+		_spec.SetField(message.FieldType, field.TypeEnum, value)
+	}
+	if value, ok := muo.mutation.Payload(); ok {
+		_spec.SetField(message.FieldPayload, field.TypeJSON, value)
+	}
+	if value, ok := muo.mutation.AppendedPayload(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, message.FieldPayload, value)
+		})
+	}
+	if muo.mutation.PayloadCleared() {
+		_spec.ClearField(message.FieldPayload, field.TypeJSON)
+	}
 	if value, ok := muo.mutation.FileURL(); ok {
 		_spec.SetField(message.FieldFileURL, field.TypeString, value)
 	}
@@ -535,6 +1561,24 @@ func (muo *MessageUpdateOne) sqlSave(ctx context.Context) (_node *Message, err e
 	if muo.mutation.DeletedAtCleared() {
 		_spec.ClearField(message.FieldDeletedAt, field.TypeTime)
 	}
+	if value, ok := muo.mutation.ReplyCount(); ok {
+		_spec.SetField(message.FieldReplyCount, field.TypeInt, value)
+	}
+	if value, ok := muo.mutation.AddedReplyCount(); ok {
+		_spec.AddField(message.FieldReplyCount, field.TypeInt, value)
+	}
+	if value, ok := muo.mutation.LastReplyAt(); ok {
+		_spec.SetField(message.FieldLastReplyAt, field.TypeTime, value)
+	}
+	if muo.mutation.LastReplyAtCleared() {
+		_spec.ClearField(message.FieldLastReplyAt, field.TypeTime)
+	}
+	if value, ok := muo.mutation.EditedAt(); ok {
+		_spec.SetField(message.FieldEditedAt, field.TypeTime, value)
+	}
+	if muo.mutation.EditedAtCleared() {
+		_spec.ClearField(message.FieldEditedAt, field.TypeTime)
+	}
 	if muo.mutation.RoomCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -593,6 +1637,289 @@ func (muo *MessageUpdateOne) sqlSave(ctx context.Context) (_node *Message, err e
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if muo.mutation.ReadsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.ReadsTable,
+			Columns: []string{message.ReadsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messageread.FieldID, field.TypeInt64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.RemovedReadsIDs(); len(nodes) > 0 && !muo.mutation.ReadsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.ReadsTable,
+			Columns: []string{message.ReadsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messageread.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.ReadsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.ReadsTable,
+			Columns: []string{message.ReadsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messageread.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if muo.mutation.ReactionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.ReactionsTable,
+			Columns: []string{message.ReactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagereaction.FieldID, field.TypeInt64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.RemovedReactionsIDs(); len(nodes) > 0 && !muo.mutation.ReactionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.ReactionsTable,
+			Columns: []string{message.ReactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagereaction.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.ReactionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.ReactionsTable,
+			Columns: []string{message.ReactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagereaction.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if muo.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.RevisionsTable,
+			Columns: []string{message.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagerevision.FieldID, field.TypeInt64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.RemovedRevisionsIDs(); len(nodes) > 0 && !muo.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.RevisionsTable,
+			Columns: []string{message.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagerevision.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.RevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.RevisionsTable,
+			Columns: []string{message.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagerevision.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if muo.mutation.ParentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   message.ParentTable,
+			Columns: []string{message.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.ParentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   message.ParentTable,
+			Columns: []string{message.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if muo.mutation.RepliesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.RepliesTable,
+			Columns: []string{message.RepliesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.RemovedRepliesIDs(); len(nodes) > 0 && !muo.mutation.RepliesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.RepliesTable,
+			Columns: []string{message.RepliesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.RepliesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.RepliesTable,
+			Columns: []string{message.RepliesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if muo.mutation.ReplyToCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   message.ReplyToTable,
+			Columns: []string{message.ReplyToColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.ReplyToIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   message.ReplyToTable,
+			Columns: []string{message.ReplyToColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if muo.mutation.QuotesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.QuotesTable,
+			Columns: []string{message.QuotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.RemovedQuotesIDs(); len(nodes) > 0 && !muo.mutation.QuotesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.QuotesTable,
+			Columns: []string{message.QuotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.QuotesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.QuotesTable,
+			Columns: []string{message.QuotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Message{config: muo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
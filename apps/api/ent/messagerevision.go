@@ -0,0 +1,190 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/message"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/messagerevision"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/user"
+)
+
+// MessageRevision is the model entity for the MessageRevision schema.
+type MessageRevision struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int64 `json:"id,omitempty"`
+	// メッセージID
+	MessageID uuid.UUID `json:"message_id,omitempty"`
+	// 編集したユーザーID
+	EditorID uuid.UUID `json:"editor_id,omitempty"`
+	// 編集前のメッセージ内容
+	Content string `json:"content,omitempty"`
+	// 編集日時
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the MessageRevisionQuery when eager-loading is set.
+	Edges        MessageRevisionEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// MessageRevisionEdges holds the relations/edges for other nodes in the graph.
+type MessageRevisionEdges struct {
+	// Message holds the value of the message edge.
+	Message *Message `json:"message,omitempty"`
+	// Editor holds the value of the editor edge.
+	Editor *User `json:"editor,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// MessageOrErr returns the Message value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e MessageRevisionEdges) MessageOrErr() (*Message, error) {
+	if e.Message != nil {
+		return e.Message, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: message.Label}
+	}
+	return nil, &NotLoadedError{edge: "message"}
+}
+
+// EditorOrErr returns the Editor value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e MessageRevisionEdges) EditorOrErr() (*User, error) {
+	if e.Editor != nil {
+		return e.Editor, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "editor"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*MessageRevision) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case messagerevision.FieldID:
+			values[i] = new(sql.NullInt64)
+		case messagerevision.FieldContent:
+			values[i] = new(sql.NullString)
+		case messagerevision.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case messagerevision.FieldMessageID, messagerevision.FieldEditorID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the MessageRevision fields.
+func (mr *MessageRevision) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case messagerevision.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			mr.ID = int64(value.Int64)
+		case messagerevision.FieldMessageID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field message_id", values[i])
+			} else if value != nil {
+				mr.MessageID = *value
+			}
+		case messagerevision.FieldEditorID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field editor_id", values[i])
+			} else if value != nil {
+				mr.EditorID = *value
+			}
+		case messagerevision.FieldContent:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field content", values[i])
+			} else if value.Valid {
+				mr.Content = value.String
+			}
+		case messagerevision.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				mr.CreatedAt = value.Time
+			}
+		default:
+			mr.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the MessageRevision.
+// This includes values selected through modifiers, order, etc.
+func (mr *MessageRevision) Value(name string) (ent.Value, error) {
+	return mr.selectValues.Get(name)
+}
+
+// QueryMessage queries the "message" edge of the MessageRevision entity.
+func (mr *MessageRevision) QueryMessage() *MessageQuery {
+	return NewMessageRevisionClient(mr.config).QueryMessage(mr)
+}
+
+// QueryEditor queries the "editor" edge of the MessageRevision entity.
+func (mr *MessageRevision) QueryEditor() *UserQuery {
+	return NewMessageRevisionClient(mr.config).QueryEditor(mr)
+}
+
+// Update returns a builder for updating this MessageRevision.
+// Note that you need to call MessageRevision.Unwrap() before calling this method if this MessageRevision
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (mr *MessageRevision) Update() *MessageRevisionUpdateOne {
+	return NewMessageRevisionClient(mr.config).UpdateOne(mr)
+}
+
+// Unwrap unwraps the MessageRevision entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (mr *MessageRevision) Unwrap() *MessageRevision {
+	_tx, ok := mr.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: MessageRevision is not a transactional entity")
+	}
+	mr.config.driver = _tx.drv
+	return mr
+}
+
+// String implements the fmt.Stringer.
+func (mr *MessageRevision) String() string {
+	var builder strings.Builder
+	builder.WriteString("MessageRevision(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", mr.ID))
+	builder.WriteString("message_id=")
+	builder.WriteString(fmt.Sprintf("%v", mr.MessageID))
+	builder.WriteString(", ")
+	builder.WriteString("editor_id=")
+	builder.WriteString(fmt.Sprintf("%v", mr.EditorID))
+	builder.WriteString(", ")
+	builder.WriteString("content=")
+	builder.WriteString(mr.Content)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(mr.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// MessageRevisions is a parsable slice of MessageRevision.
+type MessageRevisions []*MessageRevision
@@ -0,0 +1,422 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/message"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/messagerevision"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/predicate"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/user"
+)
+
+// MessageRevisionUpdate is the builder for updating MessageRevision entities.
+type MessageRevisionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *MessageRevisionMutation
+}
+
+// Where appends a list predicates to the MessageRevisionUpdate builder.
+func (mru *MessageRevisionUpdate) Where(ps ...predicate.MessageRevision) *MessageRevisionUpdate {
+	mru.mutation.Where(ps...)
+	return mru
+}
+
+// SetMessageID sets the "message_id" field.
+func (mru *MessageRevisionUpdate) SetMessageID(u uuid.UUID) *MessageRevisionUpdate {
+	mru.mutation.SetMessageID(u)
+	return mru
+}
+
+// SetNillableMessageID sets the "message_id" field if the given value is not nil.
+func (mru *MessageRevisionUpdate) SetNillableMessageID(u *uuid.UUID) *MessageRevisionUpdate {
+	if u != nil {
+		mru.SetMessageID(*u)
+	}
+	return mru
+}
+
+// SetEditorID sets the "editor_id" field.
+func (mru *MessageRevisionUpdate) SetEditorID(u uuid.UUID) *MessageRevisionUpdate {
+	mru.mutation.SetEditorID(u)
+	return mru
+}
+
+// SetNillableEditorID sets the "editor_id" field if the given value is not nil.
+func (mru *MessageRevisionUpdate) SetNillableEditorID(u *uuid.UUID) *MessageRevisionUpdate {
+	if u != nil {
+		mru.SetEditorID(*u)
+	}
+	return mru
+}
+
+// SetMessage sets the "message" edge to the Message entity.
+func (mru *MessageRevisionUpdate) SetMessage(m *Message) *MessageRevisionUpdate {
+	return mru.SetMessageID(m.ID)
+}
+
+// SetEditor sets the "editor" edge to the User entity.
+func (mru *MessageRevisionUpdate) SetEditor(u *User) *MessageRevisionUpdate {
+	return mru.SetEditorID(u.ID)
+}
+
+// Mutation returns the MessageRevisionMutation object of the builder.
+func (mru *MessageRevisionUpdate) Mutation() *MessageRevisionMutation {
+	return mru.mutation
+}
+
+// ClearMessage clears the "message" edge to the Message entity.
+func (mru *MessageRevisionUpdate) ClearMessage() *MessageRevisionUpdate {
+	mru.mutation.ClearMessage()
+	return mru
+}
+
+// ClearEditor clears the "editor" edge to the User entity.
+func (mru *MessageRevisionUpdate) ClearEditor() *MessageRevisionUpdate {
+	mru.mutation.ClearEditor()
+	return mru
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (mru *MessageRevisionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, mru.sqlSave, mru.mutation, mru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mru *MessageRevisionUpdate) SaveX(ctx context.Context) int {
+	affected, err := mru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (mru *MessageRevisionUpdate) Exec(ctx context.Context) error {
+	_, err := mru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mru *MessageRevisionUpdate) ExecX(ctx context.Context) {
+	if err := mru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mru *MessageRevisionUpdate) check() error {
+	if mru.mutation.MessageCleared() && len(mru.mutation.MessageIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "MessageRevision.message"`)
+	}
+	if mru.mutation.EditorCleared() && len(mru.mutation.EditorIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "MessageRevision.editor"`)
+	}
+	return nil
+}
+
+func (mru *MessageRevisionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := mru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(messagerevision.Table, messagerevision.Columns, sqlgraph.NewFieldSpec(messagerevision.FieldID, field.TypeInt64))
+	if ps := mru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if mru.mutation.MessageCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   messagerevision.MessageTable,
+			Columns: []string{messagerevision.MessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mru.mutation.MessageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   messagerevision.MessageTable,
+			Columns: []string{messagerevision.MessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if mru.mutation.EditorCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   messagerevision.EditorTable,
+			Columns: []string{messagerevision.EditorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mru.mutation.EditorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   messagerevision.EditorTable,
+			Columns: []string{messagerevision.EditorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, mru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{messagerevision.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	mru.mutation.done = true
+	return n, nil
+}
+
+// MessageRevisionUpdateOne is the builder for updating a single MessageRevision entity.
+type MessageRevisionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *MessageRevisionMutation
+}
+
+// SetMessageID sets the "message_id" field.
+func (mruo *MessageRevisionUpdateOne) SetMessageID(u uuid.UUID) *MessageRevisionUpdateOne {
+	mruo.mutation.SetMessageID(u)
+	return mruo
+}
+
+// SetNillableMessageID sets the "message_id" field if the given value is not nil.
+func (mruo *MessageRevisionUpdateOne) SetNillableMessageID(u *uuid.UUID) *MessageRevisionUpdateOne {
+	if u != nil {
+		mruo.SetMessageID(*u)
+	}
+	return mruo
+}
+
+// SetEditorID sets the "editor_id" field.
+func (mruo *MessageRevisionUpdateOne) SetEditorID(u uuid.UUID) *MessageRevisionUpdateOne {
+	mruo.mutation.SetEditorID(u)
+	return mruo
+}
+
+// SetNillableEditorID sets the "editor_id" field if the given value is not nil.
+func (mruo *MessageRevisionUpdateOne) SetNillableEditorID(u *uuid.UUID) *MessageRevisionUpdateOne {
+	if u != nil {
+		mruo.SetEditorID(*u)
+	}
+	return mruo
+}
+
+// SetMessage sets the "message" edge to the Message entity.
+func (mruo *MessageRevisionUpdateOne) SetMessage(m *Message) *MessageRevisionUpdateOne {
+	return mruo.SetMessageID(m.ID)
+}
+
+// SetEditor sets the "editor" edge to the User entity.
+func (mruo *MessageRevisionUpdateOne) SetEditor(u *User) *MessageRevisionUpdateOne {
+	return mruo.SetEditorID(u.ID)
+}
+
+// Mutation returns the MessageRevisionMutation object of the builder.
+func (mruo *MessageRevisionUpdateOne) Mutation() *MessageRevisionMutation {
+	return mruo.mutation
+}
+
+// ClearMessage clears the "message" edge to the Message entity.
+func (mruo *MessageRevisionUpdateOne) ClearMessage() *MessageRevisionUpdateOne {
+	mruo.mutation.ClearMessage()
+	return mruo
+}
+
+// ClearEditor clears the "editor" edge to the User entity.
+func (mruo *MessageRevisionUpdateOne) ClearEditor() *MessageRevisionUpdateOne {
+	mruo.mutation.ClearEditor()
+	return mruo
+}
+
+// Where appends a list predicates to the MessageRevisionUpdate builder.
+func (mruo *MessageRevisionUpdateOne) Where(ps ...predicate.MessageRevision) *MessageRevisionUpdateOne {
+	mruo.mutation.Where(ps...)
+	return mruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (mruo *MessageRevisionUpdateOne) Select(field string, fields ...string) *MessageRevisionUpdateOne {
+	mruo.fields = append([]string{field}, fields...)
+	return mruo
+}
+
+// Save executes the query and returns the updated MessageRevision entity.
+func (mruo *MessageRevisionUpdateOne) Save(ctx context.Context) (*MessageRevision, error) {
+	return withHooks(ctx, mruo.sqlSave, mruo.mutation, mruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mruo *MessageRevisionUpdateOne) SaveX(ctx context.Context) *MessageRevision {
+	node, err := mruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (mruo *MessageRevisionUpdateOne) Exec(ctx context.Context) error {
+	_, err := mruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mruo *MessageRevisionUpdateOne) ExecX(ctx context.Context) {
+	if err := mruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mruo *MessageRevisionUpdateOne) check() error {
+	if mruo.mutation.MessageCleared() && len(mruo.mutation.MessageIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "MessageRevision.message"`)
+	}
+	if mruo.mutation.EditorCleared() && len(mruo.mutation.EditorIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "MessageRevision.editor"`)
+	}
+	return nil
+}
+
+func (mruo *MessageRevisionUpdateOne) sqlSave(ctx context.Context) (_node *MessageRevision, err error) {
+	if err := mruo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(messagerevision.Table, messagerevision.Columns, sqlgraph.NewFieldSpec(messagerevision.FieldID, field.TypeInt64))
+	id, ok := mruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "MessageRevision.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := mruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, messagerevision.FieldID)
+		for _, f := range fields {
+			if !messagerevision.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != messagerevision.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := mruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if mruo.mutation.MessageCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   messagerevision.MessageTable,
+			Columns: []string{messagerevision.MessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mruo.mutation.MessageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   messagerevision.MessageTable,
+			Columns: []string{messagerevision.MessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if mruo.mutation.EditorCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   messagerevision.EditorTable,
+			Columns: []string{messagerevision.EditorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mruo.mutation.EditorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   messagerevision.EditorTable,
+			Columns: []string{messagerevision.EditorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &MessageRevision{config: mruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, mruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{messagerevision.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	mruo.mutation.done = true
+	return _node, nil
+}
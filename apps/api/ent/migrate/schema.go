@@ -12,25 +12,137 @@ var (
 	ChatRoomsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true},
 		{Name: "name", Type: field.TypeString, Size: 100},
+		{Name: "description", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "topic", Type: field.TypeString, Nullable: true},
+		{Name: "avatar_url", Type: field.TypeString, Nullable: true},
 		{Name: "is_group_chat", Type: field.TypeBool, Default: false},
+		{Name: "dm_key", Type: field.TypeString, Unique: true, Nullable: true},
+		{Name: "visibility", Type: field.TypeEnum, Enums: []string{"private", "public", "request_to_join"}, Default: "private"},
+		{Name: "edit_window_seconds", Type: field.TypeInt, Nullable: true},
+		{Name: "archived_at", Type: field.TypeTime, Nullable: true},
+		{Name: "last_message_at", Type: field.TypeTime, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "last_message_id", Type: field.TypeUUID, Nullable: true},
 	}
 	// ChatRoomsTable holds the schema information for the "chat_rooms" table.
 	ChatRoomsTable = &schema.Table{
 		Name:       "chat_rooms",
 		Columns:    ChatRoomsColumns,
 		PrimaryKey: []*schema.Column{ChatRoomsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "chat_rooms_messages_last_message",
+				Columns:    []*schema.Column{ChatRoomsColumns[13]},
+				RefColumns: []*schema.Column{MessagesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "chatroom_last_message_at",
+				Unique:  false,
+				Columns: []*schema.Column{ChatRoomsColumns[10]},
+			},
+		},
+	}
+	// InvitesColumns holds the columns for the "invites" table.
+	InvitesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "token_hash", Type: field.TypeBytes, Unique: true},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "max_uses", Type: field.TypeInt, Nullable: true},
+		{Name: "use_count", Type: field.TypeInt, Default: 0},
+		{Name: "revoked", Type: field.TypeBool, Default: false},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "room_id", Type: field.TypeUUID},
+		{Name: "creator_id", Type: field.TypeUUID},
+	}
+	// InvitesTable holds the schema information for the "invites" table.
+	InvitesTable = &schema.Table{
+		Name:       "invites",
+		Columns:    InvitesColumns,
+		PrimaryKey: []*schema.Column{InvitesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "invites_chat_rooms_invites",
+				Columns:    []*schema.Column{InvitesColumns[7]},
+				RefColumns: []*schema.Column{ChatRoomsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "invites_users_invites",
+				Columns:    []*schema.Column{InvitesColumns[8]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "invite_room_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{InvitesColumns[7], InvitesColumns[6]},
+			},
+		},
+	}
+	// JoinRequestsColumns holds the columns for the "join_requests" table.
+	JoinRequestsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "approved", "rejected"}, Default: "pending"},
+		{Name: "decided_by", Type: field.TypeUUID, Nullable: true},
+		{Name: "decided_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "room_id", Type: field.TypeUUID},
+		{Name: "user_id", Type: field.TypeUUID},
+	}
+	// JoinRequestsTable holds the schema information for the "join_requests" table.
+	JoinRequestsTable = &schema.Table{
+		Name:       "join_requests",
+		Columns:    JoinRequestsColumns,
+		PrimaryKey: []*schema.Column{JoinRequestsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "join_requests_chat_rooms_join_requests",
+				Columns:    []*schema.Column{JoinRequestsColumns[5]},
+				RefColumns: []*schema.Column{ChatRoomsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "join_requests_users_join_requests",
+				Columns:    []*schema.Column{JoinRequestsColumns[6]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "joinrequest_room_id_user_id",
+				Unique:  true,
+				Columns: []*schema.Column{JoinRequestsColumns[5], JoinRequestsColumns[6]},
+			},
+			{
+				Name:    "joinrequest_room_id_status",
+				Unique:  false,
+				Columns: []*schema.Column{JoinRequestsColumns[5], JoinRequestsColumns[1]},
+			},
+		},
 	}
 	// MessagesColumns holds the columns for the "messages" table.
 	MessagesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true},
 		{Name: "content", Type: field.TypeString, Size: 2147483647},
+		{Name: "type", Type: field.TypeEnum, Enums: []string{"user", "system", "bot"}, Default: "user"},
+		{Name: "payload", Type: field.TypeJSON, Nullable: true},
 		{Name: "file_url", Type: field.TypeString, Nullable: true, Size: 2147483647},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "reply_count", Type: field.TypeInt, Default: 0},
+		{Name: "last_reply_at", Type: field.TypeTime, Nullable: true},
+		{Name: "edited_at", Type: field.TypeTime, Nullable: true},
 		{Name: "room_id", Type: field.TypeUUID},
+		{Name: "parent_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "reply_to_id", Type: field.TypeUUID, Nullable: true},
 		{Name: "user_id", Type: field.TypeUUID},
 	}
 	// MessagesTable holds the schema information for the "messages" table.
@@ -41,13 +153,25 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "messages_chat_rooms_messages",
-				Columns:    []*schema.Column{MessagesColumns[6]},
+				Columns:    []*schema.Column{MessagesColumns[11]},
 				RefColumns: []*schema.Column{ChatRoomsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
+			{
+				Symbol:     "messages_messages_replies",
+				Columns:    []*schema.Column{MessagesColumns[12]},
+				RefColumns: []*schema.Column{MessagesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "messages_messages_quotes",
+				Columns:    []*schema.Column{MessagesColumns[13]},
+				RefColumns: []*schema.Column{MessagesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
 			{
 				Symbol:     "messages_users_messages",
-				Columns:    []*schema.Column{MessagesColumns[7]},
+				Columns:    []*schema.Column{MessagesColumns[14]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -56,14 +180,167 @@ var (
 			{
 				Name:    "message_room_id_created_at",
 				Unique:  false,
-				Columns: []*schema.Column{MessagesColumns[6], MessagesColumns[3]},
+				Columns: []*schema.Column{MessagesColumns[11], MessagesColumns[5]},
+			},
+			{
+				Name:    "message_parent_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{MessagesColumns[12], MessagesColumns[5]},
+			},
+		},
+	}
+	// MessageReactionsColumns holds the columns for the "message_reactions" table.
+	MessageReactionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "emoji", Type: field.TypeString, Size: 32},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "message_id", Type: field.TypeUUID},
+		{Name: "user_id", Type: field.TypeUUID},
+	}
+	// MessageReactionsTable holds the schema information for the "message_reactions" table.
+	MessageReactionsTable = &schema.Table{
+		Name:       "message_reactions",
+		Columns:    MessageReactionsColumns,
+		PrimaryKey: []*schema.Column{MessageReactionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "message_reactions_messages_reactions",
+				Columns:    []*schema.Column{MessageReactionsColumns[3]},
+				RefColumns: []*schema.Column{MessagesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "message_reactions_users_message_reactions",
+				Columns:    []*schema.Column{MessageReactionsColumns[4]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "messagereaction_message_id_user_id_emoji",
+				Unique:  true,
+				Columns: []*schema.Column{MessageReactionsColumns[3], MessageReactionsColumns[4], MessageReactionsColumns[1]},
+			},
+		},
+	}
+	// MessageReadsColumns holds the columns for the "message_reads" table.
+	MessageReadsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "read_at", Type: field.TypeTime},
+		{Name: "room_id", Type: field.TypeUUID},
+		{Name: "message_id", Type: field.TypeUUID},
+		{Name: "user_id", Type: field.TypeUUID},
+	}
+	// MessageReadsTable holds the schema information for the "message_reads" table.
+	MessageReadsTable = &schema.Table{
+		Name:       "message_reads",
+		Columns:    MessageReadsColumns,
+		PrimaryKey: []*schema.Column{MessageReadsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "message_reads_chat_rooms_message_reads",
+				Columns:    []*schema.Column{MessageReadsColumns[2]},
+				RefColumns: []*schema.Column{ChatRoomsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "message_reads_messages_reads",
+				Columns:    []*schema.Column{MessageReadsColumns[3]},
+				RefColumns: []*schema.Column{MessagesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "message_reads_users_message_reads",
+				Columns:    []*schema.Column{MessageReadsColumns[4]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "messageread_user_id_room_id",
+				Unique:  true,
+				Columns: []*schema.Column{MessageReadsColumns[4], MessageReadsColumns[2]},
+			},
+			{
+				Name:    "messageread_room_id_message_id",
+				Unique:  false,
+				Columns: []*schema.Column{MessageReadsColumns[2], MessageReadsColumns[3]},
+			},
+		},
+	}
+	// MessageRevisionsColumns holds the columns for the "message_revisions" table.
+	MessageRevisionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "content", Type: field.TypeString, Size: 2147483647},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "message_id", Type: field.TypeUUID},
+		{Name: "editor_id", Type: field.TypeUUID},
+	}
+	// MessageRevisionsTable holds the schema information for the "message_revisions" table.
+	MessageRevisionsTable = &schema.Table{
+		Name:       "message_revisions",
+		Columns:    MessageRevisionsColumns,
+		PrimaryKey: []*schema.Column{MessageRevisionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "message_revisions_messages_revisions",
+				Columns:    []*schema.Column{MessageRevisionsColumns[3]},
+				RefColumns: []*schema.Column{MessagesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "message_revisions_users_message_revisions",
+				Columns:    []*schema.Column{MessageRevisionsColumns[4]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "messagerevision_message_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{MessageRevisionsColumns[3], MessageRevisionsColumns[2]},
+			},
+		},
+	}
+	// RevokedTokensColumns holds the columns for the "revoked_tokens" table.
+	RevokedTokensColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeUUID},
+	}
+	// RevokedTokensTable holds the schema information for the "revoked_tokens" table.
+	RevokedTokensTable = &schema.Table{
+		Name:       "revoked_tokens",
+		Columns:    RevokedTokensColumns,
+		PrimaryKey: []*schema.Column{RevokedTokensColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "revoked_tokens_users_revoked_tokens",
+				Columns:    []*schema.Column{RevokedTokensColumns[3]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "revokedtoken_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{RevokedTokensColumns[1]},
 			},
 		},
 	}
 	// RoomMembersColumns holds the columns for the "room_members" table.
 	RoomMembersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "role", Type: field.TypeEnum, Enums: []string{"owner", "admin", "member"}, Default: "member"},
 		{Name: "joined_at", Type: field.TypeTime},
+		{Name: "archived", Type: field.TypeBool, Default: false},
+		{Name: "muted_until", Type: field.TypeTime, Nullable: true},
+		{Name: "pinned_order", Type: field.TypeInt, Nullable: true},
 		{Name: "room_id", Type: field.TypeUUID},
 		{Name: "user_id", Type: field.TypeUUID},
 	}
@@ -75,13 +352,13 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "room_members_chat_rooms_room_members",
-				Columns:    []*schema.Column{RoomMembersColumns[2]},
+				Columns:    []*schema.Column{RoomMembersColumns[6]},
 				RefColumns: []*schema.Column{ChatRoomsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "room_members_users_room_members",
-				Columns:    []*schema.Column{RoomMembersColumns[3]},
+				Columns:    []*schema.Column{RoomMembersColumns[7]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -90,12 +367,136 @@ var (
 			{
 				Name:    "roommember_room_id_user_id",
 				Unique:  true,
-				Columns: []*schema.Column{RoomMembersColumns[2], RoomMembersColumns[3]},
+				Columns: []*schema.Column{RoomMembersColumns[6], RoomMembersColumns[7]},
 			},
 			{
 				Name:    "roommember_user_id",
 				Unique:  false,
-				Columns: []*schema.Column{RoomMembersColumns[3]},
+				Columns: []*schema.Column{RoomMembersColumns[7]},
+			},
+		},
+	}
+	// RotatedRefreshTokensColumns holds the columns for the "rotated_refresh_tokens" table.
+	RotatedRefreshTokensColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "token_hash", Type: field.TypeBytes, Unique: true},
+		{Name: "rotated_at", Type: field.TypeTime},
+		{Name: "session_id", Type: field.TypeUUID},
+	}
+	// RotatedRefreshTokensTable holds the schema information for the "rotated_refresh_tokens" table.
+	RotatedRefreshTokensTable = &schema.Table{
+		Name:       "rotated_refresh_tokens",
+		Columns:    RotatedRefreshTokensColumns,
+		PrimaryKey: []*schema.Column{RotatedRefreshTokensColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "rotated_refresh_tokens_sessions_rotated_tokens",
+				Columns:    []*schema.Column{RotatedRefreshTokensColumns[3]},
+				RefColumns: []*schema.Column{SessionsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "rotatedrefreshtoken_session_id_rotated_at",
+				Unique:  false,
+				Columns: []*schema.Column{RotatedRefreshTokensColumns[3], RotatedRefreshTokensColumns[2]},
+			},
+		},
+	}
+	// SecurityEventsColumns holds the columns for the "security_events" table.
+	SecurityEventsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "type", Type: field.TypeEnum, Enums: []string{"refresh_token_reuse"}},
+		{Name: "session_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "ip_address", Type: field.TypeString, Nullable: true},
+		{Name: "user_agent", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeUUID},
+	}
+	// SecurityEventsTable holds the schema information for the "security_events" table.
+	SecurityEventsTable = &schema.Table{
+		Name:       "security_events",
+		Columns:    SecurityEventsColumns,
+		PrimaryKey: []*schema.Column{SecurityEventsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "security_events_users_security_events",
+				Columns:    []*schema.Column{SecurityEventsColumns[6]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "securityevent_user_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{SecurityEventsColumns[6], SecurityEventsColumns[5]},
+			},
+		},
+	}
+	// SessionsColumns holds the columns for the "sessions" table.
+	SessionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "refresh_token_hash", Type: field.TypeBytes, Unique: true},
+		{Name: "device_label", Type: field.TypeString, Nullable: true},
+		{Name: "user_agent", Type: field.TypeString, Nullable: true},
+		{Name: "ip_address", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "last_used_at", Type: field.TypeTime},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeUUID},
+	}
+	// SessionsTable holds the schema information for the "sessions" table.
+	SessionsTable = &schema.Table{
+		Name:       "sessions",
+		Columns:    SessionsColumns,
+		PrimaryKey: []*schema.Column{SessionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "sessions_users_sessions",
+				Columns:    []*schema.Column{SessionsColumns[8]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "session_user_id_last_used_at",
+				Unique:  false,
+				Columns: []*schema.Column{SessionsColumns[8], SessionsColumns[6]},
+			},
+		},
+	}
+	// StreamTicketsColumns holds the columns for the "stream_tickets" table.
+	StreamTicketsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "token_hash", Type: field.TypeBytes, Unique: true},
+		{Name: "token_id", Type: field.TypeString},
+		{Name: "token_version", Type: field.TypeInt},
+		{Name: "session_id", Type: field.TypeString, Nullable: true},
+		{Name: "token_expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeUUID},
+	}
+	// StreamTicketsTable holds the schema information for the "stream_tickets" table.
+	StreamTicketsTable = &schema.Table{
+		Name:       "stream_tickets",
+		Columns:    StreamTicketsColumns,
+		PrimaryKey: []*schema.Column{StreamTicketsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "stream_tickets_users_stream_tickets",
+				Columns:    []*schema.Column{StreamTicketsColumns[7]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "streamticket_user_id_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{StreamTicketsColumns[7], StreamTicketsColumns[6]},
 			},
 		},
 	}
@@ -109,8 +510,8 @@ var (
 		{Name: "bio", Type: field.TypeString, Nullable: true, Size: 2147483647},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
-		{Name: "refresh_token_hash", Type: field.TypeBytes, Unique: true, Nullable: true},
-		{Name: "refresh_token_expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "last_seen_at", Type: field.TypeTime, Nullable: true},
+		{Name: "token_version", Type: field.TypeInt, Default: 0},
 	}
 	// UsersTable holds the schema information for the "users" table.
 	UsersTable = &schema.Table{
@@ -128,15 +529,44 @@ var (
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
 		ChatRoomsTable,
+		InvitesTable,
+		JoinRequestsTable,
 		MessagesTable,
+		MessageReactionsTable,
+		MessageReadsTable,
+		MessageRevisionsTable,
+		RevokedTokensTable,
 		RoomMembersTable,
+		RotatedRefreshTokensTable,
+		SecurityEventsTable,
+		SessionsTable,
+		StreamTicketsTable,
 		UsersTable,
 	}
 )
 
 func init() {
+	ChatRoomsTable.ForeignKeys[0].RefTable = MessagesTable
+	InvitesTable.ForeignKeys[0].RefTable = ChatRoomsTable
+	InvitesTable.ForeignKeys[1].RefTable = UsersTable
+	JoinRequestsTable.ForeignKeys[0].RefTable = ChatRoomsTable
+	JoinRequestsTable.ForeignKeys[1].RefTable = UsersTable
 	MessagesTable.ForeignKeys[0].RefTable = ChatRoomsTable
-	MessagesTable.ForeignKeys[1].RefTable = UsersTable
+	MessagesTable.ForeignKeys[1].RefTable = MessagesTable
+	MessagesTable.ForeignKeys[2].RefTable = MessagesTable
+	MessagesTable.ForeignKeys[3].RefTable = UsersTable
+	MessageReactionsTable.ForeignKeys[0].RefTable = MessagesTable
+	MessageReactionsTable.ForeignKeys[1].RefTable = UsersTable
+	MessageReadsTable.ForeignKeys[0].RefTable = ChatRoomsTable
+	MessageReadsTable.ForeignKeys[1].RefTable = MessagesTable
+	MessageReadsTable.ForeignKeys[2].RefTable = UsersTable
+	MessageRevisionsTable.ForeignKeys[0].RefTable = MessagesTable
+	MessageRevisionsTable.ForeignKeys[1].RefTable = UsersTable
+	RevokedTokensTable.ForeignKeys[0].RefTable = UsersTable
 	RoomMembersTable.ForeignKeys[0].RefTable = ChatRoomsTable
 	RoomMembersTable.ForeignKeys[1].RefTable = UsersTable
+	RotatedRefreshTokensTable.ForeignKeys[0].RefTable = SessionsTable
+	SecurityEventsTable.ForeignKeys[0].RefTable = UsersTable
+	SessionsTable.ForeignKeys[0].RefTable = UsersTable
+	StreamTicketsTable.ForeignKeys[0].RefTable = UsersTable
 }
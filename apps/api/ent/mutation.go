@@ -4,6 +4,7 @@ package ent
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"sync"
@@ -13,9 +14,19 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"github.com/google/uuid"
 	"github.com/hideaki1979/cc-chat-app/apps/api/ent/chatroom"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/invite"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/joinrequest"
 	"github.com/hideaki1979/cc-chat-app/apps/api/ent/message"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/messagereaction"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/messageread"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/messagerevision"
 	"github.com/hideaki1979/cc-chat-app/apps/api/ent/predicate"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/revokedtoken"
 	"github.com/hideaki1979/cc-chat-app/apps/api/ent/roommember"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/rotatedrefreshtoken"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/securityevent"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/session"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/streamticket"
 	"github.com/hideaki1979/cc-chat-app/apps/api/ent/user"
 )
 
@@ -28,32 +39,62 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeChatRoom   = "ChatRoom"
-	TypeMessage    = "Message"
-	TypeRoomMember = "RoomMember"
-	TypeUser       = "User"
+	TypeChatRoom            = "ChatRoom"
+	TypeInvite              = "Invite"
+	TypeJoinRequest         = "JoinRequest"
+	TypeMessage             = "Message"
+	TypeMessageReaction     = "MessageReaction"
+	TypeMessageRead         = "MessageRead"
+	TypeMessageRevision     = "MessageRevision"
+	TypeRevokedToken        = "RevokedToken"
+	TypeRoomMember          = "RoomMember"
+	TypeRotatedRefreshToken = "RotatedRefreshToken"
+	TypeSecurityEvent       = "SecurityEvent"
+	TypeSession             = "Session"
+	TypeStreamTicket        = "StreamTicket"
+	TypeUser                = "User"
 )
 
 // ChatRoomMutation represents an operation that mutates the ChatRoom nodes in the graph.
 type ChatRoomMutation struct {
 	config
-	op                  Op
-	typ                 string
-	id                  *uuid.UUID
-	name                *string
-	is_group_chat       *bool
-	created_at          *time.Time
-	updated_at          *time.Time
-	clearedFields       map[string]struct{}
-	room_members        map[int64]struct{}
-	removedroom_members map[int64]struct{}
-	clearedroom_members bool
-	messages            map[uuid.UUID]struct{}
-	removedmessages     map[uuid.UUID]struct{}
-	clearedmessages     bool
-	done                bool
-	oldValue            func(context.Context) (*ChatRoom, error)
-	predicates          []predicate.ChatRoom
+	op                     Op
+	typ                    string
+	id                     *uuid.UUID
+	name                   *string
+	description            *string
+	topic                  *string
+	avatar_url             *string
+	is_group_chat          *bool
+	dm_key                 *string
+	visibility             *chatroom.Visibility
+	edit_window_seconds    *int
+	addedit_window_seconds *int
+	archived_at            *time.Time
+	last_message_at        *time.Time
+	created_at             *time.Time
+	updated_at             *time.Time
+	clearedFields          map[string]struct{}
+	room_members           map[int64]struct{}
+	removedroom_members    map[int64]struct{}
+	clearedroom_members    bool
+	messages               map[uuid.UUID]struct{}
+	removedmessages        map[uuid.UUID]struct{}
+	clearedmessages        bool
+	last_message           *uuid.UUID
+	clearedlast_message    bool
+	message_reads          map[int64]struct{}
+	removedmessage_reads   map[int64]struct{}
+	clearedmessage_reads   bool
+	invites                map[uuid.UUID]struct{}
+	removedinvites         map[uuid.UUID]struct{}
+	clearedinvites         bool
+	join_requests          map[int64]struct{}
+	removedjoin_requests   map[int64]struct{}
+	clearedjoin_requests   bool
+	done                   bool
+	oldValue               func(context.Context) (*ChatRoom, error)
+	predicates             []predicate.ChatRoom
 }
 
 var _ ent.Mutation = (*ChatRoomMutation)(nil)
@@ -196,6 +237,153 @@ func (m *ChatRoomMutation) ResetName() {
 	m.name = nil
 }
 
+// SetDescription sets the "description" field.
+func (m *ChatRoomMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *ChatRoomMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the ChatRoom entity.
+// If the ChatRoom object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChatRoomMutation) OldDescription(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *ChatRoomMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[chatroom.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *ChatRoomMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[chatroom.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *ChatRoomMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, chatroom.FieldDescription)
+}
+
+// SetTopic sets the "topic" field.
+func (m *ChatRoomMutation) SetTopic(s string) {
+	m.topic = &s
+}
+
+// Topic returns the value of the "topic" field in the mutation.
+func (m *ChatRoomMutation) Topic() (r string, exists bool) {
+	v := m.topic
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTopic returns the old "topic" field's value of the ChatRoom entity.
+// If the ChatRoom object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChatRoomMutation) OldTopic(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTopic is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTopic requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTopic: %w", err)
+	}
+	return oldValue.Topic, nil
+}
+
+// ClearTopic clears the value of the "topic" field.
+func (m *ChatRoomMutation) ClearTopic() {
+	m.topic = nil
+	m.clearedFields[chatroom.FieldTopic] = struct{}{}
+}
+
+// TopicCleared returns if the "topic" field was cleared in this mutation.
+func (m *ChatRoomMutation) TopicCleared() bool {
+	_, ok := m.clearedFields[chatroom.FieldTopic]
+	return ok
+}
+
+// ResetTopic resets all changes to the "topic" field.
+func (m *ChatRoomMutation) ResetTopic() {
+	m.topic = nil
+	delete(m.clearedFields, chatroom.FieldTopic)
+}
+
+// SetAvatarURL sets the "avatar_url" field.
+func (m *ChatRoomMutation) SetAvatarURL(s string) {
+	m.avatar_url = &s
+}
+
+// AvatarURL returns the value of the "avatar_url" field in the mutation.
+func (m *ChatRoomMutation) AvatarURL() (r string, exists bool) {
+	v := m.avatar_url
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAvatarURL returns the old "avatar_url" field's value of the ChatRoom entity.
+// If the ChatRoom object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChatRoomMutation) OldAvatarURL(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAvatarURL is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAvatarURL requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAvatarURL: %w", err)
+	}
+	return oldValue.AvatarURL, nil
+}
+
+// ClearAvatarURL clears the value of the "avatar_url" field.
+func (m *ChatRoomMutation) ClearAvatarURL() {
+	m.avatar_url = nil
+	m.clearedFields[chatroom.FieldAvatarURL] = struct{}{}
+}
+
+// AvatarURLCleared returns if the "avatar_url" field was cleared in this mutation.
+func (m *ChatRoomMutation) AvatarURLCleared() bool {
+	_, ok := m.clearedFields[chatroom.FieldAvatarURL]
+	return ok
+}
+
+// ResetAvatarURL resets all changes to the "avatar_url" field.
+func (m *ChatRoomMutation) ResetAvatarURL() {
+	m.avatar_url = nil
+	delete(m.clearedFields, chatroom.FieldAvatarURL)
+}
+
 // SetIsGroupChat sets the "is_group_chat" field.
 func (m *ChatRoomMutation) SetIsGroupChat(b bool) {
 	m.is_group_chat = &b
@@ -232,6 +420,308 @@ func (m *ChatRoomMutation) ResetIsGroupChat() {
 	m.is_group_chat = nil
 }
 
+// SetDmKey sets the "dm_key" field.
+func (m *ChatRoomMutation) SetDmKey(s string) {
+	m.dm_key = &s
+}
+
+// DmKey returns the value of the "dm_key" field in the mutation.
+func (m *ChatRoomMutation) DmKey() (r string, exists bool) {
+	v := m.dm_key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDmKey returns the old "dm_key" field's value of the ChatRoom entity.
+// If the ChatRoom object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChatRoomMutation) OldDmKey(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDmKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDmKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDmKey: %w", err)
+	}
+	return oldValue.DmKey, nil
+}
+
+// ClearDmKey clears the value of the "dm_key" field.
+func (m *ChatRoomMutation) ClearDmKey() {
+	m.dm_key = nil
+	m.clearedFields[chatroom.FieldDmKey] = struct{}{}
+}
+
+// DmKeyCleared returns if the "dm_key" field was cleared in this mutation.
+func (m *ChatRoomMutation) DmKeyCleared() bool {
+	_, ok := m.clearedFields[chatroom.FieldDmKey]
+	return ok
+}
+
+// ResetDmKey resets all changes to the "dm_key" field.
+func (m *ChatRoomMutation) ResetDmKey() {
+	m.dm_key = nil
+	delete(m.clearedFields, chatroom.FieldDmKey)
+}
+
+// SetVisibility sets the "visibility" field.
+func (m *ChatRoomMutation) SetVisibility(c chatroom.Visibility) {
+	m.visibility = &c
+}
+
+// Visibility returns the value of the "visibility" field in the mutation.
+func (m *ChatRoomMutation) Visibility() (r chatroom.Visibility, exists bool) {
+	v := m.visibility
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVisibility returns the old "visibility" field's value of the ChatRoom entity.
+// If the ChatRoom object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChatRoomMutation) OldVisibility(ctx context.Context) (v chatroom.Visibility, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVisibility is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVisibility requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVisibility: %w", err)
+	}
+	return oldValue.Visibility, nil
+}
+
+// ResetVisibility resets all changes to the "visibility" field.
+func (m *ChatRoomMutation) ResetVisibility() {
+	m.visibility = nil
+}
+
+// SetEditWindowSeconds sets the "edit_window_seconds" field.
+func (m *ChatRoomMutation) SetEditWindowSeconds(i int) {
+	m.edit_window_seconds = &i
+	m.addedit_window_seconds = nil
+}
+
+// EditWindowSeconds returns the value of the "edit_window_seconds" field in the mutation.
+func (m *ChatRoomMutation) EditWindowSeconds() (r int, exists bool) {
+	v := m.edit_window_seconds
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEditWindowSeconds returns the old "edit_window_seconds" field's value of the ChatRoom entity.
+// If the ChatRoom object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChatRoomMutation) OldEditWindowSeconds(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEditWindowSeconds is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEditWindowSeconds requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEditWindowSeconds: %w", err)
+	}
+	return oldValue.EditWindowSeconds, nil
+}
+
+// AddEditWindowSeconds adds i to the "edit_window_seconds" field.
+func (m *ChatRoomMutation) AddEditWindowSeconds(i int) {
+	if m.addedit_window_seconds != nil {
+		*m.addedit_window_seconds += i
+	} else {
+		m.addedit_window_seconds = &i
+	}
+}
+
+// AddedEditWindowSeconds returns the value that was added to the "edit_window_seconds" field in this mutation.
+func (m *ChatRoomMutation) AddedEditWindowSeconds() (r int, exists bool) {
+	v := m.addedit_window_seconds
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearEditWindowSeconds clears the value of the "edit_window_seconds" field.
+func (m *ChatRoomMutation) ClearEditWindowSeconds() {
+	m.edit_window_seconds = nil
+	m.addedit_window_seconds = nil
+	m.clearedFields[chatroom.FieldEditWindowSeconds] = struct{}{}
+}
+
+// EditWindowSecondsCleared returns if the "edit_window_seconds" field was cleared in this mutation.
+func (m *ChatRoomMutation) EditWindowSecondsCleared() bool {
+	_, ok := m.clearedFields[chatroom.FieldEditWindowSeconds]
+	return ok
+}
+
+// ResetEditWindowSeconds resets all changes to the "edit_window_seconds" field.
+func (m *ChatRoomMutation) ResetEditWindowSeconds() {
+	m.edit_window_seconds = nil
+	m.addedit_window_seconds = nil
+	delete(m.clearedFields, chatroom.FieldEditWindowSeconds)
+}
+
+// SetArchivedAt sets the "archived_at" field.
+func (m *ChatRoomMutation) SetArchivedAt(t time.Time) {
+	m.archived_at = &t
+}
+
+// ArchivedAt returns the value of the "archived_at" field in the mutation.
+func (m *ChatRoomMutation) ArchivedAt() (r time.Time, exists bool) {
+	v := m.archived_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldArchivedAt returns the old "archived_at" field's value of the ChatRoom entity.
+// If the ChatRoom object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChatRoomMutation) OldArchivedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldArchivedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldArchivedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldArchivedAt: %w", err)
+	}
+	return oldValue.ArchivedAt, nil
+}
+
+// ClearArchivedAt clears the value of the "archived_at" field.
+func (m *ChatRoomMutation) ClearArchivedAt() {
+	m.archived_at = nil
+	m.clearedFields[chatroom.FieldArchivedAt] = struct{}{}
+}
+
+// ArchivedAtCleared returns if the "archived_at" field was cleared in this mutation.
+func (m *ChatRoomMutation) ArchivedAtCleared() bool {
+	_, ok := m.clearedFields[chatroom.FieldArchivedAt]
+	return ok
+}
+
+// ResetArchivedAt resets all changes to the "archived_at" field.
+func (m *ChatRoomMutation) ResetArchivedAt() {
+	m.archived_at = nil
+	delete(m.clearedFields, chatroom.FieldArchivedAt)
+}
+
+// SetLastMessageAt sets the "last_message_at" field.
+func (m *ChatRoomMutation) SetLastMessageAt(t time.Time) {
+	m.last_message_at = &t
+}
+
+// LastMessageAt returns the value of the "last_message_at" field in the mutation.
+func (m *ChatRoomMutation) LastMessageAt() (r time.Time, exists bool) {
+	v := m.last_message_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastMessageAt returns the old "last_message_at" field's value of the ChatRoom entity.
+// If the ChatRoom object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChatRoomMutation) OldLastMessageAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastMessageAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastMessageAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastMessageAt: %w", err)
+	}
+	return oldValue.LastMessageAt, nil
+}
+
+// ClearLastMessageAt clears the value of the "last_message_at" field.
+func (m *ChatRoomMutation) ClearLastMessageAt() {
+	m.last_message_at = nil
+	m.clearedFields[chatroom.FieldLastMessageAt] = struct{}{}
+}
+
+// LastMessageAtCleared returns if the "last_message_at" field was cleared in this mutation.
+func (m *ChatRoomMutation) LastMessageAtCleared() bool {
+	_, ok := m.clearedFields[chatroom.FieldLastMessageAt]
+	return ok
+}
+
+// ResetLastMessageAt resets all changes to the "last_message_at" field.
+func (m *ChatRoomMutation) ResetLastMessageAt() {
+	m.last_message_at = nil
+	delete(m.clearedFields, chatroom.FieldLastMessageAt)
+}
+
+// SetLastMessageID sets the "last_message_id" field.
+func (m *ChatRoomMutation) SetLastMessageID(u uuid.UUID) {
+	m.last_message = &u
+}
+
+// LastMessageID returns the value of the "last_message_id" field in the mutation.
+func (m *ChatRoomMutation) LastMessageID() (r uuid.UUID, exists bool) {
+	v := m.last_message
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastMessageID returns the old "last_message_id" field's value of the ChatRoom entity.
+// If the ChatRoom object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChatRoomMutation) OldLastMessageID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastMessageID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastMessageID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastMessageID: %w", err)
+	}
+	return oldValue.LastMessageID, nil
+}
+
+// ClearLastMessageID clears the value of the "last_message_id" field.
+func (m *ChatRoomMutation) ClearLastMessageID() {
+	m.last_message = nil
+	m.clearedFields[chatroom.FieldLastMessageID] = struct{}{}
+}
+
+// LastMessageIDCleared returns if the "last_message_id" field was cleared in this mutation.
+func (m *ChatRoomMutation) LastMessageIDCleared() bool {
+	_, ok := m.clearedFields[chatroom.FieldLastMessageID]
+	return ok
+}
+
+// ResetLastMessageID resets all changes to the "last_message_id" field.
+func (m *ChatRoomMutation) ResetLastMessageID() {
+	m.last_message = nil
+	delete(m.clearedFields, chatroom.FieldLastMessageID)
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (m *ChatRoomMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
@@ -412,24 +902,213 @@ func (m *ChatRoomMutation) ResetMessages() {
 	m.removedmessages = nil
 }
 
-// Where appends a list predicates to the ChatRoomMutation builder.
-func (m *ChatRoomMutation) Where(ps ...predicate.ChatRoom) {
-	m.predicates = append(m.predicates, ps...)
+// ClearLastMessage clears the "last_message" edge to the Message entity.
+func (m *ChatRoomMutation) ClearLastMessage() {
+	m.clearedlast_message = true
+	m.clearedFields[chatroom.FieldLastMessageID] = struct{}{}
 }
 
-// WhereP appends storage-level predicates to the ChatRoomMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ChatRoomMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.ChatRoom, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// LastMessageCleared reports if the "last_message" edge to the Message entity was cleared.
+func (m *ChatRoomMutation) LastMessageCleared() bool {
+	return m.LastMessageIDCleared() || m.clearedlast_message
+}
+
+// LastMessageIDs returns the "last_message" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// LastMessageID instead. It exists only for internal usage by the builders.
+func (m *ChatRoomMutation) LastMessageIDs() (ids []uuid.UUID) {
+	if id := m.last_message; id != nil {
+		ids = append(ids, *id)
 	}
-	m.Where(p...)
+	return
 }
 
-// Op returns the operation name.
-func (m *ChatRoomMutation) Op() Op {
-	return m.op
+// ResetLastMessage resets all changes to the "last_message" edge.
+func (m *ChatRoomMutation) ResetLastMessage() {
+	m.last_message = nil
+	m.clearedlast_message = false
+}
+
+// AddMessageReadIDs adds the "message_reads" edge to the MessageRead entity by ids.
+func (m *ChatRoomMutation) AddMessageReadIDs(ids ...int64) {
+	if m.message_reads == nil {
+		m.message_reads = make(map[int64]struct{})
+	}
+	for i := range ids {
+		m.message_reads[ids[i]] = struct{}{}
+	}
+}
+
+// ClearMessageReads clears the "message_reads" edge to the MessageRead entity.
+func (m *ChatRoomMutation) ClearMessageReads() {
+	m.clearedmessage_reads = true
+}
+
+// MessageReadsCleared reports if the "message_reads" edge to the MessageRead entity was cleared.
+func (m *ChatRoomMutation) MessageReadsCleared() bool {
+	return m.clearedmessage_reads
+}
+
+// RemoveMessageReadIDs removes the "message_reads" edge to the MessageRead entity by IDs.
+func (m *ChatRoomMutation) RemoveMessageReadIDs(ids ...int64) {
+	if m.removedmessage_reads == nil {
+		m.removedmessage_reads = make(map[int64]struct{})
+	}
+	for i := range ids {
+		delete(m.message_reads, ids[i])
+		m.removedmessage_reads[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedMessageReads returns the removed IDs of the "message_reads" edge to the MessageRead entity.
+func (m *ChatRoomMutation) RemovedMessageReadsIDs() (ids []int64) {
+	for id := range m.removedmessage_reads {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// MessageReadsIDs returns the "message_reads" edge IDs in the mutation.
+func (m *ChatRoomMutation) MessageReadsIDs() (ids []int64) {
+	for id := range m.message_reads {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetMessageReads resets all changes to the "message_reads" edge.
+func (m *ChatRoomMutation) ResetMessageReads() {
+	m.message_reads = nil
+	m.clearedmessage_reads = false
+	m.removedmessage_reads = nil
+}
+
+// AddInviteIDs adds the "invites" edge to the Invite entity by ids.
+func (m *ChatRoomMutation) AddInviteIDs(ids ...uuid.UUID) {
+	if m.invites == nil {
+		m.invites = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.invites[ids[i]] = struct{}{}
+	}
+}
+
+// ClearInvites clears the "invites" edge to the Invite entity.
+func (m *ChatRoomMutation) ClearInvites() {
+	m.clearedinvites = true
+}
+
+// InvitesCleared reports if the "invites" edge to the Invite entity was cleared.
+func (m *ChatRoomMutation) InvitesCleared() bool {
+	return m.clearedinvites
+}
+
+// RemoveInviteIDs removes the "invites" edge to the Invite entity by IDs.
+func (m *ChatRoomMutation) RemoveInviteIDs(ids ...uuid.UUID) {
+	if m.removedinvites == nil {
+		m.removedinvites = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.invites, ids[i])
+		m.removedinvites[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedInvites returns the removed IDs of the "invites" edge to the Invite entity.
+func (m *ChatRoomMutation) RemovedInvitesIDs() (ids []uuid.UUID) {
+	for id := range m.removedinvites {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// InvitesIDs returns the "invites" edge IDs in the mutation.
+func (m *ChatRoomMutation) InvitesIDs() (ids []uuid.UUID) {
+	for id := range m.invites {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetInvites resets all changes to the "invites" edge.
+func (m *ChatRoomMutation) ResetInvites() {
+	m.invites = nil
+	m.clearedinvites = false
+	m.removedinvites = nil
+}
+
+// AddJoinRequestIDs adds the "join_requests" edge to the JoinRequest entity by ids.
+func (m *ChatRoomMutation) AddJoinRequestIDs(ids ...int64) {
+	if m.join_requests == nil {
+		m.join_requests = make(map[int64]struct{})
+	}
+	for i := range ids {
+		m.join_requests[ids[i]] = struct{}{}
+	}
+}
+
+// ClearJoinRequests clears the "join_requests" edge to the JoinRequest entity.
+func (m *ChatRoomMutation) ClearJoinRequests() {
+	m.clearedjoin_requests = true
+}
+
+// JoinRequestsCleared reports if the "join_requests" edge to the JoinRequest entity was cleared.
+func (m *ChatRoomMutation) JoinRequestsCleared() bool {
+	return m.clearedjoin_requests
+}
+
+// RemoveJoinRequestIDs removes the "join_requests" edge to the JoinRequest entity by IDs.
+func (m *ChatRoomMutation) RemoveJoinRequestIDs(ids ...int64) {
+	if m.removedjoin_requests == nil {
+		m.removedjoin_requests = make(map[int64]struct{})
+	}
+	for i := range ids {
+		delete(m.join_requests, ids[i])
+		m.removedjoin_requests[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedJoinRequests returns the removed IDs of the "join_requests" edge to the JoinRequest entity.
+func (m *ChatRoomMutation) RemovedJoinRequestsIDs() (ids []int64) {
+	for id := range m.removedjoin_requests {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// JoinRequestsIDs returns the "join_requests" edge IDs in the mutation.
+func (m *ChatRoomMutation) JoinRequestsIDs() (ids []int64) {
+	for id := range m.join_requests {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetJoinRequests resets all changes to the "join_requests" edge.
+func (m *ChatRoomMutation) ResetJoinRequests() {
+	m.join_requests = nil
+	m.clearedjoin_requests = false
+	m.removedjoin_requests = nil
+}
+
+// Where appends a list predicates to the ChatRoomMutation builder.
+func (m *ChatRoomMutation) Where(ps ...predicate.ChatRoom) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ChatRoomMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ChatRoomMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ChatRoom, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ChatRoomMutation) Op() Op {
+	return m.op
 }
 
 // SetOp allows setting the mutation operation.
@@ -446,13 +1125,40 @@ func (m *ChatRoomMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *ChatRoomMutation) Fields() []string {
-	fields := make([]string, 0, 4)
+	fields := make([]string, 0, 13)
 	if m.name != nil {
 		fields = append(fields, chatroom.FieldName)
 	}
+	if m.description != nil {
+		fields = append(fields, chatroom.FieldDescription)
+	}
+	if m.topic != nil {
+		fields = append(fields, chatroom.FieldTopic)
+	}
+	if m.avatar_url != nil {
+		fields = append(fields, chatroom.FieldAvatarURL)
+	}
 	if m.is_group_chat != nil {
 		fields = append(fields, chatroom.FieldIsGroupChat)
 	}
+	if m.dm_key != nil {
+		fields = append(fields, chatroom.FieldDmKey)
+	}
+	if m.visibility != nil {
+		fields = append(fields, chatroom.FieldVisibility)
+	}
+	if m.edit_window_seconds != nil {
+		fields = append(fields, chatroom.FieldEditWindowSeconds)
+	}
+	if m.archived_at != nil {
+		fields = append(fields, chatroom.FieldArchivedAt)
+	}
+	if m.last_message_at != nil {
+		fields = append(fields, chatroom.FieldLastMessageAt)
+	}
+	if m.last_message != nil {
+		fields = append(fields, chatroom.FieldLastMessageID)
+	}
 	if m.created_at != nil {
 		fields = append(fields, chatroom.FieldCreatedAt)
 	}
@@ -469,8 +1175,26 @@ func (m *ChatRoomMutation) Field(name string) (ent.Value, bool) {
 	switch name {
 	case chatroom.FieldName:
 		return m.Name()
+	case chatroom.FieldDescription:
+		return m.Description()
+	case chatroom.FieldTopic:
+		return m.Topic()
+	case chatroom.FieldAvatarURL:
+		return m.AvatarURL()
 	case chatroom.FieldIsGroupChat:
 		return m.IsGroupChat()
+	case chatroom.FieldDmKey:
+		return m.DmKey()
+	case chatroom.FieldVisibility:
+		return m.Visibility()
+	case chatroom.FieldEditWindowSeconds:
+		return m.EditWindowSeconds()
+	case chatroom.FieldArchivedAt:
+		return m.ArchivedAt()
+	case chatroom.FieldLastMessageAt:
+		return m.LastMessageAt()
+	case chatroom.FieldLastMessageID:
+		return m.LastMessageID()
 	case chatroom.FieldCreatedAt:
 		return m.CreatedAt()
 	case chatroom.FieldUpdatedAt:
@@ -486,8 +1210,26 @@ func (m *ChatRoomMutation) OldField(ctx context.Context, name string) (ent.Value
 	switch name {
 	case chatroom.FieldName:
 		return m.OldName(ctx)
+	case chatroom.FieldDescription:
+		return m.OldDescription(ctx)
+	case chatroom.FieldTopic:
+		return m.OldTopic(ctx)
+	case chatroom.FieldAvatarURL:
+		return m.OldAvatarURL(ctx)
 	case chatroom.FieldIsGroupChat:
 		return m.OldIsGroupChat(ctx)
+	case chatroom.FieldDmKey:
+		return m.OldDmKey(ctx)
+	case chatroom.FieldVisibility:
+		return m.OldVisibility(ctx)
+	case chatroom.FieldEditWindowSeconds:
+		return m.OldEditWindowSeconds(ctx)
+	case chatroom.FieldArchivedAt:
+		return m.OldArchivedAt(ctx)
+	case chatroom.FieldLastMessageAt:
+		return m.OldLastMessageAt(ctx)
+	case chatroom.FieldLastMessageID:
+		return m.OldLastMessageID(ctx)
 	case chatroom.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
 	case chatroom.FieldUpdatedAt:
@@ -508,6 +1250,27 @@ func (m *ChatRoomMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetName(v)
 		return nil
+	case chatroom.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case chatroom.FieldTopic:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTopic(v)
+		return nil
+	case chatroom.FieldAvatarURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAvatarURL(v)
+		return nil
 	case chatroom.FieldIsGroupChat:
 		v, ok := value.(bool)
 		if !ok {
@@ -515,6 +1278,48 @@ func (m *ChatRoomMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetIsGroupChat(v)
 		return nil
+	case chatroom.FieldDmKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDmKey(v)
+		return nil
+	case chatroom.FieldVisibility:
+		v, ok := value.(chatroom.Visibility)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVisibility(v)
+		return nil
+	case chatroom.FieldEditWindowSeconds:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEditWindowSeconds(v)
+		return nil
+	case chatroom.FieldArchivedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetArchivedAt(v)
+		return nil
+	case chatroom.FieldLastMessageAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastMessageAt(v)
+		return nil
+	case chatroom.FieldLastMessageID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastMessageID(v)
+		return nil
 	case chatroom.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
@@ -536,13 +1341,21 @@ func (m *ChatRoomMutation) SetField(name string, value ent.Value) error {
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
 func (m *ChatRoomMutation) AddedFields() []string {
-	return nil
+	var fields []string
+	if m.addedit_window_seconds != nil {
+		fields = append(fields, chatroom.FieldEditWindowSeconds)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
 func (m *ChatRoomMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case chatroom.FieldEditWindowSeconds:
+		return m.AddedEditWindowSeconds()
+	}
 	return nil, false
 }
 
@@ -551,6 +1364,13 @@ func (m *ChatRoomMutation) AddedField(name string) (ent.Value, bool) {
 // type.
 func (m *ChatRoomMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case chatroom.FieldEditWindowSeconds:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddEditWindowSeconds(v)
+		return nil
 	}
 	return fmt.Errorf("unknown ChatRoom numeric field %s", name)
 }
@@ -558,7 +1378,32 @@ func (m *ChatRoomMutation) AddField(name string, value ent.Value) error {
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
 func (m *ChatRoomMutation) ClearedFields() []string {
-	return nil
+	var fields []string
+	if m.FieldCleared(chatroom.FieldDescription) {
+		fields = append(fields, chatroom.FieldDescription)
+	}
+	if m.FieldCleared(chatroom.FieldTopic) {
+		fields = append(fields, chatroom.FieldTopic)
+	}
+	if m.FieldCleared(chatroom.FieldAvatarURL) {
+		fields = append(fields, chatroom.FieldAvatarURL)
+	}
+	if m.FieldCleared(chatroom.FieldDmKey) {
+		fields = append(fields, chatroom.FieldDmKey)
+	}
+	if m.FieldCleared(chatroom.FieldEditWindowSeconds) {
+		fields = append(fields, chatroom.FieldEditWindowSeconds)
+	}
+	if m.FieldCleared(chatroom.FieldArchivedAt) {
+		fields = append(fields, chatroom.FieldArchivedAt)
+	}
+	if m.FieldCleared(chatroom.FieldLastMessageAt) {
+		fields = append(fields, chatroom.FieldLastMessageAt)
+	}
+	if m.FieldCleared(chatroom.FieldLastMessageID) {
+		fields = append(fields, chatroom.FieldLastMessageID)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
@@ -571,6 +1416,32 @@ func (m *ChatRoomMutation) FieldCleared(name string) bool {
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
 func (m *ChatRoomMutation) ClearField(name string) error {
+	switch name {
+	case chatroom.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case chatroom.FieldTopic:
+		m.ClearTopic()
+		return nil
+	case chatroom.FieldAvatarURL:
+		m.ClearAvatarURL()
+		return nil
+	case chatroom.FieldDmKey:
+		m.ClearDmKey()
+		return nil
+	case chatroom.FieldEditWindowSeconds:
+		m.ClearEditWindowSeconds()
+		return nil
+	case chatroom.FieldArchivedAt:
+		m.ClearArchivedAt()
+		return nil
+	case chatroom.FieldLastMessageAt:
+		m.ClearLastMessageAt()
+		return nil
+	case chatroom.FieldLastMessageID:
+		m.ClearLastMessageID()
+		return nil
+	}
 	return fmt.Errorf("unknown ChatRoom nullable field %s", name)
 }
 
@@ -581,9 +1452,36 @@ func (m *ChatRoomMutation) ResetField(name string) error {
 	case chatroom.FieldName:
 		m.ResetName()
 		return nil
+	case chatroom.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case chatroom.FieldTopic:
+		m.ResetTopic()
+		return nil
+	case chatroom.FieldAvatarURL:
+		m.ResetAvatarURL()
+		return nil
 	case chatroom.FieldIsGroupChat:
 		m.ResetIsGroupChat()
 		return nil
+	case chatroom.FieldDmKey:
+		m.ResetDmKey()
+		return nil
+	case chatroom.FieldVisibility:
+		m.ResetVisibility()
+		return nil
+	case chatroom.FieldEditWindowSeconds:
+		m.ResetEditWindowSeconds()
+		return nil
+	case chatroom.FieldArchivedAt:
+		m.ResetArchivedAt()
+		return nil
+	case chatroom.FieldLastMessageAt:
+		m.ResetLastMessageAt()
+		return nil
+	case chatroom.FieldLastMessageID:
+		m.ResetLastMessageID()
+		return nil
 	case chatroom.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
@@ -596,13 +1494,25 @@ func (m *ChatRoomMutation) ResetField(name string) error {
 
 // AddedEdges returns all edge names that were set/added in this mutation.
 func (m *ChatRoomMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
+	edges := make([]string, 0, 6)
 	if m.room_members != nil {
 		edges = append(edges, chatroom.EdgeRoomMembers)
 	}
 	if m.messages != nil {
 		edges = append(edges, chatroom.EdgeMessages)
 	}
+	if m.last_message != nil {
+		edges = append(edges, chatroom.EdgeLastMessage)
+	}
+	if m.message_reads != nil {
+		edges = append(edges, chatroom.EdgeMessageReads)
+	}
+	if m.invites != nil {
+		edges = append(edges, chatroom.EdgeInvites)
+	}
+	if m.join_requests != nil {
+		edges = append(edges, chatroom.EdgeJoinRequests)
+	}
 	return edges
 }
 
@@ -622,19 +1532,50 @@ func (m *ChatRoomMutation) AddedIDs(name string) []ent.Value {
 			ids = append(ids, id)
 		}
 		return ids
+	case chatroom.EdgeLastMessage:
+		if id := m.last_message; id != nil {
+			return []ent.Value{*id}
+		}
+	case chatroom.EdgeMessageReads:
+		ids := make([]ent.Value, 0, len(m.message_reads))
+		for id := range m.message_reads {
+			ids = append(ids, id)
+		}
+		return ids
+	case chatroom.EdgeInvites:
+		ids := make([]ent.Value, 0, len(m.invites))
+		for id := range m.invites {
+			ids = append(ids, id)
+		}
+		return ids
+	case chatroom.EdgeJoinRequests:
+		ids := make([]ent.Value, 0, len(m.join_requests))
+		for id := range m.join_requests {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
 func (m *ChatRoomMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
+	edges := make([]string, 0, 6)
 	if m.removedroom_members != nil {
 		edges = append(edges, chatroom.EdgeRoomMembers)
 	}
 	if m.removedmessages != nil {
 		edges = append(edges, chatroom.EdgeMessages)
 	}
+	if m.removedmessage_reads != nil {
+		edges = append(edges, chatroom.EdgeMessageReads)
+	}
+	if m.removedinvites != nil {
+		edges = append(edges, chatroom.EdgeInvites)
+	}
+	if m.removedjoin_requests != nil {
+		edges = append(edges, chatroom.EdgeJoinRequests)
+	}
 	return edges
 }
 
@@ -654,19 +1595,49 @@ func (m *ChatRoomMutation) RemovedIDs(name string) []ent.Value {
 			ids = append(ids, id)
 		}
 		return ids
+	case chatroom.EdgeMessageReads:
+		ids := make([]ent.Value, 0, len(m.removedmessage_reads))
+		for id := range m.removedmessage_reads {
+			ids = append(ids, id)
+		}
+		return ids
+	case chatroom.EdgeInvites:
+		ids := make([]ent.Value, 0, len(m.removedinvites))
+		for id := range m.removedinvites {
+			ids = append(ids, id)
+		}
+		return ids
+	case chatroom.EdgeJoinRequests:
+		ids := make([]ent.Value, 0, len(m.removedjoin_requests))
+		for id := range m.removedjoin_requests {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
 func (m *ChatRoomMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
+	edges := make([]string, 0, 6)
 	if m.clearedroom_members {
 		edges = append(edges, chatroom.EdgeRoomMembers)
 	}
 	if m.clearedmessages {
 		edges = append(edges, chatroom.EdgeMessages)
 	}
+	if m.clearedlast_message {
+		edges = append(edges, chatroom.EdgeLastMessage)
+	}
+	if m.clearedmessage_reads {
+		edges = append(edges, chatroom.EdgeMessageReads)
+	}
+	if m.clearedinvites {
+		edges = append(edges, chatroom.EdgeInvites)
+	}
+	if m.clearedjoin_requests {
+		edges = append(edges, chatroom.EdgeJoinRequests)
+	}
 	return edges
 }
 
@@ -678,6 +1649,14 @@ func (m *ChatRoomMutation) EdgeCleared(name string) bool {
 		return m.clearedroom_members
 	case chatroom.EdgeMessages:
 		return m.clearedmessages
+	case chatroom.EdgeLastMessage:
+		return m.clearedlast_message
+	case chatroom.EdgeMessageReads:
+		return m.clearedmessage_reads
+	case chatroom.EdgeInvites:
+		return m.clearedinvites
+	case chatroom.EdgeJoinRequests:
+		return m.clearedjoin_requests
 	}
 	return false
 }
@@ -686,6 +1665,9 @@ func (m *ChatRoomMutation) EdgeCleared(name string) bool {
 // if that edge is not defined in the schema.
 func (m *ChatRoomMutation) ClearEdge(name string) error {
 	switch name {
+	case chatroom.EdgeLastMessage:
+		m.ClearLastMessage()
+		return nil
 	}
 	return fmt.Errorf("unknown ChatRoom unique edge %s", name)
 }
@@ -700,42 +1682,57 @@ func (m *ChatRoomMutation) ResetEdge(name string) error {
 	case chatroom.EdgeMessages:
 		m.ResetMessages()
 		return nil
+	case chatroom.EdgeLastMessage:
+		m.ResetLastMessage()
+		return nil
+	case chatroom.EdgeMessageReads:
+		m.ResetMessageReads()
+		return nil
+	case chatroom.EdgeInvites:
+		m.ResetInvites()
+		return nil
+	case chatroom.EdgeJoinRequests:
+		m.ResetJoinRequests()
+		return nil
 	}
 	return fmt.Errorf("unknown ChatRoom edge %s", name)
 }
 
-// MessageMutation represents an operation that mutates the Message nodes in the graph.
-type MessageMutation struct {
+// InviteMutation represents an operation that mutates the Invite nodes in the graph.
+type InviteMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *uuid.UUID
-	content       *string
-	file_url      *string
-	created_at    *time.Time
-	updated_at    *time.Time
-	deleted_at    *time.Time
-	clearedFields map[string]struct{}
-	room          *uuid.UUID
-	clearedroom   bool
-	sender        *uuid.UUID
-	clearedsender bool
-	done          bool
-	oldValue      func(context.Context) (*Message, error)
-	predicates    []predicate.Message
-}
-
-var _ ent.Mutation = (*MessageMutation)(nil)
-
-// messageOption allows management of the mutation configuration using functional options.
-type messageOption func(*MessageMutation)
-
-// newMessageMutation creates new mutation for the Message entity.
-func newMessageMutation(c config, op Op, opts ...messageOption) *MessageMutation {
-	m := &MessageMutation{
+	op             Op
+	typ            string
+	id             *uuid.UUID
+	token_hash     *[]byte
+	expires_at     *time.Time
+	max_uses       *int
+	addmax_uses    *int
+	use_count      *int
+	adduse_count   *int
+	revoked        *bool
+	created_at     *time.Time
+	clearedFields  map[string]struct{}
+	room           *uuid.UUID
+	clearedroom    bool
+	creator        *uuid.UUID
+	clearedcreator bool
+	done           bool
+	oldValue       func(context.Context) (*Invite, error)
+	predicates     []predicate.Invite
+}
+
+var _ ent.Mutation = (*InviteMutation)(nil)
+
+// inviteOption allows management of the mutation configuration using functional options.
+type inviteOption func(*InviteMutation)
+
+// newInviteMutation creates new mutation for the Invite entity.
+func newInviteMutation(c config, op Op, opts ...inviteOption) *InviteMutation {
+	m := &InviteMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeMessage,
+		typ:           TypeInvite,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -744,20 +1741,20 @@ func newMessageMutation(c config, op Op, opts ...messageOption) *MessageMutation
 	return m
 }
 
-// withMessageID sets the ID field of the mutation.
-func withMessageID(id uuid.UUID) messageOption {
-	return func(m *MessageMutation) {
+// withInviteID sets the ID field of the mutation.
+func withInviteID(id uuid.UUID) inviteOption {
+	return func(m *InviteMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Message
+			value *Invite
 		)
-		m.oldValue = func(ctx context.Context) (*Message, error) {
+		m.oldValue = func(ctx context.Context) (*Invite, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Message.Get(ctx, id)
+					value, err = m.Client().Invite.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -766,10 +1763,10 @@ func withMessageID(id uuid.UUID) messageOption {
 	}
 }
 
-// withMessage sets the old Message of the mutation.
-func withMessage(node *Message) messageOption {
-	return func(m *MessageMutation) {
-		m.oldValue = func(context.Context) (*Message, error) {
+// withInvite sets the old Invite of the mutation.
+func withInvite(node *Invite) inviteOption {
+	return func(m *InviteMutation) {
+		m.oldValue = func(context.Context) (*Invite, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -778,7 +1775,7 @@ func withMessage(node *Message) messageOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m MessageMutation) Client() *Client {
+func (m InviteMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -786,7 +1783,7 @@ func (m MessageMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m MessageMutation) Tx() (*Tx, error) {
+func (m InviteMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -796,14 +1793,14 @@ func (m MessageMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Message entities.
-func (m *MessageMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of Invite entities.
+func (m *InviteMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *MessageMutation) ID() (id uuid.UUID, exists bool) {
+func (m *InviteMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -814,7 +1811,7 @@ func (m *MessageMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *MessageMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *InviteMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -823,19 +1820,19 @@ func (m *MessageMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Message.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Invite.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetRoomID sets the "room_id" field.
-func (m *MessageMutation) SetRoomID(u uuid.UUID) {
+func (m *InviteMutation) SetRoomID(u uuid.UUID) {
 	m.room = &u
 }
 
 // RoomID returns the value of the "room_id" field in the mutation.
-func (m *MessageMutation) RoomID() (r uuid.UUID, exists bool) {
+func (m *InviteMutation) RoomID() (r uuid.UUID, exists bool) {
 	v := m.room
 	if v == nil {
 		return
@@ -843,10 +1840,10 @@ func (m *MessageMutation) RoomID() (r uuid.UUID, exists bool) {
 	return *v, true
 }
 
-// OldRoomID returns the old "room_id" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// OldRoomID returns the old "room_id" field's value of the Invite entity.
+// If the Invite object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldRoomID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *InviteMutation) OldRoomID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldRoomID is only allowed on UpdateOne operations")
 	}
@@ -861,267 +1858,331 @@ func (m *MessageMutation) OldRoomID(ctx context.Context) (v uuid.UUID, err error
 }
 
 // ResetRoomID resets all changes to the "room_id" field.
-func (m *MessageMutation) ResetRoomID() {
+func (m *InviteMutation) ResetRoomID() {
 	m.room = nil
 }
 
-// SetUserID sets the "user_id" field.
-func (m *MessageMutation) SetUserID(u uuid.UUID) {
-	m.sender = &u
+// SetCreatorID sets the "creator_id" field.
+func (m *InviteMutation) SetCreatorID(u uuid.UUID) {
+	m.creator = &u
 }
 
-// UserID returns the value of the "user_id" field in the mutation.
-func (m *MessageMutation) UserID() (r uuid.UUID, exists bool) {
-	v := m.sender
+// CreatorID returns the value of the "creator_id" field in the mutation.
+func (m *InviteMutation) CreatorID() (r uuid.UUID, exists bool) {
+	v := m.creator
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUserID returns the old "user_id" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatorID returns the old "creator_id" field's value of the Invite entity.
+// If the Invite object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *InviteMutation) OldCreatorID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatorID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUserID requires an ID field in the mutation")
+		return v, errors.New("OldCreatorID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatorID: %w", err)
 	}
-	return oldValue.UserID, nil
+	return oldValue.CreatorID, nil
 }
 
-// ResetUserID resets all changes to the "user_id" field.
-func (m *MessageMutation) ResetUserID() {
-	m.sender = nil
+// ResetCreatorID resets all changes to the "creator_id" field.
+func (m *InviteMutation) ResetCreatorID() {
+	m.creator = nil
 }
 
-// SetContent sets the "content" field.
-func (m *MessageMutation) SetContent(s string) {
-	m.content = &s
+// SetTokenHash sets the "token_hash" field.
+func (m *InviteMutation) SetTokenHash(b []byte) {
+	m.token_hash = &b
 }
 
-// Content returns the value of the "content" field in the mutation.
-func (m *MessageMutation) Content() (r string, exists bool) {
-	v := m.content
+// TokenHash returns the value of the "token_hash" field in the mutation.
+func (m *InviteMutation) TokenHash() (r []byte, exists bool) {
+	v := m.token_hash
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldContent returns the old "content" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// OldTokenHash returns the old "token_hash" field's value of the Invite entity.
+// If the Invite object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldContent(ctx context.Context) (v string, err error) {
+func (m *InviteMutation) OldTokenHash(ctx context.Context) (v []byte, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldContent is only allowed on UpdateOne operations")
+		return v, errors.New("OldTokenHash is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldContent requires an ID field in the mutation")
+		return v, errors.New("OldTokenHash requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldContent: %w", err)
+		return v, fmt.Errorf("querying old value for OldTokenHash: %w", err)
 	}
-	return oldValue.Content, nil
+	return oldValue.TokenHash, nil
 }
 
-// ResetContent resets all changes to the "content" field.
-func (m *MessageMutation) ResetContent() {
-	m.content = nil
+// ResetTokenHash resets all changes to the "token_hash" field.
+func (m *InviteMutation) ResetTokenHash() {
+	m.token_hash = nil
 }
 
-// SetFileURL sets the "file_url" field.
-func (m *MessageMutation) SetFileURL(s string) {
-	m.file_url = &s
+// SetExpiresAt sets the "expires_at" field.
+func (m *InviteMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
 }
 
-// FileURL returns the value of the "file_url" field in the mutation.
-func (m *MessageMutation) FileURL() (r string, exists bool) {
-	v := m.file_url
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *InviteMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldFileURL returns the old "file_url" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// OldExpiresAt returns the old "expires_at" field's value of the Invite entity.
+// If the Invite object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldFileURL(ctx context.Context) (v *string, err error) {
+func (m *InviteMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFileURL is only allowed on UpdateOne operations")
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFileURL requires an ID field in the mutation")
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFileURL: %w", err)
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
 	}
-	return oldValue.FileURL, nil
+	return oldValue.ExpiresAt, nil
 }
 
-// ClearFileURL clears the value of the "file_url" field.
-func (m *MessageMutation) ClearFileURL() {
-	m.file_url = nil
-	m.clearedFields[message.FieldFileURL] = struct{}{}
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *InviteMutation) ResetExpiresAt() {
+	m.expires_at = nil
 }
 
-// FileURLCleared returns if the "file_url" field was cleared in this mutation.
-func (m *MessageMutation) FileURLCleared() bool {
-	_, ok := m.clearedFields[message.FieldFileURL]
+// SetMaxUses sets the "max_uses" field.
+func (m *InviteMutation) SetMaxUses(i int) {
+	m.max_uses = &i
+	m.addmax_uses = nil
+}
+
+// MaxUses returns the value of the "max_uses" field in the mutation.
+func (m *InviteMutation) MaxUses() (r int, exists bool) {
+	v := m.max_uses
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMaxUses returns the old "max_uses" field's value of the Invite entity.
+// If the Invite object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InviteMutation) OldMaxUses(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMaxUses is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMaxUses requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMaxUses: %w", err)
+	}
+	return oldValue.MaxUses, nil
+}
+
+// AddMaxUses adds i to the "max_uses" field.
+func (m *InviteMutation) AddMaxUses(i int) {
+	if m.addmax_uses != nil {
+		*m.addmax_uses += i
+	} else {
+		m.addmax_uses = &i
+	}
+}
+
+// AddedMaxUses returns the value that was added to the "max_uses" field in this mutation.
+func (m *InviteMutation) AddedMaxUses() (r int, exists bool) {
+	v := m.addmax_uses
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearMaxUses clears the value of the "max_uses" field.
+func (m *InviteMutation) ClearMaxUses() {
+	m.max_uses = nil
+	m.addmax_uses = nil
+	m.clearedFields[invite.FieldMaxUses] = struct{}{}
+}
+
+// MaxUsesCleared returns if the "max_uses" field was cleared in this mutation.
+func (m *InviteMutation) MaxUsesCleared() bool {
+	_, ok := m.clearedFields[invite.FieldMaxUses]
 	return ok
 }
 
-// ResetFileURL resets all changes to the "file_url" field.
-func (m *MessageMutation) ResetFileURL() {
-	m.file_url = nil
-	delete(m.clearedFields, message.FieldFileURL)
+// ResetMaxUses resets all changes to the "max_uses" field.
+func (m *InviteMutation) ResetMaxUses() {
+	m.max_uses = nil
+	m.addmax_uses = nil
+	delete(m.clearedFields, invite.FieldMaxUses)
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *MessageMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetUseCount sets the "use_count" field.
+func (m *InviteMutation) SetUseCount(i int) {
+	m.use_count = &i
+	m.adduse_count = nil
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *MessageMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// UseCount returns the value of the "use_count" field in the mutation.
+func (m *InviteMutation) UseCount() (r int, exists bool) {
+	v := m.use_count
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// OldUseCount returns the old "use_count" field's value of the Invite entity.
+// If the Invite object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *InviteMutation) OldUseCount(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldUseCount is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldUseCount requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldUseCount: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.UseCount, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *MessageMutation) ResetCreatedAt() {
-	m.created_at = nil
+// AddUseCount adds i to the "use_count" field.
+func (m *InviteMutation) AddUseCount(i int) {
+	if m.adduse_count != nil {
+		*m.adduse_count += i
+	} else {
+		m.adduse_count = &i
+	}
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *MessageMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// AddedUseCount returns the value that was added to the "use_count" field in this mutation.
+func (m *InviteMutation) AddedUseCount() (r int, exists bool) {
+	v := m.adduse_count
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *MessageMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// ResetUseCount resets all changes to the "use_count" field.
+func (m *InviteMutation) ResetUseCount() {
+	m.use_count = nil
+	m.adduse_count = nil
+}
+
+// SetRevoked sets the "revoked" field.
+func (m *InviteMutation) SetRevoked(b bool) {
+	m.revoked = &b
+}
+
+// Revoked returns the value of the "revoked" field in the mutation.
+func (m *InviteMutation) Revoked() (r bool, exists bool) {
+	v := m.revoked
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// OldRevoked returns the old "revoked" field's value of the Invite entity.
+// If the Invite object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *InviteMutation) OldRevoked(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldRevoked is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldRevoked requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldRevoked: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.Revoked, nil
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *MessageMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// ResetRevoked resets all changes to the "revoked" field.
+func (m *InviteMutation) ResetRevoked() {
+	m.revoked = nil
 }
 
-// SetDeletedAt sets the "deleted_at" field.
-func (m *MessageMutation) SetDeletedAt(t time.Time) {
-	m.deleted_at = &t
+// SetCreatedAt sets the "created_at" field.
+func (m *InviteMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// DeletedAt returns the value of the "deleted_at" field in the mutation.
-func (m *MessageMutation) DeletedAt() (r time.Time, exists bool) {
-	v := m.deleted_at
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *InviteMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDeletedAt returns the old "deleted_at" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Invite entity.
+// If the Invite object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+func (m *InviteMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.DeletedAt, nil
-}
-
-// ClearDeletedAt clears the value of the "deleted_at" field.
-func (m *MessageMutation) ClearDeletedAt() {
-	m.deleted_at = nil
-	m.clearedFields[message.FieldDeletedAt] = struct{}{}
-}
-
-// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
-func (m *MessageMutation) DeletedAtCleared() bool {
-	_, ok := m.clearedFields[message.FieldDeletedAt]
-	return ok
+	return oldValue.CreatedAt, nil
 }
 
-// ResetDeletedAt resets all changes to the "deleted_at" field.
-func (m *MessageMutation) ResetDeletedAt() {
-	m.deleted_at = nil
-	delete(m.clearedFields, message.FieldDeletedAt)
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *InviteMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
 // ClearRoom clears the "room" edge to the ChatRoom entity.
-func (m *MessageMutation) ClearRoom() {
+func (m *InviteMutation) ClearRoom() {
 	m.clearedroom = true
-	m.clearedFields[message.FieldRoomID] = struct{}{}
+	m.clearedFields[invite.FieldRoomID] = struct{}{}
 }
 
 // RoomCleared reports if the "room" edge to the ChatRoom entity was cleared.
-func (m *MessageMutation) RoomCleared() bool {
+func (m *InviteMutation) RoomCleared() bool {
 	return m.clearedroom
 }
 
 // RoomIDs returns the "room" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
 // RoomID instead. It exists only for internal usage by the builders.
-func (m *MessageMutation) RoomIDs() (ids []uuid.UUID) {
+func (m *InviteMutation) RoomIDs() (ids []uuid.UUID) {
 	if id := m.room; id != nil {
 		ids = append(ids, *id)
 	}
@@ -1129,60 +2190,47 @@ func (m *MessageMutation) RoomIDs() (ids []uuid.UUID) {
 }
 
 // ResetRoom resets all changes to the "room" edge.
-func (m *MessageMutation) ResetRoom() {
+func (m *InviteMutation) ResetRoom() {
 	m.room = nil
 	m.clearedroom = false
 }
 
-// SetSenderID sets the "sender" edge to the User entity by id.
-func (m *MessageMutation) SetSenderID(id uuid.UUID) {
-	m.sender = &id
-}
-
-// ClearSender clears the "sender" edge to the User entity.
-func (m *MessageMutation) ClearSender() {
-	m.clearedsender = true
-	m.clearedFields[message.FieldUserID] = struct{}{}
-}
-
-// SenderCleared reports if the "sender" edge to the User entity was cleared.
-func (m *MessageMutation) SenderCleared() bool {
-	return m.clearedsender
+// ClearCreator clears the "creator" edge to the User entity.
+func (m *InviteMutation) ClearCreator() {
+	m.clearedcreator = true
+	m.clearedFields[invite.FieldCreatorID] = struct{}{}
 }
 
-// SenderID returns the "sender" edge ID in the mutation.
-func (m *MessageMutation) SenderID() (id uuid.UUID, exists bool) {
-	if m.sender != nil {
-		return *m.sender, true
-	}
-	return
+// CreatorCleared reports if the "creator" edge to the User entity was cleared.
+func (m *InviteMutation) CreatorCleared() bool {
+	return m.clearedcreator
 }
 
-// SenderIDs returns the "sender" edge IDs in the mutation.
+// CreatorIDs returns the "creator" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// SenderID instead. It exists only for internal usage by the builders.
-func (m *MessageMutation) SenderIDs() (ids []uuid.UUID) {
-	if id := m.sender; id != nil {
+// CreatorID instead. It exists only for internal usage by the builders.
+func (m *InviteMutation) CreatorIDs() (ids []uuid.UUID) {
+	if id := m.creator; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetSender resets all changes to the "sender" edge.
-func (m *MessageMutation) ResetSender() {
-	m.sender = nil
-	m.clearedsender = false
+// ResetCreator resets all changes to the "creator" edge.
+func (m *InviteMutation) ResetCreator() {
+	m.creator = nil
+	m.clearedcreator = false
 }
 
-// Where appends a list predicates to the MessageMutation builder.
-func (m *MessageMutation) Where(ps ...predicate.Message) {
+// Where appends a list predicates to the InviteMutation builder.
+func (m *InviteMutation) Where(ps ...predicate.Invite) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the MessageMutation builder. Using this method,
+// WhereP appends storage-level predicates to the InviteMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *MessageMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Message, len(ps))
+func (m *InviteMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Invite, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -1190,45 +2238,48 @@ func (m *MessageMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *MessageMutation) Op() Op {
+func (m *InviteMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *MessageMutation) SetOp(op Op) {
+func (m *InviteMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Message).
-func (m *MessageMutation) Type() string {
+// Type returns the node type of this mutation (Invite).
+func (m *InviteMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *MessageMutation) Fields() []string {
-	fields := make([]string, 0, 7)
+func (m *InviteMutation) Fields() []string {
+	fields := make([]string, 0, 8)
 	if m.room != nil {
-		fields = append(fields, message.FieldRoomID)
+		fields = append(fields, invite.FieldRoomID)
 	}
-	if m.sender != nil {
-		fields = append(fields, message.FieldUserID)
+	if m.creator != nil {
+		fields = append(fields, invite.FieldCreatorID)
 	}
-	if m.content != nil {
-		fields = append(fields, message.FieldContent)
+	if m.token_hash != nil {
+		fields = append(fields, invite.FieldTokenHash)
 	}
-	if m.file_url != nil {
-		fields = append(fields, message.FieldFileURL)
+	if m.expires_at != nil {
+		fields = append(fields, invite.FieldExpiresAt)
 	}
-	if m.created_at != nil {
-		fields = append(fields, message.FieldCreatedAt)
+	if m.max_uses != nil {
+		fields = append(fields, invite.FieldMaxUses)
 	}
-	if m.updated_at != nil {
-		fields = append(fields, message.FieldUpdatedAt)
+	if m.use_count != nil {
+		fields = append(fields, invite.FieldUseCount)
 	}
-	if m.deleted_at != nil {
-		fields = append(fields, message.FieldDeletedAt)
+	if m.revoked != nil {
+		fields = append(fields, invite.FieldRevoked)
+	}
+	if m.created_at != nil {
+		fields = append(fields, invite.FieldCreatedAt)
 	}
 	return fields
 }
@@ -1236,22 +2287,24 @@ func (m *MessageMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *MessageMutation) Field(name string) (ent.Value, bool) {
+func (m *InviteMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case message.FieldRoomID:
+	case invite.FieldRoomID:
 		return m.RoomID()
-	case message.FieldUserID:
-		return m.UserID()
-	case message.FieldContent:
-		return m.Content()
-	case message.FieldFileURL:
-		return m.FileURL()
-	case message.FieldCreatedAt:
+	case invite.FieldCreatorID:
+		return m.CreatorID()
+	case invite.FieldTokenHash:
+		return m.TokenHash()
+	case invite.FieldExpiresAt:
+		return m.ExpiresAt()
+	case invite.FieldMaxUses:
+		return m.MaxUses()
+	case invite.FieldUseCount:
+		return m.UseCount()
+	case invite.FieldRevoked:
+		return m.Revoked()
+	case invite.FieldCreatedAt:
 		return m.CreatedAt()
-	case message.FieldUpdatedAt:
-		return m.UpdatedAt()
-	case message.FieldDeletedAt:
-		return m.DeletedAt()
 	}
 	return nil, false
 }
@@ -1259,191 +2312,224 @@ func (m *MessageMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *MessageMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *InviteMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case message.FieldRoomID:
+	case invite.FieldRoomID:
 		return m.OldRoomID(ctx)
-	case message.FieldUserID:
-		return m.OldUserID(ctx)
-	case message.FieldContent:
-		return m.OldContent(ctx)
-	case message.FieldFileURL:
-		return m.OldFileURL(ctx)
-	case message.FieldCreatedAt:
+	case invite.FieldCreatorID:
+		return m.OldCreatorID(ctx)
+	case invite.FieldTokenHash:
+		return m.OldTokenHash(ctx)
+	case invite.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case invite.FieldMaxUses:
+		return m.OldMaxUses(ctx)
+	case invite.FieldUseCount:
+		return m.OldUseCount(ctx)
+	case invite.FieldRevoked:
+		return m.OldRevoked(ctx)
+	case invite.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case message.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
-	case message.FieldDeletedAt:
-		return m.OldDeletedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Message field %s", name)
+	return nil, fmt.Errorf("unknown Invite field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MessageMutation) SetField(name string, value ent.Value) error {
+func (m *InviteMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case message.FieldRoomID:
+	case invite.FieldRoomID:
 		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetRoomID(v)
 		return nil
-	case message.FieldUserID:
+	case invite.FieldCreatorID:
 		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUserID(v)
+		m.SetCreatorID(v)
 		return nil
-	case message.FieldContent:
-		v, ok := value.(string)
+	case invite.FieldTokenHash:
+		v, ok := value.([]byte)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetContent(v)
+		m.SetTokenHash(v)
 		return nil
-	case message.FieldFileURL:
-		v, ok := value.(string)
+	case invite.FieldExpiresAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetFileURL(v)
+		m.SetExpiresAt(v)
 		return nil
-	case message.FieldCreatedAt:
-		v, ok := value.(time.Time)
+	case invite.FieldMaxUses:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetMaxUses(v)
 		return nil
-	case message.FieldUpdatedAt:
-		v, ok := value.(time.Time)
+	case invite.FieldUseCount:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUpdatedAt(v)
+		m.SetUseCount(v)
 		return nil
-	case message.FieldDeletedAt:
+	case invite.FieldRevoked:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRevoked(v)
+		return nil
+	case invite.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDeletedAt(v)
+		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Message field %s", name)
+	return fmt.Errorf("unknown Invite field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *MessageMutation) AddedFields() []string {
-	return nil
+func (m *InviteMutation) AddedFields() []string {
+	var fields []string
+	if m.addmax_uses != nil {
+		fields = append(fields, invite.FieldMaxUses)
+	}
+	if m.adduse_count != nil {
+		fields = append(fields, invite.FieldUseCount)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *MessageMutation) AddedField(name string) (ent.Value, bool) {
+func (m *InviteMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case invite.FieldMaxUses:
+		return m.AddedMaxUses()
+	case invite.FieldUseCount:
+		return m.AddedUseCount()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MessageMutation) AddField(name string, value ent.Value) error {
+func (m *InviteMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case invite.FieldMaxUses:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMaxUses(v)
+		return nil
+	case invite.FieldUseCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUseCount(v)
+		return nil
 	}
-	return fmt.Errorf("unknown Message numeric field %s", name)
+	return fmt.Errorf("unknown Invite numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *MessageMutation) ClearedFields() []string {
+func (m *InviteMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(message.FieldFileURL) {
-		fields = append(fields, message.FieldFileURL)
-	}
-	if m.FieldCleared(message.FieldDeletedAt) {
-		fields = append(fields, message.FieldDeletedAt)
+	if m.FieldCleared(invite.FieldMaxUses) {
+		fields = append(fields, invite.FieldMaxUses)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *MessageMutation) FieldCleared(name string) bool {
+func (m *InviteMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *MessageMutation) ClearField(name string) error {
+func (m *InviteMutation) ClearField(name string) error {
 	switch name {
-	case message.FieldFileURL:
-		m.ClearFileURL()
-		return nil
-	case message.FieldDeletedAt:
-		m.ClearDeletedAt()
+	case invite.FieldMaxUses:
+		m.ClearMaxUses()
 		return nil
 	}
-	return fmt.Errorf("unknown Message nullable field %s", name)
+	return fmt.Errorf("unknown Invite nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *MessageMutation) ResetField(name string) error {
+func (m *InviteMutation) ResetField(name string) error {
 	switch name {
-	case message.FieldRoomID:
+	case invite.FieldRoomID:
 		m.ResetRoomID()
 		return nil
-	case message.FieldUserID:
-		m.ResetUserID()
+	case invite.FieldCreatorID:
+		m.ResetCreatorID()
 		return nil
-	case message.FieldContent:
-		m.ResetContent()
+	case invite.FieldTokenHash:
+		m.ResetTokenHash()
 		return nil
-	case message.FieldFileURL:
-		m.ResetFileURL()
+	case invite.FieldExpiresAt:
+		m.ResetExpiresAt()
 		return nil
-	case message.FieldCreatedAt:
-		m.ResetCreatedAt()
+	case invite.FieldMaxUses:
+		m.ResetMaxUses()
 		return nil
-	case message.FieldUpdatedAt:
-		m.ResetUpdatedAt()
+	case invite.FieldUseCount:
+		m.ResetUseCount()
 		return nil
-	case message.FieldDeletedAt:
-		m.ResetDeletedAt()
+	case invite.FieldRevoked:
+		m.ResetRevoked()
+		return nil
+	case invite.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Message field %s", name)
+	return fmt.Errorf("unknown Invite field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *MessageMutation) AddedEdges() []string {
+func (m *InviteMutation) AddedEdges() []string {
 	edges := make([]string, 0, 2)
 	if m.room != nil {
-		edges = append(edges, message.EdgeRoom)
+		edges = append(edges, invite.EdgeRoom)
 	}
-	if m.sender != nil {
-		edges = append(edges, message.EdgeSender)
+	if m.creator != nil {
+		edges = append(edges, invite.EdgeCreator)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *MessageMutation) AddedIDs(name string) []ent.Value {
+func (m *InviteMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case message.EdgeRoom:
+	case invite.EdgeRoom:
 		if id := m.room; id != nil {
 			return []ent.Value{*id}
 		}
-	case message.EdgeSender:
-		if id := m.sender; id != nil {
+	case invite.EdgeCreator:
+		if id := m.creator; id != nil {
 			return []ent.Value{*id}
 		}
 	}
@@ -1451,97 +2537,100 @@ func (m *MessageMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *MessageMutation) RemovedEdges() []string {
+func (m *InviteMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 2)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *MessageMutation) RemovedIDs(name string) []ent.Value {
+func (m *InviteMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *MessageMutation) ClearedEdges() []string {
+func (m *InviteMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 2)
 	if m.clearedroom {
-		edges = append(edges, message.EdgeRoom)
+		edges = append(edges, invite.EdgeRoom)
 	}
-	if m.clearedsender {
-		edges = append(edges, message.EdgeSender)
+	if m.clearedcreator {
+		edges = append(edges, invite.EdgeCreator)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *MessageMutation) EdgeCleared(name string) bool {
+func (m *InviteMutation) EdgeCleared(name string) bool {
 	switch name {
-	case message.EdgeRoom:
+	case invite.EdgeRoom:
 		return m.clearedroom
-	case message.EdgeSender:
-		return m.clearedsender
+	case invite.EdgeCreator:
+		return m.clearedcreator
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *MessageMutation) ClearEdge(name string) error {
+func (m *InviteMutation) ClearEdge(name string) error {
 	switch name {
-	case message.EdgeRoom:
+	case invite.EdgeRoom:
 		m.ClearRoom()
 		return nil
-	case message.EdgeSender:
-		m.ClearSender()
+	case invite.EdgeCreator:
+		m.ClearCreator()
 		return nil
 	}
-	return fmt.Errorf("unknown Message unique edge %s", name)
+	return fmt.Errorf("unknown Invite unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *MessageMutation) ResetEdge(name string) error {
+func (m *InviteMutation) ResetEdge(name string) error {
 	switch name {
-	case message.EdgeRoom:
+	case invite.EdgeRoom:
 		m.ResetRoom()
 		return nil
-	case message.EdgeSender:
-		m.ResetSender()
+	case invite.EdgeCreator:
+		m.ResetCreator()
 		return nil
 	}
-	return fmt.Errorf("unknown Message edge %s", name)
+	return fmt.Errorf("unknown Invite edge %s", name)
 }
 
-// RoomMemberMutation represents an operation that mutates the RoomMember nodes in the graph.
-type RoomMemberMutation struct {
+// JoinRequestMutation represents an operation that mutates the JoinRequest nodes in the graph.
+type JoinRequestMutation struct {
 	config
 	op            Op
 	typ           string
 	id            *int64
-	joined_at     *time.Time
+	status        *joinrequest.Status
+	decided_by    *uuid.UUID
+	decided_at    *time.Time
+	created_at    *time.Time
 	clearedFields map[string]struct{}
 	room          *uuid.UUID
 	clearedroom   bool
 	user          *uuid.UUID
 	cleareduser   bool
 	done          bool
-	oldValue      func(context.Context) (*RoomMember, error)
-	predicates    []predicate.RoomMember
+	oldValue      func(context.Context) (*JoinRequest, error)
+	predicates    []predicate.JoinRequest
 }
 
-var _ ent.Mutation = (*RoomMemberMutation)(nil)
+var _ ent.Mutation = (*JoinRequestMutation)(nil)
 
-// roommemberOption allows management of the mutation configuration using functional options.
-type roommemberOption func(*RoomMemberMutation)
+// joinrequestOption allows management of the mutation configuration using functional options.
+type joinrequestOption func(*JoinRequestMutation)
 
-// newRoomMemberMutation creates new mutation for the RoomMember entity.
-func newRoomMemberMutation(c config, op Op, opts ...roommemberOption) *RoomMemberMutation {
-	m := &RoomMemberMutation{
+// newJoinRequestMutation creates new mutation for the JoinRequest entity.
+func newJoinRequestMutation(c config, op Op, opts ...joinrequestOption) *JoinRequestMutation {
+	m := &JoinRequestMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeRoomMember,
+		typ:           TypeJoinRequest,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1550,20 +2639,20 @@ func newRoomMemberMutation(c config, op Op, opts ...roommemberOption) *RoomMembe
 	return m
 }
 
-// withRoomMemberID sets the ID field of the mutation.
-func withRoomMemberID(id int64) roommemberOption {
-	return func(m *RoomMemberMutation) {
+// withJoinRequestID sets the ID field of the mutation.
+func withJoinRequestID(id int64) joinrequestOption {
+	return func(m *JoinRequestMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *RoomMember
+			value *JoinRequest
 		)
-		m.oldValue = func(ctx context.Context) (*RoomMember, error) {
+		m.oldValue = func(ctx context.Context) (*JoinRequest, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().RoomMember.Get(ctx, id)
+					value, err = m.Client().JoinRequest.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1572,10 +2661,10 @@ func withRoomMemberID(id int64) roommemberOption {
 	}
 }
 
-// withRoomMember sets the old RoomMember of the mutation.
-func withRoomMember(node *RoomMember) roommemberOption {
-	return func(m *RoomMemberMutation) {
-		m.oldValue = func(context.Context) (*RoomMember, error) {
+// withJoinRequest sets the old JoinRequest of the mutation.
+func withJoinRequest(node *JoinRequest) joinrequestOption {
+	return func(m *JoinRequestMutation) {
+		m.oldValue = func(context.Context) (*JoinRequest, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1584,7 +2673,7 @@ func withRoomMember(node *RoomMember) roommemberOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m RoomMemberMutation) Client() *Client {
+func (m JoinRequestMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1592,7 +2681,7 @@ func (m RoomMemberMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m RoomMemberMutation) Tx() (*Tx, error) {
+func (m JoinRequestMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -1602,14 +2691,14 @@ func (m RoomMemberMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of RoomMember entities.
-func (m *RoomMemberMutation) SetID(id int64) {
+// operation is only accepted on creation of JoinRequest entities.
+func (m *JoinRequestMutation) SetID(id int64) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *RoomMemberMutation) ID() (id int64, exists bool) {
+func (m *JoinRequestMutation) ID() (id int64, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1620,7 +2709,7 @@ func (m *RoomMemberMutation) ID() (id int64, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *RoomMemberMutation) IDs(ctx context.Context) ([]int64, error) {
+func (m *JoinRequestMutation) IDs(ctx context.Context) ([]int64, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -1629,19 +2718,19 @@ func (m *RoomMemberMutation) IDs(ctx context.Context) ([]int64, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().RoomMember.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().JoinRequest.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetRoomID sets the "room_id" field.
-func (m *RoomMemberMutation) SetRoomID(u uuid.UUID) {
+func (m *JoinRequestMutation) SetRoomID(u uuid.UUID) {
 	m.room = &u
 }
 
 // RoomID returns the value of the "room_id" field in the mutation.
-func (m *RoomMemberMutation) RoomID() (r uuid.UUID, exists bool) {
+func (m *JoinRequestMutation) RoomID() (r uuid.UUID, exists bool) {
 	v := m.room
 	if v == nil {
 		return
@@ -1649,10 +2738,10 @@ func (m *RoomMemberMutation) RoomID() (r uuid.UUID, exists bool) {
 	return *v, true
 }
 
-// OldRoomID returns the old "room_id" field's value of the RoomMember entity.
-// If the RoomMember object wasn't provided to the builder, the object is fetched from the database.
+// OldRoomID returns the old "room_id" field's value of the JoinRequest entity.
+// If the JoinRequest object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RoomMemberMutation) OldRoomID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *JoinRequestMutation) OldRoomID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldRoomID is only allowed on UpdateOne operations")
 	}
@@ -1667,17 +2756,17 @@ func (m *RoomMemberMutation) OldRoomID(ctx context.Context) (v uuid.UUID, err er
 }
 
 // ResetRoomID resets all changes to the "room_id" field.
-func (m *RoomMemberMutation) ResetRoomID() {
+func (m *JoinRequestMutation) ResetRoomID() {
 	m.room = nil
 }
 
 // SetUserID sets the "user_id" field.
-func (m *RoomMemberMutation) SetUserID(u uuid.UUID) {
+func (m *JoinRequestMutation) SetUserID(u uuid.UUID) {
 	m.user = &u
 }
 
 // UserID returns the value of the "user_id" field in the mutation.
-func (m *RoomMemberMutation) UserID() (r uuid.UUID, exists bool) {
+func (m *JoinRequestMutation) UserID() (r uuid.UUID, exists bool) {
 	v := m.user
 	if v == nil {
 		return
@@ -1685,10 +2774,10 @@ func (m *RoomMemberMutation) UserID() (r uuid.UUID, exists bool) {
 	return *v, true
 }
 
-// OldUserID returns the old "user_id" field's value of the RoomMember entity.
-// If the RoomMember object wasn't provided to the builder, the object is fetched from the database.
+// OldUserID returns the old "user_id" field's value of the JoinRequest entity.
+// If the JoinRequest object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RoomMemberMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *JoinRequestMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
 	}
@@ -1703,143 +2792,286 @@ func (m *RoomMemberMutation) OldUserID(ctx context.Context) (v uuid.UUID, err er
 }
 
 // ResetUserID resets all changes to the "user_id" field.
-func (m *RoomMemberMutation) ResetUserID() {
+func (m *JoinRequestMutation) ResetUserID() {
 	m.user = nil
 }
 
-// SetJoinedAt sets the "joined_at" field.
-func (m *RoomMemberMutation) SetJoinedAt(t time.Time) {
-	m.joined_at = &t
+// SetStatus sets the "status" field.
+func (m *JoinRequestMutation) SetStatus(j joinrequest.Status) {
+	m.status = &j
 }
 
-// JoinedAt returns the value of the "joined_at" field in the mutation.
-func (m *RoomMemberMutation) JoinedAt() (r time.Time, exists bool) {
-	v := m.joined_at
+// Status returns the value of the "status" field in the mutation.
+func (m *JoinRequestMutation) Status() (r joinrequest.Status, exists bool) {
+	v := m.status
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldJoinedAt returns the old "joined_at" field's value of the RoomMember entity.
-// If the RoomMember object wasn't provided to the builder, the object is fetched from the database.
+// OldStatus returns the old "status" field's value of the JoinRequest entity.
+// If the JoinRequest object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RoomMemberMutation) OldJoinedAt(ctx context.Context) (v time.Time, err error) {
+func (m *JoinRequestMutation) OldStatus(ctx context.Context) (v joinrequest.Status, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldJoinedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldJoinedAt requires an ID field in the mutation")
+		return v, errors.New("OldStatus requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldJoinedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
 	}
-	return oldValue.JoinedAt, nil
+	return oldValue.Status, nil
 }
 
-// ResetJoinedAt resets all changes to the "joined_at" field.
-func (m *RoomMemberMutation) ResetJoinedAt() {
-	m.joined_at = nil
+// ResetStatus resets all changes to the "status" field.
+func (m *JoinRequestMutation) ResetStatus() {
+	m.status = nil
 }
 
-// ClearRoom clears the "room" edge to the ChatRoom entity.
-func (m *RoomMemberMutation) ClearRoom() {
-	m.clearedroom = true
-	m.clearedFields[roommember.FieldRoomID] = struct{}{}
+// SetDecidedBy sets the "decided_by" field.
+func (m *JoinRequestMutation) SetDecidedBy(u uuid.UUID) {
+	m.decided_by = &u
 }
 
-// RoomCleared reports if the "room" edge to the ChatRoom entity was cleared.
-func (m *RoomMemberMutation) RoomCleared() bool {
-	return m.clearedroom
+// DecidedBy returns the value of the "decided_by" field in the mutation.
+func (m *JoinRequestMutation) DecidedBy() (r uuid.UUID, exists bool) {
+	v := m.decided_by
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// RoomIDs returns the "room" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// RoomID instead. It exists only for internal usage by the builders.
-func (m *RoomMemberMutation) RoomIDs() (ids []uuid.UUID) {
-	if id := m.room; id != nil {
-		ids = append(ids, *id)
+// OldDecidedBy returns the old "decided_by" field's value of the JoinRequest entity.
+// If the JoinRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JoinRequestMutation) OldDecidedBy(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDecidedBy is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDecidedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDecidedBy: %w", err)
+	}
+	return oldValue.DecidedBy, nil
 }
 
-// ResetRoom resets all changes to the "room" edge.
-func (m *RoomMemberMutation) ResetRoom() {
-	m.room = nil
-	m.clearedroom = false
+// ClearDecidedBy clears the value of the "decided_by" field.
+func (m *JoinRequestMutation) ClearDecidedBy() {
+	m.decided_by = nil
+	m.clearedFields[joinrequest.FieldDecidedBy] = struct{}{}
 }
 
-// ClearUser clears the "user" edge to the User entity.
-func (m *RoomMemberMutation) ClearUser() {
-	m.cleareduser = true
-	m.clearedFields[roommember.FieldUserID] = struct{}{}
+// DecidedByCleared returns if the "decided_by" field was cleared in this mutation.
+func (m *JoinRequestMutation) DecidedByCleared() bool {
+	_, ok := m.clearedFields[joinrequest.FieldDecidedBy]
+	return ok
 }
 
-// UserCleared reports if the "user" edge to the User entity was cleared.
-func (m *RoomMemberMutation) UserCleared() bool {
-	return m.cleareduser
+// ResetDecidedBy resets all changes to the "decided_by" field.
+func (m *JoinRequestMutation) ResetDecidedBy() {
+	m.decided_by = nil
+	delete(m.clearedFields, joinrequest.FieldDecidedBy)
 }
 
-// UserIDs returns the "user" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// UserID instead. It exists only for internal usage by the builders.
-func (m *RoomMemberMutation) UserIDs() (ids []uuid.UUID) {
-	if id := m.user; id != nil {
-		ids = append(ids, *id)
-	}
-	return
+// SetDecidedAt sets the "decided_at" field.
+func (m *JoinRequestMutation) SetDecidedAt(t time.Time) {
+	m.decided_at = &t
 }
 
-// ResetUser resets all changes to the "user" edge.
-func (m *RoomMemberMutation) ResetUser() {
-	m.user = nil
-	m.cleareduser = false
+// DecidedAt returns the value of the "decided_at" field in the mutation.
+func (m *JoinRequestMutation) DecidedAt() (r time.Time, exists bool) {
+	v := m.decided_at
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Where appends a list predicates to the RoomMemberMutation builder.
-func (m *RoomMemberMutation) Where(ps ...predicate.RoomMember) {
-	m.predicates = append(m.predicates, ps...)
+// OldDecidedAt returns the old "decided_at" field's value of the JoinRequest entity.
+// If the JoinRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JoinRequestMutation) OldDecidedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDecidedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDecidedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDecidedAt: %w", err)
+	}
+	return oldValue.DecidedAt, nil
 }
 
-// WhereP appends storage-level predicates to the RoomMemberMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *RoomMemberMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.RoomMember, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
-	}
-	m.Where(p...)
+// ClearDecidedAt clears the value of the "decided_at" field.
+func (m *JoinRequestMutation) ClearDecidedAt() {
+	m.decided_at = nil
+	m.clearedFields[joinrequest.FieldDecidedAt] = struct{}{}
 }
 
-// Op returns the operation name.
-func (m *RoomMemberMutation) Op() Op {
-	return m.op
+// DecidedAtCleared returns if the "decided_at" field was cleared in this mutation.
+func (m *JoinRequestMutation) DecidedAtCleared() bool {
+	_, ok := m.clearedFields[joinrequest.FieldDecidedAt]
+	return ok
 }
 
-// SetOp allows setting the mutation operation.
-func (m *RoomMemberMutation) SetOp(op Op) {
-	m.op = op
+// ResetDecidedAt resets all changes to the "decided_at" field.
+func (m *JoinRequestMutation) ResetDecidedAt() {
+	m.decided_at = nil
+	delete(m.clearedFields, joinrequest.FieldDecidedAt)
 }
 
-// Type returns the node type of this mutation (RoomMember).
-func (m *RoomMemberMutation) Type() string {
-	return m.typ
+// SetCreatedAt sets the "created_at" field.
+func (m *JoinRequestMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *JoinRequestMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the JoinRequest entity.
+// If the JoinRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JoinRequestMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *JoinRequestMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// ClearRoom clears the "room" edge to the ChatRoom entity.
+func (m *JoinRequestMutation) ClearRoom() {
+	m.clearedroom = true
+	m.clearedFields[joinrequest.FieldRoomID] = struct{}{}
+}
+
+// RoomCleared reports if the "room" edge to the ChatRoom entity was cleared.
+func (m *JoinRequestMutation) RoomCleared() bool {
+	return m.clearedroom
+}
+
+// RoomIDs returns the "room" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// RoomID instead. It exists only for internal usage by the builders.
+func (m *JoinRequestMutation) RoomIDs() (ids []uuid.UUID) {
+	if id := m.room; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetRoom resets all changes to the "room" edge.
+func (m *JoinRequestMutation) ResetRoom() {
+	m.room = nil
+	m.clearedroom = false
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *JoinRequestMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[joinrequest.FieldUserID] = struct{}{}
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *JoinRequestMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *JoinRequestMutation) UserIDs() (ids []uuid.UUID) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *JoinRequestMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// Where appends a list predicates to the JoinRequestMutation builder.
+func (m *JoinRequestMutation) Where(ps ...predicate.JoinRequest) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the JoinRequestMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *JoinRequestMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.JoinRequest, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *JoinRequestMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *JoinRequestMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (JoinRequest).
+func (m *JoinRequestMutation) Type() string {
+	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *RoomMemberMutation) Fields() []string {
-	fields := make([]string, 0, 3)
+func (m *JoinRequestMutation) Fields() []string {
+	fields := make([]string, 0, 6)
 	if m.room != nil {
-		fields = append(fields, roommember.FieldRoomID)
+		fields = append(fields, joinrequest.FieldRoomID)
 	}
 	if m.user != nil {
-		fields = append(fields, roommember.FieldUserID)
+		fields = append(fields, joinrequest.FieldUserID)
 	}
-	if m.joined_at != nil {
-		fields = append(fields, roommember.FieldJoinedAt)
+	if m.status != nil {
+		fields = append(fields, joinrequest.FieldStatus)
+	}
+	if m.decided_by != nil {
+		fields = append(fields, joinrequest.FieldDecidedBy)
+	}
+	if m.decided_at != nil {
+		fields = append(fields, joinrequest.FieldDecidedAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, joinrequest.FieldCreatedAt)
 	}
 	return fields
 }
@@ -1847,14 +3079,20 @@ func (m *RoomMemberMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *RoomMemberMutation) Field(name string) (ent.Value, bool) {
+func (m *JoinRequestMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case roommember.FieldRoomID:
+	case joinrequest.FieldRoomID:
 		return m.RoomID()
-	case roommember.FieldUserID:
+	case joinrequest.FieldUserID:
 		return m.UserID()
-	case roommember.FieldJoinedAt:
-		return m.JoinedAt()
+	case joinrequest.FieldStatus:
+		return m.Status()
+	case joinrequest.FieldDecidedBy:
+		return m.DecidedBy()
+	case joinrequest.FieldDecidedAt:
+		return m.DecidedAt()
+	case joinrequest.FieldCreatedAt:
+		return m.CreatedAt()
 	}
 	return nil, false
 }
@@ -1862,127 +3100,178 @@ func (m *RoomMemberMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *RoomMemberMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *JoinRequestMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case roommember.FieldRoomID:
+	case joinrequest.FieldRoomID:
 		return m.OldRoomID(ctx)
-	case roommember.FieldUserID:
+	case joinrequest.FieldUserID:
 		return m.OldUserID(ctx)
-	case roommember.FieldJoinedAt:
-		return m.OldJoinedAt(ctx)
+	case joinrequest.FieldStatus:
+		return m.OldStatus(ctx)
+	case joinrequest.FieldDecidedBy:
+		return m.OldDecidedBy(ctx)
+	case joinrequest.FieldDecidedAt:
+		return m.OldDecidedAt(ctx)
+	case joinrequest.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown RoomMember field %s", name)
+	return nil, fmt.Errorf("unknown JoinRequest field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *RoomMemberMutation) SetField(name string, value ent.Value) error {
+func (m *JoinRequestMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case roommember.FieldRoomID:
+	case joinrequest.FieldRoomID:
 		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetRoomID(v)
 		return nil
-	case roommember.FieldUserID:
+	case joinrequest.FieldUserID:
 		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUserID(v)
 		return nil
-	case roommember.FieldJoinedAt:
+	case joinrequest.FieldStatus:
+		v, ok := value.(joinrequest.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case joinrequest.FieldDecidedBy:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDecidedBy(v)
+		return nil
+	case joinrequest.FieldDecidedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetJoinedAt(v)
+		m.SetDecidedAt(v)
+		return nil
+	case joinrequest.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown RoomMember field %s", name)
+	return fmt.Errorf("unknown JoinRequest field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *RoomMemberMutation) AddedFields() []string {
+func (m *JoinRequestMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *RoomMemberMutation) AddedField(name string) (ent.Value, bool) {
+func (m *JoinRequestMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *RoomMemberMutation) AddField(name string, value ent.Value) error {
+func (m *JoinRequestMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown RoomMember numeric field %s", name)
+	return fmt.Errorf("unknown JoinRequest numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *RoomMemberMutation) ClearedFields() []string {
-	return nil
+func (m *JoinRequestMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(joinrequest.FieldDecidedBy) {
+		fields = append(fields, joinrequest.FieldDecidedBy)
+	}
+	if m.FieldCleared(joinrequest.FieldDecidedAt) {
+		fields = append(fields, joinrequest.FieldDecidedAt)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *RoomMemberMutation) FieldCleared(name string) bool {
+func (m *JoinRequestMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *RoomMemberMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown RoomMember nullable field %s", name)
+func (m *JoinRequestMutation) ClearField(name string) error {
+	switch name {
+	case joinrequest.FieldDecidedBy:
+		m.ClearDecidedBy()
+		return nil
+	case joinrequest.FieldDecidedAt:
+		m.ClearDecidedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown JoinRequest nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *RoomMemberMutation) ResetField(name string) error {
+func (m *JoinRequestMutation) ResetField(name string) error {
 	switch name {
-	case roommember.FieldRoomID:
+	case joinrequest.FieldRoomID:
 		m.ResetRoomID()
 		return nil
-	case roommember.FieldUserID:
+	case joinrequest.FieldUserID:
 		m.ResetUserID()
 		return nil
-	case roommember.FieldJoinedAt:
-		m.ResetJoinedAt()
+	case joinrequest.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case joinrequest.FieldDecidedBy:
+		m.ResetDecidedBy()
+		return nil
+	case joinrequest.FieldDecidedAt:
+		m.ResetDecidedAt()
+		return nil
+	case joinrequest.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown RoomMember field %s", name)
+	return fmt.Errorf("unknown JoinRequest field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *RoomMemberMutation) AddedEdges() []string {
+func (m *JoinRequestMutation) AddedEdges() []string {
 	edges := make([]string, 0, 2)
 	if m.room != nil {
-		edges = append(edges, roommember.EdgeRoom)
+		edges = append(edges, joinrequest.EdgeRoom)
 	}
 	if m.user != nil {
-		edges = append(edges, roommember.EdgeUser)
+		edges = append(edges, joinrequest.EdgeUser)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *RoomMemberMutation) AddedIDs(name string) []ent.Value {
+func (m *JoinRequestMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case roommember.EdgeRoom:
+	case joinrequest.EdgeRoom:
 		if id := m.room; id != nil {
 			return []ent.Value{*id}
 		}
-	case roommember.EdgeUser:
+	case joinrequest.EdgeUser:
 		if id := m.user; id != nil {
 			return []ent.Value{*id}
 		}
@@ -1991,36 +3280,36 @@ func (m *RoomMemberMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *RoomMemberMutation) RemovedEdges() []string {
+func (m *JoinRequestMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 2)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *RoomMemberMutation) RemovedIDs(name string) []ent.Value {
+func (m *JoinRequestMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *RoomMemberMutation) ClearedEdges() []string {
+func (m *JoinRequestMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 2)
 	if m.clearedroom {
-		edges = append(edges, roommember.EdgeRoom)
+		edges = append(edges, joinrequest.EdgeRoom)
 	}
 	if m.cleareduser {
-		edges = append(edges, roommember.EdgeUser)
+		edges = append(edges, joinrequest.EdgeUser)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *RoomMemberMutation) EdgeCleared(name string) bool {
+func (m *JoinRequestMutation) EdgeCleared(name string) bool {
 	switch name {
-	case roommember.EdgeRoom:
+	case joinrequest.EdgeRoom:
 		return m.clearedroom
-	case roommember.EdgeUser:
+	case joinrequest.EdgeUser:
 		return m.cleareduser
 	}
 	return false
@@ -2028,70 +3317,90 @@ func (m *RoomMemberMutation) EdgeCleared(name string) bool {
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *RoomMemberMutation) ClearEdge(name string) error {
+func (m *JoinRequestMutation) ClearEdge(name string) error {
 	switch name {
-	case roommember.EdgeRoom:
+	case joinrequest.EdgeRoom:
 		m.ClearRoom()
 		return nil
-	case roommember.EdgeUser:
+	case joinrequest.EdgeUser:
 		m.ClearUser()
 		return nil
 	}
-	return fmt.Errorf("unknown RoomMember unique edge %s", name)
+	return fmt.Errorf("unknown JoinRequest unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *RoomMemberMutation) ResetEdge(name string) error {
+func (m *JoinRequestMutation) ResetEdge(name string) error {
 	switch name {
-	case roommember.EdgeRoom:
+	case joinrequest.EdgeRoom:
 		m.ResetRoom()
 		return nil
-	case roommember.EdgeUser:
+	case joinrequest.EdgeUser:
 		m.ResetUser()
 		return nil
 	}
-	return fmt.Errorf("unknown RoomMember edge %s", name)
+	return fmt.Errorf("unknown JoinRequest edge %s", name)
 }
 
-// UserMutation represents an operation that mutates the User nodes in the graph.
-type UserMutation struct {
+// MessageMutation represents an operation that mutates the Message nodes in the graph.
+type MessageMutation struct {
 	config
-	op                       Op
-	typ                      string
-	id                       *uuid.UUID
-	name                     *string
-	email                    *string
-	password_hash            *[]byte
-	profile_image_url        *string
-	bio                      *string
-	created_at               *time.Time
-	updated_at               *time.Time
-	refresh_token_hash       *[]byte
-	refresh_token_expires_at *time.Time
-	clearedFields            map[string]struct{}
-	room_members             map[int64]struct{}
-	removedroom_members      map[int64]struct{}
-	clearedroom_members      bool
-	messages                 map[uuid.UUID]struct{}
-	removedmessages          map[uuid.UUID]struct{}
-	clearedmessages          bool
-	done                     bool
-	oldValue                 func(context.Context) (*User, error)
-	predicates               []predicate.User
+	op               Op
+	typ              string
+	id               *uuid.UUID
+	content          *string
+	_type            *message.Type
+	payload          *json.RawMessage
+	appendpayload    json.RawMessage
+	file_url         *string
+	created_at       *time.Time
+	updated_at       *time.Time
+	deleted_at       *time.Time
+	reply_count      *int
+	addreply_count   *int
+	last_reply_at    *time.Time
+	edited_at        *time.Time
+	clearedFields    map[string]struct{}
+	room             *uuid.UUID
+	clearedroom      bool
+	sender           *uuid.UUID
+	clearedsender    bool
+	reads            map[int64]struct{}
+	removedreads     map[int64]struct{}
+	clearedreads     bool
+	reactions        map[int64]struct{}
+	removedreactions map[int64]struct{}
+	clearedreactions bool
+	revisions        map[int64]struct{}
+	removedrevisions map[int64]struct{}
+	clearedrevisions bool
+	parent           *uuid.UUID
+	clearedparent    bool
+	replies          map[uuid.UUID]struct{}
+	removedreplies   map[uuid.UUID]struct{}
+	clearedreplies   bool
+	reply_to         *uuid.UUID
+	clearedreply_to  bool
+	quotes           map[uuid.UUID]struct{}
+	removedquotes    map[uuid.UUID]struct{}
+	clearedquotes    bool
+	done             bool
+	oldValue         func(context.Context) (*Message, error)
+	predicates       []predicate.Message
 }
 
-var _ ent.Mutation = (*UserMutation)(nil)
+var _ ent.Mutation = (*MessageMutation)(nil)
 
-// userOption allows management of the mutation configuration using functional options.
-type userOption func(*UserMutation)
+// messageOption allows management of the mutation configuration using functional options.
+type messageOption func(*MessageMutation)
 
-// newUserMutation creates new mutation for the User entity.
-func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
-	m := &UserMutation{
+// newMessageMutation creates new mutation for the Message entity.
+func newMessageMutation(c config, op Op, opts ...messageOption) *MessageMutation {
+	m := &MessageMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeUser,
+		typ:           TypeMessage,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -2100,20 +3409,20 @@ func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
 	return m
 }
 
-// withUserID sets the ID field of the mutation.
-func withUserID(id uuid.UUID) userOption {
-	return func(m *UserMutation) {
+// withMessageID sets the ID field of the mutation.
+func withMessageID(id uuid.UUID) messageOption {
+	return func(m *MessageMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *User
+			value *Message
 		)
-		m.oldValue = func(ctx context.Context) (*User, error) {
+		m.oldValue = func(ctx context.Context) (*Message, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().User.Get(ctx, id)
+					value, err = m.Client().Message.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -2122,10 +3431,10 @@ func withUserID(id uuid.UUID) userOption {
 	}
 }
 
-// withUser sets the old User of the mutation.
-func withUser(node *User) userOption {
-	return func(m *UserMutation) {
-		m.oldValue = func(context.Context) (*User, error) {
+// withMessage sets the old Message of the mutation.
+func withMessage(node *Message) messageOption {
+	return func(m *MessageMutation) {
+		m.oldValue = func(context.Context) (*Message, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -2134,7 +3443,7 @@ func withUser(node *User) userOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m UserMutation) Client() *Client {
+func (m MessageMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -2142,7 +3451,7 @@ func (m UserMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m UserMutation) Tx() (*Tx, error) {
+func (m MessageMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -2152,14 +3461,14 @@ func (m UserMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of User entities.
-func (m *UserMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of Message entities.
+func (m *MessageMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *UserMutation) ID() (id uuid.UUID, exists bool) {
+func (m *MessageMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -2170,7 +3479,7 @@ func (m *UserMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *UserMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *MessageMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -2179,225 +3488,277 @@ func (m *UserMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().User.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Message.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetName sets the "name" field.
-func (m *UserMutation) SetName(s string) {
-	m.name = &s
+// SetRoomID sets the "room_id" field.
+func (m *MessageMutation) SetRoomID(u uuid.UUID) {
+	m.room = &u
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *UserMutation) Name() (r string, exists bool) {
-	v := m.name
+// RoomID returns the value of the "room_id" field in the mutation.
+func (m *MessageMutation) RoomID() (r uuid.UUID, exists bool) {
+	v := m.room
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldRoomID returns the old "room_id" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *MessageMutation) OldRoomID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldRoomID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldRoomID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldRoomID: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.RoomID, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *UserMutation) ResetName() {
-	m.name = nil
+// ResetRoomID resets all changes to the "room_id" field.
+func (m *MessageMutation) ResetRoomID() {
+	m.room = nil
 }
 
-// SetEmail sets the "email" field.
-func (m *UserMutation) SetEmail(s string) {
-	m.email = &s
+// SetUserID sets the "user_id" field.
+func (m *MessageMutation) SetUserID(u uuid.UUID) {
+	m.sender = &u
 }
 
-// Email returns the value of the "email" field in the mutation.
-func (m *UserMutation) Email() (r string, exists bool) {
-	v := m.email
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *MessageMutation) UserID() (r uuid.UUID, exists bool) {
+	v := m.sender
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEmail returns the old "email" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldUserID returns the old "user_id" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldEmail(ctx context.Context) (v string, err error) {
+func (m *MessageMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEmail requires an ID field in the mutation")
+		return v, errors.New("OldUserID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
 	}
-	return oldValue.Email, nil
+	return oldValue.UserID, nil
 }
 
-// ResetEmail resets all changes to the "email" field.
-func (m *UserMutation) ResetEmail() {
-	m.email = nil
+// ResetUserID resets all changes to the "user_id" field.
+func (m *MessageMutation) ResetUserID() {
+	m.sender = nil
 }
 
-// SetPasswordHash sets the "password_hash" field.
-func (m *UserMutation) SetPasswordHash(b []byte) {
-	m.password_hash = &b
+// SetContent sets the "content" field.
+func (m *MessageMutation) SetContent(s string) {
+	m.content = &s
 }
 
-// PasswordHash returns the value of the "password_hash" field in the mutation.
-func (m *UserMutation) PasswordHash() (r []byte, exists bool) {
-	v := m.password_hash
+// Content returns the value of the "content" field in the mutation.
+func (m *MessageMutation) Content() (r string, exists bool) {
+	v := m.content
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPasswordHash returns the old "password_hash" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldContent returns the old "content" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldPasswordHash(ctx context.Context) (v []byte, err error) {
+func (m *MessageMutation) OldContent(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPasswordHash is only allowed on UpdateOne operations")
+		return v, errors.New("OldContent is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPasswordHash requires an ID field in the mutation")
+		return v, errors.New("OldContent requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPasswordHash: %w", err)
+		return v, fmt.Errorf("querying old value for OldContent: %w", err)
 	}
-	return oldValue.PasswordHash, nil
+	return oldValue.Content, nil
 }
 
-// ResetPasswordHash resets all changes to the "password_hash" field.
-func (m *UserMutation) ResetPasswordHash() {
-	m.password_hash = nil
+// ResetContent resets all changes to the "content" field.
+func (m *MessageMutation) ResetContent() {
+	m.content = nil
 }
 
-// SetProfileImageURL sets the "profile_image_url" field.
-func (m *UserMutation) SetProfileImageURL(s string) {
-	m.profile_image_url = &s
+// SetType sets the "type" field.
+func (m *MessageMutation) SetType(value message.Type) {
+	m._type = &value
 }
 
-// ProfileImageURL returns the value of the "profile_image_url" field in the mutation.
-func (m *UserMutation) ProfileImageURL() (r string, exists bool) {
-	v := m.profile_image_url
+// GetType returns the value of the "type" field in the mutation.
+func (m *MessageMutation) GetType() (r message.Type, exists bool) {
+	v := m._type
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldProfileImageURL returns the old "profile_image_url" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldType returns the old "type" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldProfileImageURL(ctx context.Context) (v *string, err error) {
+func (m *MessageMutation) OldType(ctx context.Context) (v message.Type, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldProfileImageURL is only allowed on UpdateOne operations")
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldProfileImageURL requires an ID field in the mutation")
+		return v, errors.New("OldType requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldProfileImageURL: %w", err)
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
 	}
-	return oldValue.ProfileImageURL, nil
+	return oldValue.Type, nil
 }
 
-// ClearProfileImageURL clears the value of the "profile_image_url" field.
-func (m *UserMutation) ClearProfileImageURL() {
-	m.profile_image_url = nil
-	m.clearedFields[user.FieldProfileImageURL] = struct{}{}
+// ResetType resets all changes to the "type" field.
+func (m *MessageMutation) ResetType() {
+	m._type = nil
 }
 
-// ProfileImageURLCleared returns if the "profile_image_url" field was cleared in this mutation.
-func (m *UserMutation) ProfileImageURLCleared() bool {
-	_, ok := m.clearedFields[user.FieldProfileImageURL]
+// SetPayload sets the "payload" field.
+func (m *MessageMutation) SetPayload(jm json.RawMessage) {
+	m.payload = &jm
+	m.appendpayload = nil
+}
+
+// Payload returns the value of the "payload" field in the mutation.
+func (m *MessageMutation) Payload() (r json.RawMessage, exists bool) {
+	v := m.payload
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPayload returns the old "payload" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldPayload(ctx context.Context) (v json.RawMessage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPayload is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPayload requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPayload: %w", err)
+	}
+	return oldValue.Payload, nil
+}
+
+// AppendPayload adds jm to the "payload" field.
+func (m *MessageMutation) AppendPayload(jm json.RawMessage) {
+	m.appendpayload = append(m.appendpayload, jm...)
+}
+
+// AppendedPayload returns the list of values that were appended to the "payload" field in this mutation.
+func (m *MessageMutation) AppendedPayload() (json.RawMessage, bool) {
+	if len(m.appendpayload) == 0 {
+		return nil, false
+	}
+	return m.appendpayload, true
+}
+
+// ClearPayload clears the value of the "payload" field.
+func (m *MessageMutation) ClearPayload() {
+	m.payload = nil
+	m.appendpayload = nil
+	m.clearedFields[message.FieldPayload] = struct{}{}
+}
+
+// PayloadCleared returns if the "payload" field was cleared in this mutation.
+func (m *MessageMutation) PayloadCleared() bool {
+	_, ok := m.clearedFields[message.FieldPayload]
 	return ok
 }
 
-// ResetProfileImageURL resets all changes to the "profile_image_url" field.
-func (m *UserMutation) ResetProfileImageURL() {
-	m.profile_image_url = nil
-	delete(m.clearedFields, user.FieldProfileImageURL)
+// ResetPayload resets all changes to the "payload" field.
+func (m *MessageMutation) ResetPayload() {
+	m.payload = nil
+	m.appendpayload = nil
+	delete(m.clearedFields, message.FieldPayload)
 }
 
-// SetBio sets the "bio" field.
-func (m *UserMutation) SetBio(s string) {
-	m.bio = &s
+// SetFileURL sets the "file_url" field.
+func (m *MessageMutation) SetFileURL(s string) {
+	m.file_url = &s
 }
 
-// Bio returns the value of the "bio" field in the mutation.
-func (m *UserMutation) Bio() (r string, exists bool) {
-	v := m.bio
+// FileURL returns the value of the "file_url" field in the mutation.
+func (m *MessageMutation) FileURL() (r string, exists bool) {
+	v := m.file_url
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldBio returns the old "bio" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldFileURL returns the old "file_url" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldBio(ctx context.Context) (v *string, err error) {
+func (m *MessageMutation) OldFileURL(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldBio is only allowed on UpdateOne operations")
+		return v, errors.New("OldFileURL is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldBio requires an ID field in the mutation")
+		return v, errors.New("OldFileURL requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldBio: %w", err)
+		return v, fmt.Errorf("querying old value for OldFileURL: %w", err)
 	}
-	return oldValue.Bio, nil
+	return oldValue.FileURL, nil
 }
 
-// ClearBio clears the value of the "bio" field.
-func (m *UserMutation) ClearBio() {
-	m.bio = nil
-	m.clearedFields[user.FieldBio] = struct{}{}
+// ClearFileURL clears the value of the "file_url" field.
+func (m *MessageMutation) ClearFileURL() {
+	m.file_url = nil
+	m.clearedFields[message.FieldFileURL] = struct{}{}
 }
 
-// BioCleared returns if the "bio" field was cleared in this mutation.
-func (m *UserMutation) BioCleared() bool {
-	_, ok := m.clearedFields[user.FieldBio]
+// FileURLCleared returns if the "file_url" field was cleared in this mutation.
+func (m *MessageMutation) FileURLCleared() bool {
+	_, ok := m.clearedFields[message.FieldFileURL]
 	return ok
 }
 
-// ResetBio resets all changes to the "bio" field.
-func (m *UserMutation) ResetBio() {
-	m.bio = nil
-	delete(m.clearedFields, user.FieldBio)
+// ResetFileURL resets all changes to the "file_url" field.
+func (m *MessageMutation) ResetFileURL() {
+	m.file_url = nil
+	delete(m.clearedFields, message.FieldFileURL)
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *UserMutation) SetCreatedAt(t time.Time) {
+func (m *MessageMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *UserMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *MessageMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -2405,10 +3766,10 @@ func (m *UserMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *MessageMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -2423,250 +3784,8606 @@ func (m *UserMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *UserMutation) ResetCreatedAt() {
+func (m *MessageMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *UserMutation) SetUpdatedAt(t time.Time) {
+func (m *MessageMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *UserMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
-	if v == nil {
-		return
-	}
-	return *v, true
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *MessageMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *MessageMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (m *MessageMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *MessageMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedAt returns the old "deleted_at" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *MessageMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[message.FieldDeletedAt] = struct{}{}
+}
+
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *MessageMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[message.FieldDeletedAt]
+	return ok
+}
+
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *MessageMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, message.FieldDeletedAt)
+}
+
+// SetParentID sets the "parent_id" field.
+func (m *MessageMutation) SetParentID(u uuid.UUID) {
+	m.parent = &u
+}
+
+// ParentID returns the value of the "parent_id" field in the mutation.
+func (m *MessageMutation) ParentID() (r uuid.UUID, exists bool) {
+	v := m.parent
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldParentID returns the old "parent_id" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldParentID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldParentID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldParentID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldParentID: %w", err)
+	}
+	return oldValue.ParentID, nil
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (m *MessageMutation) ClearParentID() {
+	m.parent = nil
+	m.clearedFields[message.FieldParentID] = struct{}{}
+}
+
+// ParentIDCleared returns if the "parent_id" field was cleared in this mutation.
+func (m *MessageMutation) ParentIDCleared() bool {
+	_, ok := m.clearedFields[message.FieldParentID]
+	return ok
+}
+
+// ResetParentID resets all changes to the "parent_id" field.
+func (m *MessageMutation) ResetParentID() {
+	m.parent = nil
+	delete(m.clearedFields, message.FieldParentID)
+}
+
+// SetReplyToID sets the "reply_to_id" field.
+func (m *MessageMutation) SetReplyToID(u uuid.UUID) {
+	m.reply_to = &u
+}
+
+// ReplyToID returns the value of the "reply_to_id" field in the mutation.
+func (m *MessageMutation) ReplyToID() (r uuid.UUID, exists bool) {
+	v := m.reply_to
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReplyToID returns the old "reply_to_id" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldReplyToID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReplyToID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReplyToID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReplyToID: %w", err)
+	}
+	return oldValue.ReplyToID, nil
+}
+
+// ClearReplyToID clears the value of the "reply_to_id" field.
+func (m *MessageMutation) ClearReplyToID() {
+	m.reply_to = nil
+	m.clearedFields[message.FieldReplyToID] = struct{}{}
+}
+
+// ReplyToIDCleared returns if the "reply_to_id" field was cleared in this mutation.
+func (m *MessageMutation) ReplyToIDCleared() bool {
+	_, ok := m.clearedFields[message.FieldReplyToID]
+	return ok
+}
+
+// ResetReplyToID resets all changes to the "reply_to_id" field.
+func (m *MessageMutation) ResetReplyToID() {
+	m.reply_to = nil
+	delete(m.clearedFields, message.FieldReplyToID)
+}
+
+// SetReplyCount sets the "reply_count" field.
+func (m *MessageMutation) SetReplyCount(i int) {
+	m.reply_count = &i
+	m.addreply_count = nil
+}
+
+// ReplyCount returns the value of the "reply_count" field in the mutation.
+func (m *MessageMutation) ReplyCount() (r int, exists bool) {
+	v := m.reply_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReplyCount returns the old "reply_count" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldReplyCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReplyCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReplyCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReplyCount: %w", err)
+	}
+	return oldValue.ReplyCount, nil
+}
+
+// AddReplyCount adds i to the "reply_count" field.
+func (m *MessageMutation) AddReplyCount(i int) {
+	if m.addreply_count != nil {
+		*m.addreply_count += i
+	} else {
+		m.addreply_count = &i
+	}
+}
+
+// AddedReplyCount returns the value that was added to the "reply_count" field in this mutation.
+func (m *MessageMutation) AddedReplyCount() (r int, exists bool) {
+	v := m.addreply_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetReplyCount resets all changes to the "reply_count" field.
+func (m *MessageMutation) ResetReplyCount() {
+	m.reply_count = nil
+	m.addreply_count = nil
+}
+
+// SetLastReplyAt sets the "last_reply_at" field.
+func (m *MessageMutation) SetLastReplyAt(t time.Time) {
+	m.last_reply_at = &t
+}
+
+// LastReplyAt returns the value of the "last_reply_at" field in the mutation.
+func (m *MessageMutation) LastReplyAt() (r time.Time, exists bool) {
+	v := m.last_reply_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastReplyAt returns the old "last_reply_at" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldLastReplyAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastReplyAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastReplyAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastReplyAt: %w", err)
+	}
+	return oldValue.LastReplyAt, nil
+}
+
+// ClearLastReplyAt clears the value of the "last_reply_at" field.
+func (m *MessageMutation) ClearLastReplyAt() {
+	m.last_reply_at = nil
+	m.clearedFields[message.FieldLastReplyAt] = struct{}{}
+}
+
+// LastReplyAtCleared returns if the "last_reply_at" field was cleared in this mutation.
+func (m *MessageMutation) LastReplyAtCleared() bool {
+	_, ok := m.clearedFields[message.FieldLastReplyAt]
+	return ok
+}
+
+// ResetLastReplyAt resets all changes to the "last_reply_at" field.
+func (m *MessageMutation) ResetLastReplyAt() {
+	m.last_reply_at = nil
+	delete(m.clearedFields, message.FieldLastReplyAt)
+}
+
+// SetEditedAt sets the "edited_at" field.
+func (m *MessageMutation) SetEditedAt(t time.Time) {
+	m.edited_at = &t
+}
+
+// EditedAt returns the value of the "edited_at" field in the mutation.
+func (m *MessageMutation) EditedAt() (r time.Time, exists bool) {
+	v := m.edited_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEditedAt returns the old "edited_at" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldEditedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEditedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEditedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEditedAt: %w", err)
+	}
+	return oldValue.EditedAt, nil
+}
+
+// ClearEditedAt clears the value of the "edited_at" field.
+func (m *MessageMutation) ClearEditedAt() {
+	m.edited_at = nil
+	m.clearedFields[message.FieldEditedAt] = struct{}{}
+}
+
+// EditedAtCleared returns if the "edited_at" field was cleared in this mutation.
+func (m *MessageMutation) EditedAtCleared() bool {
+	_, ok := m.clearedFields[message.FieldEditedAt]
+	return ok
+}
+
+// ResetEditedAt resets all changes to the "edited_at" field.
+func (m *MessageMutation) ResetEditedAt() {
+	m.edited_at = nil
+	delete(m.clearedFields, message.FieldEditedAt)
+}
+
+// ClearRoom clears the "room" edge to the ChatRoom entity.
+func (m *MessageMutation) ClearRoom() {
+	m.clearedroom = true
+	m.clearedFields[message.FieldRoomID] = struct{}{}
+}
+
+// RoomCleared reports if the "room" edge to the ChatRoom entity was cleared.
+func (m *MessageMutation) RoomCleared() bool {
+	return m.clearedroom
+}
+
+// RoomIDs returns the "room" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// RoomID instead. It exists only for internal usage by the builders.
+func (m *MessageMutation) RoomIDs() (ids []uuid.UUID) {
+	if id := m.room; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetRoom resets all changes to the "room" edge.
+func (m *MessageMutation) ResetRoom() {
+	m.room = nil
+	m.clearedroom = false
+}
+
+// SetSenderID sets the "sender" edge to the User entity by id.
+func (m *MessageMutation) SetSenderID(id uuid.UUID) {
+	m.sender = &id
+}
+
+// ClearSender clears the "sender" edge to the User entity.
+func (m *MessageMutation) ClearSender() {
+	m.clearedsender = true
+	m.clearedFields[message.FieldUserID] = struct{}{}
+}
+
+// SenderCleared reports if the "sender" edge to the User entity was cleared.
+func (m *MessageMutation) SenderCleared() bool {
+	return m.clearedsender
+}
+
+// SenderID returns the "sender" edge ID in the mutation.
+func (m *MessageMutation) SenderID() (id uuid.UUID, exists bool) {
+	if m.sender != nil {
+		return *m.sender, true
+	}
+	return
+}
+
+// SenderIDs returns the "sender" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// SenderID instead. It exists only for internal usage by the builders.
+func (m *MessageMutation) SenderIDs() (ids []uuid.UUID) {
+	if id := m.sender; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetSender resets all changes to the "sender" edge.
+func (m *MessageMutation) ResetSender() {
+	m.sender = nil
+	m.clearedsender = false
+}
+
+// AddReadIDs adds the "reads" edge to the MessageRead entity by ids.
+func (m *MessageMutation) AddReadIDs(ids ...int64) {
+	if m.reads == nil {
+		m.reads = make(map[int64]struct{})
+	}
+	for i := range ids {
+		m.reads[ids[i]] = struct{}{}
+	}
+}
+
+// ClearReads clears the "reads" edge to the MessageRead entity.
+func (m *MessageMutation) ClearReads() {
+	m.clearedreads = true
+}
+
+// ReadsCleared reports if the "reads" edge to the MessageRead entity was cleared.
+func (m *MessageMutation) ReadsCleared() bool {
+	return m.clearedreads
+}
+
+// RemoveReadIDs removes the "reads" edge to the MessageRead entity by IDs.
+func (m *MessageMutation) RemoveReadIDs(ids ...int64) {
+	if m.removedreads == nil {
+		m.removedreads = make(map[int64]struct{})
+	}
+	for i := range ids {
+		delete(m.reads, ids[i])
+		m.removedreads[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedReads returns the removed IDs of the "reads" edge to the MessageRead entity.
+func (m *MessageMutation) RemovedReadsIDs() (ids []int64) {
+	for id := range m.removedreads {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ReadsIDs returns the "reads" edge IDs in the mutation.
+func (m *MessageMutation) ReadsIDs() (ids []int64) {
+	for id := range m.reads {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetReads resets all changes to the "reads" edge.
+func (m *MessageMutation) ResetReads() {
+	m.reads = nil
+	m.clearedreads = false
+	m.removedreads = nil
+}
+
+// AddReactionIDs adds the "reactions" edge to the MessageReaction entity by ids.
+func (m *MessageMutation) AddReactionIDs(ids ...int64) {
+	if m.reactions == nil {
+		m.reactions = make(map[int64]struct{})
+	}
+	for i := range ids {
+		m.reactions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearReactions clears the "reactions" edge to the MessageReaction entity.
+func (m *MessageMutation) ClearReactions() {
+	m.clearedreactions = true
+}
+
+// ReactionsCleared reports if the "reactions" edge to the MessageReaction entity was cleared.
+func (m *MessageMutation) ReactionsCleared() bool {
+	return m.clearedreactions
+}
+
+// RemoveReactionIDs removes the "reactions" edge to the MessageReaction entity by IDs.
+func (m *MessageMutation) RemoveReactionIDs(ids ...int64) {
+	if m.removedreactions == nil {
+		m.removedreactions = make(map[int64]struct{})
+	}
+	for i := range ids {
+		delete(m.reactions, ids[i])
+		m.removedreactions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedReactions returns the removed IDs of the "reactions" edge to the MessageReaction entity.
+func (m *MessageMutation) RemovedReactionsIDs() (ids []int64) {
+	for id := range m.removedreactions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ReactionsIDs returns the "reactions" edge IDs in the mutation.
+func (m *MessageMutation) ReactionsIDs() (ids []int64) {
+	for id := range m.reactions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetReactions resets all changes to the "reactions" edge.
+func (m *MessageMutation) ResetReactions() {
+	m.reactions = nil
+	m.clearedreactions = false
+	m.removedreactions = nil
+}
+
+// AddRevisionIDs adds the "revisions" edge to the MessageRevision entity by ids.
+func (m *MessageMutation) AddRevisionIDs(ids ...int64) {
+	if m.revisions == nil {
+		m.revisions = make(map[int64]struct{})
+	}
+	for i := range ids {
+		m.revisions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearRevisions clears the "revisions" edge to the MessageRevision entity.
+func (m *MessageMutation) ClearRevisions() {
+	m.clearedrevisions = true
+}
+
+// RevisionsCleared reports if the "revisions" edge to the MessageRevision entity was cleared.
+func (m *MessageMutation) RevisionsCleared() bool {
+	return m.clearedrevisions
+}
+
+// RemoveRevisionIDs removes the "revisions" edge to the MessageRevision entity by IDs.
+func (m *MessageMutation) RemoveRevisionIDs(ids ...int64) {
+	if m.removedrevisions == nil {
+		m.removedrevisions = make(map[int64]struct{})
+	}
+	for i := range ids {
+		delete(m.revisions, ids[i])
+		m.removedrevisions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedRevisions returns the removed IDs of the "revisions" edge to the MessageRevision entity.
+func (m *MessageMutation) RemovedRevisionsIDs() (ids []int64) {
+	for id := range m.removedrevisions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// RevisionsIDs returns the "revisions" edge IDs in the mutation.
+func (m *MessageMutation) RevisionsIDs() (ids []int64) {
+	for id := range m.revisions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetRevisions resets all changes to the "revisions" edge.
+func (m *MessageMutation) ResetRevisions() {
+	m.revisions = nil
+	m.clearedrevisions = false
+	m.removedrevisions = nil
+}
+
+// ClearParent clears the "parent" edge to the Message entity.
+func (m *MessageMutation) ClearParent() {
+	m.clearedparent = true
+	m.clearedFields[message.FieldParentID] = struct{}{}
+}
+
+// ParentCleared reports if the "parent" edge to the Message entity was cleared.
+func (m *MessageMutation) ParentCleared() bool {
+	return m.ParentIDCleared() || m.clearedparent
+}
+
+// ParentIDs returns the "parent" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ParentID instead. It exists only for internal usage by the builders.
+func (m *MessageMutation) ParentIDs() (ids []uuid.UUID) {
+	if id := m.parent; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetParent resets all changes to the "parent" edge.
+func (m *MessageMutation) ResetParent() {
+	m.parent = nil
+	m.clearedparent = false
+}
+
+// AddReplyIDs adds the "replies" edge to the Message entity by ids.
+func (m *MessageMutation) AddReplyIDs(ids ...uuid.UUID) {
+	if m.replies == nil {
+		m.replies = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.replies[ids[i]] = struct{}{}
+	}
+}
+
+// ClearReplies clears the "replies" edge to the Message entity.
+func (m *MessageMutation) ClearReplies() {
+	m.clearedreplies = true
+}
+
+// RepliesCleared reports if the "replies" edge to the Message entity was cleared.
+func (m *MessageMutation) RepliesCleared() bool {
+	return m.clearedreplies
+}
+
+// RemoveReplyIDs removes the "replies" edge to the Message entity by IDs.
+func (m *MessageMutation) RemoveReplyIDs(ids ...uuid.UUID) {
+	if m.removedreplies == nil {
+		m.removedreplies = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.replies, ids[i])
+		m.removedreplies[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedReplies returns the removed IDs of the "replies" edge to the Message entity.
+func (m *MessageMutation) RemovedRepliesIDs() (ids []uuid.UUID) {
+	for id := range m.removedreplies {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// RepliesIDs returns the "replies" edge IDs in the mutation.
+func (m *MessageMutation) RepliesIDs() (ids []uuid.UUID) {
+	for id := range m.replies {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetReplies resets all changes to the "replies" edge.
+func (m *MessageMutation) ResetReplies() {
+	m.replies = nil
+	m.clearedreplies = false
+	m.removedreplies = nil
+}
+
+// ClearReplyTo clears the "reply_to" edge to the Message entity.
+func (m *MessageMutation) ClearReplyTo() {
+	m.clearedreply_to = true
+	m.clearedFields[message.FieldReplyToID] = struct{}{}
+}
+
+// ReplyToCleared reports if the "reply_to" edge to the Message entity was cleared.
+func (m *MessageMutation) ReplyToCleared() bool {
+	return m.ReplyToIDCleared() || m.clearedreply_to
+}
+
+// ReplyToIDs returns the "reply_to" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ReplyToID instead. It exists only for internal usage by the builders.
+func (m *MessageMutation) ReplyToIDs() (ids []uuid.UUID) {
+	if id := m.reply_to; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetReplyTo resets all changes to the "reply_to" edge.
+func (m *MessageMutation) ResetReplyTo() {
+	m.reply_to = nil
+	m.clearedreply_to = false
+}
+
+// AddQuoteIDs adds the "quotes" edge to the Message entity by ids.
+func (m *MessageMutation) AddQuoteIDs(ids ...uuid.UUID) {
+	if m.quotes == nil {
+		m.quotes = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.quotes[ids[i]] = struct{}{}
+	}
+}
+
+// ClearQuotes clears the "quotes" edge to the Message entity.
+func (m *MessageMutation) ClearQuotes() {
+	m.clearedquotes = true
+}
+
+// QuotesCleared reports if the "quotes" edge to the Message entity was cleared.
+func (m *MessageMutation) QuotesCleared() bool {
+	return m.clearedquotes
+}
+
+// RemoveQuoteIDs removes the "quotes" edge to the Message entity by IDs.
+func (m *MessageMutation) RemoveQuoteIDs(ids ...uuid.UUID) {
+	if m.removedquotes == nil {
+		m.removedquotes = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.quotes, ids[i])
+		m.removedquotes[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedQuotes returns the removed IDs of the "quotes" edge to the Message entity.
+func (m *MessageMutation) RemovedQuotesIDs() (ids []uuid.UUID) {
+	for id := range m.removedquotes {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// QuotesIDs returns the "quotes" edge IDs in the mutation.
+func (m *MessageMutation) QuotesIDs() (ids []uuid.UUID) {
+	for id := range m.quotes {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetQuotes resets all changes to the "quotes" edge.
+func (m *MessageMutation) ResetQuotes() {
+	m.quotes = nil
+	m.clearedquotes = false
+	m.removedquotes = nil
+}
+
+// Where appends a list predicates to the MessageMutation builder.
+func (m *MessageMutation) Where(ps ...predicate.Message) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the MessageMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *MessageMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Message, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *MessageMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *MessageMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Message).
+func (m *MessageMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *MessageMutation) Fields() []string {
+	fields := make([]string, 0, 14)
+	if m.room != nil {
+		fields = append(fields, message.FieldRoomID)
+	}
+	if m.sender != nil {
+		fields = append(fields, message.FieldUserID)
+	}
+	if m.content != nil {
+		fields = append(fields, message.FieldContent)
+	}
+	if m._type != nil {
+		fields = append(fields, message.FieldType)
+	}
+	if m.payload != nil {
+		fields = append(fields, message.FieldPayload)
+	}
+	if m.file_url != nil {
+		fields = append(fields, message.FieldFileURL)
+	}
+	if m.created_at != nil {
+		fields = append(fields, message.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, message.FieldUpdatedAt)
+	}
+	if m.deleted_at != nil {
+		fields = append(fields, message.FieldDeletedAt)
+	}
+	if m.parent != nil {
+		fields = append(fields, message.FieldParentID)
+	}
+	if m.reply_to != nil {
+		fields = append(fields, message.FieldReplyToID)
+	}
+	if m.reply_count != nil {
+		fields = append(fields, message.FieldReplyCount)
+	}
+	if m.last_reply_at != nil {
+		fields = append(fields, message.FieldLastReplyAt)
+	}
+	if m.edited_at != nil {
+		fields = append(fields, message.FieldEditedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *MessageMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case message.FieldRoomID:
+		return m.RoomID()
+	case message.FieldUserID:
+		return m.UserID()
+	case message.FieldContent:
+		return m.Content()
+	case message.FieldType:
+		return m.GetType()
+	case message.FieldPayload:
+		return m.Payload()
+	case message.FieldFileURL:
+		return m.FileURL()
+	case message.FieldCreatedAt:
+		return m.CreatedAt()
+	case message.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case message.FieldDeletedAt:
+		return m.DeletedAt()
+	case message.FieldParentID:
+		return m.ParentID()
+	case message.FieldReplyToID:
+		return m.ReplyToID()
+	case message.FieldReplyCount:
+		return m.ReplyCount()
+	case message.FieldLastReplyAt:
+		return m.LastReplyAt()
+	case message.FieldEditedAt:
+		return m.EditedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *MessageMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case message.FieldRoomID:
+		return m.OldRoomID(ctx)
+	case message.FieldUserID:
+		return m.OldUserID(ctx)
+	case message.FieldContent:
+		return m.OldContent(ctx)
+	case message.FieldType:
+		return m.OldType(ctx)
+	case message.FieldPayload:
+		return m.OldPayload(ctx)
+	case message.FieldFileURL:
+		return m.OldFileURL(ctx)
+	case message.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case message.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case message.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	case message.FieldParentID:
+		return m.OldParentID(ctx)
+	case message.FieldReplyToID:
+		return m.OldReplyToID(ctx)
+	case message.FieldReplyCount:
+		return m.OldReplyCount(ctx)
+	case message.FieldLastReplyAt:
+		return m.OldLastReplyAt(ctx)
+	case message.FieldEditedAt:
+		return m.OldEditedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Message field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MessageMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case message.FieldRoomID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRoomID(v)
+		return nil
+	case message.FieldUserID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case message.FieldContent:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContent(v)
+		return nil
+	case message.FieldType:
+		v, ok := value.(message.Type)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetType(v)
+		return nil
+	case message.FieldPayload:
+		v, ok := value.(json.RawMessage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPayload(v)
+		return nil
+	case message.FieldFileURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFileURL(v)
+		return nil
+	case message.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case message.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case message.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	case message.FieldParentID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetParentID(v)
+		return nil
+	case message.FieldReplyToID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReplyToID(v)
+		return nil
+	case message.FieldReplyCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReplyCount(v)
+		return nil
+	case message.FieldLastReplyAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastReplyAt(v)
+		return nil
+	case message.FieldEditedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEditedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Message field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *MessageMutation) AddedFields() []string {
+	var fields []string
+	if m.addreply_count != nil {
+		fields = append(fields, message.FieldReplyCount)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *MessageMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case message.FieldReplyCount:
+		return m.AddedReplyCount()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MessageMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case message.FieldReplyCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddReplyCount(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Message numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *MessageMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(message.FieldPayload) {
+		fields = append(fields, message.FieldPayload)
+	}
+	if m.FieldCleared(message.FieldFileURL) {
+		fields = append(fields, message.FieldFileURL)
+	}
+	if m.FieldCleared(message.FieldDeletedAt) {
+		fields = append(fields, message.FieldDeletedAt)
+	}
+	if m.FieldCleared(message.FieldParentID) {
+		fields = append(fields, message.FieldParentID)
+	}
+	if m.FieldCleared(message.FieldReplyToID) {
+		fields = append(fields, message.FieldReplyToID)
+	}
+	if m.FieldCleared(message.FieldLastReplyAt) {
+		fields = append(fields, message.FieldLastReplyAt)
+	}
+	if m.FieldCleared(message.FieldEditedAt) {
+		fields = append(fields, message.FieldEditedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *MessageMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *MessageMutation) ClearField(name string) error {
+	switch name {
+	case message.FieldPayload:
+		m.ClearPayload()
+		return nil
+	case message.FieldFileURL:
+		m.ClearFileURL()
+		return nil
+	case message.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
+	case message.FieldParentID:
+		m.ClearParentID()
+		return nil
+	case message.FieldReplyToID:
+		m.ClearReplyToID()
+		return nil
+	case message.FieldLastReplyAt:
+		m.ClearLastReplyAt()
+		return nil
+	case message.FieldEditedAt:
+		m.ClearEditedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Message nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *MessageMutation) ResetField(name string) error {
+	switch name {
+	case message.FieldRoomID:
+		m.ResetRoomID()
+		return nil
+	case message.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case message.FieldContent:
+		m.ResetContent()
+		return nil
+	case message.FieldType:
+		m.ResetType()
+		return nil
+	case message.FieldPayload:
+		m.ResetPayload()
+		return nil
+	case message.FieldFileURL:
+		m.ResetFileURL()
+		return nil
+	case message.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case message.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case message.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	case message.FieldParentID:
+		m.ResetParentID()
+		return nil
+	case message.FieldReplyToID:
+		m.ResetReplyToID()
+		return nil
+	case message.FieldReplyCount:
+		m.ResetReplyCount()
+		return nil
+	case message.FieldLastReplyAt:
+		m.ResetLastReplyAt()
+		return nil
+	case message.FieldEditedAt:
+		m.ResetEditedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Message field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *MessageMutation) AddedEdges() []string {
+	edges := make([]string, 0, 9)
+	if m.room != nil {
+		edges = append(edges, message.EdgeRoom)
+	}
+	if m.sender != nil {
+		edges = append(edges, message.EdgeSender)
+	}
+	if m.reads != nil {
+		edges = append(edges, message.EdgeReads)
+	}
+	if m.reactions != nil {
+		edges = append(edges, message.EdgeReactions)
+	}
+	if m.revisions != nil {
+		edges = append(edges, message.EdgeRevisions)
+	}
+	if m.parent != nil {
+		edges = append(edges, message.EdgeParent)
+	}
+	if m.replies != nil {
+		edges = append(edges, message.EdgeReplies)
+	}
+	if m.reply_to != nil {
+		edges = append(edges, message.EdgeReplyTo)
+	}
+	if m.quotes != nil {
+		edges = append(edges, message.EdgeQuotes)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *MessageMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case message.EdgeRoom:
+		if id := m.room; id != nil {
+			return []ent.Value{*id}
+		}
+	case message.EdgeSender:
+		if id := m.sender; id != nil {
+			return []ent.Value{*id}
+		}
+	case message.EdgeReads:
+		ids := make([]ent.Value, 0, len(m.reads))
+		for id := range m.reads {
+			ids = append(ids, id)
+		}
+		return ids
+	case message.EdgeReactions:
+		ids := make([]ent.Value, 0, len(m.reactions))
+		for id := range m.reactions {
+			ids = append(ids, id)
+		}
+		return ids
+	case message.EdgeRevisions:
+		ids := make([]ent.Value, 0, len(m.revisions))
+		for id := range m.revisions {
+			ids = append(ids, id)
+		}
+		return ids
+	case message.EdgeParent:
+		if id := m.parent; id != nil {
+			return []ent.Value{*id}
+		}
+	case message.EdgeReplies:
+		ids := make([]ent.Value, 0, len(m.replies))
+		for id := range m.replies {
+			ids = append(ids, id)
+		}
+		return ids
+	case message.EdgeReplyTo:
+		if id := m.reply_to; id != nil {
+			return []ent.Value{*id}
+		}
+	case message.EdgeQuotes:
+		ids := make([]ent.Value, 0, len(m.quotes))
+		for id := range m.quotes {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *MessageMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 9)
+	if m.removedreads != nil {
+		edges = append(edges, message.EdgeReads)
+	}
+	if m.removedreactions != nil {
+		edges = append(edges, message.EdgeReactions)
+	}
+	if m.removedrevisions != nil {
+		edges = append(edges, message.EdgeRevisions)
+	}
+	if m.removedreplies != nil {
+		edges = append(edges, message.EdgeReplies)
+	}
+	if m.removedquotes != nil {
+		edges = append(edges, message.EdgeQuotes)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *MessageMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case message.EdgeReads:
+		ids := make([]ent.Value, 0, len(m.removedreads))
+		for id := range m.removedreads {
+			ids = append(ids, id)
+		}
+		return ids
+	case message.EdgeReactions:
+		ids := make([]ent.Value, 0, len(m.removedreactions))
+		for id := range m.removedreactions {
+			ids = append(ids, id)
+		}
+		return ids
+	case message.EdgeRevisions:
+		ids := make([]ent.Value, 0, len(m.removedrevisions))
+		for id := range m.removedrevisions {
+			ids = append(ids, id)
+		}
+		return ids
+	case message.EdgeReplies:
+		ids := make([]ent.Value, 0, len(m.removedreplies))
+		for id := range m.removedreplies {
+			ids = append(ids, id)
+		}
+		return ids
+	case message.EdgeQuotes:
+		ids := make([]ent.Value, 0, len(m.removedquotes))
+		for id := range m.removedquotes {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *MessageMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 9)
+	if m.clearedroom {
+		edges = append(edges, message.EdgeRoom)
+	}
+	if m.clearedsender {
+		edges = append(edges, message.EdgeSender)
+	}
+	if m.clearedreads {
+		edges = append(edges, message.EdgeReads)
+	}
+	if m.clearedreactions {
+		edges = append(edges, message.EdgeReactions)
+	}
+	if m.clearedrevisions {
+		edges = append(edges, message.EdgeRevisions)
+	}
+	if m.clearedparent {
+		edges = append(edges, message.EdgeParent)
+	}
+	if m.clearedreplies {
+		edges = append(edges, message.EdgeReplies)
+	}
+	if m.clearedreply_to {
+		edges = append(edges, message.EdgeReplyTo)
+	}
+	if m.clearedquotes {
+		edges = append(edges, message.EdgeQuotes)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *MessageMutation) EdgeCleared(name string) bool {
+	switch name {
+	case message.EdgeRoom:
+		return m.clearedroom
+	case message.EdgeSender:
+		return m.clearedsender
+	case message.EdgeReads:
+		return m.clearedreads
+	case message.EdgeReactions:
+		return m.clearedreactions
+	case message.EdgeRevisions:
+		return m.clearedrevisions
+	case message.EdgeParent:
+		return m.clearedparent
+	case message.EdgeReplies:
+		return m.clearedreplies
+	case message.EdgeReplyTo:
+		return m.clearedreply_to
+	case message.EdgeQuotes:
+		return m.clearedquotes
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *MessageMutation) ClearEdge(name string) error {
+	switch name {
+	case message.EdgeRoom:
+		m.ClearRoom()
+		return nil
+	case message.EdgeSender:
+		m.ClearSender()
+		return nil
+	case message.EdgeParent:
+		m.ClearParent()
+		return nil
+	case message.EdgeReplyTo:
+		m.ClearReplyTo()
+		return nil
+	}
+	return fmt.Errorf("unknown Message unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *MessageMutation) ResetEdge(name string) error {
+	switch name {
+	case message.EdgeRoom:
+		m.ResetRoom()
+		return nil
+	case message.EdgeSender:
+		m.ResetSender()
+		return nil
+	case message.EdgeReads:
+		m.ResetReads()
+		return nil
+	case message.EdgeReactions:
+		m.ResetReactions()
+		return nil
+	case message.EdgeRevisions:
+		m.ResetRevisions()
+		return nil
+	case message.EdgeParent:
+		m.ResetParent()
+		return nil
+	case message.EdgeReplies:
+		m.ResetReplies()
+		return nil
+	case message.EdgeReplyTo:
+		m.ResetReplyTo()
+		return nil
+	case message.EdgeQuotes:
+		m.ResetQuotes()
+		return nil
+	}
+	return fmt.Errorf("unknown Message edge %s", name)
+}
+
+// MessageReactionMutation represents an operation that mutates the MessageReaction nodes in the graph.
+type MessageReactionMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *int64
+	emoji          *string
+	created_at     *time.Time
+	clearedFields  map[string]struct{}
+	message        *uuid.UUID
+	clearedmessage bool
+	user           *uuid.UUID
+	cleareduser    bool
+	done           bool
+	oldValue       func(context.Context) (*MessageReaction, error)
+	predicates     []predicate.MessageReaction
+}
+
+var _ ent.Mutation = (*MessageReactionMutation)(nil)
+
+// messagereactionOption allows management of the mutation configuration using functional options.
+type messagereactionOption func(*MessageReactionMutation)
+
+// newMessageReactionMutation creates new mutation for the MessageReaction entity.
+func newMessageReactionMutation(c config, op Op, opts ...messagereactionOption) *MessageReactionMutation {
+	m := &MessageReactionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMessageReaction,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withMessageReactionID sets the ID field of the mutation.
+func withMessageReactionID(id int64) messagereactionOption {
+	return func(m *MessageReactionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *MessageReaction
+		)
+		m.oldValue = func(ctx context.Context) (*MessageReaction, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().MessageReaction.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withMessageReaction sets the old MessageReaction of the mutation.
+func withMessageReaction(node *MessageReaction) messagereactionOption {
+	return func(m *MessageReactionMutation) {
+		m.oldValue = func(context.Context) (*MessageReaction, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MessageReactionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MessageReactionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of MessageReaction entities.
+func (m *MessageReactionMutation) SetID(id int64) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *MessageReactionMutation) ID() (id int64, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *MessageReactionMutation) IDs(ctx context.Context) ([]int64, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int64{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().MessageReaction.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetMessageID sets the "message_id" field.
+func (m *MessageReactionMutation) SetMessageID(u uuid.UUID) {
+	m.message = &u
+}
+
+// MessageID returns the value of the "message_id" field in the mutation.
+func (m *MessageReactionMutation) MessageID() (r uuid.UUID, exists bool) {
+	v := m.message
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMessageID returns the old "message_id" field's value of the MessageReaction entity.
+// If the MessageReaction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageReactionMutation) OldMessageID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMessageID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMessageID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMessageID: %w", err)
+	}
+	return oldValue.MessageID, nil
+}
+
+// ResetMessageID resets all changes to the "message_id" field.
+func (m *MessageReactionMutation) ResetMessageID() {
+	m.message = nil
+}
+
+// SetUserID sets the "user_id" field.
+func (m *MessageReactionMutation) SetUserID(u uuid.UUID) {
+	m.user = &u
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *MessageReactionMutation) UserID() (r uuid.UUID, exists bool) {
+	v := m.user
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the MessageReaction entity.
+// If the MessageReaction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageReactionMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *MessageReactionMutation) ResetUserID() {
+	m.user = nil
+}
+
+// SetEmoji sets the "emoji" field.
+func (m *MessageReactionMutation) SetEmoji(s string) {
+	m.emoji = &s
+}
+
+// Emoji returns the value of the "emoji" field in the mutation.
+func (m *MessageReactionMutation) Emoji() (r string, exists bool) {
+	v := m.emoji
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEmoji returns the old "emoji" field's value of the MessageReaction entity.
+// If the MessageReaction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageReactionMutation) OldEmoji(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmoji is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmoji requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmoji: %w", err)
+	}
+	return oldValue.Emoji, nil
+}
+
+// ResetEmoji resets all changes to the "emoji" field.
+func (m *MessageReactionMutation) ResetEmoji() {
+	m.emoji = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *MessageReactionMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *MessageReactionMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the MessageReaction entity.
+// If the MessageReaction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageReactionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *MessageReactionMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// ClearMessage clears the "message" edge to the Message entity.
+func (m *MessageReactionMutation) ClearMessage() {
+	m.clearedmessage = true
+	m.clearedFields[messagereaction.FieldMessageID] = struct{}{}
+}
+
+// MessageCleared reports if the "message" edge to the Message entity was cleared.
+func (m *MessageReactionMutation) MessageCleared() bool {
+	return m.clearedmessage
+}
+
+// MessageIDs returns the "message" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// MessageID instead. It exists only for internal usage by the builders.
+func (m *MessageReactionMutation) MessageIDs() (ids []uuid.UUID) {
+	if id := m.message; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetMessage resets all changes to the "message" edge.
+func (m *MessageReactionMutation) ResetMessage() {
+	m.message = nil
+	m.clearedmessage = false
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *MessageReactionMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[messagereaction.FieldUserID] = struct{}{}
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *MessageReactionMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *MessageReactionMutation) UserIDs() (ids []uuid.UUID) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *MessageReactionMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// Where appends a list predicates to the MessageReactionMutation builder.
+func (m *MessageReactionMutation) Where(ps ...predicate.MessageReaction) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the MessageReactionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *MessageReactionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.MessageReaction, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *MessageReactionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *MessageReactionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (MessageReaction).
+func (m *MessageReactionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *MessageReactionMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.message != nil {
+		fields = append(fields, messagereaction.FieldMessageID)
+	}
+	if m.user != nil {
+		fields = append(fields, messagereaction.FieldUserID)
+	}
+	if m.emoji != nil {
+		fields = append(fields, messagereaction.FieldEmoji)
+	}
+	if m.created_at != nil {
+		fields = append(fields, messagereaction.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *MessageReactionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case messagereaction.FieldMessageID:
+		return m.MessageID()
+	case messagereaction.FieldUserID:
+		return m.UserID()
+	case messagereaction.FieldEmoji:
+		return m.Emoji()
+	case messagereaction.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *MessageReactionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case messagereaction.FieldMessageID:
+		return m.OldMessageID(ctx)
+	case messagereaction.FieldUserID:
+		return m.OldUserID(ctx)
+	case messagereaction.FieldEmoji:
+		return m.OldEmoji(ctx)
+	case messagereaction.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown MessageReaction field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MessageReactionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case messagereaction.FieldMessageID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMessageID(v)
+		return nil
+	case messagereaction.FieldUserID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case messagereaction.FieldEmoji:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEmoji(v)
+		return nil
+	case messagereaction.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown MessageReaction field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *MessageReactionMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *MessageReactionMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MessageReactionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown MessageReaction numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *MessageReactionMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *MessageReactionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *MessageReactionMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown MessageReaction nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *MessageReactionMutation) ResetField(name string) error {
+	switch name {
+	case messagereaction.FieldMessageID:
+		m.ResetMessageID()
+		return nil
+	case messagereaction.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case messagereaction.FieldEmoji:
+		m.ResetEmoji()
+		return nil
+	case messagereaction.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown MessageReaction field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *MessageReactionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.message != nil {
+		edges = append(edges, messagereaction.EdgeMessage)
+	}
+	if m.user != nil {
+		edges = append(edges, messagereaction.EdgeUser)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *MessageReactionMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case messagereaction.EdgeMessage:
+		if id := m.message; id != nil {
+			return []ent.Value{*id}
+		}
+	case messagereaction.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *MessageReactionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *MessageReactionMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *MessageReactionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedmessage {
+		edges = append(edges, messagereaction.EdgeMessage)
+	}
+	if m.cleareduser {
+		edges = append(edges, messagereaction.EdgeUser)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *MessageReactionMutation) EdgeCleared(name string) bool {
+	switch name {
+	case messagereaction.EdgeMessage:
+		return m.clearedmessage
+	case messagereaction.EdgeUser:
+		return m.cleareduser
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *MessageReactionMutation) ClearEdge(name string) error {
+	switch name {
+	case messagereaction.EdgeMessage:
+		m.ClearMessage()
+		return nil
+	case messagereaction.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown MessageReaction unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *MessageReactionMutation) ResetEdge(name string) error {
+	switch name {
+	case messagereaction.EdgeMessage:
+		m.ResetMessage()
+		return nil
+	case messagereaction.EdgeUser:
+		m.ResetUser()
+		return nil
+	}
+	return fmt.Errorf("unknown MessageReaction edge %s", name)
+}
+
+// MessageReadMutation represents an operation that mutates the MessageRead nodes in the graph.
+type MessageReadMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *int64
+	read_at        *time.Time
+	clearedFields  map[string]struct{}
+	room           *uuid.UUID
+	clearedroom    bool
+	user           *uuid.UUID
+	cleareduser    bool
+	message        *uuid.UUID
+	clearedmessage bool
+	done           bool
+	oldValue       func(context.Context) (*MessageRead, error)
+	predicates     []predicate.MessageRead
+}
+
+var _ ent.Mutation = (*MessageReadMutation)(nil)
+
+// messagereadOption allows management of the mutation configuration using functional options.
+type messagereadOption func(*MessageReadMutation)
+
+// newMessageReadMutation creates new mutation for the MessageRead entity.
+func newMessageReadMutation(c config, op Op, opts ...messagereadOption) *MessageReadMutation {
+	m := &MessageReadMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMessageRead,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withMessageReadID sets the ID field of the mutation.
+func withMessageReadID(id int64) messagereadOption {
+	return func(m *MessageReadMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *MessageRead
+		)
+		m.oldValue = func(ctx context.Context) (*MessageRead, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().MessageRead.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withMessageRead sets the old MessageRead of the mutation.
+func withMessageRead(node *MessageRead) messagereadOption {
+	return func(m *MessageReadMutation) {
+		m.oldValue = func(context.Context) (*MessageRead, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MessageReadMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MessageReadMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of MessageRead entities.
+func (m *MessageReadMutation) SetID(id int64) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *MessageReadMutation) ID() (id int64, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *MessageReadMutation) IDs(ctx context.Context) ([]int64, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int64{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().MessageRead.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetRoomID sets the "room_id" field.
+func (m *MessageReadMutation) SetRoomID(u uuid.UUID) {
+	m.room = &u
+}
+
+// RoomID returns the value of the "room_id" field in the mutation.
+func (m *MessageReadMutation) RoomID() (r uuid.UUID, exists bool) {
+	v := m.room
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRoomID returns the old "room_id" field's value of the MessageRead entity.
+// If the MessageRead object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageReadMutation) OldRoomID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRoomID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRoomID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRoomID: %w", err)
+	}
+	return oldValue.RoomID, nil
+}
+
+// ResetRoomID resets all changes to the "room_id" field.
+func (m *MessageReadMutation) ResetRoomID() {
+	m.room = nil
+}
+
+// SetUserID sets the "user_id" field.
+func (m *MessageReadMutation) SetUserID(u uuid.UUID) {
+	m.user = &u
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *MessageReadMutation) UserID() (r uuid.UUID, exists bool) {
+	v := m.user
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the MessageRead entity.
+// If the MessageRead object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageReadMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *MessageReadMutation) ResetUserID() {
+	m.user = nil
+}
+
+// SetMessageID sets the "message_id" field.
+func (m *MessageReadMutation) SetMessageID(u uuid.UUID) {
+	m.message = &u
+}
+
+// MessageID returns the value of the "message_id" field in the mutation.
+func (m *MessageReadMutation) MessageID() (r uuid.UUID, exists bool) {
+	v := m.message
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMessageID returns the old "message_id" field's value of the MessageRead entity.
+// If the MessageRead object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageReadMutation) OldMessageID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMessageID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMessageID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMessageID: %w", err)
+	}
+	return oldValue.MessageID, nil
+}
+
+// ResetMessageID resets all changes to the "message_id" field.
+func (m *MessageReadMutation) ResetMessageID() {
+	m.message = nil
+}
+
+// SetReadAt sets the "read_at" field.
+func (m *MessageReadMutation) SetReadAt(t time.Time) {
+	m.read_at = &t
+}
+
+// ReadAt returns the value of the "read_at" field in the mutation.
+func (m *MessageReadMutation) ReadAt() (r time.Time, exists bool) {
+	v := m.read_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReadAt returns the old "read_at" field's value of the MessageRead entity.
+// If the MessageRead object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageReadMutation) OldReadAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReadAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReadAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReadAt: %w", err)
+	}
+	return oldValue.ReadAt, nil
+}
+
+// ResetReadAt resets all changes to the "read_at" field.
+func (m *MessageReadMutation) ResetReadAt() {
+	m.read_at = nil
+}
+
+// ClearRoom clears the "room" edge to the ChatRoom entity.
+func (m *MessageReadMutation) ClearRoom() {
+	m.clearedroom = true
+	m.clearedFields[messageread.FieldRoomID] = struct{}{}
+}
+
+// RoomCleared reports if the "room" edge to the ChatRoom entity was cleared.
+func (m *MessageReadMutation) RoomCleared() bool {
+	return m.clearedroom
+}
+
+// RoomIDs returns the "room" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// RoomID instead. It exists only for internal usage by the builders.
+func (m *MessageReadMutation) RoomIDs() (ids []uuid.UUID) {
+	if id := m.room; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetRoom resets all changes to the "room" edge.
+func (m *MessageReadMutation) ResetRoom() {
+	m.room = nil
+	m.clearedroom = false
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *MessageReadMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[messageread.FieldUserID] = struct{}{}
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *MessageReadMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *MessageReadMutation) UserIDs() (ids []uuid.UUID) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *MessageReadMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// ClearMessage clears the "message" edge to the Message entity.
+func (m *MessageReadMutation) ClearMessage() {
+	m.clearedmessage = true
+	m.clearedFields[messageread.FieldMessageID] = struct{}{}
+}
+
+// MessageCleared reports if the "message" edge to the Message entity was cleared.
+func (m *MessageReadMutation) MessageCleared() bool {
+	return m.clearedmessage
+}
+
+// MessageIDs returns the "message" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// MessageID instead. It exists only for internal usage by the builders.
+func (m *MessageReadMutation) MessageIDs() (ids []uuid.UUID) {
+	if id := m.message; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetMessage resets all changes to the "message" edge.
+func (m *MessageReadMutation) ResetMessage() {
+	m.message = nil
+	m.clearedmessage = false
+}
+
+// Where appends a list predicates to the MessageReadMutation builder.
+func (m *MessageReadMutation) Where(ps ...predicate.MessageRead) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the MessageReadMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *MessageReadMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.MessageRead, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *MessageReadMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *MessageReadMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (MessageRead).
+func (m *MessageReadMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *MessageReadMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.room != nil {
+		fields = append(fields, messageread.FieldRoomID)
+	}
+	if m.user != nil {
+		fields = append(fields, messageread.FieldUserID)
+	}
+	if m.message != nil {
+		fields = append(fields, messageread.FieldMessageID)
+	}
+	if m.read_at != nil {
+		fields = append(fields, messageread.FieldReadAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *MessageReadMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case messageread.FieldRoomID:
+		return m.RoomID()
+	case messageread.FieldUserID:
+		return m.UserID()
+	case messageread.FieldMessageID:
+		return m.MessageID()
+	case messageread.FieldReadAt:
+		return m.ReadAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *MessageReadMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case messageread.FieldRoomID:
+		return m.OldRoomID(ctx)
+	case messageread.FieldUserID:
+		return m.OldUserID(ctx)
+	case messageread.FieldMessageID:
+		return m.OldMessageID(ctx)
+	case messageread.FieldReadAt:
+		return m.OldReadAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown MessageRead field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MessageReadMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case messageread.FieldRoomID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRoomID(v)
+		return nil
+	case messageread.FieldUserID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case messageread.FieldMessageID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMessageID(v)
+		return nil
+	case messageread.FieldReadAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReadAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown MessageRead field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *MessageReadMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *MessageReadMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MessageReadMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown MessageRead numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *MessageReadMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *MessageReadMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *MessageReadMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown MessageRead nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *MessageReadMutation) ResetField(name string) error {
+	switch name {
+	case messageread.FieldRoomID:
+		m.ResetRoomID()
+		return nil
+	case messageread.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case messageread.FieldMessageID:
+		m.ResetMessageID()
+		return nil
+	case messageread.FieldReadAt:
+		m.ResetReadAt()
+		return nil
+	}
+	return fmt.Errorf("unknown MessageRead field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *MessageReadMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.room != nil {
+		edges = append(edges, messageread.EdgeRoom)
+	}
+	if m.user != nil {
+		edges = append(edges, messageread.EdgeUser)
+	}
+	if m.message != nil {
+		edges = append(edges, messageread.EdgeMessage)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *MessageReadMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case messageread.EdgeRoom:
+		if id := m.room; id != nil {
+			return []ent.Value{*id}
+		}
+	case messageread.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	case messageread.EdgeMessage:
+		if id := m.message; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *MessageReadMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *MessageReadMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *MessageReadMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedroom {
+		edges = append(edges, messageread.EdgeRoom)
+	}
+	if m.cleareduser {
+		edges = append(edges, messageread.EdgeUser)
+	}
+	if m.clearedmessage {
+		edges = append(edges, messageread.EdgeMessage)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *MessageReadMutation) EdgeCleared(name string) bool {
+	switch name {
+	case messageread.EdgeRoom:
+		return m.clearedroom
+	case messageread.EdgeUser:
+		return m.cleareduser
+	case messageread.EdgeMessage:
+		return m.clearedmessage
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *MessageReadMutation) ClearEdge(name string) error {
+	switch name {
+	case messageread.EdgeRoom:
+		m.ClearRoom()
+		return nil
+	case messageread.EdgeUser:
+		m.ClearUser()
+		return nil
+	case messageread.EdgeMessage:
+		m.ClearMessage()
+		return nil
+	}
+	return fmt.Errorf("unknown MessageRead unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *MessageReadMutation) ResetEdge(name string) error {
+	switch name {
+	case messageread.EdgeRoom:
+		m.ResetRoom()
+		return nil
+	case messageread.EdgeUser:
+		m.ResetUser()
+		return nil
+	case messageread.EdgeMessage:
+		m.ResetMessage()
+		return nil
+	}
+	return fmt.Errorf("unknown MessageRead edge %s", name)
+}
+
+// MessageRevisionMutation represents an operation that mutates the MessageRevision nodes in the graph.
+type MessageRevisionMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *int64
+	content        *string
+	created_at     *time.Time
+	clearedFields  map[string]struct{}
+	message        *uuid.UUID
+	clearedmessage bool
+	editor         *uuid.UUID
+	clearededitor  bool
+	done           bool
+	oldValue       func(context.Context) (*MessageRevision, error)
+	predicates     []predicate.MessageRevision
+}
+
+var _ ent.Mutation = (*MessageRevisionMutation)(nil)
+
+// messagerevisionOption allows management of the mutation configuration using functional options.
+type messagerevisionOption func(*MessageRevisionMutation)
+
+// newMessageRevisionMutation creates new mutation for the MessageRevision entity.
+func newMessageRevisionMutation(c config, op Op, opts ...messagerevisionOption) *MessageRevisionMutation {
+	m := &MessageRevisionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMessageRevision,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withMessageRevisionID sets the ID field of the mutation.
+func withMessageRevisionID(id int64) messagerevisionOption {
+	return func(m *MessageRevisionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *MessageRevision
+		)
+		m.oldValue = func(ctx context.Context) (*MessageRevision, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().MessageRevision.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withMessageRevision sets the old MessageRevision of the mutation.
+func withMessageRevision(node *MessageRevision) messagerevisionOption {
+	return func(m *MessageRevisionMutation) {
+		m.oldValue = func(context.Context) (*MessageRevision, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MessageRevisionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MessageRevisionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of MessageRevision entities.
+func (m *MessageRevisionMutation) SetID(id int64) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *MessageRevisionMutation) ID() (id int64, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *MessageRevisionMutation) IDs(ctx context.Context) ([]int64, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int64{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().MessageRevision.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetMessageID sets the "message_id" field.
+func (m *MessageRevisionMutation) SetMessageID(u uuid.UUID) {
+	m.message = &u
+}
+
+// MessageID returns the value of the "message_id" field in the mutation.
+func (m *MessageRevisionMutation) MessageID() (r uuid.UUID, exists bool) {
+	v := m.message
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMessageID returns the old "message_id" field's value of the MessageRevision entity.
+// If the MessageRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageRevisionMutation) OldMessageID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMessageID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMessageID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMessageID: %w", err)
+	}
+	return oldValue.MessageID, nil
+}
+
+// ResetMessageID resets all changes to the "message_id" field.
+func (m *MessageRevisionMutation) ResetMessageID() {
+	m.message = nil
+}
+
+// SetEditorID sets the "editor_id" field.
+func (m *MessageRevisionMutation) SetEditorID(u uuid.UUID) {
+	m.editor = &u
+}
+
+// EditorID returns the value of the "editor_id" field in the mutation.
+func (m *MessageRevisionMutation) EditorID() (r uuid.UUID, exists bool) {
+	v := m.editor
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEditorID returns the old "editor_id" field's value of the MessageRevision entity.
+// If the MessageRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageRevisionMutation) OldEditorID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEditorID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEditorID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEditorID: %w", err)
+	}
+	return oldValue.EditorID, nil
+}
+
+// ResetEditorID resets all changes to the "editor_id" field.
+func (m *MessageRevisionMutation) ResetEditorID() {
+	m.editor = nil
+}
+
+// SetContent sets the "content" field.
+func (m *MessageRevisionMutation) SetContent(s string) {
+	m.content = &s
+}
+
+// Content returns the value of the "content" field in the mutation.
+func (m *MessageRevisionMutation) Content() (r string, exists bool) {
+	v := m.content
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldContent returns the old "content" field's value of the MessageRevision entity.
+// If the MessageRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageRevisionMutation) OldContent(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContent: %w", err)
+	}
+	return oldValue.Content, nil
+}
+
+// ResetContent resets all changes to the "content" field.
+func (m *MessageRevisionMutation) ResetContent() {
+	m.content = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *MessageRevisionMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *MessageRevisionMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the MessageRevision entity.
+// If the MessageRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageRevisionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *MessageRevisionMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// ClearMessage clears the "message" edge to the Message entity.
+func (m *MessageRevisionMutation) ClearMessage() {
+	m.clearedmessage = true
+	m.clearedFields[messagerevision.FieldMessageID] = struct{}{}
+}
+
+// MessageCleared reports if the "message" edge to the Message entity was cleared.
+func (m *MessageRevisionMutation) MessageCleared() bool {
+	return m.clearedmessage
+}
+
+// MessageIDs returns the "message" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// MessageID instead. It exists only for internal usage by the builders.
+func (m *MessageRevisionMutation) MessageIDs() (ids []uuid.UUID) {
+	if id := m.message; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetMessage resets all changes to the "message" edge.
+func (m *MessageRevisionMutation) ResetMessage() {
+	m.message = nil
+	m.clearedmessage = false
+}
+
+// ClearEditor clears the "editor" edge to the User entity.
+func (m *MessageRevisionMutation) ClearEditor() {
+	m.clearededitor = true
+	m.clearedFields[messagerevision.FieldEditorID] = struct{}{}
+}
+
+// EditorCleared reports if the "editor" edge to the User entity was cleared.
+func (m *MessageRevisionMutation) EditorCleared() bool {
+	return m.clearededitor
+}
+
+// EditorIDs returns the "editor" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// EditorID instead. It exists only for internal usage by the builders.
+func (m *MessageRevisionMutation) EditorIDs() (ids []uuid.UUID) {
+	if id := m.editor; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetEditor resets all changes to the "editor" edge.
+func (m *MessageRevisionMutation) ResetEditor() {
+	m.editor = nil
+	m.clearededitor = false
+}
+
+// Where appends a list predicates to the MessageRevisionMutation builder.
+func (m *MessageRevisionMutation) Where(ps ...predicate.MessageRevision) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the MessageRevisionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *MessageRevisionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.MessageRevision, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *MessageRevisionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *MessageRevisionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (MessageRevision).
+func (m *MessageRevisionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *MessageRevisionMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.message != nil {
+		fields = append(fields, messagerevision.FieldMessageID)
+	}
+	if m.editor != nil {
+		fields = append(fields, messagerevision.FieldEditorID)
+	}
+	if m.content != nil {
+		fields = append(fields, messagerevision.FieldContent)
+	}
+	if m.created_at != nil {
+		fields = append(fields, messagerevision.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *MessageRevisionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case messagerevision.FieldMessageID:
+		return m.MessageID()
+	case messagerevision.FieldEditorID:
+		return m.EditorID()
+	case messagerevision.FieldContent:
+		return m.Content()
+	case messagerevision.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *MessageRevisionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case messagerevision.FieldMessageID:
+		return m.OldMessageID(ctx)
+	case messagerevision.FieldEditorID:
+		return m.OldEditorID(ctx)
+	case messagerevision.FieldContent:
+		return m.OldContent(ctx)
+	case messagerevision.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown MessageRevision field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MessageRevisionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case messagerevision.FieldMessageID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMessageID(v)
+		return nil
+	case messagerevision.FieldEditorID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEditorID(v)
+		return nil
+	case messagerevision.FieldContent:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContent(v)
+		return nil
+	case messagerevision.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown MessageRevision field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *MessageRevisionMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *MessageRevisionMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MessageRevisionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown MessageRevision numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *MessageRevisionMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *MessageRevisionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *MessageRevisionMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown MessageRevision nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *MessageRevisionMutation) ResetField(name string) error {
+	switch name {
+	case messagerevision.FieldMessageID:
+		m.ResetMessageID()
+		return nil
+	case messagerevision.FieldEditorID:
+		m.ResetEditorID()
+		return nil
+	case messagerevision.FieldContent:
+		m.ResetContent()
+		return nil
+	case messagerevision.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown MessageRevision field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *MessageRevisionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.message != nil {
+		edges = append(edges, messagerevision.EdgeMessage)
+	}
+	if m.editor != nil {
+		edges = append(edges, messagerevision.EdgeEditor)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *MessageRevisionMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case messagerevision.EdgeMessage:
+		if id := m.message; id != nil {
+			return []ent.Value{*id}
+		}
+	case messagerevision.EdgeEditor:
+		if id := m.editor; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *MessageRevisionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *MessageRevisionMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *MessageRevisionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedmessage {
+		edges = append(edges, messagerevision.EdgeMessage)
+	}
+	if m.clearededitor {
+		edges = append(edges, messagerevision.EdgeEditor)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *MessageRevisionMutation) EdgeCleared(name string) bool {
+	switch name {
+	case messagerevision.EdgeMessage:
+		return m.clearedmessage
+	case messagerevision.EdgeEditor:
+		return m.clearededitor
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *MessageRevisionMutation) ClearEdge(name string) error {
+	switch name {
+	case messagerevision.EdgeMessage:
+		m.ClearMessage()
+		return nil
+	case messagerevision.EdgeEditor:
+		m.ClearEditor()
+		return nil
+	}
+	return fmt.Errorf("unknown MessageRevision unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *MessageRevisionMutation) ResetEdge(name string) error {
+	switch name {
+	case messagerevision.EdgeMessage:
+		m.ResetMessage()
+		return nil
+	case messagerevision.EdgeEditor:
+		m.ResetEditor()
+		return nil
+	}
+	return fmt.Errorf("unknown MessageRevision edge %s", name)
+}
+
+// RevokedTokenMutation represents an operation that mutates the RevokedToken nodes in the graph.
+type RevokedTokenMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *string
+	expires_at    *time.Time
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	user          *uuid.UUID
+	cleareduser   bool
+	done          bool
+	oldValue      func(context.Context) (*RevokedToken, error)
+	predicates    []predicate.RevokedToken
+}
+
+var _ ent.Mutation = (*RevokedTokenMutation)(nil)
+
+// revokedtokenOption allows management of the mutation configuration using functional options.
+type revokedtokenOption func(*RevokedTokenMutation)
+
+// newRevokedTokenMutation creates new mutation for the RevokedToken entity.
+func newRevokedTokenMutation(c config, op Op, opts ...revokedtokenOption) *RevokedTokenMutation {
+	m := &RevokedTokenMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeRevokedToken,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withRevokedTokenID sets the ID field of the mutation.
+func withRevokedTokenID(id string) revokedtokenOption {
+	return func(m *RevokedTokenMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *RevokedToken
+		)
+		m.oldValue = func(ctx context.Context) (*RevokedToken, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().RevokedToken.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withRevokedToken sets the old RevokedToken of the mutation.
+func withRevokedToken(node *RevokedToken) revokedtokenOption {
+	return func(m *RevokedTokenMutation) {
+		m.oldValue = func(context.Context) (*RevokedToken, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m RevokedTokenMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m RevokedTokenMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of RevokedToken entities.
+func (m *RevokedTokenMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *RevokedTokenMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *RevokedTokenMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().RevokedToken.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUserID sets the "user_id" field.
+func (m *RevokedTokenMutation) SetUserID(u uuid.UUID) {
+	m.user = &u
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *RevokedTokenMutation) UserID() (r uuid.UUID, exists bool) {
+	v := m.user
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the RevokedToken entity.
+// If the RevokedToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RevokedTokenMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *RevokedTokenMutation) ResetUserID() {
+	m.user = nil
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (m *RevokedTokenMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *RevokedTokenMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiresAt returns the old "expires_at" field's value of the RevokedToken entity.
+// If the RevokedToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RevokedTokenMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+	}
+	return oldValue.ExpiresAt, nil
+}
+
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *RevokedTokenMutation) ResetExpiresAt() {
+	m.expires_at = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *RevokedTokenMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *RevokedTokenMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the RevokedToken entity.
+// If the RevokedToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RevokedTokenMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *RevokedTokenMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *RevokedTokenMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[revokedtoken.FieldUserID] = struct{}{}
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *RevokedTokenMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *RevokedTokenMutation) UserIDs() (ids []uuid.UUID) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *RevokedTokenMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// Where appends a list predicates to the RevokedTokenMutation builder.
+func (m *RevokedTokenMutation) Where(ps ...predicate.RevokedToken) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the RevokedTokenMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *RevokedTokenMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.RevokedToken, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *RevokedTokenMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *RevokedTokenMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (RevokedToken).
+func (m *RevokedTokenMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *RevokedTokenMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.user != nil {
+		fields = append(fields, revokedtoken.FieldUserID)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, revokedtoken.FieldExpiresAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, revokedtoken.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *RevokedTokenMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case revokedtoken.FieldUserID:
+		return m.UserID()
+	case revokedtoken.FieldExpiresAt:
+		return m.ExpiresAt()
+	case revokedtoken.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *RevokedTokenMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case revokedtoken.FieldUserID:
+		return m.OldUserID(ctx)
+	case revokedtoken.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case revokedtoken.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown RevokedToken field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RevokedTokenMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case revokedtoken.FieldUserID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case revokedtoken.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
+	case revokedtoken.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown RevokedToken field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *RevokedTokenMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *RevokedTokenMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RevokedTokenMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown RevokedToken numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *RevokedTokenMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *RevokedTokenMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *RevokedTokenMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown RevokedToken nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *RevokedTokenMutation) ResetField(name string) error {
+	switch name {
+	case revokedtoken.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case revokedtoken.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	case revokedtoken.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown RevokedToken field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *RevokedTokenMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.user != nil {
+		edges = append(edges, revokedtoken.EdgeUser)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *RevokedTokenMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case revokedtoken.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *RevokedTokenMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *RevokedTokenMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *RevokedTokenMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareduser {
+		edges = append(edges, revokedtoken.EdgeUser)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *RevokedTokenMutation) EdgeCleared(name string) bool {
+	switch name {
+	case revokedtoken.EdgeUser:
+		return m.cleareduser
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *RevokedTokenMutation) ClearEdge(name string) error {
+	switch name {
+	case revokedtoken.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown RevokedToken unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *RevokedTokenMutation) ResetEdge(name string) error {
+	switch name {
+	case revokedtoken.EdgeUser:
+		m.ResetUser()
+		return nil
+	}
+	return fmt.Errorf("unknown RevokedToken edge %s", name)
+}
+
+// RoomMemberMutation represents an operation that mutates the RoomMember nodes in the graph.
+type RoomMemberMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *int64
+	role            *roommember.Role
+	joined_at       *time.Time
+	archived        *bool
+	muted_until     *time.Time
+	pinned_order    *int
+	addpinned_order *int
+	clearedFields   map[string]struct{}
+	room            *uuid.UUID
+	clearedroom     bool
+	user            *uuid.UUID
+	cleareduser     bool
+	done            bool
+	oldValue        func(context.Context) (*RoomMember, error)
+	predicates      []predicate.RoomMember
+}
+
+var _ ent.Mutation = (*RoomMemberMutation)(nil)
+
+// roommemberOption allows management of the mutation configuration using functional options.
+type roommemberOption func(*RoomMemberMutation)
+
+// newRoomMemberMutation creates new mutation for the RoomMember entity.
+func newRoomMemberMutation(c config, op Op, opts ...roommemberOption) *RoomMemberMutation {
+	m := &RoomMemberMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeRoomMember,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withRoomMemberID sets the ID field of the mutation.
+func withRoomMemberID(id int64) roommemberOption {
+	return func(m *RoomMemberMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *RoomMember
+		)
+		m.oldValue = func(ctx context.Context) (*RoomMember, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().RoomMember.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withRoomMember sets the old RoomMember of the mutation.
+func withRoomMember(node *RoomMember) roommemberOption {
+	return func(m *RoomMemberMutation) {
+		m.oldValue = func(context.Context) (*RoomMember, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m RoomMemberMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m RoomMemberMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of RoomMember entities.
+func (m *RoomMemberMutation) SetID(id int64) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *RoomMemberMutation) ID() (id int64, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *RoomMemberMutation) IDs(ctx context.Context) ([]int64, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int64{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().RoomMember.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetRoomID sets the "room_id" field.
+func (m *RoomMemberMutation) SetRoomID(u uuid.UUID) {
+	m.room = &u
+}
+
+// RoomID returns the value of the "room_id" field in the mutation.
+func (m *RoomMemberMutation) RoomID() (r uuid.UUID, exists bool) {
+	v := m.room
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRoomID returns the old "room_id" field's value of the RoomMember entity.
+// If the RoomMember object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoomMemberMutation) OldRoomID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRoomID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRoomID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRoomID: %w", err)
+	}
+	return oldValue.RoomID, nil
+}
+
+// ResetRoomID resets all changes to the "room_id" field.
+func (m *RoomMemberMutation) ResetRoomID() {
+	m.room = nil
+}
+
+// SetUserID sets the "user_id" field.
+func (m *RoomMemberMutation) SetUserID(u uuid.UUID) {
+	m.user = &u
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *RoomMemberMutation) UserID() (r uuid.UUID, exists bool) {
+	v := m.user
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the RoomMember entity.
+// If the RoomMember object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoomMemberMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *RoomMemberMutation) ResetUserID() {
+	m.user = nil
+}
+
+// SetRole sets the "role" field.
+func (m *RoomMemberMutation) SetRole(r roommember.Role) {
+	m.role = &r
+}
+
+// Role returns the value of the "role" field in the mutation.
+func (m *RoomMemberMutation) Role() (r roommember.Role, exists bool) {
+	v := m.role
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRole returns the old "role" field's value of the RoomMember entity.
+// If the RoomMember object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoomMemberMutation) OldRole(ctx context.Context) (v roommember.Role, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRole is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRole requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRole: %w", err)
+	}
+	return oldValue.Role, nil
+}
+
+// ResetRole resets all changes to the "role" field.
+func (m *RoomMemberMutation) ResetRole() {
+	m.role = nil
+}
+
+// SetJoinedAt sets the "joined_at" field.
+func (m *RoomMemberMutation) SetJoinedAt(t time.Time) {
+	m.joined_at = &t
+}
+
+// JoinedAt returns the value of the "joined_at" field in the mutation.
+func (m *RoomMemberMutation) JoinedAt() (r time.Time, exists bool) {
+	v := m.joined_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldJoinedAt returns the old "joined_at" field's value of the RoomMember entity.
+// If the RoomMember object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoomMemberMutation) OldJoinedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldJoinedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldJoinedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldJoinedAt: %w", err)
+	}
+	return oldValue.JoinedAt, nil
+}
+
+// ResetJoinedAt resets all changes to the "joined_at" field.
+func (m *RoomMemberMutation) ResetJoinedAt() {
+	m.joined_at = nil
+}
+
+// SetArchived sets the "archived" field.
+func (m *RoomMemberMutation) SetArchived(b bool) {
+	m.archived = &b
+}
+
+// Archived returns the value of the "archived" field in the mutation.
+func (m *RoomMemberMutation) Archived() (r bool, exists bool) {
+	v := m.archived
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldArchived returns the old "archived" field's value of the RoomMember entity.
+// If the RoomMember object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoomMemberMutation) OldArchived(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldArchived is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldArchived requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldArchived: %w", err)
+	}
+	return oldValue.Archived, nil
+}
+
+// ResetArchived resets all changes to the "archived" field.
+func (m *RoomMemberMutation) ResetArchived() {
+	m.archived = nil
+}
+
+// SetMutedUntil sets the "muted_until" field.
+func (m *RoomMemberMutation) SetMutedUntil(t time.Time) {
+	m.muted_until = &t
+}
+
+// MutedUntil returns the value of the "muted_until" field in the mutation.
+func (m *RoomMemberMutation) MutedUntil() (r time.Time, exists bool) {
+	v := m.muted_until
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMutedUntil returns the old "muted_until" field's value of the RoomMember entity.
+// If the RoomMember object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoomMemberMutation) OldMutedUntil(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMutedUntil is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMutedUntil requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMutedUntil: %w", err)
+	}
+	return oldValue.MutedUntil, nil
+}
+
+// ClearMutedUntil clears the value of the "muted_until" field.
+func (m *RoomMemberMutation) ClearMutedUntil() {
+	m.muted_until = nil
+	m.clearedFields[roommember.FieldMutedUntil] = struct{}{}
+}
+
+// MutedUntilCleared returns if the "muted_until" field was cleared in this mutation.
+func (m *RoomMemberMutation) MutedUntilCleared() bool {
+	_, ok := m.clearedFields[roommember.FieldMutedUntil]
+	return ok
+}
+
+// ResetMutedUntil resets all changes to the "muted_until" field.
+func (m *RoomMemberMutation) ResetMutedUntil() {
+	m.muted_until = nil
+	delete(m.clearedFields, roommember.FieldMutedUntil)
+}
+
+// SetPinnedOrder sets the "pinned_order" field.
+func (m *RoomMemberMutation) SetPinnedOrder(i int) {
+	m.pinned_order = &i
+	m.addpinned_order = nil
+}
+
+// PinnedOrder returns the value of the "pinned_order" field in the mutation.
+func (m *RoomMemberMutation) PinnedOrder() (r int, exists bool) {
+	v := m.pinned_order
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPinnedOrder returns the old "pinned_order" field's value of the RoomMember entity.
+// If the RoomMember object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoomMemberMutation) OldPinnedOrder(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPinnedOrder is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPinnedOrder requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPinnedOrder: %w", err)
+	}
+	return oldValue.PinnedOrder, nil
+}
+
+// AddPinnedOrder adds i to the "pinned_order" field.
+func (m *RoomMemberMutation) AddPinnedOrder(i int) {
+	if m.addpinned_order != nil {
+		*m.addpinned_order += i
+	} else {
+		m.addpinned_order = &i
+	}
+}
+
+// AddedPinnedOrder returns the value that was added to the "pinned_order" field in this mutation.
+func (m *RoomMemberMutation) AddedPinnedOrder() (r int, exists bool) {
+	v := m.addpinned_order
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearPinnedOrder clears the value of the "pinned_order" field.
+func (m *RoomMemberMutation) ClearPinnedOrder() {
+	m.pinned_order = nil
+	m.addpinned_order = nil
+	m.clearedFields[roommember.FieldPinnedOrder] = struct{}{}
+}
+
+// PinnedOrderCleared returns if the "pinned_order" field was cleared in this mutation.
+func (m *RoomMemberMutation) PinnedOrderCleared() bool {
+	_, ok := m.clearedFields[roommember.FieldPinnedOrder]
+	return ok
+}
+
+// ResetPinnedOrder resets all changes to the "pinned_order" field.
+func (m *RoomMemberMutation) ResetPinnedOrder() {
+	m.pinned_order = nil
+	m.addpinned_order = nil
+	delete(m.clearedFields, roommember.FieldPinnedOrder)
+}
+
+// ClearRoom clears the "room" edge to the ChatRoom entity.
+func (m *RoomMemberMutation) ClearRoom() {
+	m.clearedroom = true
+	m.clearedFields[roommember.FieldRoomID] = struct{}{}
+}
+
+// RoomCleared reports if the "room" edge to the ChatRoom entity was cleared.
+func (m *RoomMemberMutation) RoomCleared() bool {
+	return m.clearedroom
+}
+
+// RoomIDs returns the "room" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// RoomID instead. It exists only for internal usage by the builders.
+func (m *RoomMemberMutation) RoomIDs() (ids []uuid.UUID) {
+	if id := m.room; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetRoom resets all changes to the "room" edge.
+func (m *RoomMemberMutation) ResetRoom() {
+	m.room = nil
+	m.clearedroom = false
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *RoomMemberMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[roommember.FieldUserID] = struct{}{}
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *RoomMemberMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *RoomMemberMutation) UserIDs() (ids []uuid.UUID) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *RoomMemberMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// Where appends a list predicates to the RoomMemberMutation builder.
+func (m *RoomMemberMutation) Where(ps ...predicate.RoomMember) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the RoomMemberMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *RoomMemberMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.RoomMember, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *RoomMemberMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *RoomMemberMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (RoomMember).
+func (m *RoomMemberMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *RoomMemberMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.room != nil {
+		fields = append(fields, roommember.FieldRoomID)
+	}
+	if m.user != nil {
+		fields = append(fields, roommember.FieldUserID)
+	}
+	if m.role != nil {
+		fields = append(fields, roommember.FieldRole)
+	}
+	if m.joined_at != nil {
+		fields = append(fields, roommember.FieldJoinedAt)
+	}
+	if m.archived != nil {
+		fields = append(fields, roommember.FieldArchived)
+	}
+	if m.muted_until != nil {
+		fields = append(fields, roommember.FieldMutedUntil)
+	}
+	if m.pinned_order != nil {
+		fields = append(fields, roommember.FieldPinnedOrder)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *RoomMemberMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case roommember.FieldRoomID:
+		return m.RoomID()
+	case roommember.FieldUserID:
+		return m.UserID()
+	case roommember.FieldRole:
+		return m.Role()
+	case roommember.FieldJoinedAt:
+		return m.JoinedAt()
+	case roommember.FieldArchived:
+		return m.Archived()
+	case roommember.FieldMutedUntil:
+		return m.MutedUntil()
+	case roommember.FieldPinnedOrder:
+		return m.PinnedOrder()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *RoomMemberMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case roommember.FieldRoomID:
+		return m.OldRoomID(ctx)
+	case roommember.FieldUserID:
+		return m.OldUserID(ctx)
+	case roommember.FieldRole:
+		return m.OldRole(ctx)
+	case roommember.FieldJoinedAt:
+		return m.OldJoinedAt(ctx)
+	case roommember.FieldArchived:
+		return m.OldArchived(ctx)
+	case roommember.FieldMutedUntil:
+		return m.OldMutedUntil(ctx)
+	case roommember.FieldPinnedOrder:
+		return m.OldPinnedOrder(ctx)
+	}
+	return nil, fmt.Errorf("unknown RoomMember field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RoomMemberMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case roommember.FieldRoomID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRoomID(v)
+		return nil
+	case roommember.FieldUserID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case roommember.FieldRole:
+		v, ok := value.(roommember.Role)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRole(v)
+		return nil
+	case roommember.FieldJoinedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetJoinedAt(v)
+		return nil
+	case roommember.FieldArchived:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetArchived(v)
+		return nil
+	case roommember.FieldMutedUntil:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMutedUntil(v)
+		return nil
+	case roommember.FieldPinnedOrder:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPinnedOrder(v)
+		return nil
+	}
+	return fmt.Errorf("unknown RoomMember field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *RoomMemberMutation) AddedFields() []string {
+	var fields []string
+	if m.addpinned_order != nil {
+		fields = append(fields, roommember.FieldPinnedOrder)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *RoomMemberMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case roommember.FieldPinnedOrder:
+		return m.AddedPinnedOrder()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RoomMemberMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case roommember.FieldPinnedOrder:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPinnedOrder(v)
+		return nil
+	}
+	return fmt.Errorf("unknown RoomMember numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *RoomMemberMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(roommember.FieldMutedUntil) {
+		fields = append(fields, roommember.FieldMutedUntil)
+	}
+	if m.FieldCleared(roommember.FieldPinnedOrder) {
+		fields = append(fields, roommember.FieldPinnedOrder)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *RoomMemberMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *RoomMemberMutation) ClearField(name string) error {
+	switch name {
+	case roommember.FieldMutedUntil:
+		m.ClearMutedUntil()
+		return nil
+	case roommember.FieldPinnedOrder:
+		m.ClearPinnedOrder()
+		return nil
+	}
+	return fmt.Errorf("unknown RoomMember nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *RoomMemberMutation) ResetField(name string) error {
+	switch name {
+	case roommember.FieldRoomID:
+		m.ResetRoomID()
+		return nil
+	case roommember.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case roommember.FieldRole:
+		m.ResetRole()
+		return nil
+	case roommember.FieldJoinedAt:
+		m.ResetJoinedAt()
+		return nil
+	case roommember.FieldArchived:
+		m.ResetArchived()
+		return nil
+	case roommember.FieldMutedUntil:
+		m.ResetMutedUntil()
+		return nil
+	case roommember.FieldPinnedOrder:
+		m.ResetPinnedOrder()
+		return nil
+	}
+	return fmt.Errorf("unknown RoomMember field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *RoomMemberMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.room != nil {
+		edges = append(edges, roommember.EdgeRoom)
+	}
+	if m.user != nil {
+		edges = append(edges, roommember.EdgeUser)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *RoomMemberMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case roommember.EdgeRoom:
+		if id := m.room; id != nil {
+			return []ent.Value{*id}
+		}
+	case roommember.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *RoomMemberMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *RoomMemberMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *RoomMemberMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedroom {
+		edges = append(edges, roommember.EdgeRoom)
+	}
+	if m.cleareduser {
+		edges = append(edges, roommember.EdgeUser)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *RoomMemberMutation) EdgeCleared(name string) bool {
+	switch name {
+	case roommember.EdgeRoom:
+		return m.clearedroom
+	case roommember.EdgeUser:
+		return m.cleareduser
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *RoomMemberMutation) ClearEdge(name string) error {
+	switch name {
+	case roommember.EdgeRoom:
+		m.ClearRoom()
+		return nil
+	case roommember.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown RoomMember unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *RoomMemberMutation) ResetEdge(name string) error {
+	switch name {
+	case roommember.EdgeRoom:
+		m.ResetRoom()
+		return nil
+	case roommember.EdgeUser:
+		m.ResetUser()
+		return nil
+	}
+	return fmt.Errorf("unknown RoomMember edge %s", name)
+}
+
+// RotatedRefreshTokenMutation represents an operation that mutates the RotatedRefreshToken nodes in the graph.
+type RotatedRefreshTokenMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *int64
+	token_hash     *[]byte
+	rotated_at     *time.Time
+	clearedFields  map[string]struct{}
+	session        *uuid.UUID
+	clearedsession bool
+	done           bool
+	oldValue       func(context.Context) (*RotatedRefreshToken, error)
+	predicates     []predicate.RotatedRefreshToken
+}
+
+var _ ent.Mutation = (*RotatedRefreshTokenMutation)(nil)
+
+// rotatedrefreshtokenOption allows management of the mutation configuration using functional options.
+type rotatedrefreshtokenOption func(*RotatedRefreshTokenMutation)
+
+// newRotatedRefreshTokenMutation creates new mutation for the RotatedRefreshToken entity.
+func newRotatedRefreshTokenMutation(c config, op Op, opts ...rotatedrefreshtokenOption) *RotatedRefreshTokenMutation {
+	m := &RotatedRefreshTokenMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeRotatedRefreshToken,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withRotatedRefreshTokenID sets the ID field of the mutation.
+func withRotatedRefreshTokenID(id int64) rotatedrefreshtokenOption {
+	return func(m *RotatedRefreshTokenMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *RotatedRefreshToken
+		)
+		m.oldValue = func(ctx context.Context) (*RotatedRefreshToken, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().RotatedRefreshToken.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withRotatedRefreshToken sets the old RotatedRefreshToken of the mutation.
+func withRotatedRefreshToken(node *RotatedRefreshToken) rotatedrefreshtokenOption {
+	return func(m *RotatedRefreshTokenMutation) {
+		m.oldValue = func(context.Context) (*RotatedRefreshToken, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m RotatedRefreshTokenMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m RotatedRefreshTokenMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of RotatedRefreshToken entities.
+func (m *RotatedRefreshTokenMutation) SetID(id int64) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *RotatedRefreshTokenMutation) ID() (id int64, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *RotatedRefreshTokenMutation) IDs(ctx context.Context) ([]int64, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int64{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().RotatedRefreshToken.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetSessionID sets the "session_id" field.
+func (m *RotatedRefreshTokenMutation) SetSessionID(u uuid.UUID) {
+	m.session = &u
+}
+
+// SessionID returns the value of the "session_id" field in the mutation.
+func (m *RotatedRefreshTokenMutation) SessionID() (r uuid.UUID, exists bool) {
+	v := m.session
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSessionID returns the old "session_id" field's value of the RotatedRefreshToken entity.
+// If the RotatedRefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RotatedRefreshTokenMutation) OldSessionID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSessionID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSessionID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSessionID: %w", err)
+	}
+	return oldValue.SessionID, nil
+}
+
+// ResetSessionID resets all changes to the "session_id" field.
+func (m *RotatedRefreshTokenMutation) ResetSessionID() {
+	m.session = nil
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (m *RotatedRefreshTokenMutation) SetTokenHash(b []byte) {
+	m.token_hash = &b
+}
+
+// TokenHash returns the value of the "token_hash" field in the mutation.
+func (m *RotatedRefreshTokenMutation) TokenHash() (r []byte, exists bool) {
+	v := m.token_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTokenHash returns the old "token_hash" field's value of the RotatedRefreshToken entity.
+// If the RotatedRefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RotatedRefreshTokenMutation) OldTokenHash(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTokenHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTokenHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTokenHash: %w", err)
+	}
+	return oldValue.TokenHash, nil
+}
+
+// ResetTokenHash resets all changes to the "token_hash" field.
+func (m *RotatedRefreshTokenMutation) ResetTokenHash() {
+	m.token_hash = nil
+}
+
+// SetRotatedAt sets the "rotated_at" field.
+func (m *RotatedRefreshTokenMutation) SetRotatedAt(t time.Time) {
+	m.rotated_at = &t
+}
+
+// RotatedAt returns the value of the "rotated_at" field in the mutation.
+func (m *RotatedRefreshTokenMutation) RotatedAt() (r time.Time, exists bool) {
+	v := m.rotated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRotatedAt returns the old "rotated_at" field's value of the RotatedRefreshToken entity.
+// If the RotatedRefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RotatedRefreshTokenMutation) OldRotatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRotatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRotatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRotatedAt: %w", err)
+	}
+	return oldValue.RotatedAt, nil
+}
+
+// ResetRotatedAt resets all changes to the "rotated_at" field.
+func (m *RotatedRefreshTokenMutation) ResetRotatedAt() {
+	m.rotated_at = nil
+}
+
+// ClearSession clears the "session" edge to the Session entity.
+func (m *RotatedRefreshTokenMutation) ClearSession() {
+	m.clearedsession = true
+	m.clearedFields[rotatedrefreshtoken.FieldSessionID] = struct{}{}
+}
+
+// SessionCleared reports if the "session" edge to the Session entity was cleared.
+func (m *RotatedRefreshTokenMutation) SessionCleared() bool {
+	return m.clearedsession
+}
+
+// SessionIDs returns the "session" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// SessionID instead. It exists only for internal usage by the builders.
+func (m *RotatedRefreshTokenMutation) SessionIDs() (ids []uuid.UUID) {
+	if id := m.session; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetSession resets all changes to the "session" edge.
+func (m *RotatedRefreshTokenMutation) ResetSession() {
+	m.session = nil
+	m.clearedsession = false
+}
+
+// Where appends a list predicates to the RotatedRefreshTokenMutation builder.
+func (m *RotatedRefreshTokenMutation) Where(ps ...predicate.RotatedRefreshToken) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the RotatedRefreshTokenMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *RotatedRefreshTokenMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.RotatedRefreshToken, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *RotatedRefreshTokenMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *RotatedRefreshTokenMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (RotatedRefreshToken).
+func (m *RotatedRefreshTokenMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *RotatedRefreshTokenMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.session != nil {
+		fields = append(fields, rotatedrefreshtoken.FieldSessionID)
+	}
+	if m.token_hash != nil {
+		fields = append(fields, rotatedrefreshtoken.FieldTokenHash)
+	}
+	if m.rotated_at != nil {
+		fields = append(fields, rotatedrefreshtoken.FieldRotatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *RotatedRefreshTokenMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case rotatedrefreshtoken.FieldSessionID:
+		return m.SessionID()
+	case rotatedrefreshtoken.FieldTokenHash:
+		return m.TokenHash()
+	case rotatedrefreshtoken.FieldRotatedAt:
+		return m.RotatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *RotatedRefreshTokenMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case rotatedrefreshtoken.FieldSessionID:
+		return m.OldSessionID(ctx)
+	case rotatedrefreshtoken.FieldTokenHash:
+		return m.OldTokenHash(ctx)
+	case rotatedrefreshtoken.FieldRotatedAt:
+		return m.OldRotatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown RotatedRefreshToken field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RotatedRefreshTokenMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case rotatedrefreshtoken.FieldSessionID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSessionID(v)
+		return nil
+	case rotatedrefreshtoken.FieldTokenHash:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTokenHash(v)
+		return nil
+	case rotatedrefreshtoken.FieldRotatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRotatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown RotatedRefreshToken field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *RotatedRefreshTokenMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *RotatedRefreshTokenMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RotatedRefreshTokenMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown RotatedRefreshToken numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *RotatedRefreshTokenMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *RotatedRefreshTokenMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *RotatedRefreshTokenMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown RotatedRefreshToken nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *RotatedRefreshTokenMutation) ResetField(name string) error {
+	switch name {
+	case rotatedrefreshtoken.FieldSessionID:
+		m.ResetSessionID()
+		return nil
+	case rotatedrefreshtoken.FieldTokenHash:
+		m.ResetTokenHash()
+		return nil
+	case rotatedrefreshtoken.FieldRotatedAt:
+		m.ResetRotatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown RotatedRefreshToken field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *RotatedRefreshTokenMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.session != nil {
+		edges = append(edges, rotatedrefreshtoken.EdgeSession)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *RotatedRefreshTokenMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case rotatedrefreshtoken.EdgeSession:
+		if id := m.session; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *RotatedRefreshTokenMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *RotatedRefreshTokenMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *RotatedRefreshTokenMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedsession {
+		edges = append(edges, rotatedrefreshtoken.EdgeSession)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *RotatedRefreshTokenMutation) EdgeCleared(name string) bool {
+	switch name {
+	case rotatedrefreshtoken.EdgeSession:
+		return m.clearedsession
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *RotatedRefreshTokenMutation) ClearEdge(name string) error {
+	switch name {
+	case rotatedrefreshtoken.EdgeSession:
+		m.ClearSession()
+		return nil
+	}
+	return fmt.Errorf("unknown RotatedRefreshToken unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *RotatedRefreshTokenMutation) ResetEdge(name string) error {
+	switch name {
+	case rotatedrefreshtoken.EdgeSession:
+		m.ResetSession()
+		return nil
+	}
+	return fmt.Errorf("unknown RotatedRefreshToken edge %s", name)
+}
+
+// SecurityEventMutation represents an operation that mutates the SecurityEvent nodes in the graph.
+type SecurityEventMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int64
+	_type         *securityevent.Type
+	session_id    *uuid.UUID
+	ip_address    *string
+	user_agent    *string
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	user          *uuid.UUID
+	cleareduser   bool
+	done          bool
+	oldValue      func(context.Context) (*SecurityEvent, error)
+	predicates    []predicate.SecurityEvent
+}
+
+var _ ent.Mutation = (*SecurityEventMutation)(nil)
+
+// securityeventOption allows management of the mutation configuration using functional options.
+type securityeventOption func(*SecurityEventMutation)
+
+// newSecurityEventMutation creates new mutation for the SecurityEvent entity.
+func newSecurityEventMutation(c config, op Op, opts ...securityeventOption) *SecurityEventMutation {
+	m := &SecurityEventMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSecurityEvent,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSecurityEventID sets the ID field of the mutation.
+func withSecurityEventID(id int64) securityeventOption {
+	return func(m *SecurityEventMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *SecurityEvent
+		)
+		m.oldValue = func(ctx context.Context) (*SecurityEvent, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().SecurityEvent.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSecurityEvent sets the old SecurityEvent of the mutation.
+func withSecurityEvent(node *SecurityEvent) securityeventOption {
+	return func(m *SecurityEventMutation) {
+		m.oldValue = func(context.Context) (*SecurityEvent, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SecurityEventMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SecurityEventMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of SecurityEvent entities.
+func (m *SecurityEventMutation) SetID(id int64) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SecurityEventMutation) ID() (id int64, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SecurityEventMutation) IDs(ctx context.Context) ([]int64, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int64{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().SecurityEvent.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUserID sets the "user_id" field.
+func (m *SecurityEventMutation) SetUserID(u uuid.UUID) {
+	m.user = &u
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *SecurityEventMutation) UserID() (r uuid.UUID, exists bool) {
+	v := m.user
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the SecurityEvent entity.
+// If the SecurityEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SecurityEventMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *SecurityEventMutation) ResetUserID() {
+	m.user = nil
+}
+
+// SetType sets the "type" field.
+func (m *SecurityEventMutation) SetType(s securityevent.Type) {
+	m._type = &s
+}
+
+// GetType returns the value of the "type" field in the mutation.
+func (m *SecurityEventMutation) GetType() (r securityevent.Type, exists bool) {
+	v := m._type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldType returns the old "type" field's value of the SecurityEvent entity.
+// If the SecurityEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SecurityEventMutation) OldType(ctx context.Context) (v securityevent.Type, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
+	}
+	return oldValue.Type, nil
+}
+
+// ResetType resets all changes to the "type" field.
+func (m *SecurityEventMutation) ResetType() {
+	m._type = nil
+}
+
+// SetSessionID sets the "session_id" field.
+func (m *SecurityEventMutation) SetSessionID(u uuid.UUID) {
+	m.session_id = &u
+}
+
+// SessionID returns the value of the "session_id" field in the mutation.
+func (m *SecurityEventMutation) SessionID() (r uuid.UUID, exists bool) {
+	v := m.session_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSessionID returns the old "session_id" field's value of the SecurityEvent entity.
+// If the SecurityEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SecurityEventMutation) OldSessionID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSessionID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSessionID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSessionID: %w", err)
+	}
+	return oldValue.SessionID, nil
+}
+
+// ClearSessionID clears the value of the "session_id" field.
+func (m *SecurityEventMutation) ClearSessionID() {
+	m.session_id = nil
+	m.clearedFields[securityevent.FieldSessionID] = struct{}{}
+}
+
+// SessionIDCleared returns if the "session_id" field was cleared in this mutation.
+func (m *SecurityEventMutation) SessionIDCleared() bool {
+	_, ok := m.clearedFields[securityevent.FieldSessionID]
+	return ok
+}
+
+// ResetSessionID resets all changes to the "session_id" field.
+func (m *SecurityEventMutation) ResetSessionID() {
+	m.session_id = nil
+	delete(m.clearedFields, securityevent.FieldSessionID)
+}
+
+// SetIPAddress sets the "ip_address" field.
+func (m *SecurityEventMutation) SetIPAddress(s string) {
+	m.ip_address = &s
+}
+
+// IPAddress returns the value of the "ip_address" field in the mutation.
+func (m *SecurityEventMutation) IPAddress() (r string, exists bool) {
+	v := m.ip_address
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIPAddress returns the old "ip_address" field's value of the SecurityEvent entity.
+// If the SecurityEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SecurityEventMutation) OldIPAddress(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIPAddress is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIPAddress requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIPAddress: %w", err)
+	}
+	return oldValue.IPAddress, nil
+}
+
+// ClearIPAddress clears the value of the "ip_address" field.
+func (m *SecurityEventMutation) ClearIPAddress() {
+	m.ip_address = nil
+	m.clearedFields[securityevent.FieldIPAddress] = struct{}{}
+}
+
+// IPAddressCleared returns if the "ip_address" field was cleared in this mutation.
+func (m *SecurityEventMutation) IPAddressCleared() bool {
+	_, ok := m.clearedFields[securityevent.FieldIPAddress]
+	return ok
+}
+
+// ResetIPAddress resets all changes to the "ip_address" field.
+func (m *SecurityEventMutation) ResetIPAddress() {
+	m.ip_address = nil
+	delete(m.clearedFields, securityevent.FieldIPAddress)
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (m *SecurityEventMutation) SetUserAgent(s string) {
+	m.user_agent = &s
+}
+
+// UserAgent returns the value of the "user_agent" field in the mutation.
+func (m *SecurityEventMutation) UserAgent() (r string, exists bool) {
+	v := m.user_agent
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserAgent returns the old "user_agent" field's value of the SecurityEvent entity.
+// If the SecurityEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SecurityEventMutation) OldUserAgent(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserAgent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserAgent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserAgent: %w", err)
+	}
+	return oldValue.UserAgent, nil
+}
+
+// ClearUserAgent clears the value of the "user_agent" field.
+func (m *SecurityEventMutation) ClearUserAgent() {
+	m.user_agent = nil
+	m.clearedFields[securityevent.FieldUserAgent] = struct{}{}
+}
+
+// UserAgentCleared returns if the "user_agent" field was cleared in this mutation.
+func (m *SecurityEventMutation) UserAgentCleared() bool {
+	_, ok := m.clearedFields[securityevent.FieldUserAgent]
+	return ok
+}
+
+// ResetUserAgent resets all changes to the "user_agent" field.
+func (m *SecurityEventMutation) ResetUserAgent() {
+	m.user_agent = nil
+	delete(m.clearedFields, securityevent.FieldUserAgent)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *SecurityEventMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *SecurityEventMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the SecurityEvent entity.
+// If the SecurityEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SecurityEventMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *SecurityEventMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *SecurityEventMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[securityevent.FieldUserID] = struct{}{}
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *SecurityEventMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *SecurityEventMutation) UserIDs() (ids []uuid.UUID) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *SecurityEventMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// Where appends a list predicates to the SecurityEventMutation builder.
+func (m *SecurityEventMutation) Where(ps ...predicate.SecurityEvent) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the SecurityEventMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SecurityEventMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.SecurityEvent, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *SecurityEventMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *SecurityEventMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (SecurityEvent).
+func (m *SecurityEventMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SecurityEventMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.user != nil {
+		fields = append(fields, securityevent.FieldUserID)
+	}
+	if m._type != nil {
+		fields = append(fields, securityevent.FieldType)
+	}
+	if m.session_id != nil {
+		fields = append(fields, securityevent.FieldSessionID)
+	}
+	if m.ip_address != nil {
+		fields = append(fields, securityevent.FieldIPAddress)
+	}
+	if m.user_agent != nil {
+		fields = append(fields, securityevent.FieldUserAgent)
+	}
+	if m.created_at != nil {
+		fields = append(fields, securityevent.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SecurityEventMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case securityevent.FieldUserID:
+		return m.UserID()
+	case securityevent.FieldType:
+		return m.GetType()
+	case securityevent.FieldSessionID:
+		return m.SessionID()
+	case securityevent.FieldIPAddress:
+		return m.IPAddress()
+	case securityevent.FieldUserAgent:
+		return m.UserAgent()
+	case securityevent.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SecurityEventMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case securityevent.FieldUserID:
+		return m.OldUserID(ctx)
+	case securityevent.FieldType:
+		return m.OldType(ctx)
+	case securityevent.FieldSessionID:
+		return m.OldSessionID(ctx)
+	case securityevent.FieldIPAddress:
+		return m.OldIPAddress(ctx)
+	case securityevent.FieldUserAgent:
+		return m.OldUserAgent(ctx)
+	case securityevent.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown SecurityEvent field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SecurityEventMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case securityevent.FieldUserID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case securityevent.FieldType:
+		v, ok := value.(securityevent.Type)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetType(v)
+		return nil
+	case securityevent.FieldSessionID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSessionID(v)
+		return nil
+	case securityevent.FieldIPAddress:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIPAddress(v)
+		return nil
+	case securityevent.FieldUserAgent:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserAgent(v)
+		return nil
+	case securityevent.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown SecurityEvent field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SecurityEventMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SecurityEventMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SecurityEventMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown SecurityEvent numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SecurityEventMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(securityevent.FieldSessionID) {
+		fields = append(fields, securityevent.FieldSessionID)
+	}
+	if m.FieldCleared(securityevent.FieldIPAddress) {
+		fields = append(fields, securityevent.FieldIPAddress)
+	}
+	if m.FieldCleared(securityevent.FieldUserAgent) {
+		fields = append(fields, securityevent.FieldUserAgent)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SecurityEventMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SecurityEventMutation) ClearField(name string) error {
+	switch name {
+	case securityevent.FieldSessionID:
+		m.ClearSessionID()
+		return nil
+	case securityevent.FieldIPAddress:
+		m.ClearIPAddress()
+		return nil
+	case securityevent.FieldUserAgent:
+		m.ClearUserAgent()
+		return nil
+	}
+	return fmt.Errorf("unknown SecurityEvent nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SecurityEventMutation) ResetField(name string) error {
+	switch name {
+	case securityevent.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case securityevent.FieldType:
+		m.ResetType()
+		return nil
+	case securityevent.FieldSessionID:
+		m.ResetSessionID()
+		return nil
+	case securityevent.FieldIPAddress:
+		m.ResetIPAddress()
+		return nil
+	case securityevent.FieldUserAgent:
+		m.ResetUserAgent()
+		return nil
+	case securityevent.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown SecurityEvent field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SecurityEventMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.user != nil {
+		edges = append(edges, securityevent.EdgeUser)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SecurityEventMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case securityevent.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SecurityEventMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SecurityEventMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SecurityEventMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareduser {
+		edges = append(edges, securityevent.EdgeUser)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SecurityEventMutation) EdgeCleared(name string) bool {
+	switch name {
+	case securityevent.EdgeUser:
+		return m.cleareduser
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SecurityEventMutation) ClearEdge(name string) error {
+	switch name {
+	case securityevent.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown SecurityEvent unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SecurityEventMutation) ResetEdge(name string) error {
+	switch name {
+	case securityevent.EdgeUser:
+		m.ResetUser()
+		return nil
+	}
+	return fmt.Errorf("unknown SecurityEvent edge %s", name)
+}
+
+// SessionMutation represents an operation that mutates the Session nodes in the graph.
+type SessionMutation struct {
+	config
+	op                    Op
+	typ                   string
+	id                    *uuid.UUID
+	refresh_token_hash    *[]byte
+	device_label          *string
+	user_agent            *string
+	ip_address            *string
+	created_at            *time.Time
+	last_used_at          *time.Time
+	expires_at            *time.Time
+	clearedFields         map[string]struct{}
+	user                  *uuid.UUID
+	cleareduser           bool
+	rotated_tokens        map[int64]struct{}
+	removedrotated_tokens map[int64]struct{}
+	clearedrotated_tokens bool
+	done                  bool
+	oldValue              func(context.Context) (*Session, error)
+	predicates            []predicate.Session
+}
+
+var _ ent.Mutation = (*SessionMutation)(nil)
+
+// sessionOption allows management of the mutation configuration using functional options.
+type sessionOption func(*SessionMutation)
+
+// newSessionMutation creates new mutation for the Session entity.
+func newSessionMutation(c config, op Op, opts ...sessionOption) *SessionMutation {
+	m := &SessionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSession,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSessionID sets the ID field of the mutation.
+func withSessionID(id uuid.UUID) sessionOption {
+	return func(m *SessionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Session
+		)
+		m.oldValue = func(ctx context.Context) (*Session, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Session.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSession sets the old Session of the mutation.
+func withSession(node *Session) sessionOption {
+	return func(m *SessionMutation) {
+		m.oldValue = func(context.Context) (*Session, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SessionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SessionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Session entities.
+func (m *SessionMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SessionMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SessionMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Session.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUserID sets the "user_id" field.
+func (m *SessionMutation) SetUserID(u uuid.UUID) {
+	m.user = &u
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *SessionMutation) UserID() (r uuid.UUID, exists bool) {
+	v := m.user
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SessionMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *SessionMutation) ResetUserID() {
+	m.user = nil
+}
+
+// SetRefreshTokenHash sets the "refresh_token_hash" field.
+func (m *SessionMutation) SetRefreshTokenHash(b []byte) {
+	m.refresh_token_hash = &b
+}
+
+// RefreshTokenHash returns the value of the "refresh_token_hash" field in the mutation.
+func (m *SessionMutation) RefreshTokenHash() (r []byte, exists bool) {
+	v := m.refresh_token_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRefreshTokenHash returns the old "refresh_token_hash" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SessionMutation) OldRefreshTokenHash(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRefreshTokenHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRefreshTokenHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRefreshTokenHash: %w", err)
+	}
+	return oldValue.RefreshTokenHash, nil
+}
+
+// ResetRefreshTokenHash resets all changes to the "refresh_token_hash" field.
+func (m *SessionMutation) ResetRefreshTokenHash() {
+	m.refresh_token_hash = nil
+}
+
+// SetDeviceLabel sets the "device_label" field.
+func (m *SessionMutation) SetDeviceLabel(s string) {
+	m.device_label = &s
+}
+
+// DeviceLabel returns the value of the "device_label" field in the mutation.
+func (m *SessionMutation) DeviceLabel() (r string, exists bool) {
+	v := m.device_label
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeviceLabel returns the old "device_label" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SessionMutation) OldDeviceLabel(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeviceLabel is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeviceLabel requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeviceLabel: %w", err)
+	}
+	return oldValue.DeviceLabel, nil
+}
+
+// ClearDeviceLabel clears the value of the "device_label" field.
+func (m *SessionMutation) ClearDeviceLabel() {
+	m.device_label = nil
+	m.clearedFields[session.FieldDeviceLabel] = struct{}{}
+}
+
+// DeviceLabelCleared returns if the "device_label" field was cleared in this mutation.
+func (m *SessionMutation) DeviceLabelCleared() bool {
+	_, ok := m.clearedFields[session.FieldDeviceLabel]
+	return ok
+}
+
+// ResetDeviceLabel resets all changes to the "device_label" field.
+func (m *SessionMutation) ResetDeviceLabel() {
+	m.device_label = nil
+	delete(m.clearedFields, session.FieldDeviceLabel)
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (m *SessionMutation) SetUserAgent(s string) {
+	m.user_agent = &s
+}
+
+// UserAgent returns the value of the "user_agent" field in the mutation.
+func (m *SessionMutation) UserAgent() (r string, exists bool) {
+	v := m.user_agent
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserAgent returns the old "user_agent" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SessionMutation) OldUserAgent(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserAgent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserAgent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserAgent: %w", err)
+	}
+	return oldValue.UserAgent, nil
+}
+
+// ClearUserAgent clears the value of the "user_agent" field.
+func (m *SessionMutation) ClearUserAgent() {
+	m.user_agent = nil
+	m.clearedFields[session.FieldUserAgent] = struct{}{}
+}
+
+// UserAgentCleared returns if the "user_agent" field was cleared in this mutation.
+func (m *SessionMutation) UserAgentCleared() bool {
+	_, ok := m.clearedFields[session.FieldUserAgent]
+	return ok
+}
+
+// ResetUserAgent resets all changes to the "user_agent" field.
+func (m *SessionMutation) ResetUserAgent() {
+	m.user_agent = nil
+	delete(m.clearedFields, session.FieldUserAgent)
+}
+
+// SetIPAddress sets the "ip_address" field.
+func (m *SessionMutation) SetIPAddress(s string) {
+	m.ip_address = &s
+}
+
+// IPAddress returns the value of the "ip_address" field in the mutation.
+func (m *SessionMutation) IPAddress() (r string, exists bool) {
+	v := m.ip_address
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIPAddress returns the old "ip_address" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SessionMutation) OldIPAddress(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIPAddress is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIPAddress requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIPAddress: %w", err)
+	}
+	return oldValue.IPAddress, nil
+}
+
+// ClearIPAddress clears the value of the "ip_address" field.
+func (m *SessionMutation) ClearIPAddress() {
+	m.ip_address = nil
+	m.clearedFields[session.FieldIPAddress] = struct{}{}
+}
+
+// IPAddressCleared returns if the "ip_address" field was cleared in this mutation.
+func (m *SessionMutation) IPAddressCleared() bool {
+	_, ok := m.clearedFields[session.FieldIPAddress]
+	return ok
+}
+
+// ResetIPAddress resets all changes to the "ip_address" field.
+func (m *SessionMutation) ResetIPAddress() {
+	m.ip_address = nil
+	delete(m.clearedFields, session.FieldIPAddress)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *SessionMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *SessionMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SessionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *SessionMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (m *SessionMutation) SetLastUsedAt(t time.Time) {
+	m.last_used_at = &t
+}
+
+// LastUsedAt returns the value of the "last_used_at" field in the mutation.
+func (m *SessionMutation) LastUsedAt() (r time.Time, exists bool) {
+	v := m.last_used_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastUsedAt returns the old "last_used_at" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SessionMutation) OldLastUsedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastUsedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastUsedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastUsedAt: %w", err)
+	}
+	return oldValue.LastUsedAt, nil
+}
+
+// ResetLastUsedAt resets all changes to the "last_used_at" field.
+func (m *SessionMutation) ResetLastUsedAt() {
+	m.last_used_at = nil
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (m *SessionMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *SessionMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiresAt returns the old "expires_at" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SessionMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+	}
+	return oldValue.ExpiresAt, nil
+}
+
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *SessionMutation) ResetExpiresAt() {
+	m.expires_at = nil
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *SessionMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[session.FieldUserID] = struct{}{}
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *SessionMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *SessionMutation) UserIDs() (ids []uuid.UUID) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *SessionMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// AddRotatedTokenIDs adds the "rotated_tokens" edge to the RotatedRefreshToken entity by ids.
+func (m *SessionMutation) AddRotatedTokenIDs(ids ...int64) {
+	if m.rotated_tokens == nil {
+		m.rotated_tokens = make(map[int64]struct{})
+	}
+	for i := range ids {
+		m.rotated_tokens[ids[i]] = struct{}{}
+	}
+}
+
+// ClearRotatedTokens clears the "rotated_tokens" edge to the RotatedRefreshToken entity.
+func (m *SessionMutation) ClearRotatedTokens() {
+	m.clearedrotated_tokens = true
+}
+
+// RotatedTokensCleared reports if the "rotated_tokens" edge to the RotatedRefreshToken entity was cleared.
+func (m *SessionMutation) RotatedTokensCleared() bool {
+	return m.clearedrotated_tokens
+}
+
+// RemoveRotatedTokenIDs removes the "rotated_tokens" edge to the RotatedRefreshToken entity by IDs.
+func (m *SessionMutation) RemoveRotatedTokenIDs(ids ...int64) {
+	if m.removedrotated_tokens == nil {
+		m.removedrotated_tokens = make(map[int64]struct{})
+	}
+	for i := range ids {
+		delete(m.rotated_tokens, ids[i])
+		m.removedrotated_tokens[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedRotatedTokens returns the removed IDs of the "rotated_tokens" edge to the RotatedRefreshToken entity.
+func (m *SessionMutation) RemovedRotatedTokensIDs() (ids []int64) {
+	for id := range m.removedrotated_tokens {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// RotatedTokensIDs returns the "rotated_tokens" edge IDs in the mutation.
+func (m *SessionMutation) RotatedTokensIDs() (ids []int64) {
+	for id := range m.rotated_tokens {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetRotatedTokens resets all changes to the "rotated_tokens" edge.
+func (m *SessionMutation) ResetRotatedTokens() {
+	m.rotated_tokens = nil
+	m.clearedrotated_tokens = false
+	m.removedrotated_tokens = nil
+}
+
+// Where appends a list predicates to the SessionMutation builder.
+func (m *SessionMutation) Where(ps ...predicate.Session) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the SessionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SessionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Session, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *SessionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *SessionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Session).
+func (m *SessionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SessionMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.user != nil {
+		fields = append(fields, session.FieldUserID)
+	}
+	if m.refresh_token_hash != nil {
+		fields = append(fields, session.FieldRefreshTokenHash)
+	}
+	if m.device_label != nil {
+		fields = append(fields, session.FieldDeviceLabel)
+	}
+	if m.user_agent != nil {
+		fields = append(fields, session.FieldUserAgent)
+	}
+	if m.ip_address != nil {
+		fields = append(fields, session.FieldIPAddress)
+	}
+	if m.created_at != nil {
+		fields = append(fields, session.FieldCreatedAt)
+	}
+	if m.last_used_at != nil {
+		fields = append(fields, session.FieldLastUsedAt)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, session.FieldExpiresAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SessionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case session.FieldUserID:
+		return m.UserID()
+	case session.FieldRefreshTokenHash:
+		return m.RefreshTokenHash()
+	case session.FieldDeviceLabel:
+		return m.DeviceLabel()
+	case session.FieldUserAgent:
+		return m.UserAgent()
+	case session.FieldIPAddress:
+		return m.IPAddress()
+	case session.FieldCreatedAt:
+		return m.CreatedAt()
+	case session.FieldLastUsedAt:
+		return m.LastUsedAt()
+	case session.FieldExpiresAt:
+		return m.ExpiresAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SessionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case session.FieldUserID:
+		return m.OldUserID(ctx)
+	case session.FieldRefreshTokenHash:
+		return m.OldRefreshTokenHash(ctx)
+	case session.FieldDeviceLabel:
+		return m.OldDeviceLabel(ctx)
+	case session.FieldUserAgent:
+		return m.OldUserAgent(ctx)
+	case session.FieldIPAddress:
+		return m.OldIPAddress(ctx)
+	case session.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case session.FieldLastUsedAt:
+		return m.OldLastUsedAt(ctx)
+	case session.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Session field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SessionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case session.FieldUserID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case session.FieldRefreshTokenHash:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRefreshTokenHash(v)
+		return nil
+	case session.FieldDeviceLabel:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeviceLabel(v)
+		return nil
+	case session.FieldUserAgent:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserAgent(v)
+		return nil
+	case session.FieldIPAddress:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIPAddress(v)
+		return nil
+	case session.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case session.FieldLastUsedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastUsedAt(v)
+		return nil
+	case session.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Session field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SessionMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SessionMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SessionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Session numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SessionMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(session.FieldDeviceLabel) {
+		fields = append(fields, session.FieldDeviceLabel)
+	}
+	if m.FieldCleared(session.FieldUserAgent) {
+		fields = append(fields, session.FieldUserAgent)
+	}
+	if m.FieldCleared(session.FieldIPAddress) {
+		fields = append(fields, session.FieldIPAddress)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SessionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SessionMutation) ClearField(name string) error {
+	switch name {
+	case session.FieldDeviceLabel:
+		m.ClearDeviceLabel()
+		return nil
+	case session.FieldUserAgent:
+		m.ClearUserAgent()
+		return nil
+	case session.FieldIPAddress:
+		m.ClearIPAddress()
+		return nil
+	}
+	return fmt.Errorf("unknown Session nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SessionMutation) ResetField(name string) error {
+	switch name {
+	case session.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case session.FieldRefreshTokenHash:
+		m.ResetRefreshTokenHash()
+		return nil
+	case session.FieldDeviceLabel:
+		m.ResetDeviceLabel()
+		return nil
+	case session.FieldUserAgent:
+		m.ResetUserAgent()
+		return nil
+	case session.FieldIPAddress:
+		m.ResetIPAddress()
+		return nil
+	case session.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case session.FieldLastUsedAt:
+		m.ResetLastUsedAt()
+		return nil
+	case session.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Session field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SessionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.user != nil {
+		edges = append(edges, session.EdgeUser)
+	}
+	if m.rotated_tokens != nil {
+		edges = append(edges, session.EdgeRotatedTokens)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SessionMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case session.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	case session.EdgeRotatedTokens:
+		ids := make([]ent.Value, 0, len(m.rotated_tokens))
+		for id := range m.rotated_tokens {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SessionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedrotated_tokens != nil {
+		edges = append(edges, session.EdgeRotatedTokens)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SessionMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case session.EdgeRotatedTokens:
+		ids := make([]ent.Value, 0, len(m.removedrotated_tokens))
+		for id := range m.removedrotated_tokens {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SessionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.cleareduser {
+		edges = append(edges, session.EdgeUser)
+	}
+	if m.clearedrotated_tokens {
+		edges = append(edges, session.EdgeRotatedTokens)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SessionMutation) EdgeCleared(name string) bool {
+	switch name {
+	case session.EdgeUser:
+		return m.cleareduser
+	case session.EdgeRotatedTokens:
+		return m.clearedrotated_tokens
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SessionMutation) ClearEdge(name string) error {
+	switch name {
+	case session.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown Session unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SessionMutation) ResetEdge(name string) error {
+	switch name {
+	case session.EdgeUser:
+		m.ResetUser()
+		return nil
+	case session.EdgeRotatedTokens:
+		m.ResetRotatedTokens()
+		return nil
+	}
+	return fmt.Errorf("unknown Session edge %s", name)
+}
+
+// StreamTicketMutation represents an operation that mutates the StreamTicket nodes in the graph.
+type StreamTicketMutation struct {
+	config
+	op               Op
+	typ              string
+	id               *int64
+	token_hash       *[]byte
+	token_id         *string
+	token_version    *int
+	addtoken_version *int
+	session_id       *string
+	token_expires_at *time.Time
+	expires_at       *time.Time
+	clearedFields    map[string]struct{}
+	user             *uuid.UUID
+	cleareduser      bool
+	done             bool
+	oldValue         func(context.Context) (*StreamTicket, error)
+	predicates       []predicate.StreamTicket
+}
+
+var _ ent.Mutation = (*StreamTicketMutation)(nil)
+
+// streamticketOption allows management of the mutation configuration using functional options.
+type streamticketOption func(*StreamTicketMutation)
+
+// newStreamTicketMutation creates new mutation for the StreamTicket entity.
+func newStreamTicketMutation(c config, op Op, opts ...streamticketOption) *StreamTicketMutation {
+	m := &StreamTicketMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeStreamTicket,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withStreamTicketID sets the ID field of the mutation.
+func withStreamTicketID(id int64) streamticketOption {
+	return func(m *StreamTicketMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *StreamTicket
+		)
+		m.oldValue = func(ctx context.Context) (*StreamTicket, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().StreamTicket.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withStreamTicket sets the old StreamTicket of the mutation.
+func withStreamTicket(node *StreamTicket) streamticketOption {
+	return func(m *StreamTicketMutation) {
+		m.oldValue = func(context.Context) (*StreamTicket, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m StreamTicketMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m StreamTicketMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of StreamTicket entities.
+func (m *StreamTicketMutation) SetID(id int64) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *StreamTicketMutation) ID() (id int64, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *StreamTicketMutation) IDs(ctx context.Context) ([]int64, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int64{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().StreamTicket.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (m *StreamTicketMutation) SetTokenHash(b []byte) {
+	m.token_hash = &b
+}
+
+// TokenHash returns the value of the "token_hash" field in the mutation.
+func (m *StreamTicketMutation) TokenHash() (r []byte, exists bool) {
+	v := m.token_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTokenHash returns the old "token_hash" field's value of the StreamTicket entity.
+// If the StreamTicket object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StreamTicketMutation) OldTokenHash(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTokenHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTokenHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTokenHash: %w", err)
+	}
+	return oldValue.TokenHash, nil
+}
+
+// ResetTokenHash resets all changes to the "token_hash" field.
+func (m *StreamTicketMutation) ResetTokenHash() {
+	m.token_hash = nil
+}
+
+// SetUserID sets the "user_id" field.
+func (m *StreamTicketMutation) SetUserID(u uuid.UUID) {
+	m.user = &u
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *StreamTicketMutation) UserID() (r uuid.UUID, exists bool) {
+	v := m.user
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the StreamTicket entity.
+// If the StreamTicket object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StreamTicketMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *StreamTicketMutation) ResetUserID() {
+	m.user = nil
+}
+
+// SetTokenID sets the "token_id" field.
+func (m *StreamTicketMutation) SetTokenID(s string) {
+	m.token_id = &s
+}
+
+// TokenID returns the value of the "token_id" field in the mutation.
+func (m *StreamTicketMutation) TokenID() (r string, exists bool) {
+	v := m.token_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTokenID returns the old "token_id" field's value of the StreamTicket entity.
+// If the StreamTicket object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StreamTicketMutation) OldTokenID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTokenID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTokenID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTokenID: %w", err)
+	}
+	return oldValue.TokenID, nil
+}
+
+// ResetTokenID resets all changes to the "token_id" field.
+func (m *StreamTicketMutation) ResetTokenID() {
+	m.token_id = nil
+}
+
+// SetTokenVersion sets the "token_version" field.
+func (m *StreamTicketMutation) SetTokenVersion(i int) {
+	m.token_version = &i
+	m.addtoken_version = nil
+}
+
+// TokenVersion returns the value of the "token_version" field in the mutation.
+func (m *StreamTicketMutation) TokenVersion() (r int, exists bool) {
+	v := m.token_version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTokenVersion returns the old "token_version" field's value of the StreamTicket entity.
+// If the StreamTicket object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StreamTicketMutation) OldTokenVersion(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTokenVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTokenVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTokenVersion: %w", err)
+	}
+	return oldValue.TokenVersion, nil
+}
+
+// AddTokenVersion adds i to the "token_version" field.
+func (m *StreamTicketMutation) AddTokenVersion(i int) {
+	if m.addtoken_version != nil {
+		*m.addtoken_version += i
+	} else {
+		m.addtoken_version = &i
+	}
+}
+
+// AddedTokenVersion returns the value that was added to the "token_version" field in this mutation.
+func (m *StreamTicketMutation) AddedTokenVersion() (r int, exists bool) {
+	v := m.addtoken_version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTokenVersion resets all changes to the "token_version" field.
+func (m *StreamTicketMutation) ResetTokenVersion() {
+	m.token_version = nil
+	m.addtoken_version = nil
+}
+
+// SetSessionID sets the "session_id" field.
+func (m *StreamTicketMutation) SetSessionID(s string) {
+	m.session_id = &s
+}
+
+// SessionID returns the value of the "session_id" field in the mutation.
+func (m *StreamTicketMutation) SessionID() (r string, exists bool) {
+	v := m.session_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSessionID returns the old "session_id" field's value of the StreamTicket entity.
+// If the StreamTicket object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StreamTicketMutation) OldSessionID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSessionID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSessionID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSessionID: %w", err)
+	}
+	return oldValue.SessionID, nil
+}
+
+// ClearSessionID clears the value of the "session_id" field.
+func (m *StreamTicketMutation) ClearSessionID() {
+	m.session_id = nil
+	m.clearedFields[streamticket.FieldSessionID] = struct{}{}
+}
+
+// SessionIDCleared returns if the "session_id" field was cleared in this mutation.
+func (m *StreamTicketMutation) SessionIDCleared() bool {
+	_, ok := m.clearedFields[streamticket.FieldSessionID]
+	return ok
+}
+
+// ResetSessionID resets all changes to the "session_id" field.
+func (m *StreamTicketMutation) ResetSessionID() {
+	m.session_id = nil
+	delete(m.clearedFields, streamticket.FieldSessionID)
+}
+
+// SetTokenExpiresAt sets the "token_expires_at" field.
+func (m *StreamTicketMutation) SetTokenExpiresAt(t time.Time) {
+	m.token_expires_at = &t
+}
+
+// TokenExpiresAt returns the value of the "token_expires_at" field in the mutation.
+func (m *StreamTicketMutation) TokenExpiresAt() (r time.Time, exists bool) {
+	v := m.token_expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTokenExpiresAt returns the old "token_expires_at" field's value of the StreamTicket entity.
+// If the StreamTicket object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StreamTicketMutation) OldTokenExpiresAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTokenExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTokenExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTokenExpiresAt: %w", err)
+	}
+	return oldValue.TokenExpiresAt, nil
+}
+
+// ClearTokenExpiresAt clears the value of the "token_expires_at" field.
+func (m *StreamTicketMutation) ClearTokenExpiresAt() {
+	m.token_expires_at = nil
+	m.clearedFields[streamticket.FieldTokenExpiresAt] = struct{}{}
+}
+
+// TokenExpiresAtCleared returns if the "token_expires_at" field was cleared in this mutation.
+func (m *StreamTicketMutation) TokenExpiresAtCleared() bool {
+	_, ok := m.clearedFields[streamticket.FieldTokenExpiresAt]
+	return ok
+}
+
+// ResetTokenExpiresAt resets all changes to the "token_expires_at" field.
+func (m *StreamTicketMutation) ResetTokenExpiresAt() {
+	m.token_expires_at = nil
+	delete(m.clearedFields, streamticket.FieldTokenExpiresAt)
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (m *StreamTicketMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *StreamTicketMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiresAt returns the old "expires_at" field's value of the StreamTicket entity.
+// If the StreamTicket object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StreamTicketMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+	}
+	return oldValue.ExpiresAt, nil
+}
+
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *StreamTicketMutation) ResetExpiresAt() {
+	m.expires_at = nil
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *StreamTicketMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[streamticket.FieldUserID] = struct{}{}
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *StreamTicketMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *StreamTicketMutation) UserIDs() (ids []uuid.UUID) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *StreamTicketMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// Where appends a list predicates to the StreamTicketMutation builder.
+func (m *StreamTicketMutation) Where(ps ...predicate.StreamTicket) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the StreamTicketMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *StreamTicketMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.StreamTicket, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *StreamTicketMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *StreamTicketMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (StreamTicket).
+func (m *StreamTicketMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *StreamTicketMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.token_hash != nil {
+		fields = append(fields, streamticket.FieldTokenHash)
+	}
+	if m.user != nil {
+		fields = append(fields, streamticket.FieldUserID)
+	}
+	if m.token_id != nil {
+		fields = append(fields, streamticket.FieldTokenID)
+	}
+	if m.token_version != nil {
+		fields = append(fields, streamticket.FieldTokenVersion)
+	}
+	if m.session_id != nil {
+		fields = append(fields, streamticket.FieldSessionID)
+	}
+	if m.token_expires_at != nil {
+		fields = append(fields, streamticket.FieldTokenExpiresAt)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, streamticket.FieldExpiresAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *StreamTicketMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case streamticket.FieldTokenHash:
+		return m.TokenHash()
+	case streamticket.FieldUserID:
+		return m.UserID()
+	case streamticket.FieldTokenID:
+		return m.TokenID()
+	case streamticket.FieldTokenVersion:
+		return m.TokenVersion()
+	case streamticket.FieldSessionID:
+		return m.SessionID()
+	case streamticket.FieldTokenExpiresAt:
+		return m.TokenExpiresAt()
+	case streamticket.FieldExpiresAt:
+		return m.ExpiresAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *StreamTicketMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case streamticket.FieldTokenHash:
+		return m.OldTokenHash(ctx)
+	case streamticket.FieldUserID:
+		return m.OldUserID(ctx)
+	case streamticket.FieldTokenID:
+		return m.OldTokenID(ctx)
+	case streamticket.FieldTokenVersion:
+		return m.OldTokenVersion(ctx)
+	case streamticket.FieldSessionID:
+		return m.OldSessionID(ctx)
+	case streamticket.FieldTokenExpiresAt:
+		return m.OldTokenExpiresAt(ctx)
+	case streamticket.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown StreamTicket field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *StreamTicketMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case streamticket.FieldTokenHash:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTokenHash(v)
+		return nil
+	case streamticket.FieldUserID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case streamticket.FieldTokenID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTokenID(v)
+		return nil
+	case streamticket.FieldTokenVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTokenVersion(v)
+		return nil
+	case streamticket.FieldSessionID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSessionID(v)
+		return nil
+	case streamticket.FieldTokenExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTokenExpiresAt(v)
+		return nil
+	case streamticket.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown StreamTicket field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *StreamTicketMutation) AddedFields() []string {
+	var fields []string
+	if m.addtoken_version != nil {
+		fields = append(fields, streamticket.FieldTokenVersion)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *StreamTicketMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case streamticket.FieldTokenVersion:
+		return m.AddedTokenVersion()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *StreamTicketMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case streamticket.FieldTokenVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTokenVersion(v)
+		return nil
+	}
+	return fmt.Errorf("unknown StreamTicket numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *StreamTicketMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(streamticket.FieldSessionID) {
+		fields = append(fields, streamticket.FieldSessionID)
+	}
+	if m.FieldCleared(streamticket.FieldTokenExpiresAt) {
+		fields = append(fields, streamticket.FieldTokenExpiresAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *StreamTicketMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *StreamTicketMutation) ClearField(name string) error {
+	switch name {
+	case streamticket.FieldSessionID:
+		m.ClearSessionID()
+		return nil
+	case streamticket.FieldTokenExpiresAt:
+		m.ClearTokenExpiresAt()
+		return nil
+	}
+	return fmt.Errorf("unknown StreamTicket nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *StreamTicketMutation) ResetField(name string) error {
+	switch name {
+	case streamticket.FieldTokenHash:
+		m.ResetTokenHash()
+		return nil
+	case streamticket.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case streamticket.FieldTokenID:
+		m.ResetTokenID()
+		return nil
+	case streamticket.FieldTokenVersion:
+		m.ResetTokenVersion()
+		return nil
+	case streamticket.FieldSessionID:
+		m.ResetSessionID()
+		return nil
+	case streamticket.FieldTokenExpiresAt:
+		m.ResetTokenExpiresAt()
+		return nil
+	case streamticket.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	}
+	return fmt.Errorf("unknown StreamTicket field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *StreamTicketMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.user != nil {
+		edges = append(edges, streamticket.EdgeUser)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *StreamTicketMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case streamticket.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *StreamTicketMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *StreamTicketMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *StreamTicketMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareduser {
+		edges = append(edges, streamticket.EdgeUser)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *StreamTicketMutation) EdgeCleared(name string) bool {
+	switch name {
+	case streamticket.EdgeUser:
+		return m.cleareduser
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *StreamTicketMutation) ClearEdge(name string) error {
+	switch name {
+	case streamticket.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown StreamTicket unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *StreamTicketMutation) ResetEdge(name string) error {
+	switch name {
+	case streamticket.EdgeUser:
+		m.ResetUser()
+		return nil
+	}
+	return fmt.Errorf("unknown StreamTicket edge %s", name)
+}
+
+// UserMutation represents an operation that mutates the User nodes in the graph.
+type UserMutation struct {
+	config
+	op                       Op
+	typ                      string
+	id                       *uuid.UUID
+	name                     *string
+	email                    *string
+	password_hash            *[]byte
+	profile_image_url        *string
+	bio                      *string
+	created_at               *time.Time
+	updated_at               *time.Time
+	last_seen_at             *time.Time
+	token_version            *int
+	addtoken_version         *int
+	clearedFields            map[string]struct{}
+	room_members             map[int64]struct{}
+	removedroom_members      map[int64]struct{}
+	clearedroom_members      bool
+	messages                 map[uuid.UUID]struct{}
+	removedmessages          map[uuid.UUID]struct{}
+	clearedmessages          bool
+	message_reads            map[int64]struct{}
+	removedmessage_reads     map[int64]struct{}
+	clearedmessage_reads     bool
+	message_reactions        map[int64]struct{}
+	removedmessage_reactions map[int64]struct{}
+	clearedmessage_reactions bool
+	message_revisions        map[int64]struct{}
+	removedmessage_revisions map[int64]struct{}
+	clearedmessage_revisions bool
+	invites                  map[uuid.UUID]struct{}
+	removedinvites           map[uuid.UUID]struct{}
+	clearedinvites           bool
+	join_requests            map[int64]struct{}
+	removedjoin_requests     map[int64]struct{}
+	clearedjoin_requests     bool
+	sessions                 map[uuid.UUID]struct{}
+	removedsessions          map[uuid.UUID]struct{}
+	clearedsessions          bool
+	security_events          map[int64]struct{}
+	removedsecurity_events   map[int64]struct{}
+	clearedsecurity_events   bool
+	revoked_tokens           map[string]struct{}
+	removedrevoked_tokens    map[string]struct{}
+	clearedrevoked_tokens    bool
+	stream_tickets           map[int64]struct{}
+	removedstream_tickets    map[int64]struct{}
+	clearedstream_tickets    bool
+	done                     bool
+	oldValue                 func(context.Context) (*User, error)
+	predicates               []predicate.User
+}
+
+var _ ent.Mutation = (*UserMutation)(nil)
+
+// userOption allows management of the mutation configuration using functional options.
+type userOption func(*UserMutation)
+
+// newUserMutation creates new mutation for the User entity.
+func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
+	m := &UserMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeUser,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withUserID sets the ID field of the mutation.
+func withUserID(id uuid.UUID) userOption {
+	return func(m *UserMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *User
+		)
+		m.oldValue = func(ctx context.Context) (*User, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().User.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withUser sets the old User of the mutation.
+func withUser(node *User) userOption {
+	return func(m *UserMutation) {
+		m.oldValue = func(context.Context) (*User, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m UserMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m UserMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of User entities.
+func (m *UserMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *UserMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *UserMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().User.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *UserMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *UserMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *UserMutation) ResetName() {
+	m.name = nil
+}
+
+// SetEmail sets the "email" field.
+func (m *UserMutation) SetEmail(s string) {
+	m.email = &s
+}
+
+// Email returns the value of the "email" field in the mutation.
+func (m *UserMutation) Email() (r string, exists bool) {
+	v := m.email
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEmail returns the old "email" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldEmail(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmail requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
+	}
+	return oldValue.Email, nil
+}
+
+// ResetEmail resets all changes to the "email" field.
+func (m *UserMutation) ResetEmail() {
+	m.email = nil
+}
+
+// SetPasswordHash sets the "password_hash" field.
+func (m *UserMutation) SetPasswordHash(b []byte) {
+	m.password_hash = &b
+}
+
+// PasswordHash returns the value of the "password_hash" field in the mutation.
+func (m *UserMutation) PasswordHash() (r []byte, exists bool) {
+	v := m.password_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPasswordHash returns the old "password_hash" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldPasswordHash(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPasswordHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPasswordHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPasswordHash: %w", err)
+	}
+	return oldValue.PasswordHash, nil
+}
+
+// ResetPasswordHash resets all changes to the "password_hash" field.
+func (m *UserMutation) ResetPasswordHash() {
+	m.password_hash = nil
+}
+
+// SetProfileImageURL sets the "profile_image_url" field.
+func (m *UserMutation) SetProfileImageURL(s string) {
+	m.profile_image_url = &s
+}
+
+// ProfileImageURL returns the value of the "profile_image_url" field in the mutation.
+func (m *UserMutation) ProfileImageURL() (r string, exists bool) {
+	v := m.profile_image_url
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProfileImageURL returns the old "profile_image_url" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldProfileImageURL(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProfileImageURL is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProfileImageURL requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProfileImageURL: %w", err)
+	}
+	return oldValue.ProfileImageURL, nil
+}
+
+// ClearProfileImageURL clears the value of the "profile_image_url" field.
+func (m *UserMutation) ClearProfileImageURL() {
+	m.profile_image_url = nil
+	m.clearedFields[user.FieldProfileImageURL] = struct{}{}
+}
+
+// ProfileImageURLCleared returns if the "profile_image_url" field was cleared in this mutation.
+func (m *UserMutation) ProfileImageURLCleared() bool {
+	_, ok := m.clearedFields[user.FieldProfileImageURL]
+	return ok
+}
+
+// ResetProfileImageURL resets all changes to the "profile_image_url" field.
+func (m *UserMutation) ResetProfileImageURL() {
+	m.profile_image_url = nil
+	delete(m.clearedFields, user.FieldProfileImageURL)
+}
+
+// SetBio sets the "bio" field.
+func (m *UserMutation) SetBio(s string) {
+	m.bio = &s
+}
+
+// Bio returns the value of the "bio" field in the mutation.
+func (m *UserMutation) Bio() (r string, exists bool) {
+	v := m.bio
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBio returns the old "bio" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldBio(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBio is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBio requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBio: %w", err)
+	}
+	return oldValue.Bio, nil
+}
+
+// ClearBio clears the value of the "bio" field.
+func (m *UserMutation) ClearBio() {
+	m.bio = nil
+	m.clearedFields[user.FieldBio] = struct{}{}
+}
+
+// BioCleared returns if the "bio" field was cleared in this mutation.
+func (m *UserMutation) BioCleared() bool {
+	_, ok := m.clearedFields[user.FieldBio]
+	return ok
+}
+
+// ResetBio resets all changes to the "bio" field.
+func (m *UserMutation) ResetBio() {
+	m.bio = nil
+	delete(m.clearedFields, user.FieldBio)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *UserMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *UserMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *UserMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *UserMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *UserMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *UserMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetLastSeenAt sets the "last_seen_at" field.
+func (m *UserMutation) SetLastSeenAt(t time.Time) {
+	m.last_seen_at = &t
+}
+
+// LastSeenAt returns the value of the "last_seen_at" field in the mutation.
+func (m *UserMutation) LastSeenAt() (r time.Time, exists bool) {
+	v := m.last_seen_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastSeenAt returns the old "last_seen_at" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldLastSeenAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastSeenAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastSeenAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastSeenAt: %w", err)
+	}
+	return oldValue.LastSeenAt, nil
+}
+
+// ClearLastSeenAt clears the value of the "last_seen_at" field.
+func (m *UserMutation) ClearLastSeenAt() {
+	m.last_seen_at = nil
+	m.clearedFields[user.FieldLastSeenAt] = struct{}{}
+}
+
+// LastSeenAtCleared returns if the "last_seen_at" field was cleared in this mutation.
+func (m *UserMutation) LastSeenAtCleared() bool {
+	_, ok := m.clearedFields[user.FieldLastSeenAt]
+	return ok
+}
+
+// ResetLastSeenAt resets all changes to the "last_seen_at" field.
+func (m *UserMutation) ResetLastSeenAt() {
+	m.last_seen_at = nil
+	delete(m.clearedFields, user.FieldLastSeenAt)
+}
+
+// SetTokenVersion sets the "token_version" field.
+func (m *UserMutation) SetTokenVersion(i int) {
+	m.token_version = &i
+	m.addtoken_version = nil
+}
+
+// TokenVersion returns the value of the "token_version" field in the mutation.
+func (m *UserMutation) TokenVersion() (r int, exists bool) {
+	v := m.token_version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTokenVersion returns the old "token_version" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldTokenVersion(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTokenVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTokenVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTokenVersion: %w", err)
+	}
+	return oldValue.TokenVersion, nil
+}
+
+// AddTokenVersion adds i to the "token_version" field.
+func (m *UserMutation) AddTokenVersion(i int) {
+	if m.addtoken_version != nil {
+		*m.addtoken_version += i
+	} else {
+		m.addtoken_version = &i
+	}
+}
+
+// AddedTokenVersion returns the value that was added to the "token_version" field in this mutation.
+func (m *UserMutation) AddedTokenVersion() (r int, exists bool) {
+	v := m.addtoken_version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTokenVersion resets all changes to the "token_version" field.
+func (m *UserMutation) ResetTokenVersion() {
+	m.token_version = nil
+	m.addtoken_version = nil
+}
+
+// AddRoomMemberIDs adds the "room_members" edge to the RoomMember entity by ids.
+func (m *UserMutation) AddRoomMemberIDs(ids ...int64) {
+	if m.room_members == nil {
+		m.room_members = make(map[int64]struct{})
+	}
+	for i := range ids {
+		m.room_members[ids[i]] = struct{}{}
+	}
+}
+
+// ClearRoomMembers clears the "room_members" edge to the RoomMember entity.
+func (m *UserMutation) ClearRoomMembers() {
+	m.clearedroom_members = true
+}
+
+// RoomMembersCleared reports if the "room_members" edge to the RoomMember entity was cleared.
+func (m *UserMutation) RoomMembersCleared() bool {
+	return m.clearedroom_members
+}
+
+// RemoveRoomMemberIDs removes the "room_members" edge to the RoomMember entity by IDs.
+func (m *UserMutation) RemoveRoomMemberIDs(ids ...int64) {
+	if m.removedroom_members == nil {
+		m.removedroom_members = make(map[int64]struct{})
+	}
+	for i := range ids {
+		delete(m.room_members, ids[i])
+		m.removedroom_members[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedRoomMembers returns the removed IDs of the "room_members" edge to the RoomMember entity.
+func (m *UserMutation) RemovedRoomMembersIDs() (ids []int64) {
+	for id := range m.removedroom_members {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// RoomMembersIDs returns the "room_members" edge IDs in the mutation.
+func (m *UserMutation) RoomMembersIDs() (ids []int64) {
+	for id := range m.room_members {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetRoomMembers resets all changes to the "room_members" edge.
+func (m *UserMutation) ResetRoomMembers() {
+	m.room_members = nil
+	m.clearedroom_members = false
+	m.removedroom_members = nil
+}
+
+// AddMessageIDs adds the "messages" edge to the Message entity by ids.
+func (m *UserMutation) AddMessageIDs(ids ...uuid.UUID) {
+	if m.messages == nil {
+		m.messages = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.messages[ids[i]] = struct{}{}
+	}
+}
+
+// ClearMessages clears the "messages" edge to the Message entity.
+func (m *UserMutation) ClearMessages() {
+	m.clearedmessages = true
+}
+
+// MessagesCleared reports if the "messages" edge to the Message entity was cleared.
+func (m *UserMutation) MessagesCleared() bool {
+	return m.clearedmessages
+}
+
+// RemoveMessageIDs removes the "messages" edge to the Message entity by IDs.
+func (m *UserMutation) RemoveMessageIDs(ids ...uuid.UUID) {
+	if m.removedmessages == nil {
+		m.removedmessages = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.messages, ids[i])
+		m.removedmessages[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedMessages returns the removed IDs of the "messages" edge to the Message entity.
+func (m *UserMutation) RemovedMessagesIDs() (ids []uuid.UUID) {
+	for id := range m.removedmessages {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// MessagesIDs returns the "messages" edge IDs in the mutation.
+func (m *UserMutation) MessagesIDs() (ids []uuid.UUID) {
+	for id := range m.messages {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetMessages resets all changes to the "messages" edge.
+func (m *UserMutation) ResetMessages() {
+	m.messages = nil
+	m.clearedmessages = false
+	m.removedmessages = nil
+}
+
+// AddMessageReadIDs adds the "message_reads" edge to the MessageRead entity by ids.
+func (m *UserMutation) AddMessageReadIDs(ids ...int64) {
+	if m.message_reads == nil {
+		m.message_reads = make(map[int64]struct{})
+	}
+	for i := range ids {
+		m.message_reads[ids[i]] = struct{}{}
+	}
+}
+
+// ClearMessageReads clears the "message_reads" edge to the MessageRead entity.
+func (m *UserMutation) ClearMessageReads() {
+	m.clearedmessage_reads = true
+}
+
+// MessageReadsCleared reports if the "message_reads" edge to the MessageRead entity was cleared.
+func (m *UserMutation) MessageReadsCleared() bool {
+	return m.clearedmessage_reads
+}
+
+// RemoveMessageReadIDs removes the "message_reads" edge to the MessageRead entity by IDs.
+func (m *UserMutation) RemoveMessageReadIDs(ids ...int64) {
+	if m.removedmessage_reads == nil {
+		m.removedmessage_reads = make(map[int64]struct{})
+	}
+	for i := range ids {
+		delete(m.message_reads, ids[i])
+		m.removedmessage_reads[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedMessageReads returns the removed IDs of the "message_reads" edge to the MessageRead entity.
+func (m *UserMutation) RemovedMessageReadsIDs() (ids []int64) {
+	for id := range m.removedmessage_reads {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// MessageReadsIDs returns the "message_reads" edge IDs in the mutation.
+func (m *UserMutation) MessageReadsIDs() (ids []int64) {
+	for id := range m.message_reads {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetMessageReads resets all changes to the "message_reads" edge.
+func (m *UserMutation) ResetMessageReads() {
+	m.message_reads = nil
+	m.clearedmessage_reads = false
+	m.removedmessage_reads = nil
+}
+
+// AddMessageReactionIDs adds the "message_reactions" edge to the MessageReaction entity by ids.
+func (m *UserMutation) AddMessageReactionIDs(ids ...int64) {
+	if m.message_reactions == nil {
+		m.message_reactions = make(map[int64]struct{})
+	}
+	for i := range ids {
+		m.message_reactions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearMessageReactions clears the "message_reactions" edge to the MessageReaction entity.
+func (m *UserMutation) ClearMessageReactions() {
+	m.clearedmessage_reactions = true
+}
+
+// MessageReactionsCleared reports if the "message_reactions" edge to the MessageReaction entity was cleared.
+func (m *UserMutation) MessageReactionsCleared() bool {
+	return m.clearedmessage_reactions
+}
+
+// RemoveMessageReactionIDs removes the "message_reactions" edge to the MessageReaction entity by IDs.
+func (m *UserMutation) RemoveMessageReactionIDs(ids ...int64) {
+	if m.removedmessage_reactions == nil {
+		m.removedmessage_reactions = make(map[int64]struct{})
+	}
+	for i := range ids {
+		delete(m.message_reactions, ids[i])
+		m.removedmessage_reactions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedMessageReactions returns the removed IDs of the "message_reactions" edge to the MessageReaction entity.
+func (m *UserMutation) RemovedMessageReactionsIDs() (ids []int64) {
+	for id := range m.removedmessage_reactions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// MessageReactionsIDs returns the "message_reactions" edge IDs in the mutation.
+func (m *UserMutation) MessageReactionsIDs() (ids []int64) {
+	for id := range m.message_reactions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetMessageReactions resets all changes to the "message_reactions" edge.
+func (m *UserMutation) ResetMessageReactions() {
+	m.message_reactions = nil
+	m.clearedmessage_reactions = false
+	m.removedmessage_reactions = nil
+}
+
+// AddMessageRevisionIDs adds the "message_revisions" edge to the MessageRevision entity by ids.
+func (m *UserMutation) AddMessageRevisionIDs(ids ...int64) {
+	if m.message_revisions == nil {
+		m.message_revisions = make(map[int64]struct{})
+	}
+	for i := range ids {
+		m.message_revisions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearMessageRevisions clears the "message_revisions" edge to the MessageRevision entity.
+func (m *UserMutation) ClearMessageRevisions() {
+	m.clearedmessage_revisions = true
+}
+
+// MessageRevisionsCleared reports if the "message_revisions" edge to the MessageRevision entity was cleared.
+func (m *UserMutation) MessageRevisionsCleared() bool {
+	return m.clearedmessage_revisions
+}
+
+// RemoveMessageRevisionIDs removes the "message_revisions" edge to the MessageRevision entity by IDs.
+func (m *UserMutation) RemoveMessageRevisionIDs(ids ...int64) {
+	if m.removedmessage_revisions == nil {
+		m.removedmessage_revisions = make(map[int64]struct{})
+	}
+	for i := range ids {
+		delete(m.message_revisions, ids[i])
+		m.removedmessage_revisions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedMessageRevisions returns the removed IDs of the "message_revisions" edge to the MessageRevision entity.
+func (m *UserMutation) RemovedMessageRevisionsIDs() (ids []int64) {
+	for id := range m.removedmessage_revisions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// MessageRevisionsIDs returns the "message_revisions" edge IDs in the mutation.
+func (m *UserMutation) MessageRevisionsIDs() (ids []int64) {
+	for id := range m.message_revisions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetMessageRevisions resets all changes to the "message_revisions" edge.
+func (m *UserMutation) ResetMessageRevisions() {
+	m.message_revisions = nil
+	m.clearedmessage_revisions = false
+	m.removedmessage_revisions = nil
+}
+
+// AddInviteIDs adds the "invites" edge to the Invite entity by ids.
+func (m *UserMutation) AddInviteIDs(ids ...uuid.UUID) {
+	if m.invites == nil {
+		m.invites = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.invites[ids[i]] = struct{}{}
+	}
+}
+
+// ClearInvites clears the "invites" edge to the Invite entity.
+func (m *UserMutation) ClearInvites() {
+	m.clearedinvites = true
+}
+
+// InvitesCleared reports if the "invites" edge to the Invite entity was cleared.
+func (m *UserMutation) InvitesCleared() bool {
+	return m.clearedinvites
+}
+
+// RemoveInviteIDs removes the "invites" edge to the Invite entity by IDs.
+func (m *UserMutation) RemoveInviteIDs(ids ...uuid.UUID) {
+	if m.removedinvites == nil {
+		m.removedinvites = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.invites, ids[i])
+		m.removedinvites[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedInvites returns the removed IDs of the "invites" edge to the Invite entity.
+func (m *UserMutation) RemovedInvitesIDs() (ids []uuid.UUID) {
+	for id := range m.removedinvites {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// InvitesIDs returns the "invites" edge IDs in the mutation.
+func (m *UserMutation) InvitesIDs() (ids []uuid.UUID) {
+	for id := range m.invites {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetInvites resets all changes to the "invites" edge.
+func (m *UserMutation) ResetInvites() {
+	m.invites = nil
+	m.clearedinvites = false
+	m.removedinvites = nil
+}
+
+// AddJoinRequestIDs adds the "join_requests" edge to the JoinRequest entity by ids.
+func (m *UserMutation) AddJoinRequestIDs(ids ...int64) {
+	if m.join_requests == nil {
+		m.join_requests = make(map[int64]struct{})
+	}
+	for i := range ids {
+		m.join_requests[ids[i]] = struct{}{}
+	}
+}
+
+// ClearJoinRequests clears the "join_requests" edge to the JoinRequest entity.
+func (m *UserMutation) ClearJoinRequests() {
+	m.clearedjoin_requests = true
+}
+
+// JoinRequestsCleared reports if the "join_requests" edge to the JoinRequest entity was cleared.
+func (m *UserMutation) JoinRequestsCleared() bool {
+	return m.clearedjoin_requests
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+// RemoveJoinRequestIDs removes the "join_requests" edge to the JoinRequest entity by IDs.
+func (m *UserMutation) RemoveJoinRequestIDs(ids ...int64) {
+	if m.removedjoin_requests == nil {
+		m.removedjoin_requests = make(map[int64]struct{})
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	for i := range ids {
+		delete(m.join_requests, ids[i])
+		m.removedjoin_requests[ids[i]] = struct{}{}
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+}
+
+// RemovedJoinRequests returns the removed IDs of the "join_requests" edge to the JoinRequest entity.
+func (m *UserMutation) RemovedJoinRequestsIDs() (ids []int64) {
+	for id := range m.removedjoin_requests {
+		ids = append(ids, id)
 	}
-	return oldValue.UpdatedAt, nil
+	return
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *UserMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// JoinRequestsIDs returns the "join_requests" edge IDs in the mutation.
+func (m *UserMutation) JoinRequestsIDs() (ids []int64) {
+	for id := range m.join_requests {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// SetRefreshTokenHash sets the "refresh_token_hash" field.
-func (m *UserMutation) SetRefreshTokenHash(b []byte) {
-	m.refresh_token_hash = &b
+// ResetJoinRequests resets all changes to the "join_requests" edge.
+func (m *UserMutation) ResetJoinRequests() {
+	m.join_requests = nil
+	m.clearedjoin_requests = false
+	m.removedjoin_requests = nil
 }
 
-// RefreshTokenHash returns the value of the "refresh_token_hash" field in the mutation.
-func (m *UserMutation) RefreshTokenHash() (r []byte, exists bool) {
-	v := m.refresh_token_hash
-	if v == nil {
-		return
+// AddSessionIDs adds the "sessions" edge to the Session entity by ids.
+func (m *UserMutation) AddSessionIDs(ids ...uuid.UUID) {
+	if m.sessions == nil {
+		m.sessions = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.sessions[ids[i]] = struct{}{}
 	}
-	return *v, true
 }
 
-// OldRefreshTokenHash returns the old "refresh_token_hash" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldRefreshTokenHash(ctx context.Context) (v *[]byte, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRefreshTokenHash is only allowed on UpdateOne operations")
+// ClearSessions clears the "sessions" edge to the Session entity.
+func (m *UserMutation) ClearSessions() {
+	m.clearedsessions = true
+}
+
+// SessionsCleared reports if the "sessions" edge to the Session entity was cleared.
+func (m *UserMutation) SessionsCleared() bool {
+	return m.clearedsessions
+}
+
+// RemoveSessionIDs removes the "sessions" edge to the Session entity by IDs.
+func (m *UserMutation) RemoveSessionIDs(ids ...uuid.UUID) {
+	if m.removedsessions == nil {
+		m.removedsessions = make(map[uuid.UUID]struct{})
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRefreshTokenHash requires an ID field in the mutation")
+	for i := range ids {
+		delete(m.sessions, ids[i])
+		m.removedsessions[ids[i]] = struct{}{}
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRefreshTokenHash: %w", err)
+}
+
+// RemovedSessions returns the removed IDs of the "sessions" edge to the Session entity.
+func (m *UserMutation) RemovedSessionsIDs() (ids []uuid.UUID) {
+	for id := range m.removedsessions {
+		ids = append(ids, id)
 	}
-	return oldValue.RefreshTokenHash, nil
+	return
 }
 
-// ClearRefreshTokenHash clears the value of the "refresh_token_hash" field.
-func (m *UserMutation) ClearRefreshTokenHash() {
-	m.refresh_token_hash = nil
-	m.clearedFields[user.FieldRefreshTokenHash] = struct{}{}
+// SessionsIDs returns the "sessions" edge IDs in the mutation.
+func (m *UserMutation) SessionsIDs() (ids []uuid.UUID) {
+	for id := range m.sessions {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// RefreshTokenHashCleared returns if the "refresh_token_hash" field was cleared in this mutation.
-func (m *UserMutation) RefreshTokenHashCleared() bool {
-	_, ok := m.clearedFields[user.FieldRefreshTokenHash]
-	return ok
+// ResetSessions resets all changes to the "sessions" edge.
+func (m *UserMutation) ResetSessions() {
+	m.sessions = nil
+	m.clearedsessions = false
+	m.removedsessions = nil
 }
 
-// ResetRefreshTokenHash resets all changes to the "refresh_token_hash" field.
-func (m *UserMutation) ResetRefreshTokenHash() {
-	m.refresh_token_hash = nil
-	delete(m.clearedFields, user.FieldRefreshTokenHash)
+// AddSecurityEventIDs adds the "security_events" edge to the SecurityEvent entity by ids.
+func (m *UserMutation) AddSecurityEventIDs(ids ...int64) {
+	if m.security_events == nil {
+		m.security_events = make(map[int64]struct{})
+	}
+	for i := range ids {
+		m.security_events[ids[i]] = struct{}{}
+	}
 }
 
-// SetRefreshTokenExpiresAt sets the "refresh_token_expires_at" field.
-func (m *UserMutation) SetRefreshTokenExpiresAt(t time.Time) {
-	m.refresh_token_expires_at = &t
+// ClearSecurityEvents clears the "security_events" edge to the SecurityEvent entity.
+func (m *UserMutation) ClearSecurityEvents() {
+	m.clearedsecurity_events = true
 }
 
-// RefreshTokenExpiresAt returns the value of the "refresh_token_expires_at" field in the mutation.
-func (m *UserMutation) RefreshTokenExpiresAt() (r time.Time, exists bool) {
-	v := m.refresh_token_expires_at
-	if v == nil {
-		return
-	}
-	return *v, true
+// SecurityEventsCleared reports if the "security_events" edge to the SecurityEvent entity was cleared.
+func (m *UserMutation) SecurityEventsCleared() bool {
+	return m.clearedsecurity_events
 }
 
-// OldRefreshTokenExpiresAt returns the old "refresh_token_expires_at" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldRefreshTokenExpiresAt(ctx context.Context) (v *time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRefreshTokenExpiresAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRefreshTokenExpiresAt requires an ID field in the mutation")
+// RemoveSecurityEventIDs removes the "security_events" edge to the SecurityEvent entity by IDs.
+func (m *UserMutation) RemoveSecurityEventIDs(ids ...int64) {
+	if m.removedsecurity_events == nil {
+		m.removedsecurity_events = make(map[int64]struct{})
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRefreshTokenExpiresAt: %w", err)
+	for i := range ids {
+		delete(m.security_events, ids[i])
+		m.removedsecurity_events[ids[i]] = struct{}{}
 	}
-	return oldValue.RefreshTokenExpiresAt, nil
 }
 
-// ClearRefreshTokenExpiresAt clears the value of the "refresh_token_expires_at" field.
-func (m *UserMutation) ClearRefreshTokenExpiresAt() {
-	m.refresh_token_expires_at = nil
-	m.clearedFields[user.FieldRefreshTokenExpiresAt] = struct{}{}
+// RemovedSecurityEvents returns the removed IDs of the "security_events" edge to the SecurityEvent entity.
+func (m *UserMutation) RemovedSecurityEventsIDs() (ids []int64) {
+	for id := range m.removedsecurity_events {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// RefreshTokenExpiresAtCleared returns if the "refresh_token_expires_at" field was cleared in this mutation.
-func (m *UserMutation) RefreshTokenExpiresAtCleared() bool {
-	_, ok := m.clearedFields[user.FieldRefreshTokenExpiresAt]
-	return ok
+// SecurityEventsIDs returns the "security_events" edge IDs in the mutation.
+func (m *UserMutation) SecurityEventsIDs() (ids []int64) {
+	for id := range m.security_events {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// ResetRefreshTokenExpiresAt resets all changes to the "refresh_token_expires_at" field.
-func (m *UserMutation) ResetRefreshTokenExpiresAt() {
-	m.refresh_token_expires_at = nil
-	delete(m.clearedFields, user.FieldRefreshTokenExpiresAt)
+// ResetSecurityEvents resets all changes to the "security_events" edge.
+func (m *UserMutation) ResetSecurityEvents() {
+	m.security_events = nil
+	m.clearedsecurity_events = false
+	m.removedsecurity_events = nil
 }
 
-// AddRoomMemberIDs adds the "room_members" edge to the RoomMember entity by ids.
-func (m *UserMutation) AddRoomMemberIDs(ids ...int64) {
-	if m.room_members == nil {
-		m.room_members = make(map[int64]struct{})
+// AddRevokedTokenIDs adds the "revoked_tokens" edge to the RevokedToken entity by ids.
+func (m *UserMutation) AddRevokedTokenIDs(ids ...string) {
+	if m.revoked_tokens == nil {
+		m.revoked_tokens = make(map[string]struct{})
 	}
 	for i := range ids {
-		m.room_members[ids[i]] = struct{}{}
+		m.revoked_tokens[ids[i]] = struct{}{}
 	}
 }
 
-// ClearRoomMembers clears the "room_members" edge to the RoomMember entity.
-func (m *UserMutation) ClearRoomMembers() {
-	m.clearedroom_members = true
+// ClearRevokedTokens clears the "revoked_tokens" edge to the RevokedToken entity.
+func (m *UserMutation) ClearRevokedTokens() {
+	m.clearedrevoked_tokens = true
 }
 
-// RoomMembersCleared reports if the "room_members" edge to the RoomMember entity was cleared.
-func (m *UserMutation) RoomMembersCleared() bool {
-	return m.clearedroom_members
+// RevokedTokensCleared reports if the "revoked_tokens" edge to the RevokedToken entity was cleared.
+func (m *UserMutation) RevokedTokensCleared() bool {
+	return m.clearedrevoked_tokens
 }
 
-// RemoveRoomMemberIDs removes the "room_members" edge to the RoomMember entity by IDs.
-func (m *UserMutation) RemoveRoomMemberIDs(ids ...int64) {
-	if m.removedroom_members == nil {
-		m.removedroom_members = make(map[int64]struct{})
+// RemoveRevokedTokenIDs removes the "revoked_tokens" edge to the RevokedToken entity by IDs.
+func (m *UserMutation) RemoveRevokedTokenIDs(ids ...string) {
+	if m.removedrevoked_tokens == nil {
+		m.removedrevoked_tokens = make(map[string]struct{})
 	}
 	for i := range ids {
-		delete(m.room_members, ids[i])
-		m.removedroom_members[ids[i]] = struct{}{}
+		delete(m.revoked_tokens, ids[i])
+		m.removedrevoked_tokens[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedRoomMembers returns the removed IDs of the "room_members" edge to the RoomMember entity.
-func (m *UserMutation) RemovedRoomMembersIDs() (ids []int64) {
-	for id := range m.removedroom_members {
+// RemovedRevokedTokens returns the removed IDs of the "revoked_tokens" edge to the RevokedToken entity.
+func (m *UserMutation) RemovedRevokedTokensIDs() (ids []string) {
+	for id := range m.removedrevoked_tokens {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// RoomMembersIDs returns the "room_members" edge IDs in the mutation.
-func (m *UserMutation) RoomMembersIDs() (ids []int64) {
-	for id := range m.room_members {
+// RevokedTokensIDs returns the "revoked_tokens" edge IDs in the mutation.
+func (m *UserMutation) RevokedTokensIDs() (ids []string) {
+	for id := range m.revoked_tokens {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetRoomMembers resets all changes to the "room_members" edge.
-func (m *UserMutation) ResetRoomMembers() {
-	m.room_members = nil
-	m.clearedroom_members = false
-	m.removedroom_members = nil
+// ResetRevokedTokens resets all changes to the "revoked_tokens" edge.
+func (m *UserMutation) ResetRevokedTokens() {
+	m.revoked_tokens = nil
+	m.clearedrevoked_tokens = false
+	m.removedrevoked_tokens = nil
 }
 
-// AddMessageIDs adds the "messages" edge to the Message entity by ids.
-func (m *UserMutation) AddMessageIDs(ids ...uuid.UUID) {
-	if m.messages == nil {
-		m.messages = make(map[uuid.UUID]struct{})
+// AddStreamTicketIDs adds the "stream_tickets" edge to the StreamTicket entity by ids.
+func (m *UserMutation) AddStreamTicketIDs(ids ...int64) {
+	if m.stream_tickets == nil {
+		m.stream_tickets = make(map[int64]struct{})
 	}
 	for i := range ids {
-		m.messages[ids[i]] = struct{}{}
+		m.stream_tickets[ids[i]] = struct{}{}
 	}
 }
 
-// ClearMessages clears the "messages" edge to the Message entity.
-func (m *UserMutation) ClearMessages() {
-	m.clearedmessages = true
+// ClearStreamTickets clears the "stream_tickets" edge to the StreamTicket entity.
+func (m *UserMutation) ClearStreamTickets() {
+	m.clearedstream_tickets = true
 }
 
-// MessagesCleared reports if the "messages" edge to the Message entity was cleared.
-func (m *UserMutation) MessagesCleared() bool {
-	return m.clearedmessages
+// StreamTicketsCleared reports if the "stream_tickets" edge to the StreamTicket entity was cleared.
+func (m *UserMutation) StreamTicketsCleared() bool {
+	return m.clearedstream_tickets
 }
 
-// RemoveMessageIDs removes the "messages" edge to the Message entity by IDs.
-func (m *UserMutation) RemoveMessageIDs(ids ...uuid.UUID) {
-	if m.removedmessages == nil {
-		m.removedmessages = make(map[uuid.UUID]struct{})
+// RemoveStreamTicketIDs removes the "stream_tickets" edge to the StreamTicket entity by IDs.
+func (m *UserMutation) RemoveStreamTicketIDs(ids ...int64) {
+	if m.removedstream_tickets == nil {
+		m.removedstream_tickets = make(map[int64]struct{})
 	}
 	for i := range ids {
-		delete(m.messages, ids[i])
-		m.removedmessages[ids[i]] = struct{}{}
+		delete(m.stream_tickets, ids[i])
+		m.removedstream_tickets[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedMessages returns the removed IDs of the "messages" edge to the Message entity.
-func (m *UserMutation) RemovedMessagesIDs() (ids []uuid.UUID) {
-	for id := range m.removedmessages {
+// RemovedStreamTickets returns the removed IDs of the "stream_tickets" edge to the StreamTicket entity.
+func (m *UserMutation) RemovedStreamTicketsIDs() (ids []int64) {
+	for id := range m.removedstream_tickets {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// MessagesIDs returns the "messages" edge IDs in the mutation.
-func (m *UserMutation) MessagesIDs() (ids []uuid.UUID) {
-	for id := range m.messages {
+// StreamTicketsIDs returns the "stream_tickets" edge IDs in the mutation.
+func (m *UserMutation) StreamTicketsIDs() (ids []int64) {
+	for id := range m.stream_tickets {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetMessages resets all changes to the "messages" edge.
-func (m *UserMutation) ResetMessages() {
-	m.messages = nil
-	m.clearedmessages = false
-	m.removedmessages = nil
+// ResetStreamTickets resets all changes to the "stream_tickets" edge.
+func (m *UserMutation) ResetStreamTickets() {
+	m.stream_tickets = nil
+	m.clearedstream_tickets = false
+	m.removedstream_tickets = nil
 }
 
 // Where appends a list predicates to the UserMutation builder.
@@ -2725,11 +12442,11 @@ func (m *UserMutation) Fields() []string {
 	if m.updated_at != nil {
 		fields = append(fields, user.FieldUpdatedAt)
 	}
-	if m.refresh_token_hash != nil {
-		fields = append(fields, user.FieldRefreshTokenHash)
+	if m.last_seen_at != nil {
+		fields = append(fields, user.FieldLastSeenAt)
 	}
-	if m.refresh_token_expires_at != nil {
-		fields = append(fields, user.FieldRefreshTokenExpiresAt)
+	if m.token_version != nil {
+		fields = append(fields, user.FieldTokenVersion)
 	}
 	return fields
 }
@@ -2753,10 +12470,10 @@ func (m *UserMutation) Field(name string) (ent.Value, bool) {
 		return m.CreatedAt()
 	case user.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case user.FieldRefreshTokenHash:
-		return m.RefreshTokenHash()
-	case user.FieldRefreshTokenExpiresAt:
-		return m.RefreshTokenExpiresAt()
+	case user.FieldLastSeenAt:
+		return m.LastSeenAt()
+	case user.FieldTokenVersion:
+		return m.TokenVersion()
 	}
 	return nil, false
 }
@@ -2780,10 +12497,10 @@ func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, er
 		return m.OldCreatedAt(ctx)
 	case user.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case user.FieldRefreshTokenHash:
-		return m.OldRefreshTokenHash(ctx)
-	case user.FieldRefreshTokenExpiresAt:
-		return m.OldRefreshTokenExpiresAt(ctx)
+	case user.FieldLastSeenAt:
+		return m.OldLastSeenAt(ctx)
+	case user.FieldTokenVersion:
+		return m.OldTokenVersion(ctx)
 	}
 	return nil, fmt.Errorf("unknown User field %s", name)
 }
@@ -2842,19 +12559,19 @@ func (m *UserMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetUpdatedAt(v)
 		return nil
-	case user.FieldRefreshTokenHash:
-		v, ok := value.([]byte)
+	case user.FieldLastSeenAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRefreshTokenHash(v)
+		m.SetLastSeenAt(v)
 		return nil
-	case user.FieldRefreshTokenExpiresAt:
-		v, ok := value.(time.Time)
+	case user.FieldTokenVersion:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRefreshTokenExpiresAt(v)
+		m.SetTokenVersion(v)
 		return nil
 	}
 	return fmt.Errorf("unknown User field %s", name)
@@ -2863,13 +12580,21 @@ func (m *UserMutation) SetField(name string, value ent.Value) error {
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
 func (m *UserMutation) AddedFields() []string {
-	return nil
+	var fields []string
+	if m.addtoken_version != nil {
+		fields = append(fields, user.FieldTokenVersion)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
 func (m *UserMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case user.FieldTokenVersion:
+		return m.AddedTokenVersion()
+	}
 	return nil, false
 }
 
@@ -2878,6 +12603,13 @@ func (m *UserMutation) AddedField(name string) (ent.Value, bool) {
 // type.
 func (m *UserMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case user.FieldTokenVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTokenVersion(v)
+		return nil
 	}
 	return fmt.Errorf("unknown User numeric field %s", name)
 }
@@ -2892,11 +12624,8 @@ func (m *UserMutation) ClearedFields() []string {
 	if m.FieldCleared(user.FieldBio) {
 		fields = append(fields, user.FieldBio)
 	}
-	if m.FieldCleared(user.FieldRefreshTokenHash) {
-		fields = append(fields, user.FieldRefreshTokenHash)
-	}
-	if m.FieldCleared(user.FieldRefreshTokenExpiresAt) {
-		fields = append(fields, user.FieldRefreshTokenExpiresAt)
+	if m.FieldCleared(user.FieldLastSeenAt) {
+		fields = append(fields, user.FieldLastSeenAt)
 	}
 	return fields
 }
@@ -2918,11 +12647,8 @@ func (m *UserMutation) ClearField(name string) error {
 	case user.FieldBio:
 		m.ClearBio()
 		return nil
-	case user.FieldRefreshTokenHash:
-		m.ClearRefreshTokenHash()
-		return nil
-	case user.FieldRefreshTokenExpiresAt:
-		m.ClearRefreshTokenExpiresAt()
+	case user.FieldLastSeenAt:
+		m.ClearLastSeenAt()
 		return nil
 	}
 	return fmt.Errorf("unknown User nullable field %s", name)
@@ -2953,11 +12679,11 @@ func (m *UserMutation) ResetField(name string) error {
 	case user.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case user.FieldRefreshTokenHash:
-		m.ResetRefreshTokenHash()
+	case user.FieldLastSeenAt:
+		m.ResetLastSeenAt()
 		return nil
-	case user.FieldRefreshTokenExpiresAt:
-		m.ResetRefreshTokenExpiresAt()
+	case user.FieldTokenVersion:
+		m.ResetTokenVersion()
 		return nil
 	}
 	return fmt.Errorf("unknown User field %s", name)
@@ -2965,13 +12691,40 @@ func (m *UserMutation) ResetField(name string) error {
 
 // AddedEdges returns all edge names that were set/added in this mutation.
 func (m *UserMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
+	edges := make([]string, 0, 11)
 	if m.room_members != nil {
 		edges = append(edges, user.EdgeRoomMembers)
 	}
 	if m.messages != nil {
 		edges = append(edges, user.EdgeMessages)
 	}
+	if m.message_reads != nil {
+		edges = append(edges, user.EdgeMessageReads)
+	}
+	if m.message_reactions != nil {
+		edges = append(edges, user.EdgeMessageReactions)
+	}
+	if m.message_revisions != nil {
+		edges = append(edges, user.EdgeMessageRevisions)
+	}
+	if m.invites != nil {
+		edges = append(edges, user.EdgeInvites)
+	}
+	if m.join_requests != nil {
+		edges = append(edges, user.EdgeJoinRequests)
+	}
+	if m.sessions != nil {
+		edges = append(edges, user.EdgeSessions)
+	}
+	if m.security_events != nil {
+		edges = append(edges, user.EdgeSecurityEvents)
+	}
+	if m.revoked_tokens != nil {
+		edges = append(edges, user.EdgeRevokedTokens)
+	}
+	if m.stream_tickets != nil {
+		edges = append(edges, user.EdgeStreamTickets)
+	}
 	return edges
 }
 
@@ -2991,19 +12744,100 @@ func (m *UserMutation) AddedIDs(name string) []ent.Value {
 			ids = append(ids, id)
 		}
 		return ids
+	case user.EdgeMessageReads:
+		ids := make([]ent.Value, 0, len(m.message_reads))
+		for id := range m.message_reads {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeMessageReactions:
+		ids := make([]ent.Value, 0, len(m.message_reactions))
+		for id := range m.message_reactions {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeMessageRevisions:
+		ids := make([]ent.Value, 0, len(m.message_revisions))
+		for id := range m.message_revisions {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeInvites:
+		ids := make([]ent.Value, 0, len(m.invites))
+		for id := range m.invites {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeJoinRequests:
+		ids := make([]ent.Value, 0, len(m.join_requests))
+		for id := range m.join_requests {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeSessions:
+		ids := make([]ent.Value, 0, len(m.sessions))
+		for id := range m.sessions {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeSecurityEvents:
+		ids := make([]ent.Value, 0, len(m.security_events))
+		for id := range m.security_events {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeRevokedTokens:
+		ids := make([]ent.Value, 0, len(m.revoked_tokens))
+		for id := range m.revoked_tokens {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeStreamTickets:
+		ids := make([]ent.Value, 0, len(m.stream_tickets))
+		for id := range m.stream_tickets {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
 func (m *UserMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
+	edges := make([]string, 0, 11)
 	if m.removedroom_members != nil {
 		edges = append(edges, user.EdgeRoomMembers)
 	}
 	if m.removedmessages != nil {
 		edges = append(edges, user.EdgeMessages)
 	}
+	if m.removedmessage_reads != nil {
+		edges = append(edges, user.EdgeMessageReads)
+	}
+	if m.removedmessage_reactions != nil {
+		edges = append(edges, user.EdgeMessageReactions)
+	}
+	if m.removedmessage_revisions != nil {
+		edges = append(edges, user.EdgeMessageRevisions)
+	}
+	if m.removedinvites != nil {
+		edges = append(edges, user.EdgeInvites)
+	}
+	if m.removedjoin_requests != nil {
+		edges = append(edges, user.EdgeJoinRequests)
+	}
+	if m.removedsessions != nil {
+		edges = append(edges, user.EdgeSessions)
+	}
+	if m.removedsecurity_events != nil {
+		edges = append(edges, user.EdgeSecurityEvents)
+	}
+	if m.removedrevoked_tokens != nil {
+		edges = append(edges, user.EdgeRevokedTokens)
+	}
+	if m.removedstream_tickets != nil {
+		edges = append(edges, user.EdgeStreamTickets)
+	}
 	return edges
 }
 
@@ -3023,19 +12857,100 @@ func (m *UserMutation) RemovedIDs(name string) []ent.Value {
 			ids = append(ids, id)
 		}
 		return ids
+	case user.EdgeMessageReads:
+		ids := make([]ent.Value, 0, len(m.removedmessage_reads))
+		for id := range m.removedmessage_reads {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeMessageReactions:
+		ids := make([]ent.Value, 0, len(m.removedmessage_reactions))
+		for id := range m.removedmessage_reactions {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeMessageRevisions:
+		ids := make([]ent.Value, 0, len(m.removedmessage_revisions))
+		for id := range m.removedmessage_revisions {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeInvites:
+		ids := make([]ent.Value, 0, len(m.removedinvites))
+		for id := range m.removedinvites {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeJoinRequests:
+		ids := make([]ent.Value, 0, len(m.removedjoin_requests))
+		for id := range m.removedjoin_requests {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeSessions:
+		ids := make([]ent.Value, 0, len(m.removedsessions))
+		for id := range m.removedsessions {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeSecurityEvents:
+		ids := make([]ent.Value, 0, len(m.removedsecurity_events))
+		for id := range m.removedsecurity_events {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeRevokedTokens:
+		ids := make([]ent.Value, 0, len(m.removedrevoked_tokens))
+		for id := range m.removedrevoked_tokens {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeStreamTickets:
+		ids := make([]ent.Value, 0, len(m.removedstream_tickets))
+		for id := range m.removedstream_tickets {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
 func (m *UserMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
+	edges := make([]string, 0, 11)
 	if m.clearedroom_members {
 		edges = append(edges, user.EdgeRoomMembers)
 	}
 	if m.clearedmessages {
 		edges = append(edges, user.EdgeMessages)
 	}
+	if m.clearedmessage_reads {
+		edges = append(edges, user.EdgeMessageReads)
+	}
+	if m.clearedmessage_reactions {
+		edges = append(edges, user.EdgeMessageReactions)
+	}
+	if m.clearedmessage_revisions {
+		edges = append(edges, user.EdgeMessageRevisions)
+	}
+	if m.clearedinvites {
+		edges = append(edges, user.EdgeInvites)
+	}
+	if m.clearedjoin_requests {
+		edges = append(edges, user.EdgeJoinRequests)
+	}
+	if m.clearedsessions {
+		edges = append(edges, user.EdgeSessions)
+	}
+	if m.clearedsecurity_events {
+		edges = append(edges, user.EdgeSecurityEvents)
+	}
+	if m.clearedrevoked_tokens {
+		edges = append(edges, user.EdgeRevokedTokens)
+	}
+	if m.clearedstream_tickets {
+		edges = append(edges, user.EdgeStreamTickets)
+	}
 	return edges
 }
 
@@ -3047,6 +12962,24 @@ func (m *UserMutation) EdgeCleared(name string) bool {
 		return m.clearedroom_members
 	case user.EdgeMessages:
 		return m.clearedmessages
+	case user.EdgeMessageReads:
+		return m.clearedmessage_reads
+	case user.EdgeMessageReactions:
+		return m.clearedmessage_reactions
+	case user.EdgeMessageRevisions:
+		return m.clearedmessage_revisions
+	case user.EdgeInvites:
+		return m.clearedinvites
+	case user.EdgeJoinRequests:
+		return m.clearedjoin_requests
+	case user.EdgeSessions:
+		return m.clearedsessions
+	case user.EdgeSecurityEvents:
+		return m.clearedsecurity_events
+	case user.EdgeRevokedTokens:
+		return m.clearedrevoked_tokens
+	case user.EdgeStreamTickets:
+		return m.clearedstream_tickets
 	}
 	return false
 }
@@ -3069,6 +13002,33 @@ func (m *UserMutation) ResetEdge(name string) error {
 	case user.EdgeMessages:
 		m.ResetMessages()
 		return nil
+	case user.EdgeMessageReads:
+		m.ResetMessageReads()
+		return nil
+	case user.EdgeMessageReactions:
+		m.ResetMessageReactions()
+		return nil
+	case user.EdgeMessageRevisions:
+		m.ResetMessageRevisions()
+		return nil
+	case user.EdgeInvites:
+		m.ResetInvites()
+		return nil
+	case user.EdgeJoinRequests:
+		m.ResetJoinRequests()
+		return nil
+	case user.EdgeSessions:
+		m.ResetSessions()
+		return nil
+	case user.EdgeSecurityEvents:
+		m.ResetSecurityEvents()
+		return nil
+	case user.EdgeRevokedTokens:
+		m.ResetRevokedTokens()
+		return nil
+	case user.EdgeStreamTickets:
+		m.ResetStreamTickets()
+		return nil
 	}
 	return fmt.Errorf("unknown User edge %s", name)
 }
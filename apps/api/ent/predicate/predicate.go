@@ -9,11 +9,41 @@ import (
 // ChatRoom is the predicate function for chatroom builders.
 type ChatRoom func(*sql.Selector)
 
+// Invite is the predicate function for invite builders.
+type Invite func(*sql.Selector)
+
+// JoinRequest is the predicate function for joinrequest builders.
+type JoinRequest func(*sql.Selector)
+
 // Message is the predicate function for message builders.
 type Message func(*sql.Selector)
 
+// MessageReaction is the predicate function for messagereaction builders.
+type MessageReaction func(*sql.Selector)
+
+// MessageRead is the predicate function for messageread builders.
+type MessageRead func(*sql.Selector)
+
+// MessageRevision is the predicate function for messagerevision builders.
+type MessageRevision func(*sql.Selector)
+
+// RevokedToken is the predicate function for revokedtoken builders.
+type RevokedToken func(*sql.Selector)
+
 // RoomMember is the predicate function for roommember builders.
 type RoomMember func(*sql.Selector)
 
+// RotatedRefreshToken is the predicate function for rotatedrefreshtoken builders.
+type RotatedRefreshToken func(*sql.Selector)
+
+// SecurityEvent is the predicate function for securityevent builders.
+type SecurityEvent func(*sql.Selector)
+
+// Session is the predicate function for session builders.
+type Session func(*sql.Selector)
+
+// StreamTicket is the predicate function for streamticket builders.
+type StreamTicket func(*sql.Selector)
+
 // User is the predicate function for user builders.
 type User func(*sql.Selector)
@@ -0,0 +1,159 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/revokedtoken"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/user"
+)
+
+// RevokedToken is the model entity for the RevokedToken schema.
+type RevokedToken struct {
+	config `json:"-"`
+	// ID of the ent.
+	// アクセストークンID（jti）
+	ID string `json:"id,omitempty"`
+	// トークンを発行したユーザーID
+	UserID uuid.UUID `json:"user_id,omitempty"`
+	// トークンの有効期限（期限後は記録を削除できる）
+	ExpiresAt time.Time `json:"expires_at,omitempty"`
+	// 失効日時
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the RevokedTokenQuery when eager-loading is set.
+	Edges        RevokedTokenEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// RevokedTokenEdges holds the relations/edges for other nodes in the graph.
+type RevokedTokenEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e RevokedTokenEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*RevokedToken) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case revokedtoken.FieldID:
+			values[i] = new(sql.NullString)
+		case revokedtoken.FieldExpiresAt, revokedtoken.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case revokedtoken.FieldUserID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the RevokedToken fields.
+func (rt *RevokedToken) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case revokedtoken.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				rt.ID = value.String
+			}
+		case revokedtoken.FieldUserID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value != nil {
+				rt.UserID = *value
+			}
+		case revokedtoken.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				rt.ExpiresAt = value.Time
+			}
+		case revokedtoken.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				rt.CreatedAt = value.Time
+			}
+		default:
+			rt.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the RevokedToken.
+// This includes values selected through modifiers, order, etc.
+func (rt *RevokedToken) Value(name string) (ent.Value, error) {
+	return rt.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the RevokedToken entity.
+func (rt *RevokedToken) QueryUser() *UserQuery {
+	return NewRevokedTokenClient(rt.config).QueryUser(rt)
+}
+
+// Update returns a builder for updating this RevokedToken.
+// Note that you need to call RevokedToken.Unwrap() before calling this method if this RevokedToken
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (rt *RevokedToken) Update() *RevokedTokenUpdateOne {
+	return NewRevokedTokenClient(rt.config).UpdateOne(rt)
+}
+
+// Unwrap unwraps the RevokedToken entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (rt *RevokedToken) Unwrap() *RevokedToken {
+	_tx, ok := rt.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: RevokedToken is not a transactional entity")
+	}
+	rt.config.driver = _tx.drv
+	return rt
+}
+
+// String implements the fmt.Stringer.
+func (rt *RevokedToken) String() string {
+	var builder strings.Builder
+	builder.WriteString("RevokedToken(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", rt.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", rt.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("expires_at=")
+	builder.WriteString(rt.ExpiresAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(rt.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// RevokedTokens is a parsable slice of RevokedToken.
+type RevokedTokens []*RevokedToken
@@ -24,8 +24,16 @@ type RoomMember struct {
 	RoomID uuid.UUID `json:"room_id,omitempty"`
 	// ユーザーID
 	UserID uuid.UUID `json:"user_id,omitempty"`
+	// ルーム内の役割（owner: 作成者・全権限、admin: ルーム設定とメンバー管理、member: 一般メンバー）
+	Role roommember.Role `json:"role,omitempty"`
 	// 参加日時
 	JoinedAt time.Time `json:"joined_at,omitempty"`
+	// ルーム一覧でアーカイブしているかどうか（メンバーごとの設定）
+	Archived bool `json:"archived,omitempty"`
+	// 通知をミュートする期限（メンバーごとの設定）
+	MutedUntil *time.Time `json:"muted_until,omitempty"`
+	// ルーム一覧でのピン留め順（nullはピン留めなし、小さいほど上に表示）
+	PinnedOrder *int `json:"pinned_order,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the RoomMemberQuery when eager-loading is set.
 	Edges        RoomMemberEdges `json:"edges"`
@@ -70,9 +78,13 @@ func (*RoomMember) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case roommember.FieldID:
+		case roommember.FieldArchived:
+			values[i] = new(sql.NullBool)
+		case roommember.FieldID, roommember.FieldPinnedOrder:
 			values[i] = new(sql.NullInt64)
-		case roommember.FieldJoinedAt:
+		case roommember.FieldRole:
+			values[i] = new(sql.NullString)
+		case roommember.FieldJoinedAt, roommember.FieldMutedUntil:
 			values[i] = new(sql.NullTime)
 		case roommember.FieldRoomID, roommember.FieldUserID:
 			values[i] = new(uuid.UUID)
@@ -109,12 +121,38 @@ func (rm *RoomMember) assignValues(columns []string, values []any) error {
 			} else if value != nil {
 				rm.UserID = *value
 			}
+		case roommember.FieldRole:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field role", values[i])
+			} else if value.Valid {
+				rm.Role = roommember.Role(value.String)
+			}
 		case roommember.FieldJoinedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field joined_at", values[i])
 			} else if value.Valid {
 				rm.JoinedAt = value.Time
 			}
+		case roommember.FieldArchived:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field archived", values[i])
+			} else if value.Valid {
+				rm.Archived = value.Bool
+			}
+		case roommember.FieldMutedUntil:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field muted_until", values[i])
+			} else if value.Valid {
+				rm.MutedUntil = new(time.Time)
+				*rm.MutedUntil = value.Time
+			}
+		case roommember.FieldPinnedOrder:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field pinned_order", values[i])
+			} else if value.Valid {
+				rm.PinnedOrder = new(int)
+				*rm.PinnedOrder = int(value.Int64)
+			}
 		default:
 			rm.selectValues.Set(columns[i], values[i])
 		}
@@ -167,8 +205,24 @@ func (rm *RoomMember) String() string {
 	builder.WriteString("user_id=")
 	builder.WriteString(fmt.Sprintf("%v", rm.UserID))
 	builder.WriteString(", ")
+	builder.WriteString("role=")
+	builder.WriteString(fmt.Sprintf("%v", rm.Role))
+	builder.WriteString(", ")
 	builder.WriteString("joined_at=")
 	builder.WriteString(rm.JoinedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("archived=")
+	builder.WriteString(fmt.Sprintf("%v", rm.Archived))
+	builder.WriteString(", ")
+	if v := rm.MutedUntil; v != nil {
+		builder.WriteString("muted_until=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := rm.PinnedOrder; v != nil {
+		builder.WriteString("pinned_order=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -3,6 +3,7 @@
 package roommember
 
 import (
+	"fmt"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
@@ -18,8 +19,16 @@ const (
 	FieldRoomID = "room_id"
 	// FieldUserID holds the string denoting the user_id field in the database.
 	FieldUserID = "user_id"
+	// FieldRole holds the string denoting the role field in the database.
+	FieldRole = "role"
 	// FieldJoinedAt holds the string denoting the joined_at field in the database.
 	FieldJoinedAt = "joined_at"
+	// FieldArchived holds the string denoting the archived field in the database.
+	FieldArchived = "archived"
+	// FieldMutedUntil holds the string denoting the muted_until field in the database.
+	FieldMutedUntil = "muted_until"
+	// FieldPinnedOrder holds the string denoting the pinned_order field in the database.
+	FieldPinnedOrder = "pinned_order"
 	// EdgeRoom holds the string denoting the room edge name in mutations.
 	EdgeRoom = "room"
 	// EdgeUser holds the string denoting the user edge name in mutations.
@@ -47,7 +56,11 @@ var Columns = []string{
 	FieldID,
 	FieldRoomID,
 	FieldUserID,
+	FieldRole,
 	FieldJoinedAt,
+	FieldArchived,
+	FieldMutedUntil,
+	FieldPinnedOrder,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -63,10 +76,41 @@ func ValidColumn(column string) bool {
 var (
 	// DefaultJoinedAt holds the default value on creation for the "joined_at" field.
 	DefaultJoinedAt func() time.Time
+	// DefaultArchived holds the default value on creation for the "archived" field.
+	DefaultArchived bool
+	// PinnedOrderValidator is a validator for the "pinned_order" field. It is called by the builders before save.
+	PinnedOrderValidator func(int) error
 	// IDValidator is a validator for the "id" field. It is called by the builders before save.
 	IDValidator func(int64) error
 )
 
+// Role defines the type for the "role" enum field.
+type Role string
+
+// RoleMember is the default value of the Role enum.
+const DefaultRole = RoleMember
+
+// Role values.
+const (
+	RoleOwner  Role = "owner"
+	RoleAdmin  Role = "admin"
+	RoleMember Role = "member"
+)
+
+func (r Role) String() string {
+	return string(r)
+}
+
+// RoleValidator is a validator for the "role" field enum values. It is called by the builders before save.
+func RoleValidator(r Role) error {
+	switch r {
+	case RoleOwner, RoleAdmin, RoleMember:
+		return nil
+	default:
+		return fmt.Errorf("roommember: invalid enum value for role field: %q", r)
+	}
+}
+
 // OrderOption defines the ordering options for the RoomMember queries.
 type OrderOption func(*sql.Selector)
 
@@ -85,11 +129,31 @@ func ByUserID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUserID, opts...).ToFunc()
 }
 
+// ByRole orders the results by the role field.
+func ByRole(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRole, opts...).ToFunc()
+}
+
 // ByJoinedAt orders the results by the joined_at field.
 func ByJoinedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldJoinedAt, opts...).ToFunc()
 }
 
+// ByArchived orders the results by the archived field.
+func ByArchived(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldArchived, opts...).ToFunc()
+}
+
+// ByMutedUntil orders the results by the muted_until field.
+func ByMutedUntil(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMutedUntil, opts...).ToFunc()
+}
+
+// ByPinnedOrder orders the results by the pinned_order field.
+func ByPinnedOrder(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPinnedOrder, opts...).ToFunc()
+}
+
 // ByRoomField orders the results by room field.
 func ByRoomField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
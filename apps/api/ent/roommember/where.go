@@ -71,6 +71,21 @@ func JoinedAt(v time.Time) predicate.RoomMember {
 	return predicate.RoomMember(sql.FieldEQ(FieldJoinedAt, v))
 }
 
+// Archived applies equality check predicate on the "archived" field. It's identical to ArchivedEQ.
+func Archived(v bool) predicate.RoomMember {
+	return predicate.RoomMember(sql.FieldEQ(FieldArchived, v))
+}
+
+// MutedUntil applies equality check predicate on the "muted_until" field. It's identical to MutedUntilEQ.
+func MutedUntil(v time.Time) predicate.RoomMember {
+	return predicate.RoomMember(sql.FieldEQ(FieldMutedUntil, v))
+}
+
+// PinnedOrder applies equality check predicate on the "pinned_order" field. It's identical to PinnedOrderEQ.
+func PinnedOrder(v int) predicate.RoomMember {
+	return predicate.RoomMember(sql.FieldEQ(FieldPinnedOrder, v))
+}
+
 // RoomIDEQ applies the EQ predicate on the "room_id" field.
 func RoomIDEQ(v uuid.UUID) predicate.RoomMember {
 	return predicate.RoomMember(sql.FieldEQ(FieldRoomID, v))
@@ -111,6 +126,26 @@ func UserIDNotIn(vs ...uuid.UUID) predicate.RoomMember {
 	return predicate.RoomMember(sql.FieldNotIn(FieldUserID, vs...))
 }
 
+// RoleEQ applies the EQ predicate on the "role" field.
+func RoleEQ(v Role) predicate.RoomMember {
+	return predicate.RoomMember(sql.FieldEQ(FieldRole, v))
+}
+
+// RoleNEQ applies the NEQ predicate on the "role" field.
+func RoleNEQ(v Role) predicate.RoomMember {
+	return predicate.RoomMember(sql.FieldNEQ(FieldRole, v))
+}
+
+// RoleIn applies the In predicate on the "role" field.
+func RoleIn(vs ...Role) predicate.RoomMember {
+	return predicate.RoomMember(sql.FieldIn(FieldRole, vs...))
+}
+
+// RoleNotIn applies the NotIn predicate on the "role" field.
+func RoleNotIn(vs ...Role) predicate.RoomMember {
+	return predicate.RoomMember(sql.FieldNotIn(FieldRole, vs...))
+}
+
 // JoinedAtEQ applies the EQ predicate on the "joined_at" field.
 func JoinedAtEQ(v time.Time) predicate.RoomMember {
 	return predicate.RoomMember(sql.FieldEQ(FieldJoinedAt, v))
@@ -151,6 +186,116 @@ func JoinedAtLTE(v time.Time) predicate.RoomMember {
 	return predicate.RoomMember(sql.FieldLTE(FieldJoinedAt, v))
 }
 
+// ArchivedEQ applies the EQ predicate on the "archived" field.
+func ArchivedEQ(v bool) predicate.RoomMember {
+	return predicate.RoomMember(sql.FieldEQ(FieldArchived, v))
+}
+
+// ArchivedNEQ applies the NEQ predicate on the "archived" field.
+func ArchivedNEQ(v bool) predicate.RoomMember {
+	return predicate.RoomMember(sql.FieldNEQ(FieldArchived, v))
+}
+
+// MutedUntilEQ applies the EQ predicate on the "muted_until" field.
+func MutedUntilEQ(v time.Time) predicate.RoomMember {
+	return predicate.RoomMember(sql.FieldEQ(FieldMutedUntil, v))
+}
+
+// MutedUntilNEQ applies the NEQ predicate on the "muted_until" field.
+func MutedUntilNEQ(v time.Time) predicate.RoomMember {
+	return predicate.RoomMember(sql.FieldNEQ(FieldMutedUntil, v))
+}
+
+// MutedUntilIn applies the In predicate on the "muted_until" field.
+func MutedUntilIn(vs ...time.Time) predicate.RoomMember {
+	return predicate.RoomMember(sql.FieldIn(FieldMutedUntil, vs...))
+}
+
+// MutedUntilNotIn applies the NotIn predicate on the "muted_until" field.
+func MutedUntilNotIn(vs ...time.Time) predicate.RoomMember {
+	return predicate.RoomMember(sql.FieldNotIn(FieldMutedUntil, vs...))
+}
+
+// MutedUntilGT applies the GT predicate on the "muted_until" field.
+func MutedUntilGT(v time.Time) predicate.RoomMember {
+	return predicate.RoomMember(sql.FieldGT(FieldMutedUntil, v))
+}
+
+// MutedUntilGTE applies the GTE predicate on the "muted_until" field.
+func MutedUntilGTE(v time.Time) predicate.RoomMember {
+	return predicate.RoomMember(sql.FieldGTE(FieldMutedUntil, v))
+}
+
+// MutedUntilLT applies the LT predicate on the "muted_until" field.
+func MutedUntilLT(v time.Time) predicate.RoomMember {
+	return predicate.RoomMember(sql.FieldLT(FieldMutedUntil, v))
+}
+
+// MutedUntilLTE applies the LTE predicate on the "muted_until" field.
+func MutedUntilLTE(v time.Time) predicate.RoomMember {
+	return predicate.RoomMember(sql.FieldLTE(FieldMutedUntil, v))
+}
+
+// MutedUntilIsNil applies the IsNil predicate on the "muted_until" field.
+func MutedUntilIsNil() predicate.RoomMember {
+	return predicate.RoomMember(sql.FieldIsNull(FieldMutedUntil))
+}
+
+// MutedUntilNotNil applies the NotNil predicate on the "muted_until" field.
+func MutedUntilNotNil() predicate.RoomMember {
+	return predicate.RoomMember(sql.FieldNotNull(FieldMutedUntil))
+}
+
+// PinnedOrderEQ applies the EQ predicate on the "pinned_order" field.
+func PinnedOrderEQ(v int) predicate.RoomMember {
+	return predicate.RoomMember(sql.FieldEQ(FieldPinnedOrder, v))
+}
+
+// PinnedOrderNEQ applies the NEQ predicate on the "pinned_order" field.
+func PinnedOrderNEQ(v int) predicate.RoomMember {
+	return predicate.RoomMember(sql.FieldNEQ(FieldPinnedOrder, v))
+}
+
+// PinnedOrderIn applies the In predicate on the "pinned_order" field.
+func PinnedOrderIn(vs ...int) predicate.RoomMember {
+	return predicate.RoomMember(sql.FieldIn(FieldPinnedOrder, vs...))
+}
+
+// PinnedOrderNotIn applies the NotIn predicate on the "pinned_order" field.
+func PinnedOrderNotIn(vs ...int) predicate.RoomMember {
+	return predicate.RoomMember(sql.FieldNotIn(FieldPinnedOrder, vs...))
+}
+
+// PinnedOrderGT applies the GT predicate on the "pinned_order" field.
+func PinnedOrderGT(v int) predicate.RoomMember {
+	return predicate.RoomMember(sql.FieldGT(FieldPinnedOrder, v))
+}
+
+// PinnedOrderGTE applies the GTE predicate on the "pinned_order" field.
+func PinnedOrderGTE(v int) predicate.RoomMember {
+	return predicate.RoomMember(sql.FieldGTE(FieldPinnedOrder, v))
+}
+
+// PinnedOrderLT applies the LT predicate on the "pinned_order" field.
+func PinnedOrderLT(v int) predicate.RoomMember {
+	return predicate.RoomMember(sql.FieldLT(FieldPinnedOrder, v))
+}
+
+// PinnedOrderLTE applies the LTE predicate on the "pinned_order" field.
+func PinnedOrderLTE(v int) predicate.RoomMember {
+	return predicate.RoomMember(sql.FieldLTE(FieldPinnedOrder, v))
+}
+
+// PinnedOrderIsNil applies the IsNil predicate on the "pinned_order" field.
+func PinnedOrderIsNil() predicate.RoomMember {
+	return predicate.RoomMember(sql.FieldIsNull(FieldPinnedOrder))
+}
+
+// PinnedOrderNotNil applies the NotNil predicate on the "pinned_order" field.
+func PinnedOrderNotNil() predicate.RoomMember {
+	return predicate.RoomMember(sql.FieldNotNull(FieldPinnedOrder))
+}
+
 // HasRoom applies the HasEdge predicate on the "room" edge.
 func HasRoom() predicate.RoomMember {
 	return predicate.RoomMember(func(s *sql.Selector) {
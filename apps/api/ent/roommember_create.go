@@ -35,6 +35,20 @@ func (rmc *RoomMemberCreate) SetUserID(u uuid.UUID) *RoomMemberCreate {
 	return rmc
 }
 
+// SetRole sets the "role" field.
+func (rmc *RoomMemberCreate) SetRole(r roommember.Role) *RoomMemberCreate {
+	rmc.mutation.SetRole(r)
+	return rmc
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (rmc *RoomMemberCreate) SetNillableRole(r *roommember.Role) *RoomMemberCreate {
+	if r != nil {
+		rmc.SetRole(*r)
+	}
+	return rmc
+}
+
 // SetJoinedAt sets the "joined_at" field.
 func (rmc *RoomMemberCreate) SetJoinedAt(t time.Time) *RoomMemberCreate {
 	rmc.mutation.SetJoinedAt(t)
@@ -49,6 +63,48 @@ func (rmc *RoomMemberCreate) SetNillableJoinedAt(t *time.Time) *RoomMemberCreate
 	return rmc
 }
 
+// SetArchived sets the "archived" field.
+func (rmc *RoomMemberCreate) SetArchived(b bool) *RoomMemberCreate {
+	rmc.mutation.SetArchived(b)
+	return rmc
+}
+
+// SetNillableArchived sets the "archived" field if the given value is not nil.
+func (rmc *RoomMemberCreate) SetNillableArchived(b *bool) *RoomMemberCreate {
+	if b != nil {
+		rmc.SetArchived(*b)
+	}
+	return rmc
+}
+
+// SetMutedUntil sets the "muted_until" field.
+func (rmc *RoomMemberCreate) SetMutedUntil(t time.Time) *RoomMemberCreate {
+	rmc.mutation.SetMutedUntil(t)
+	return rmc
+}
+
+// SetNillableMutedUntil sets the "muted_until" field if the given value is not nil.
+func (rmc *RoomMemberCreate) SetNillableMutedUntil(t *time.Time) *RoomMemberCreate {
+	if t != nil {
+		rmc.SetMutedUntil(*t)
+	}
+	return rmc
+}
+
+// SetPinnedOrder sets the "pinned_order" field.
+func (rmc *RoomMemberCreate) SetPinnedOrder(i int) *RoomMemberCreate {
+	rmc.mutation.SetPinnedOrder(i)
+	return rmc
+}
+
+// SetNillablePinnedOrder sets the "pinned_order" field if the given value is not nil.
+func (rmc *RoomMemberCreate) SetNillablePinnedOrder(i *int) *RoomMemberCreate {
+	if i != nil {
+		rmc.SetPinnedOrder(*i)
+	}
+	return rmc
+}
+
 // SetID sets the "id" field.
 func (rmc *RoomMemberCreate) SetID(i int64) *RoomMemberCreate {
 	rmc.mutation.SetID(i)
@@ -100,10 +156,18 @@ func (rmc *RoomMemberCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (rmc *RoomMemberCreate) defaults() {
+	if _, ok := rmc.mutation.Role(); !ok {
+		v := roommember.DefaultRole
+		rmc.mutation.SetRole(v)
+	}
 	if _, ok := rmc.mutation.JoinedAt(); !ok {
 		v := roommember.DefaultJoinedAt()
 		rmc.mutation.SetJoinedAt(v)
 	}
+	if _, ok := rmc.mutation.Archived(); !ok {
+		v := roommember.DefaultArchived
+		rmc.mutation.SetArchived(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -114,9 +178,25 @@ func (rmc *RoomMemberCreate) check() error {
 	if _, ok := rmc.mutation.UserID(); !ok {
 		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "RoomMember.user_id"`)}
 	}
+	if _, ok := rmc.mutation.Role(); !ok {
+		return &ValidationError{Name: "role", err: errors.New(`ent: missing required field "RoomMember.role"`)}
+	}
+	if v, ok := rmc.mutation.Role(); ok {
+		if err := roommember.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "RoomMember.role": %w`, err)}
+		}
+	}
 	if _, ok := rmc.mutation.JoinedAt(); !ok {
 		return &ValidationError{Name: "joined_at", err: errors.New(`ent: missing required field "RoomMember.joined_at"`)}
 	}
+	if _, ok := rmc.mutation.Archived(); !ok {
+		return &ValidationError{Name: "archived", err: errors.New(`ent: missing required field "RoomMember.archived"`)}
+	}
+	if v, ok := rmc.mutation.PinnedOrder(); ok {
+		if err := roommember.PinnedOrderValidator(v); err != nil {
+			return &ValidationError{Name: "pinned_order", err: fmt.Errorf(`ent: validator failed for field "RoomMember.pinned_order": %w`, err)}
+		}
+	}
 	if v, ok := rmc.mutation.ID(); ok {
 		if err := roommember.IDValidator(v); err != nil {
 			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "RoomMember.id": %w`, err)}
@@ -160,10 +240,26 @@ func (rmc *RoomMemberCreate) createSpec() (*RoomMember, *sqlgraph.CreateSpec) {
 		_node.ID = id
 		_spec.ID.Value = id
 	}
+	if value, ok := rmc.mutation.Role(); ok {
+		_spec.SetField(roommember.FieldRole, field.TypeEnum, value)
+		_node.Role = value
+	}
 	if value, ok := rmc.mutation.JoinedAt(); ok {
 		_spec.SetField(roommember.FieldJoinedAt, field.TypeTime, value)
 		_node.JoinedAt = value
 	}
+	if value, ok := rmc.mutation.Archived(); ok {
+		_spec.SetField(roommember.FieldArchived, field.TypeBool, value)
+		_node.Archived = value
+	}
+	if value, ok := rmc.mutation.MutedUntil(); ok {
+		_spec.SetField(roommember.FieldMutedUntil, field.TypeTime, value)
+		_node.MutedUntil = &value
+	}
+	if value, ok := rmc.mutation.PinnedOrder(); ok {
+		_spec.SetField(roommember.FieldPinnedOrder, field.TypeInt, value)
+		_node.PinnedOrder = &value
+	}
 	if nodes := rmc.mutation.RoomIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
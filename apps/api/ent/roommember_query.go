@@ -8,6 +8,7 @@ import (
 	"math"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
@@ -27,6 +28,7 @@ type RoomMemberQuery struct {
 	predicates []predicate.RoomMember
 	withRoom   *ChatRoomQuery
 	withUser   *UserQuery
+	modifiers  []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -421,6 +423,9 @@ func (rmq *RoomMemberQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(rmq.modifiers) > 0 {
+		_spec.Modifiers = rmq.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -506,6 +511,9 @@ func (rmq *RoomMemberQuery) loadUser(ctx context.Context, query *UserQuery, node
 
 func (rmq *RoomMemberQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := rmq.querySpec()
+	if len(rmq.modifiers) > 0 {
+		_spec.Modifiers = rmq.modifiers
+	}
 	_spec.Node.Columns = rmq.ctx.Fields
 	if len(rmq.ctx.Fields) > 0 {
 		_spec.Unique = rmq.ctx.Unique != nil && *rmq.ctx.Unique
@@ -574,6 +582,9 @@ func (rmq *RoomMemberQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if rmq.ctx.Unique != nil && *rmq.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range rmq.modifiers {
+		m(selector)
+	}
 	for _, p := range rmq.predicates {
 		p(selector)
 	}
@@ -591,6 +602,32 @@ func (rmq *RoomMemberQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (rmq *RoomMemberQuery) ForUpdate(opts ...sql.LockOption) *RoomMemberQuery {
+	if rmq.driver.Dialect() == dialect.Postgres {
+		rmq.Unique(false)
+	}
+	rmq.modifiers = append(rmq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return rmq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (rmq *RoomMemberQuery) ForShare(opts ...sql.LockOption) *RoomMemberQuery {
+	if rmq.driver.Dialect() == dialect.Postgres {
+		rmq.Unique(false)
+	}
+	rmq.modifiers = append(rmq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return rmq
+}
+
 // RoomMemberGroupBy is the group-by builder for RoomMember entities.
 type RoomMemberGroupBy struct {
 	selector
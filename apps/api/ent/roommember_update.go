@@ -59,6 +59,20 @@ func (rmu *RoomMemberUpdate) SetNillableUserID(u *uuid.UUID) *RoomMemberUpdate {
 	return rmu
 }
 
+// SetRole sets the "role" field.
+func (rmu *RoomMemberUpdate) SetRole(r roommember.Role) *RoomMemberUpdate {
+	rmu.mutation.SetRole(r)
+	return rmu
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (rmu *RoomMemberUpdate) SetNillableRole(r *roommember.Role) *RoomMemberUpdate {
+	if r != nil {
+		rmu.SetRole(*r)
+	}
+	return rmu
+}
+
 // SetJoinedAt sets the "joined_at" field.
 func (rmu *RoomMemberUpdate) SetJoinedAt(t time.Time) *RoomMemberUpdate {
 	rmu.mutation.SetJoinedAt(t)
@@ -73,6 +87,67 @@ func (rmu *RoomMemberUpdate) SetNillableJoinedAt(t *time.Time) *RoomMemberUpdate
 	return rmu
 }
 
+// SetArchived sets the "archived" field.
+func (rmu *RoomMemberUpdate) SetArchived(b bool) *RoomMemberUpdate {
+	rmu.mutation.SetArchived(b)
+	return rmu
+}
+
+// SetNillableArchived sets the "archived" field if the given value is not nil.
+func (rmu *RoomMemberUpdate) SetNillableArchived(b *bool) *RoomMemberUpdate {
+	if b != nil {
+		rmu.SetArchived(*b)
+	}
+	return rmu
+}
+
+// SetMutedUntil sets the "muted_until" field.
+func (rmu *RoomMemberUpdate) SetMutedUntil(t time.Time) *RoomMemberUpdate {
+	rmu.mutation.SetMutedUntil(t)
+	return rmu
+}
+
+// SetNillableMutedUntil sets the "muted_until" field if the given value is not nil.
+func (rmu *RoomMemberUpdate) SetNillableMutedUntil(t *time.Time) *RoomMemberUpdate {
+	if t != nil {
+		rmu.SetMutedUntil(*t)
+	}
+	return rmu
+}
+
+// ClearMutedUntil clears the value of the "muted_until" field.
+func (rmu *RoomMemberUpdate) ClearMutedUntil() *RoomMemberUpdate {
+	rmu.mutation.ClearMutedUntil()
+	return rmu
+}
+
+// SetPinnedOrder sets the "pinned_order" field.
+func (rmu *RoomMemberUpdate) SetPinnedOrder(i int) *RoomMemberUpdate {
+	rmu.mutation.ResetPinnedOrder()
+	rmu.mutation.SetPinnedOrder(i)
+	return rmu
+}
+
+// SetNillablePinnedOrder sets the "pinned_order" field if the given value is not nil.
+func (rmu *RoomMemberUpdate) SetNillablePinnedOrder(i *int) *RoomMemberUpdate {
+	if i != nil {
+		rmu.SetPinnedOrder(*i)
+	}
+	return rmu
+}
+
+// AddPinnedOrder adds i to the "pinned_order" field.
+func (rmu *RoomMemberUpdate) AddPinnedOrder(i int) *RoomMemberUpdate {
+	rmu.mutation.AddPinnedOrder(i)
+	return rmu
+}
+
+// ClearPinnedOrder clears the value of the "pinned_order" field.
+func (rmu *RoomMemberUpdate) ClearPinnedOrder() *RoomMemberUpdate {
+	rmu.mutation.ClearPinnedOrder()
+	return rmu
+}
+
 // SetRoom sets the "room" edge to the ChatRoom entity.
 func (rmu *RoomMemberUpdate) SetRoom(c *ChatRoom) *RoomMemberUpdate {
 	return rmu.SetRoomID(c.ID)
@@ -129,6 +204,16 @@ func (rmu *RoomMemberUpdate) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (rmu *RoomMemberUpdate) check() error {
+	if v, ok := rmu.mutation.Role(); ok {
+		if err := roommember.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "RoomMember.role": %w`, err)}
+		}
+	}
+	if v, ok := rmu.mutation.PinnedOrder(); ok {
+		if err := roommember.PinnedOrderValidator(v); err != nil {
+			return &ValidationError{Name: "pinned_order", err: fmt.Errorf(`ent: validator failed for field "RoomMember.pinned_order": %w`, err)}
+		}
+	}
 	if rmu.mutation.RoomCleared() && len(rmu.mutation.RoomIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "RoomMember.room"`)
 	}
@@ -150,9 +235,30 @@ func (rmu *RoomMemberUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			}
 		}
 	}
+	if value, ok := rmu.mutation.Role(); ok {
+		_spec.SetField(roommember.FieldRole, field.TypeEnum, value)
+	}
 	if value, ok := rmu.mutation.JoinedAt(); ok {
 		_spec.SetField(roommember.FieldJoinedAt, field.TypeTime, value)
 	}
+	if value, ok := rmu.mutation.Archived(); ok {
+		_spec.SetField(roommember.FieldArchived, field.TypeBool, value)
+	}
+	if value, ok := rmu.mutation.MutedUntil(); ok {
+		_spec.SetField(roommember.FieldMutedUntil, field.TypeTime, value)
+	}
+	if rmu.mutation.MutedUntilCleared() {
+		_spec.ClearField(roommember.FieldMutedUntil, field.TypeTime)
+	}
+	if value, ok := rmu.mutation.PinnedOrder(); ok {
+		_spec.SetField(roommember.FieldPinnedOrder, field.TypeInt, value)
+	}
+	if value, ok := rmu.mutation.AddedPinnedOrder(); ok {
+		_spec.AddField(roommember.FieldPinnedOrder, field.TypeInt, value)
+	}
+	if rmu.mutation.PinnedOrderCleared() {
+		_spec.ClearField(roommember.FieldPinnedOrder, field.TypeInt)
+	}
 	if rmu.mutation.RoomCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -259,6 +365,20 @@ func (rmuo *RoomMemberUpdateOne) SetNillableUserID(u *uuid.UUID) *RoomMemberUpda
 	return rmuo
 }
 
+// SetRole sets the "role" field.
+func (rmuo *RoomMemberUpdateOne) SetRole(r roommember.Role) *RoomMemberUpdateOne {
+	rmuo.mutation.SetRole(r)
+	return rmuo
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (rmuo *RoomMemberUpdateOne) SetNillableRole(r *roommember.Role) *RoomMemberUpdateOne {
+	if r != nil {
+		rmuo.SetRole(*r)
+	}
+	return rmuo
+}
+
 // SetJoinedAt sets the "joined_at" field.
 func (rmuo *RoomMemberUpdateOne) SetJoinedAt(t time.Time) *RoomMemberUpdateOne {
 	rmuo.mutation.SetJoinedAt(t)
@@ -273,6 +393,67 @@ func (rmuo *RoomMemberUpdateOne) SetNillableJoinedAt(t *time.Time) *RoomMemberUp
 	return rmuo
 }
 
+// SetArchived sets the "archived" field.
+func (rmuo *RoomMemberUpdateOne) SetArchived(b bool) *RoomMemberUpdateOne {
+	rmuo.mutation.SetArchived(b)
+	return rmuo
+}
+
+// SetNillableArchived sets the "archived" field if the given value is not nil.
+func (rmuo *RoomMemberUpdateOne) SetNillableArchived(b *bool) *RoomMemberUpdateOne {
+	if b != nil {
+		rmuo.SetArchived(*b)
+	}
+	return rmuo
+}
+
+// SetMutedUntil sets the "muted_until" field.
+func (rmuo *RoomMemberUpdateOne) SetMutedUntil(t time.Time) *RoomMemberUpdateOne {
+	rmuo.mutation.SetMutedUntil(t)
+	return rmuo
+}
+
+// SetNillableMutedUntil sets the "muted_until" field if the given value is not nil.
+func (rmuo *RoomMemberUpdateOne) SetNillableMutedUntil(t *time.Time) *RoomMemberUpdateOne {
+	if t != nil {
+		rmuo.SetMutedUntil(*t)
+	}
+	return rmuo
+}
+
+// ClearMutedUntil clears the value of the "muted_until" field.
+func (rmuo *RoomMemberUpdateOne) ClearMutedUntil() *RoomMemberUpdateOne {
+	rmuo.mutation.ClearMutedUntil()
+	return rmuo
+}
+
+// SetPinnedOrder sets the "pinned_order" field.
+func (rmuo *RoomMemberUpdateOne) SetPinnedOrder(i int) *RoomMemberUpdateOne {
+	rmuo.mutation.ResetPinnedOrder()
+	rmuo.mutation.SetPinnedOrder(i)
+	return rmuo
+}
+
+// SetNillablePinnedOrder sets the "pinned_order" field if the given value is not nil.
+func (rmuo *RoomMemberUpdateOne) SetNillablePinnedOrder(i *int) *RoomMemberUpdateOne {
+	if i != nil {
+		rmuo.SetPinnedOrder(*i)
+	}
+	return rmuo
+}
+
+// AddPinnedOrder adds i to the "pinned_order" field.
+func (rmuo *RoomMemberUpdateOne) AddPinnedOrder(i int) *RoomMemberUpdateOne {
+	rmuo.mutation.AddPinnedOrder(i)
+	return rmuo
+}
+
+// ClearPinnedOrder clears the value of the "pinned_order" field.
+func (rmuo *RoomMemberUpdateOne) ClearPinnedOrder() *RoomMemberUpdateOne {
+	rmuo.mutation.ClearPinnedOrder()
+	return rmuo
+}
+
 // SetRoom sets the "room" edge to the ChatRoom entity.
 func (rmuo *RoomMemberUpdateOne) SetRoom(c *ChatRoom) *RoomMemberUpdateOne {
 	return rmuo.SetRoomID(c.ID)
@@ -342,6 +523,16 @@ func (rmuo *RoomMemberUpdateOne) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (rmuo *RoomMemberUpdateOne) check() error {
+	if v, ok := rmuo.mutation.Role(); ok {
+		if err := roommember.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "RoomMember.role": %w`, err)}
+		}
+	}
+	if v, ok := rmuo.mutation.PinnedOrder(); ok {
+		if err := roommember.PinnedOrderValidator(v); err != nil {
+			return &ValidationError{Name: "pinned_order", err: fmt.Errorf(`ent: validator failed for field "RoomMember.pinned_order": %w`, err)}
+		}
+	}
 	if rmuo.mutation.RoomCleared() && len(rmuo.mutation.RoomIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "RoomMember.room"`)
 	}
@@ -380,9 +571,30 @@ func (rmuo *RoomMemberUpdateOne) sqlSave(ctx context.Context) (_node *RoomMember
 			}
 		}
 	}
+	if value, ok := rmuo.mutation.Role(); ok {
+		_spec.SetField(roommember.FieldRole, field.TypeEnum, value)
+	}
 	if value, ok := rmuo.mutation.JoinedAt(); ok {
 		_spec.SetField(roommember.FieldJoinedAt, field.TypeTime, value)
 	}
+	if value, ok := rmuo.mutation.Archived(); ok {
+		_spec.SetField(roommember.FieldArchived, field.TypeBool, value)
+	}
+	if value, ok := rmuo.mutation.MutedUntil(); ok {
+		_spec.SetField(roommember.FieldMutedUntil, field.TypeTime, value)
+	}
+	if rmuo.mutation.MutedUntilCleared() {
+		_spec.ClearField(roommember.FieldMutedUntil, field.TypeTime)
+	}
+	if value, ok := rmuo.mutation.PinnedOrder(); ok {
+		_spec.SetField(roommember.FieldPinnedOrder, field.TypeInt, value)
+	}
+	if value, ok := rmuo.mutation.AddedPinnedOrder(); ok {
+		_spec.AddField(roommember.FieldPinnedOrder, field.TypeInt, value)
+	}
+	if rmuo.mutation.PinnedOrderCleared() {
+		_spec.ClearField(roommember.FieldPinnedOrder, field.TypeInt)
+	}
 	if rmuo.mutation.RoomCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -0,0 +1,159 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/rotatedrefreshtoken"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/session"
+)
+
+// RotatedRefreshToken is the model entity for the RotatedRefreshToken schema.
+type RotatedRefreshToken struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int64 `json:"id,omitempty"`
+	// トークンファミリー（発行元のセッション）ID
+	SessionID uuid.UUID `json:"session_id,omitempty"`
+	// ローテーション済みリフレッシュトークンハッシュ
+	TokenHash []byte `json:"-"`
+	// ローテーション日時
+	RotatedAt time.Time `json:"rotated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the RotatedRefreshTokenQuery when eager-loading is set.
+	Edges        RotatedRefreshTokenEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// RotatedRefreshTokenEdges holds the relations/edges for other nodes in the graph.
+type RotatedRefreshTokenEdges struct {
+	// Session holds the value of the session edge.
+	Session *Session `json:"session,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// SessionOrErr returns the Session value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e RotatedRefreshTokenEdges) SessionOrErr() (*Session, error) {
+	if e.Session != nil {
+		return e.Session, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: session.Label}
+	}
+	return nil, &NotLoadedError{edge: "session"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*RotatedRefreshToken) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case rotatedrefreshtoken.FieldTokenHash:
+			values[i] = new([]byte)
+		case rotatedrefreshtoken.FieldID:
+			values[i] = new(sql.NullInt64)
+		case rotatedrefreshtoken.FieldRotatedAt:
+			values[i] = new(sql.NullTime)
+		case rotatedrefreshtoken.FieldSessionID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the RotatedRefreshToken fields.
+func (rrt *RotatedRefreshToken) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case rotatedrefreshtoken.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			rrt.ID = int64(value.Int64)
+		case rotatedrefreshtoken.FieldSessionID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field session_id", values[i])
+			} else if value != nil {
+				rrt.SessionID = *value
+			}
+		case rotatedrefreshtoken.FieldTokenHash:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field token_hash", values[i])
+			} else if value != nil {
+				rrt.TokenHash = *value
+			}
+		case rotatedrefreshtoken.FieldRotatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field rotated_at", values[i])
+			} else if value.Valid {
+				rrt.RotatedAt = value.Time
+			}
+		default:
+			rrt.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the RotatedRefreshToken.
+// This includes values selected through modifiers, order, etc.
+func (rrt *RotatedRefreshToken) Value(name string) (ent.Value, error) {
+	return rrt.selectValues.Get(name)
+}
+
+// QuerySession queries the "session" edge of the RotatedRefreshToken entity.
+func (rrt *RotatedRefreshToken) QuerySession() *SessionQuery {
+	return NewRotatedRefreshTokenClient(rrt.config).QuerySession(rrt)
+}
+
+// Update returns a builder for updating this RotatedRefreshToken.
+// Note that you need to call RotatedRefreshToken.Unwrap() before calling this method if this RotatedRefreshToken
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (rrt *RotatedRefreshToken) Update() *RotatedRefreshTokenUpdateOne {
+	return NewRotatedRefreshTokenClient(rrt.config).UpdateOne(rrt)
+}
+
+// Unwrap unwraps the RotatedRefreshToken entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (rrt *RotatedRefreshToken) Unwrap() *RotatedRefreshToken {
+	_tx, ok := rrt.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: RotatedRefreshToken is not a transactional entity")
+	}
+	rrt.config.driver = _tx.drv
+	return rrt
+}
+
+// String implements the fmt.Stringer.
+func (rrt *RotatedRefreshToken) String() string {
+	var builder strings.Builder
+	builder.WriteString("RotatedRefreshToken(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", rrt.ID))
+	builder.WriteString("session_id=")
+	builder.WriteString(fmt.Sprintf("%v", rrt.SessionID))
+	builder.WriteString(", ")
+	builder.WriteString("token_hash=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("rotated_at=")
+	builder.WriteString(rrt.RotatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// RotatedRefreshTokens is a parsable slice of RotatedRefreshToken.
+type RotatedRefreshTokens []*RotatedRefreshToken
@@ -0,0 +1,273 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/rotatedrefreshtoken"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/session"
+)
+
+// RotatedRefreshTokenCreate is the builder for creating a RotatedRefreshToken entity.
+type RotatedRefreshTokenCreate struct {
+	config
+	mutation *RotatedRefreshTokenMutation
+	hooks    []Hook
+}
+
+// SetSessionID sets the "session_id" field.
+func (rrtc *RotatedRefreshTokenCreate) SetSessionID(u uuid.UUID) *RotatedRefreshTokenCreate {
+	rrtc.mutation.SetSessionID(u)
+	return rrtc
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (rrtc *RotatedRefreshTokenCreate) SetTokenHash(b []byte) *RotatedRefreshTokenCreate {
+	rrtc.mutation.SetTokenHash(b)
+	return rrtc
+}
+
+// SetRotatedAt sets the "rotated_at" field.
+func (rrtc *RotatedRefreshTokenCreate) SetRotatedAt(t time.Time) *RotatedRefreshTokenCreate {
+	rrtc.mutation.SetRotatedAt(t)
+	return rrtc
+}
+
+// SetNillableRotatedAt sets the "rotated_at" field if the given value is not nil.
+func (rrtc *RotatedRefreshTokenCreate) SetNillableRotatedAt(t *time.Time) *RotatedRefreshTokenCreate {
+	if t != nil {
+		rrtc.SetRotatedAt(*t)
+	}
+	return rrtc
+}
+
+// SetID sets the "id" field.
+func (rrtc *RotatedRefreshTokenCreate) SetID(i int64) *RotatedRefreshTokenCreate {
+	rrtc.mutation.SetID(i)
+	return rrtc
+}
+
+// SetSession sets the "session" edge to the Session entity.
+func (rrtc *RotatedRefreshTokenCreate) SetSession(s *Session) *RotatedRefreshTokenCreate {
+	return rrtc.SetSessionID(s.ID)
+}
+
+// Mutation returns the RotatedRefreshTokenMutation object of the builder.
+func (rrtc *RotatedRefreshTokenCreate) Mutation() *RotatedRefreshTokenMutation {
+	return rrtc.mutation
+}
+
+// Save creates the RotatedRefreshToken in the database.
+func (rrtc *RotatedRefreshTokenCreate) Save(ctx context.Context) (*RotatedRefreshToken, error) {
+	rrtc.defaults()
+	return withHooks(ctx, rrtc.sqlSave, rrtc.mutation, rrtc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (rrtc *RotatedRefreshTokenCreate) SaveX(ctx context.Context) *RotatedRefreshToken {
+	v, err := rrtc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rrtc *RotatedRefreshTokenCreate) Exec(ctx context.Context) error {
+	_, err := rrtc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rrtc *RotatedRefreshTokenCreate) ExecX(ctx context.Context) {
+	if err := rrtc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rrtc *RotatedRefreshTokenCreate) defaults() {
+	if _, ok := rrtc.mutation.RotatedAt(); !ok {
+		v := rotatedrefreshtoken.DefaultRotatedAt()
+		rrtc.mutation.SetRotatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rrtc *RotatedRefreshTokenCreate) check() error {
+	if _, ok := rrtc.mutation.SessionID(); !ok {
+		return &ValidationError{Name: "session_id", err: errors.New(`ent: missing required field "RotatedRefreshToken.session_id"`)}
+	}
+	if _, ok := rrtc.mutation.TokenHash(); !ok {
+		return &ValidationError{Name: "token_hash", err: errors.New(`ent: missing required field "RotatedRefreshToken.token_hash"`)}
+	}
+	if v, ok := rrtc.mutation.TokenHash(); ok {
+		if err := rotatedrefreshtoken.TokenHashValidator(v); err != nil {
+			return &ValidationError{Name: "token_hash", err: fmt.Errorf(`ent: validator failed for field "RotatedRefreshToken.token_hash": %w`, err)}
+		}
+	}
+	if _, ok := rrtc.mutation.RotatedAt(); !ok {
+		return &ValidationError{Name: "rotated_at", err: errors.New(`ent: missing required field "RotatedRefreshToken.rotated_at"`)}
+	}
+	if v, ok := rrtc.mutation.ID(); ok {
+		if err := rotatedrefreshtoken.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "RotatedRefreshToken.id": %w`, err)}
+		}
+	}
+	if len(rrtc.mutation.SessionIDs()) == 0 {
+		return &ValidationError{Name: "session", err: errors.New(`ent: missing required edge "RotatedRefreshToken.session"`)}
+	}
+	return nil
+}
+
+func (rrtc *RotatedRefreshTokenCreate) sqlSave(ctx context.Context) (*RotatedRefreshToken, error) {
+	if err := rrtc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := rrtc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, rrtc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int64(id)
+	}
+	rrtc.mutation.id = &_node.ID
+	rrtc.mutation.done = true
+	return _node, nil
+}
+
+func (rrtc *RotatedRefreshTokenCreate) createSpec() (*RotatedRefreshToken, *sqlgraph.CreateSpec) {
+	var (
+		_node = &RotatedRefreshToken{config: rrtc.config}
+		_spec = sqlgraph.NewCreateSpec(rotatedrefreshtoken.Table, sqlgraph.NewFieldSpec(rotatedrefreshtoken.FieldID, field.TypeInt64))
+	)
+	if id, ok := rrtc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := rrtc.mutation.TokenHash(); ok {
+		_spec.SetField(rotatedrefreshtoken.FieldTokenHash, field.TypeBytes, value)
+		_node.TokenHash = value
+	}
+	if value, ok := rrtc.mutation.RotatedAt(); ok {
+		_spec.SetField(rotatedrefreshtoken.FieldRotatedAt, field.TypeTime, value)
+		_node.RotatedAt = value
+	}
+	if nodes := rrtc.mutation.SessionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   rotatedrefreshtoken.SessionTable,
+			Columns: []string{rotatedrefreshtoken.SessionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(session.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.SessionID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// RotatedRefreshTokenCreateBulk is the builder for creating many RotatedRefreshToken entities in bulk.
+type RotatedRefreshTokenCreateBulk struct {
+	config
+	err      error
+	builders []*RotatedRefreshTokenCreate
+}
+
+// Save creates the RotatedRefreshToken entities in the database.
+func (rrtcb *RotatedRefreshTokenCreateBulk) Save(ctx context.Context) ([]*RotatedRefreshToken, error) {
+	if rrtcb.err != nil {
+		return nil, rrtcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(rrtcb.builders))
+	nodes := make([]*RotatedRefreshToken, len(rrtcb.builders))
+	mutators := make([]Mutator, len(rrtcb.builders))
+	for i := range rrtcb.builders {
+		func(i int, root context.Context) {
+			builder := rrtcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*RotatedRefreshTokenMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, rrtcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, rrtcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, rrtcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rrtcb *RotatedRefreshTokenCreateBulk) SaveX(ctx context.Context) []*RotatedRefreshToken {
+	v, err := rrtcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rrtcb *RotatedRefreshTokenCreateBulk) Exec(ctx context.Context) error {
+	_, err := rrtcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rrtcb *RotatedRefreshTokenCreateBulk) ExecX(ctx context.Context) {
+	if err := rrtcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/predicate"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/rotatedrefreshtoken"
+)
+
+// RotatedRefreshTokenDelete is the builder for deleting a RotatedRefreshToken entity.
+type RotatedRefreshTokenDelete struct {
+	config
+	hooks    []Hook
+	mutation *RotatedRefreshTokenMutation
+}
+
+// Where appends a list predicates to the RotatedRefreshTokenDelete builder.
+func (rrtd *RotatedRefreshTokenDelete) Where(ps ...predicate.RotatedRefreshToken) *RotatedRefreshTokenDelete {
+	rrtd.mutation.Where(ps...)
+	return rrtd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (rrtd *RotatedRefreshTokenDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, rrtd.sqlExec, rrtd.mutation, rrtd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rrtd *RotatedRefreshTokenDelete) ExecX(ctx context.Context) int {
+	n, err := rrtd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (rrtd *RotatedRefreshTokenDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(rotatedrefreshtoken.Table, sqlgraph.NewFieldSpec(rotatedrefreshtoken.FieldID, field.TypeInt64))
+	if ps := rrtd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, rrtd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	rrtd.mutation.done = true
+	return affected, err
+}
+
+// RotatedRefreshTokenDeleteOne is the builder for deleting a single RotatedRefreshToken entity.
+type RotatedRefreshTokenDeleteOne struct {
+	rrtd *RotatedRefreshTokenDelete
+}
+
+// Where appends a list predicates to the RotatedRefreshTokenDelete builder.
+func (rrtdo *RotatedRefreshTokenDeleteOne) Where(ps ...predicate.RotatedRefreshToken) *RotatedRefreshTokenDeleteOne {
+	rrtdo.rrtd.mutation.Where(ps...)
+	return rrtdo
+}
+
+// Exec executes the deletion query.
+func (rrtdo *RotatedRefreshTokenDeleteOne) Exec(ctx context.Context) error {
+	n, err := rrtdo.rrtd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{rotatedrefreshtoken.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rrtdo *RotatedRefreshTokenDeleteOne) ExecX(ctx context.Context) {
+	if err := rrtdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
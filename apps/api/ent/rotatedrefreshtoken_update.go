@@ -0,0 +1,307 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/predicate"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/rotatedrefreshtoken"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/session"
+)
+
+// RotatedRefreshTokenUpdate is the builder for updating RotatedRefreshToken entities.
+type RotatedRefreshTokenUpdate struct {
+	config
+	hooks    []Hook
+	mutation *RotatedRefreshTokenMutation
+}
+
+// Where appends a list predicates to the RotatedRefreshTokenUpdate builder.
+func (rrtu *RotatedRefreshTokenUpdate) Where(ps ...predicate.RotatedRefreshToken) *RotatedRefreshTokenUpdate {
+	rrtu.mutation.Where(ps...)
+	return rrtu
+}
+
+// SetSessionID sets the "session_id" field.
+func (rrtu *RotatedRefreshTokenUpdate) SetSessionID(u uuid.UUID) *RotatedRefreshTokenUpdate {
+	rrtu.mutation.SetSessionID(u)
+	return rrtu
+}
+
+// SetNillableSessionID sets the "session_id" field if the given value is not nil.
+func (rrtu *RotatedRefreshTokenUpdate) SetNillableSessionID(u *uuid.UUID) *RotatedRefreshTokenUpdate {
+	if u != nil {
+		rrtu.SetSessionID(*u)
+	}
+	return rrtu
+}
+
+// SetSession sets the "session" edge to the Session entity.
+func (rrtu *RotatedRefreshTokenUpdate) SetSession(s *Session) *RotatedRefreshTokenUpdate {
+	return rrtu.SetSessionID(s.ID)
+}
+
+// Mutation returns the RotatedRefreshTokenMutation object of the builder.
+func (rrtu *RotatedRefreshTokenUpdate) Mutation() *RotatedRefreshTokenMutation {
+	return rrtu.mutation
+}
+
+// ClearSession clears the "session" edge to the Session entity.
+func (rrtu *RotatedRefreshTokenUpdate) ClearSession() *RotatedRefreshTokenUpdate {
+	rrtu.mutation.ClearSession()
+	return rrtu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (rrtu *RotatedRefreshTokenUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, rrtu.sqlSave, rrtu.mutation, rrtu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rrtu *RotatedRefreshTokenUpdate) SaveX(ctx context.Context) int {
+	affected, err := rrtu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (rrtu *RotatedRefreshTokenUpdate) Exec(ctx context.Context) error {
+	_, err := rrtu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rrtu *RotatedRefreshTokenUpdate) ExecX(ctx context.Context) {
+	if err := rrtu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rrtu *RotatedRefreshTokenUpdate) check() error {
+	if rrtu.mutation.SessionCleared() && len(rrtu.mutation.SessionIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "RotatedRefreshToken.session"`)
+	}
+	return nil
+}
+
+func (rrtu *RotatedRefreshTokenUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := rrtu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(rotatedrefreshtoken.Table, rotatedrefreshtoken.Columns, sqlgraph.NewFieldSpec(rotatedrefreshtoken.FieldID, field.TypeInt64))
+	if ps := rrtu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if rrtu.mutation.SessionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   rotatedrefreshtoken.SessionTable,
+			Columns: []string{rotatedrefreshtoken.SessionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(session.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rrtu.mutation.SessionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   rotatedrefreshtoken.SessionTable,
+			Columns: []string{rotatedrefreshtoken.SessionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(session.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, rrtu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{rotatedrefreshtoken.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	rrtu.mutation.done = true
+	return n, nil
+}
+
+// RotatedRefreshTokenUpdateOne is the builder for updating a single RotatedRefreshToken entity.
+type RotatedRefreshTokenUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *RotatedRefreshTokenMutation
+}
+
+// SetSessionID sets the "session_id" field.
+func (rrtuo *RotatedRefreshTokenUpdateOne) SetSessionID(u uuid.UUID) *RotatedRefreshTokenUpdateOne {
+	rrtuo.mutation.SetSessionID(u)
+	return rrtuo
+}
+
+// SetNillableSessionID sets the "session_id" field if the given value is not nil.
+func (rrtuo *RotatedRefreshTokenUpdateOne) SetNillableSessionID(u *uuid.UUID) *RotatedRefreshTokenUpdateOne {
+	if u != nil {
+		rrtuo.SetSessionID(*u)
+	}
+	return rrtuo
+}
+
+// SetSession sets the "session" edge to the Session entity.
+func (rrtuo *RotatedRefreshTokenUpdateOne) SetSession(s *Session) *RotatedRefreshTokenUpdateOne {
+	return rrtuo.SetSessionID(s.ID)
+}
+
+// Mutation returns the RotatedRefreshTokenMutation object of the builder.
+func (rrtuo *RotatedRefreshTokenUpdateOne) Mutation() *RotatedRefreshTokenMutation {
+	return rrtuo.mutation
+}
+
+// ClearSession clears the "session" edge to the Session entity.
+func (rrtuo *RotatedRefreshTokenUpdateOne) ClearSession() *RotatedRefreshTokenUpdateOne {
+	rrtuo.mutation.ClearSession()
+	return rrtuo
+}
+
+// Where appends a list predicates to the RotatedRefreshTokenUpdate builder.
+func (rrtuo *RotatedRefreshTokenUpdateOne) Where(ps ...predicate.RotatedRefreshToken) *RotatedRefreshTokenUpdateOne {
+	rrtuo.mutation.Where(ps...)
+	return rrtuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (rrtuo *RotatedRefreshTokenUpdateOne) Select(field string, fields ...string) *RotatedRefreshTokenUpdateOne {
+	rrtuo.fields = append([]string{field}, fields...)
+	return rrtuo
+}
+
+// Save executes the query and returns the updated RotatedRefreshToken entity.
+func (rrtuo *RotatedRefreshTokenUpdateOne) Save(ctx context.Context) (*RotatedRefreshToken, error) {
+	return withHooks(ctx, rrtuo.sqlSave, rrtuo.mutation, rrtuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rrtuo *RotatedRefreshTokenUpdateOne) SaveX(ctx context.Context) *RotatedRefreshToken {
+	node, err := rrtuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (rrtuo *RotatedRefreshTokenUpdateOne) Exec(ctx context.Context) error {
+	_, err := rrtuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rrtuo *RotatedRefreshTokenUpdateOne) ExecX(ctx context.Context) {
+	if err := rrtuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rrtuo *RotatedRefreshTokenUpdateOne) check() error {
+	if rrtuo.mutation.SessionCleared() && len(rrtuo.mutation.SessionIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "RotatedRefreshToken.session"`)
+	}
+	return nil
+}
+
+func (rrtuo *RotatedRefreshTokenUpdateOne) sqlSave(ctx context.Context) (_node *RotatedRefreshToken, err error) {
+	if err := rrtuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(rotatedrefreshtoken.Table, rotatedrefreshtoken.Columns, sqlgraph.NewFieldSpec(rotatedrefreshtoken.FieldID, field.TypeInt64))
+	id, ok := rrtuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "RotatedRefreshToken.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := rrtuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, rotatedrefreshtoken.FieldID)
+		for _, f := range fields {
+			if !rotatedrefreshtoken.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != rotatedrefreshtoken.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := rrtuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if rrtuo.mutation.SessionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   rotatedrefreshtoken.SessionTable,
+			Columns: []string{rotatedrefreshtoken.SessionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(session.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rrtuo.mutation.SessionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   rotatedrefreshtoken.SessionTable,
+			Columns: []string{rotatedrefreshtoken.SessionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(session.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &RotatedRefreshToken{config: rrtuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, rrtuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{rotatedrefreshtoken.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	rrtuo.mutation.done = true
+	return _node, nil
+}
@@ -7,9 +7,19 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/hideaki1979/cc-chat-app/apps/api/ent/chatroom"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/invite"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/joinrequest"
 	"github.com/hideaki1979/cc-chat-app/apps/api/ent/message"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/messagereaction"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/messageread"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/messagerevision"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/revokedtoken"
 	"github.com/hideaki1979/cc-chat-app/apps/api/ent/roommember"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/rotatedrefreshtoken"
 	"github.com/hideaki1979/cc-chat-app/apps/api/ent/schema"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/securityevent"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/session"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/streamticket"
 	"github.com/hideaki1979/cc-chat-app/apps/api/ent/user"
 )
 
@@ -38,15 +48,19 @@ func init() {
 		}
 	}()
 	// chatroomDescIsGroupChat is the schema descriptor for is_group_chat field.
-	chatroomDescIsGroupChat := chatroomFields[2].Descriptor()
+	chatroomDescIsGroupChat := chatroomFields[5].Descriptor()
 	// chatroom.DefaultIsGroupChat holds the default value on creation for the is_group_chat field.
 	chatroom.DefaultIsGroupChat = chatroomDescIsGroupChat.Default.(bool)
+	// chatroomDescEditWindowSeconds is the schema descriptor for edit_window_seconds field.
+	chatroomDescEditWindowSeconds := chatroomFields[8].Descriptor()
+	// chatroom.EditWindowSecondsValidator is a validator for the "edit_window_seconds" field. It is called by the builders before save.
+	chatroom.EditWindowSecondsValidator = chatroomDescEditWindowSeconds.Validators[0].(func(int) error)
 	// chatroomDescCreatedAt is the schema descriptor for created_at field.
-	chatroomDescCreatedAt := chatroomFields[3].Descriptor()
+	chatroomDescCreatedAt := chatroomFields[12].Descriptor()
 	// chatroom.DefaultCreatedAt holds the default value on creation for the created_at field.
 	chatroom.DefaultCreatedAt = chatroomDescCreatedAt.Default.(func() time.Time)
 	// chatroomDescUpdatedAt is the schema descriptor for updated_at field.
-	chatroomDescUpdatedAt := chatroomFields[4].Descriptor()
+	chatroomDescUpdatedAt := chatroomFields[13].Descriptor()
 	// chatroom.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	chatroom.DefaultUpdatedAt = chatroomDescUpdatedAt.Default.(func() time.Time)
 	// chatroom.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
@@ -55,35 +69,210 @@ func init() {
 	chatroomDescID := chatroomFields[0].Descriptor()
 	// chatroom.DefaultID holds the default value on creation for the id field.
 	chatroom.DefaultID = chatroomDescID.Default.(func() uuid.UUID)
+	inviteFields := schema.Invite{}.Fields()
+	_ = inviteFields
+	// inviteDescTokenHash is the schema descriptor for token_hash field.
+	inviteDescTokenHash := inviteFields[3].Descriptor()
+	// invite.TokenHashValidator is a validator for the "token_hash" field. It is called by the builders before save.
+	invite.TokenHashValidator = inviteDescTokenHash.Validators[0].(func([]byte) error)
+	// inviteDescMaxUses is the schema descriptor for max_uses field.
+	inviteDescMaxUses := inviteFields[5].Descriptor()
+	// invite.MaxUsesValidator is a validator for the "max_uses" field. It is called by the builders before save.
+	invite.MaxUsesValidator = inviteDescMaxUses.Validators[0].(func(int) error)
+	// inviteDescUseCount is the schema descriptor for use_count field.
+	inviteDescUseCount := inviteFields[6].Descriptor()
+	// invite.DefaultUseCount holds the default value on creation for the use_count field.
+	invite.DefaultUseCount = inviteDescUseCount.Default.(int)
+	// invite.UseCountValidator is a validator for the "use_count" field. It is called by the builders before save.
+	invite.UseCountValidator = inviteDescUseCount.Validators[0].(func(int) error)
+	// inviteDescRevoked is the schema descriptor for revoked field.
+	inviteDescRevoked := inviteFields[7].Descriptor()
+	// invite.DefaultRevoked holds the default value on creation for the revoked field.
+	invite.DefaultRevoked = inviteDescRevoked.Default.(bool)
+	// inviteDescCreatedAt is the schema descriptor for created_at field.
+	inviteDescCreatedAt := inviteFields[8].Descriptor()
+	// invite.DefaultCreatedAt holds the default value on creation for the created_at field.
+	invite.DefaultCreatedAt = inviteDescCreatedAt.Default.(func() time.Time)
+	// inviteDescID is the schema descriptor for id field.
+	inviteDescID := inviteFields[0].Descriptor()
+	// invite.DefaultID holds the default value on creation for the id field.
+	invite.DefaultID = inviteDescID.Default.(func() uuid.UUID)
+	joinrequestFields := schema.JoinRequest{}.Fields()
+	_ = joinrequestFields
+	// joinrequestDescCreatedAt is the schema descriptor for created_at field.
+	joinrequestDescCreatedAt := joinrequestFields[6].Descriptor()
+	// joinrequest.DefaultCreatedAt holds the default value on creation for the created_at field.
+	joinrequest.DefaultCreatedAt = joinrequestDescCreatedAt.Default.(func() time.Time)
+	// joinrequest.UpdateDefaultCreatedAt holds the default value on update for the created_at field.
+	joinrequest.UpdateDefaultCreatedAt = joinrequestDescCreatedAt.UpdateDefault.(func() time.Time)
+	// joinrequestDescID is the schema descriptor for id field.
+	joinrequestDescID := joinrequestFields[0].Descriptor()
+	// joinrequest.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	joinrequest.IDValidator = joinrequestDescID.Validators[0].(func(int64) error)
+	messageHooks := schema.Message{}.Hooks()
+	message.Hooks[0] = messageHooks[0]
+	message.Hooks[1] = messageHooks[1]
+	message.Hooks[2] = messageHooks[2]
 	messageFields := schema.Message{}.Fields()
 	_ = messageFields
 	// messageDescCreatedAt is the schema descriptor for created_at field.
-	messageDescCreatedAt := messageFields[5].Descriptor()
+	messageDescCreatedAt := messageFields[7].Descriptor()
 	// message.DefaultCreatedAt holds the default value on creation for the created_at field.
 	message.DefaultCreatedAt = messageDescCreatedAt.Default.(func() time.Time)
 	// messageDescUpdatedAt is the schema descriptor for updated_at field.
-	messageDescUpdatedAt := messageFields[6].Descriptor()
+	messageDescUpdatedAt := messageFields[8].Descriptor()
 	// message.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	message.DefaultUpdatedAt = messageDescUpdatedAt.Default.(func() time.Time)
 	// message.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	message.UpdateDefaultUpdatedAt = messageDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// messageDescReplyCount is the schema descriptor for reply_count field.
+	messageDescReplyCount := messageFields[12].Descriptor()
+	// message.DefaultReplyCount holds the default value on creation for the reply_count field.
+	message.DefaultReplyCount = messageDescReplyCount.Default.(int)
 	// messageDescID is the schema descriptor for id field.
 	messageDescID := messageFields[0].Descriptor()
 	// message.DefaultID holds the default value on creation for the id field.
 	message.DefaultID = messageDescID.Default.(func() uuid.UUID)
+	messagereactionFields := schema.MessageReaction{}.Fields()
+	_ = messagereactionFields
+	// messagereactionDescEmoji is the schema descriptor for emoji field.
+	messagereactionDescEmoji := messagereactionFields[3].Descriptor()
+	// messagereaction.EmojiValidator is a validator for the "emoji" field. It is called by the builders before save.
+	messagereaction.EmojiValidator = func() func(string) error {
+		validators := messagereactionDescEmoji.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(emoji string) error {
+			for _, fn := range fns {
+				if err := fn(emoji); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// messagereactionDescCreatedAt is the schema descriptor for created_at field.
+	messagereactionDescCreatedAt := messagereactionFields[4].Descriptor()
+	// messagereaction.DefaultCreatedAt holds the default value on creation for the created_at field.
+	messagereaction.DefaultCreatedAt = messagereactionDescCreatedAt.Default.(func() time.Time)
+	// messagereactionDescID is the schema descriptor for id field.
+	messagereactionDescID := messagereactionFields[0].Descriptor()
+	// messagereaction.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	messagereaction.IDValidator = messagereactionDescID.Validators[0].(func(int64) error)
+	messagereadFields := schema.MessageRead{}.Fields()
+	_ = messagereadFields
+	// messagereadDescReadAt is the schema descriptor for read_at field.
+	messagereadDescReadAt := messagereadFields[4].Descriptor()
+	// messageread.DefaultReadAt holds the default value on creation for the read_at field.
+	messageread.DefaultReadAt = messagereadDescReadAt.Default.(func() time.Time)
+	// messagereadDescID is the schema descriptor for id field.
+	messagereadDescID := messagereadFields[0].Descriptor()
+	// messageread.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	messageread.IDValidator = messagereadDescID.Validators[0].(func(int64) error)
+	messagerevisionFields := schema.MessageRevision{}.Fields()
+	_ = messagerevisionFields
+	// messagerevisionDescCreatedAt is the schema descriptor for created_at field.
+	messagerevisionDescCreatedAt := messagerevisionFields[4].Descriptor()
+	// messagerevision.DefaultCreatedAt holds the default value on creation for the created_at field.
+	messagerevision.DefaultCreatedAt = messagerevisionDescCreatedAt.Default.(func() time.Time)
+	// messagerevisionDescID is the schema descriptor for id field.
+	messagerevisionDescID := messagerevisionFields[0].Descriptor()
+	// messagerevision.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	messagerevision.IDValidator = messagerevisionDescID.Validators[0].(func(int64) error)
+	revokedtokenFields := schema.RevokedToken{}.Fields()
+	_ = revokedtokenFields
+	// revokedtokenDescCreatedAt is the schema descriptor for created_at field.
+	revokedtokenDescCreatedAt := revokedtokenFields[3].Descriptor()
+	// revokedtoken.DefaultCreatedAt holds the default value on creation for the created_at field.
+	revokedtoken.DefaultCreatedAt = revokedtokenDescCreatedAt.Default.(func() time.Time)
+	// revokedtokenDescID is the schema descriptor for id field.
+	revokedtokenDescID := revokedtokenFields[0].Descriptor()
+	// revokedtoken.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	revokedtoken.IDValidator = revokedtokenDescID.Validators[0].(func(string) error)
 	roommemberFields := schema.RoomMember{}.Fields()
 	_ = roommemberFields
 	// roommemberDescJoinedAt is the schema descriptor for joined_at field.
-	roommemberDescJoinedAt := roommemberFields[3].Descriptor()
+	roommemberDescJoinedAt := roommemberFields[4].Descriptor()
 	// roommember.DefaultJoinedAt holds the default value on creation for the joined_at field.
 	roommember.DefaultJoinedAt = roommemberDescJoinedAt.Default.(func() time.Time)
+	// roommemberDescArchived is the schema descriptor for archived field.
+	roommemberDescArchived := roommemberFields[5].Descriptor()
+	// roommember.DefaultArchived holds the default value on creation for the archived field.
+	roommember.DefaultArchived = roommemberDescArchived.Default.(bool)
+	// roommemberDescPinnedOrder is the schema descriptor for pinned_order field.
+	roommemberDescPinnedOrder := roommemberFields[7].Descriptor()
+	// roommember.PinnedOrderValidator is a validator for the "pinned_order" field. It is called by the builders before save.
+	roommember.PinnedOrderValidator = roommemberDescPinnedOrder.Validators[0].(func(int) error)
 	// roommemberDescID is the schema descriptor for id field.
 	roommemberDescID := roommemberFields[0].Descriptor()
 	// roommember.IDValidator is a validator for the "id" field. It is called by the builders before save.
 	roommember.IDValidator = roommemberDescID.Validators[0].(func(int64) error)
+	rotatedrefreshtokenFields := schema.RotatedRefreshToken{}.Fields()
+	_ = rotatedrefreshtokenFields
+	// rotatedrefreshtokenDescTokenHash is the schema descriptor for token_hash field.
+	rotatedrefreshtokenDescTokenHash := rotatedrefreshtokenFields[2].Descriptor()
+	// rotatedrefreshtoken.TokenHashValidator is a validator for the "token_hash" field. It is called by the builders before save.
+	rotatedrefreshtoken.TokenHashValidator = rotatedrefreshtokenDescTokenHash.Validators[0].(func([]byte) error)
+	// rotatedrefreshtokenDescRotatedAt is the schema descriptor for rotated_at field.
+	rotatedrefreshtokenDescRotatedAt := rotatedrefreshtokenFields[3].Descriptor()
+	// rotatedrefreshtoken.DefaultRotatedAt holds the default value on creation for the rotated_at field.
+	rotatedrefreshtoken.DefaultRotatedAt = rotatedrefreshtokenDescRotatedAt.Default.(func() time.Time)
+	// rotatedrefreshtokenDescID is the schema descriptor for id field.
+	rotatedrefreshtokenDescID := rotatedrefreshtokenFields[0].Descriptor()
+	// rotatedrefreshtoken.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	rotatedrefreshtoken.IDValidator = rotatedrefreshtokenDescID.Validators[0].(func(int64) error)
+	securityeventFields := schema.SecurityEvent{}.Fields()
+	_ = securityeventFields
+	// securityeventDescCreatedAt is the schema descriptor for created_at field.
+	securityeventDescCreatedAt := securityeventFields[6].Descriptor()
+	// securityevent.DefaultCreatedAt holds the default value on creation for the created_at field.
+	securityevent.DefaultCreatedAt = securityeventDescCreatedAt.Default.(func() time.Time)
+	// securityeventDescID is the schema descriptor for id field.
+	securityeventDescID := securityeventFields[0].Descriptor()
+	// securityevent.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	securityevent.IDValidator = securityeventDescID.Validators[0].(func(int64) error)
+	sessionHooks := schema.Session{}.Hooks()
+	session.Hooks[0] = sessionHooks[0]
+	sessionFields := schema.Session{}.Fields()
+	_ = sessionFields
+	// sessionDescRefreshTokenHash is the schema descriptor for refresh_token_hash field.
+	sessionDescRefreshTokenHash := sessionFields[2].Descriptor()
+	// session.RefreshTokenHashValidator is a validator for the "refresh_token_hash" field. It is called by the builders before save.
+	session.RefreshTokenHashValidator = sessionDescRefreshTokenHash.Validators[0].(func([]byte) error)
+	// sessionDescCreatedAt is the schema descriptor for created_at field.
+	sessionDescCreatedAt := sessionFields[6].Descriptor()
+	// session.DefaultCreatedAt holds the default value on creation for the created_at field.
+	session.DefaultCreatedAt = sessionDescCreatedAt.Default.(func() time.Time)
+	// sessionDescLastUsedAt is the schema descriptor for last_used_at field.
+	sessionDescLastUsedAt := sessionFields[7].Descriptor()
+	// session.DefaultLastUsedAt holds the default value on creation for the last_used_at field.
+	session.DefaultLastUsedAt = sessionDescLastUsedAt.Default.(func() time.Time)
+	// sessionDescID is the schema descriptor for id field.
+	sessionDescID := sessionFields[0].Descriptor()
+	// session.DefaultID holds the default value on creation for the id field.
+	session.DefaultID = sessionDescID.Default.(func() uuid.UUID)
+	streamticketFields := schema.StreamTicket{}.Fields()
+	_ = streamticketFields
+	// streamticketDescTokenHash is the schema descriptor for token_hash field.
+	streamticketDescTokenHash := streamticketFields[1].Descriptor()
+	// streamticket.TokenHashValidator is a validator for the "token_hash" field. It is called by the builders before save.
+	streamticket.TokenHashValidator = streamticketDescTokenHash.Validators[0].(func([]byte) error)
+	// streamticketDescTokenID is the schema descriptor for token_id field.
+	streamticketDescTokenID := streamticketFields[3].Descriptor()
+	// streamticket.TokenIDValidator is a validator for the "token_id" field. It is called by the builders before save.
+	streamticket.TokenIDValidator = streamticketDescTokenID.Validators[0].(func(string) error)
+	// streamticketDescTokenVersion is the schema descriptor for token_version field.
+	streamticketDescTokenVersion := streamticketFields[4].Descriptor()
+	// streamticket.TokenVersionValidator is a validator for the "token_version" field. It is called by the builders before save.
+	streamticket.TokenVersionValidator = streamticketDescTokenVersion.Validators[0].(func(int) error)
+	// streamticketDescID is the schema descriptor for id field.
+	streamticketDescID := streamticketFields[0].Descriptor()
+	// streamticket.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	streamticket.IDValidator = streamticketDescID.Validators[0].(func(int64) error)
 	userHooks := schema.User{}.Hooks()
 	user.Hooks[0] = userHooks[0]
-	user.Hooks[1] = userHooks[1]
 	userFields := schema.User{}.Fields()
 	_ = userFields
 	// userDescName is the schema descriptor for name field.
@@ -108,6 +297,12 @@ func init() {
 	user.DefaultUpdatedAt = userDescUpdatedAt.Default.(func() time.Time)
 	// user.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	user.UpdateDefaultUpdatedAt = userDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// userDescTokenVersion is the schema descriptor for token_version field.
+	userDescTokenVersion := userFields[9].Descriptor()
+	// user.DefaultTokenVersion holds the default value on creation for the token_version field.
+	user.DefaultTokenVersion = userDescTokenVersion.Default.(int)
+	// user.TokenVersionValidator is a validator for the "token_version" field. It is called by the builders before save.
+	user.TokenVersionValidator = userDescTokenVersion.Validators[0].(func(int) error)
 	// userDescID is the schema descriptor for id field.
 	userDescID := userFields[0].Descriptor()
 	// user.DefaultID holds the default value on creation for the id field.
@@ -6,6 +6,7 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 	"github.com/google/uuid"
 )
 
@@ -24,9 +25,48 @@ func (ChatRoom) Fields() []ent.Field {
 			NotEmpty().
 			MaxLen(100).
 			Comment("チャットルーム名"),
+		field.Text("description").
+			Optional().
+			Nillable().
+			Comment("ルームの説明"),
+		field.String("topic").
+			Optional().
+			Nillable().
+			Comment("ルームのトピック（変更時はシステムメッセージを記録）"),
+		field.String("avatar_url").
+			Optional().
+			Nillable().
+			Comment("ルームのアイコン画像URL"),
 		field.Bool("is_group_chat").
 			Default(false).
 			Comment("グループチャットかどうか"),
+		field.String("dm_key").
+			Optional().
+			Nillable().
+			Unique().
+			Immutable().
+			Comment("1対1のダイレクトメッセージの識別キー（2人のユーザーIDを昇順に連結）。同じ2人のDMを重複させない"),
+		field.Enum("visibility").
+			Values("private", "public", "request_to_join").
+			Default("private").
+			Comment("公開設定（private: 招待のみ、public: 誰でも参加可能、request_to_join: 参加申請の承認が必要）"),
+		field.Int("edit_window_seconds").
+			Optional().
+			Nillable().
+			Min(-1).
+			Comment("メッセージ編集可能時間（秒）。nullはサーバーのデフォルト、0は編集不可、-1は無期限"),
+		field.Time("archived_at").
+			Optional().
+			Nillable().
+			Comment("アーカイブ日時（最後のメンバーが退出した際に設定）"),
+		field.Time("last_message_at").
+			Optional().
+			Nillable().
+			Comment("最新メッセージの送信日時（スレッドの返信・論理削除されたメッセージを除く）。Messageのフックで更新"),
+		field.UUID("last_message_id", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Comment("最新メッセージID（スレッドの返信・論理削除されたメッセージを除く）。Messageのフックで更新"),
 		field.Time("created_at").
 			Default(time.Now).
 			Immutable(),
@@ -43,5 +83,23 @@ func (ChatRoom) Edges() []ent.Edge {
 		edge.To("room_members", RoomMember.Type),
 		// ChatRoomは複数のメッセージ（Message）を持つ
 		edge.To("messages", Message.Type),
+		// ChatRoomは最新メッセージ（Message）を参照する（ルーム一覧のプレビュー用）
+		edge.To("last_message", Message.Type).
+			Field("last_message_id").
+			Unique(),
+		// ChatRoomは複数の既読位置（MessageRead）を持つ
+		edge.To("message_reads", MessageRead.Type),
+		// ChatRoomは複数の招待（Invite）を持つ
+		edge.To("invites", Invite.Type),
+		// ChatRoomは複数の参加申請（JoinRequest）を持つ
+		edge.To("join_requests", JoinRequest.Type),
 	}
-}
\ No newline at end of file
+}
+
+// Indexes of the ChatRoom.
+func (ChatRoom) Indexes() []ent.Index {
+	return []ent.Index{
+		// ルーム一覧を最終アクティビティ順に並べる
+		index.Fields("last_message_at"),
+	}
+}
@@ -0,0 +1,77 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// Invite holds the schema definition for the Invite entity.
+type Invite struct {
+	ent.Schema
+}
+
+// Fields of the Invite.
+func (Invite) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Unique(),
+		field.UUID("room_id", uuid.UUID{}).
+			Comment("招待先のチャットルームID"),
+		field.UUID("creator_id", uuid.UUID{}).
+			Comment("招待を作成したユーザーID"),
+		field.Bytes("token_hash").
+			NotEmpty().
+			Unique().
+			Sensitive().
+			Comment("招待トークンハッシュ"),
+		field.Time("expires_at").
+			Comment("有効期限"),
+		field.Int("max_uses").
+			Optional().
+			Nillable().
+			Positive().
+			Comment("最大使用回数（nullは無制限）"),
+		field.Int("use_count").
+			Default(0).
+			NonNegative().
+			Comment("使用回数"),
+		field.Bool("revoked").
+			Default(false).
+			Comment("無効化されたかどうか"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the Invite.
+func (Invite) Edges() []ent.Edge {
+	return []ent.Edge{
+		// Inviteはチャットルーム（ChatRoom）に属する
+		edge.From("room", ChatRoom.Type).
+			Ref("invites").
+			Field("room_id").
+			Required().
+			Unique(),
+		// Inviteは作成したユーザー（User）に属する
+		edge.From("creator", User.Type).
+			Ref("invites").
+			Field("creator_id").
+			Required().
+			Unique(),
+	}
+}
+
+// Indexes of the Invite.
+func (Invite) Indexes() []ent.Index {
+	return []ent.Index{
+		// ルームごとの招待一覧を効率的に取得
+		index.Fields("room_id", "created_at"),
+	}
+}
@@ -0,0 +1,73 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// JoinRequest holds the schema definition for the JoinRequest entity.
+type JoinRequest struct {
+	ent.Schema
+}
+
+// Fields of the JoinRequest.
+func (JoinRequest) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id").
+			Positive(),
+		field.UUID("room_id", uuid.UUID{}).
+			Comment("参加を申請したチャットルームID"),
+		field.UUID("user_id", uuid.UUID{}).
+			Comment("申請したユーザーID"),
+		field.Enum("status").
+			Values("pending", "approved", "rejected").
+			Default("pending").
+			Comment("申請状態"),
+		field.UUID("decided_by", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Comment("承認・却下したユーザーID"),
+		field.Time("decided_at").
+			Optional().
+			Nillable().
+			Comment("承認・却下日時"),
+		field.Time("created_at").
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			Comment("申請日時（再申請時に更新）"),
+	}
+}
+
+// Edges of the JoinRequest.
+func (JoinRequest) Edges() []ent.Edge {
+	return []ent.Edge{
+		// JoinRequestはチャットルーム（ChatRoom）に属する
+		edge.From("room", ChatRoom.Type).
+			Ref("join_requests").
+			Field("room_id").
+			Required().
+			Unique(),
+		// JoinRequestは申請したユーザー（User）に属する
+		edge.From("user", User.Type).
+			Ref("join_requests").
+			Field("user_id").
+			Required().
+			Unique(),
+	}
+}
+
+// Indexes of the JoinRequest.
+func (JoinRequest) Indexes() []ent.Index {
+	return []ent.Index{
+		// 同じユーザーの申請はルームごとに1件（再申請時は既存の申請を更新）
+		index.Fields("room_id", "user_id").
+			Unique(),
+		// 承認待ちの申請を効率的に取得
+		index.Fields("room_id", "status"),
+	}
+}
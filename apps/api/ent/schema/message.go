@@ -1,6 +1,8 @@
 package schema
 
 import (
+	"context"
+	"encoding/json"
 	"time"
 
 	"entgo.io/ent"
@@ -8,6 +10,10 @@ import (
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
 	"github.com/google/uuid"
+	gen "github.com/hideaki1979/cc-chat-app/apps/api/ent"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/chatroom"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/hook"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/message"
 )
 
 // Message holds the schema definition for the Message entity.
@@ -27,6 +33,13 @@ func (Message) Fields() []ent.Field {
 			Comment("送信者ユーザーID"),
 		field.Text("content").
 			Comment("メッセージ内容"),
+		field.Enum("type").
+			Values("user", "system", "bot").
+			Default("user").
+			Comment("メッセージ種別（user: ユーザーの投稿、system: ルームの変更を記録するシステムメッセージ、bot: ボットの投稿）"),
+		field.JSON("payload", json.RawMessage{}).
+			Optional().
+			Comment("システムメッセージの構造化データ（イベント種別・対象ユーザーなど）"),
 		field.Text("file_url").
 			Optional().
 			Nillable().
@@ -41,6 +54,25 @@ func (Message) Fields() []ent.Field {
 			Optional().
 			Nillable().
 			Comment("論理削除日時"),
+		field.UUID("parent_id", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Comment("スレッドの親メッセージID"),
+		field.UUID("reply_to_id", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Comment("引用表示する返信先メッセージID（スレッドの親とは別に保持する）"),
+		field.Int("reply_count").
+			Default(0).
+			Comment("スレッドの返信数（論理削除された返信を除く）"),
+		field.Time("last_reply_at").
+			Optional().
+			Nillable().
+			Comment("スレッドの最終返信日時"),
+		field.Time("edited_at").
+			Optional().
+			Nillable().
+			Comment("最終編集日時（未編集の場合はnull）"),
 	}
 }
 
@@ -59,6 +91,22 @@ func (Message) Edges() []ent.Edge {
 			Field("user_id").
 			Required().
 			Unique(),
+		// Messageを既読位置とする既読情報（MessageRead）
+		edge.To("reads", MessageRead.Type),
+		// Messageは複数のリアクション（MessageReaction）を持つ
+		edge.To("reactions", MessageReaction.Type),
+		// Messageは複数の編集履歴（MessageRevision）を持つ
+		edge.To("revisions", MessageRevision.Type),
+		// Messageはスレッドの返信（Message）を持つ
+		edge.To("replies", Message.Type).
+			From("parent").
+			Field("parent_id").
+			Unique(),
+		// Messageは自身を返信先として引用するメッセージ（Message）を持つ
+		edge.To("quotes", Message.Type).
+			From("reply_to").
+			Field("reply_to_id").
+			Unique(),
 	}
 }
 
@@ -67,5 +115,194 @@ func (Message) Indexes() []ent.Index {
 	return []ent.Index{
 		// 設計書で指定されたパフォーマンス最適化インデックス
 		index.Fields("room_id", "created_at"),
+		// スレッドの返信を効率的に検索
+		index.Fields("parent_id", "created_at"),
 	}
-}
\ No newline at end of file
+}
+// Hooks of the Message.
+func (Message) Hooks() []ent.Hook {
+	return []ent.Hook{
+		// 返信の作成・論理削除時に親メッセージの返信数と最終返信日時を更新するフック
+		hook.On(
+			func(next ent.Mutator) ent.Mutator {
+				return hook.MessageFunc(func(ctx context.Context, m *gen.MessageMutation) (ent.Value, error) {
+					if m.Op().Is(ent.OpCreate) {
+						v, err := next.Mutate(ctx, m)
+						if err != nil {
+							return v, err
+						}
+						if msg, ok := v.(*gen.Message); ok && msg.ParentID != nil {
+							err = m.Client().Message.UpdateOneID(*msg.ParentID).
+								AddReplyCount(1).
+								SetLastReplyAt(msg.CreatedAt).
+								Exec(ctx)
+						}
+						return v, err
+					}
+
+					// 論理削除以外の更新は対象外
+					if _, deleted := m.DeletedAt(); !deleted {
+						return next.Mutate(ctx, m)
+					}
+					oldDeletedAt, err := m.OldDeletedAt(ctx)
+					if err != nil {
+						return nil, err
+					}
+					parentID, err := m.OldParentID(ctx)
+					if err != nil {
+						return nil, err
+					}
+					v, err := next.Mutate(ctx, m)
+					if err != nil || parentID == nil || oldDeletedAt != nil {
+						return v, err
+					}
+
+					// 残っている返信から最終返信日時を再計算
+					update := m.Client().Message.UpdateOneID(*parentID).AddReplyCount(-1)
+					latest, err := m.Client().Message.Query().
+						Where(
+							message.ParentID(*parentID),
+							message.DeletedAtIsNil(),
+						).
+						Order(gen.Desc(message.FieldCreatedAt)).
+						First(ctx)
+					switch {
+					case gen.IsNotFound(err):
+						update.ClearLastReplyAt()
+					case err != nil:
+						return nil, err
+					default:
+						update.SetLastReplyAt(latest.CreatedAt)
+					}
+					return v, update.Exec(ctx)
+				})
+			},
+			ent.OpCreate|ent.OpUpdateOne,
+		),
+		// 内容の編集時に編集前の内容を履歴として保存し、編集日時を設定するフック
+		hook.On(
+			func(next ent.Mutator) ent.Mutator {
+				return hook.MessageFunc(func(ctx context.Context, m *gen.MessageMutation) (ent.Value, error) {
+					content, ok := m.Content()
+					if !ok {
+						return next.Mutate(ctx, m)
+					}
+					ids, err := m.IDs(ctx)
+					if err != nil {
+						return nil, err
+					}
+					// 内容が変わらない場合は編集として扱わない
+					olds, err := m.Client().Message.Query().
+						Where(
+							message.IDIn(ids...),
+							message.ContentNEQ(content),
+						).
+						Select(message.FieldUserID, message.FieldContent).
+						All(ctx)
+					if err != nil {
+						return nil, err
+					}
+					if len(olds) == 0 {
+						return next.Mutate(ctx, m)
+					}
+
+					m.SetEditedAt(time.Now())
+					v, err := next.Mutate(ctx, m)
+					if err != nil {
+						return v, err
+					}
+
+					// メッセージの編集は送信者のみ可能なため、編集者は送信者とする
+					builders := make([]*gen.MessageRevisionCreate, len(olds))
+					for i, old := range olds {
+						builders[i] = m.Client().MessageRevision.Create().
+							SetMessageID(old.ID).
+							SetEditorID(old.UserID).
+							SetContent(old.Content)
+					}
+					return v, m.Client().MessageRevision.CreateBulk(builders...).Exec(ctx)
+				})
+			},
+			ent.OpUpdate|ent.OpUpdateOne,
+		),
+		// メッセージの作成・論理削除・削除時にルームの最新メッセージを更新するフック
+		hook.On(
+			func(next ent.Mutator) ent.Mutator {
+				return hook.MessageFunc(func(ctx context.Context, m *gen.MessageMutation) (ent.Value, error) {
+					if m.Op().Is(ent.OpCreate) {
+						v, err := next.Mutate(ctx, m)
+						if err != nil {
+							return v, err
+						}
+						msg, ok := v.(*gen.Message)
+						if !ok || msg.ParentID != nil || msg.DeletedAt != nil {
+							return v, nil
+						}
+						// 送信日時が既存の最新メッセージより古い場合は更新しない
+						return v, m.Client().ChatRoom.Update().
+							Where(
+								chatroom.ID(msg.RoomID),
+								chatroom.Or(
+									chatroom.LastMessageAtIsNil(),
+									chatroom.LastMessageAtLTE(msg.CreatedAt),
+								),
+							).
+							SetLastMessageAt(msg.CreatedAt).
+							SetLastMessageID(msg.ID).
+							Exec(ctx)
+					}
+
+					// 論理削除以外の更新は対象外
+					if _, deleted := m.DeletedAt(); !deleted && m.Op().Is(ent.OpUpdate|ent.OpUpdateOne) {
+						return next.Mutate(ctx, m)
+					}
+					ids, err := m.IDs(ctx)
+					if err != nil {
+						return nil, err
+					}
+					// 対象のメッセージを最新メッセージとしているルームのみ再計算する
+					roomIDs, err := m.Client().ChatRoom.Query().
+						Where(chatroom.LastMessageIDIn(ids...)).
+						IDs(ctx)
+					if err != nil {
+						return nil, err
+					}
+					// 削除時はルームの参照が外部キー制約でnullになるため、削除後に再計算する
+					v, err := next.Mutate(ctx, m)
+					if err != nil {
+						return v, err
+					}
+					for _, roomID := range roomIDs {
+						if err := refreshLastMessage(ctx, m.Client(), roomID); err != nil {
+							return nil, err
+						}
+					}
+					return v, nil
+				})
+			},
+			ent.OpCreate|ent.OpUpdate|ent.OpUpdateOne|ent.OpDelete|ent.OpDeleteOne,
+		),
+	}
+}
+
+// refreshLastMessage 残っているメッセージからルームの最新メッセージを再計算
+func refreshLastMessage(ctx context.Context, client *gen.Client, roomID uuid.UUID) error {
+	update := client.ChatRoom.UpdateOneID(roomID)
+	latest, err := client.Message.Query().
+		Where(
+			message.RoomID(roomID),
+			message.DeletedAtIsNil(),
+			message.ParentIDIsNil(),
+		).
+		Order(gen.Desc(message.FieldCreatedAt), gen.Desc(message.FieldID)).
+		First(ctx)
+	switch {
+	case gen.IsNotFound(err):
+		update.ClearLastMessageAt().ClearLastMessageID()
+	case err != nil:
+		return err
+	default:
+		update.SetLastMessageAt(latest.CreatedAt).SetLastMessageID(latest.ID)
+	}
+	return update.Exec(ctx)
+}
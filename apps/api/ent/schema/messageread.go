@@ -0,0 +1,69 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// MessageRead holds the schema definition for the MessageRead entity.
+// ルームごとのメンバーの既読位置（最後に読んだメッセージ）を保持する
+type MessageRead struct {
+	ent.Schema
+}
+
+// Fields of the MessageRead.
+func (MessageRead) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id").
+			Positive(),
+		field.UUID("room_id", uuid.UUID{}).
+			Comment("チャットルームID"),
+		field.UUID("user_id", uuid.UUID{}).
+			Comment("ユーザーID"),
+		field.UUID("message_id", uuid.UUID{}).
+			Comment("最後に読んだメッセージID"),
+		field.Time("read_at").
+			Default(time.Now).
+			Comment("既読日時"),
+	}
+}
+
+// Edges of the MessageRead.
+func (MessageRead) Edges() []ent.Edge {
+	return []ent.Edge{
+		// MessageReadはチャットルーム（ChatRoom）に属する
+		edge.From("room", ChatRoom.Type).
+			Ref("message_reads").
+			Field("room_id").
+			Required().
+			Unique(),
+		// MessageReadはユーザー（User）に属する
+		edge.From("user", User.Type).
+			Ref("message_reads").
+			Field("user_id").
+			Required().
+			Unique(),
+		// MessageReadは既読位置のメッセージ（Message）を参照する
+		edge.From("message", Message.Type).
+			Ref("reads").
+			Field("message_id").
+			Required().
+			Unique(),
+	}
+}
+
+// Indexes of the MessageRead.
+func (MessageRead) Indexes() []ent.Index {
+	return []ent.Index{
+		// 既読位置はユーザー・ルームごとに1件のみ
+		index.Fields("user_id", "room_id").
+			Unique(),
+		// メッセージの既読者を効率的に検索
+		index.Fields("room_id", "message_id"),
+	}
+}
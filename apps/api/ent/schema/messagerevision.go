@@ -0,0 +1,62 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// MessageRevision holds the schema definition for the MessageRevision entity.
+// メッセージ編集時に、編集前の内容を保存する
+type MessageRevision struct {
+	ent.Schema
+}
+
+// Fields of the MessageRevision.
+func (MessageRevision) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id").
+			Positive(),
+		field.UUID("message_id", uuid.UUID{}).
+			Comment("メッセージID"),
+		field.UUID("editor_id", uuid.UUID{}).
+			Comment("編集したユーザーID"),
+		field.Text("content").
+			Immutable().
+			Comment("編集前のメッセージ内容"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("編集日時"),
+	}
+}
+
+// Edges of the MessageRevision.
+func (MessageRevision) Edges() []ent.Edge {
+	return []ent.Edge{
+		// MessageRevisionはメッセージ（Message）に属する
+		edge.From("message", Message.Type).
+			Ref("revisions").
+			Field("message_id").
+			Required().
+			Unique(),
+		// MessageRevisionは編集したユーザー（User）に属する
+		edge.From("editor", User.Type).
+			Ref("message_revisions").
+			Field("editor_id").
+			Required().
+			Unique(),
+	}
+}
+
+// Indexes of the MessageRevision.
+func (MessageRevision) Indexes() []ent.Index {
+	return []ent.Index{
+		// メッセージの編集履歴を時系列で取得
+		index.Fields("message_id", "created_at"),
+	}
+}
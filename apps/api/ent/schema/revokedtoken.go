@@ -0,0 +1,58 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// RevokedToken holds the schema definition for the RevokedToken entity.
+// 有効期限前に失効させたアクセストークンのID（jti）を保存する
+type RevokedToken struct {
+	ent.Schema
+}
+
+// Fields of the RevokedToken.
+func (RevokedToken) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			NotEmpty().
+			Immutable().
+			Comment("アクセストークンID（jti）"),
+		field.UUID("user_id", uuid.UUID{}).
+			Immutable().
+			Comment("トークンを発行したユーザーID"),
+		field.Time("expires_at").
+			Immutable().
+			Comment("トークンの有効期限（期限後は記録を削除できる）"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("失効日時"),
+	}
+}
+
+// Edges of the RevokedToken.
+func (RevokedToken) Edges() []ent.Edge {
+	return []ent.Edge{
+		// RevokedTokenはユーザー（User）に属する
+		edge.From("user", User.Type).
+			Ref("revoked_tokens").
+			Field("user_id").
+			Required().
+			Immutable().
+			Unique(),
+	}
+}
+
+// Indexes of the RevokedToken.
+func (RevokedToken) Indexes() []ent.Index {
+	return []ent.Index{
+		// 期限切れの記録を効率的に削除
+		index.Fields("expires_at"),
+	}
+}
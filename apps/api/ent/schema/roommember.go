@@ -24,9 +24,25 @@ func (RoomMember) Fields() []ent.Field {
 			Comment("チャットルームID"),
 		field.UUID("user_id", uuid.UUID{}).
 			Comment("ユーザーID"),
+		field.Enum("role").
+			Values("owner", "admin", "member").
+			Default("member").
+			Comment("ルーム内の役割（owner: 作成者・全権限、admin: ルーム設定とメンバー管理、member: 一般メンバー）"),
 		field.Time("joined_at").
 			Default(time.Now).
 			Comment("参加日時"),
+		field.Bool("archived").
+			Default(false).
+			Comment("ルーム一覧でアーカイブしているかどうか（メンバーごとの設定）"),
+		field.Time("muted_until").
+			Optional().
+			Nillable().
+			Comment("通知をミュートする期限（メンバーごとの設定）"),
+		field.Int("pinned_order").
+			Optional().
+			Nillable().
+			NonNegative().
+			Comment("ルーム一覧でのピン留め順（nullはピン留めなし、小さいほど上に表示）"),
 	}
 }
 
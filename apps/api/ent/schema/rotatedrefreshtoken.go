@@ -0,0 +1,58 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// RotatedRefreshToken holds the schema definition for the RotatedRefreshToken entity.
+// ローテーション済みのリフレッシュトークンを、発行元のセッション（トークンファミリー）ごとに保存する
+// 再利用された場合はトークンの盗用とみなし、ファミリー全体を失効させる
+type RotatedRefreshToken struct {
+	ent.Schema
+}
+
+// Fields of the RotatedRefreshToken.
+func (RotatedRefreshToken) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id").
+			Positive(),
+		field.UUID("session_id", uuid.UUID{}).
+			Comment("トークンファミリー（発行元のセッション）ID"),
+		field.Bytes("token_hash").
+			NotEmpty().
+			Unique().
+			Sensitive().
+			Immutable().
+			Comment("ローテーション済みリフレッシュトークンハッシュ"),
+		field.Time("rotated_at").
+			Default(time.Now).
+			Immutable().
+			Comment("ローテーション日時"),
+	}
+}
+
+// Edges of the RotatedRefreshToken.
+func (RotatedRefreshToken) Edges() []ent.Edge {
+	return []ent.Edge{
+		// RotatedRefreshTokenはセッション（Session）に属する
+		edge.From("session", Session.Type).
+			Ref("rotated_tokens").
+			Field("session_id").
+			Required().
+			Unique(),
+	}
+}
+
+// Indexes of the RotatedRefreshToken.
+func (RotatedRefreshToken) Indexes() []ent.Index {
+	return []ent.Index{
+		// ローテーション時にセッションの古い記録を効率的に削除
+		index.Fields("session_id", "rotated_at"),
+	}
+}
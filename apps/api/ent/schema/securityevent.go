@@ -0,0 +1,69 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// SecurityEvent holds the schema definition for the SecurityEvent entity.
+// トークンの盗用検知など、アカウントのセキュリティに関わる出来事を記録する
+type SecurityEvent struct {
+	ent.Schema
+}
+
+// Fields of the SecurityEvent.
+func (SecurityEvent) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id").
+			Positive(),
+		field.UUID("user_id", uuid.UUID{}).
+			Comment("対象のユーザーID"),
+		field.Enum("type").
+			Values("refresh_token_reuse").
+			Immutable().
+			Comment("イベント種別"),
+		field.UUID("session_id", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("関連するセッションID（失効後も記録として残すため外部キーにしない）"),
+		field.String("ip_address").
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("検知時のリクエスト元IPアドレス"),
+		field.String("user_agent").
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("検知時のUser-Agent"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the SecurityEvent.
+func (SecurityEvent) Edges() []ent.Edge {
+	return []ent.Edge{
+		// SecurityEventはユーザー（User）に属する
+		edge.From("user", User.Type).
+			Ref("security_events").
+			Field("user_id").
+			Required().
+			Unique(),
+	}
+}
+
+// Indexes of the SecurityEvent.
+func (SecurityEvent) Indexes() []ent.Index {
+	return []ent.Index{
+		// ユーザーごとのイベントを時系列で取得
+		index.Fields("user_id", "created_at"),
+	}
+}
@@ -0,0 +1,111 @@
+package schema
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// Session holds the schema definition for the Session entity.
+type Session struct {
+	ent.Schema
+}
+
+// Fields of the Session.
+func (Session) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Unique(),
+		field.UUID("user_id", uuid.UUID{}).
+			Comment("ログインしたユーザーID"),
+		field.Bytes("refresh_token_hash").
+			NotEmpty().
+			Unique().
+			Sensitive().
+			Comment("リフレッシュトークンハッシュ"),
+		field.String("device_label").
+			Optional().
+			Nillable().
+			Comment("端末名（ログイン時にクライアントが指定）"),
+		field.String("user_agent").
+			Optional().
+			Nillable().
+			Comment("ログイン時のUser-Agent"),
+		field.String("ip_address").
+			Optional().
+			Nillable().
+			Comment("最終使用時のIPアドレス"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("last_used_at").
+			Default(time.Now).
+			Comment("最終使用日時（トークンリフレッシュ時に更新）"),
+		field.Time("expires_at").
+			Comment("リフレッシュトークン有効期限"),
+	}
+}
+
+// Edges of the Session.
+func (Session) Edges() []ent.Edge {
+	return []ent.Edge{
+		// Sessionはユーザー（User）に属する
+		edge.From("user", User.Type).
+			Ref("sessions").
+			Field("user_id").
+			Required().
+			Unique(),
+		// Sessionはローテーション済みのリフレッシュトークン（RotatedRefreshToken）を持つ
+		edge.To("rotated_tokens", RotatedRefreshToken.Type),
+	}
+}
+
+// Indexes of the Session.
+func (Session) Indexes() []ent.Index {
+	return []ent.Index{
+		// ユーザーごとのセッション一覧を効率的に取得
+		index.Fields("user_id", "last_used_at"),
+	}
+}
+
+// Hooks of the Session.
+func (Session) Hooks() []ent.Hook {
+	return []ent.Hook{
+		// リフレッシュトークンフィールドの整合性を保つフック
+		func(next ent.Mutator) ent.Mutator {
+			return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+				sm, ok := m.(interface {
+					RefreshTokenHash() ([]byte, bool)
+					ExpiresAt() (time.Time, bool)
+				})
+				if ok {
+					refreshTokenHash, hasRefreshTokenHash := sm.RefreshTokenHash()
+					expiresAt, hasExpiresAt := sm.ExpiresAt()
+
+					// リフレッシュトークンが設定されているが、有効期限が設定されていない場合はエラー（ローテーション時も有効期限を更新する）
+					if hasRefreshTokenHash && len(refreshTokenHash) > 0 && !hasExpiresAt {
+						return nil, fmt.Errorf("refresh token requires expiry time")
+					}
+
+					// 有効期限が設定されているが、リフレッシュトークンが設定されていない場合はエラー
+					if hasExpiresAt && !hasRefreshTokenHash {
+						return nil, fmt.Errorf("refresh token expiry requires refresh token")
+					}
+
+					// 有効期限が過去の時刻の場合はエラー
+					if hasExpiresAt && expiresAt.Before(time.Now()) {
+						return nil, fmt.Errorf("refresh token expiry must be in the future")
+					}
+				}
+				return next.Mutate(ctx, m)
+			})
+		},
+	}
+}
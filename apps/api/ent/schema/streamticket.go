@@ -0,0 +1,74 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// StreamTicket holds the schema definition for the StreamTicket entity.
+// WebSocket・SSEの接続時に、アクセストークンの代わりにURLで渡す使い捨てのチケット
+// 発行元のアクセストークンの情報を保存し、接続後もそのトークンと同様に失効を検知する
+type StreamTicket struct {
+	ent.Schema
+}
+
+// Fields of the StreamTicket.
+func (StreamTicket) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id").
+			Positive(),
+		field.Bytes("token_hash").
+			NotEmpty().
+			Unique().
+			Sensitive().
+			Immutable().
+			Comment("チケットハッシュ"),
+		field.UUID("user_id", uuid.UUID{}).
+			Immutable().
+			Comment("チケットを発行したユーザーID"),
+		field.String("token_id").
+			NotEmpty().
+			Immutable().
+			Comment("発行元のアクセストークンID（jti）"),
+		field.Int("token_version").
+			NonNegative().
+			Immutable().
+			Comment("発行元のアクセストークンのバージョン"),
+		field.String("session_id").
+			Optional().
+			Immutable().
+			Comment("発行元のアクセストークンのログインセッションID"),
+		field.Time("token_expires_at").
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("発行元のアクセストークンの有効期限（接続はこの日時に切断される）"),
+		field.Time("expires_at").
+			Immutable().
+			Comment("チケットの有効期限"),
+	}
+}
+
+// Edges of the StreamTicket.
+func (StreamTicket) Edges() []ent.Edge {
+	return []ent.Edge{
+		// StreamTicketはユーザー（User）に属する
+		edge.From("user", User.Type).
+			Ref("stream_tickets").
+			Field("user_id").
+			Required().
+			Immutable().
+			Unique(),
+	}
+}
+
+// Indexes of the StreamTicket.
+func (StreamTicket) Indexes() []ent.Index {
+	return []ent.Index{
+		// 期限切れのチケットを効率的に削除
+		index.Fields("user_id", "expires_at"),
+	}
+}
@@ -2,7 +2,6 @@ package schema
 
 import (
 	"context"
-	"fmt"
 	"strings"
 	"time"
 
@@ -52,16 +51,14 @@ func (User) Fields() []ent.Field {
 			Default(time.Now).
 			UpdateDefault(time.Now).
 			Comment("更新日時"),
-		field.Bytes("refresh_token_hash").
+		field.Time("last_seen_at").
 			Optional().
 			Nillable().
-			Unique().
-			Sensitive().
-			Comment("リフレッシュトークンハッシュ"),
-		field.Time("refresh_token_expires_at").
-			Optional().
-			Nillable().
-			Comment("リフレッシュトークン有効期限"),
+			Comment("最終オンライン日時（リアルタイム接続のハートビートで更新）"),
+		field.Int("token_version").
+			Default(0).
+			NonNegative().
+			Comment("アクセストークンのバージョン（更新すると発行済みのトークンが全て無効になる）"),
 	}
 }
 
@@ -72,6 +69,24 @@ func (User) Edges() []ent.Edge {
 		edge.To("room_members", RoomMember.Type),
 		// Userは複数のメッセージ（Message）を持つ
 		edge.To("messages", Message.Type),
+		// Userはルームごとの既読位置（MessageRead）を持つ
+		edge.To("message_reads", MessageRead.Type),
+		// Userは複数のリアクション（MessageReaction）を持つ
+		edge.To("message_reactions", MessageReaction.Type),
+		// Userは編集したメッセージの履歴（MessageRevision）を持つ
+		edge.To("message_revisions", MessageRevision.Type),
+		// Userは作成した招待（Invite）を持つ
+		edge.To("invites", Invite.Type),
+		// Userはルームへの参加申請（JoinRequest）を持つ
+		edge.To("join_requests", JoinRequest.Type),
+		// Userは端末ごとのログインセッション（Session）を持つ
+		edge.To("sessions", Session.Type),
+		// Userはセキュリティイベント（SecurityEvent）を持つ
+		edge.To("security_events", SecurityEvent.Type),
+		// Userは失効させたアクセストークン（RevokedToken）を持つ
+		edge.To("revoked_tokens", RevokedToken.Type),
+		// Userはストリーミング接続用のチケット（StreamTicket）を持つ
+		edge.To("stream_tickets", StreamTicket.Type),
 	}
 }
 
@@ -86,50 +101,6 @@ func (User) Indexes() []ent.Index {
 // Hooks of the User.
 func (User) Hooks() []ent.Hook {
 	return []ent.Hook{
-		// リフレッシュトークンフィールドの整合性を保つフック
-		func(next ent.Mutator) ent.Mutator {
-			return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
-				um, ok := m.(interface {
-					RefreshTokenHash() ([]byte, bool)
-					RefreshTokenExpiresAt() (time.Time, bool)
-					RefreshTokenHashCleared() bool
-					RefreshTokenExpiresAtCleared() bool
-					ClearRefreshTokenHash()
-					ClearRefreshTokenExpiresAt()
-				})
-				if ok {
-					// === クリア操作の整合性チェック ===
-					// リフレッシュトークンがクリアされる場合、有効期限もクリア
-					if um.RefreshTokenHashCleared() && !um.RefreshTokenExpiresAtCleared() {
-						um.ClearRefreshTokenExpiresAt()
-					}
-					// 有効期限がクリアされる場合、リフレッシュトークンもクリア
-					if um.RefreshTokenExpiresAtCleared() && !um.RefreshTokenHashCleared() {
-						um.ClearRefreshTokenHash()
-					}
-
-					// === 設定操作の整合性チェック ===
-					refreshTokenHash, hasRefreshTokenHash := um.RefreshTokenHash()
-					expiresAt, hasExpiresAt := um.RefreshTokenExpiresAt()
-
-					// リフレッシュトークンが設定されているが、有効期限が設定されていない場合はエラー
-					if hasRefreshTokenHash && len(refreshTokenHash) > 0 && !hasExpiresAt {
-						return nil, fmt.Errorf("refresh token requires expiry time")
-					}
-
-					// 有効期限が設定されているが、リフレッシュトークンが設定されていない場合はエラー
-					if hasExpiresAt && !hasRefreshTokenHash {
-						return nil, fmt.Errorf("refresh token expiry requires refresh token")
-					}
-
-					// 有効期限が過去の時刻の場合はエラー
-					if hasExpiresAt && expiresAt.Before(time.Now()) {
-						return nil, fmt.Errorf("refresh token expiry must be in the future")
-					}
-				}
-				return next.Mutate(ctx, m)
-			})
-		},
 		// Email正規化フック
 		func(next ent.Mutator) ent.Mutator {
 			return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
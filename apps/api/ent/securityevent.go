@@ -0,0 +1,204 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/securityevent"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/user"
+)
+
+// SecurityEvent is the model entity for the SecurityEvent schema.
+type SecurityEvent struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int64 `json:"id,omitempty"`
+	// 対象のユーザーID
+	UserID uuid.UUID `json:"user_id,omitempty"`
+	// イベント種別
+	Type securityevent.Type `json:"type,omitempty"`
+	// 関連するセッションID（失効後も記録として残すため外部キーにしない）
+	SessionID *uuid.UUID `json:"session_id,omitempty"`
+	// 検知時のリクエスト元IPアドレス
+	IPAddress *string `json:"ip_address,omitempty"`
+	// 検知時のUser-Agent
+	UserAgent *string `json:"user_agent,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the SecurityEventQuery when eager-loading is set.
+	Edges        SecurityEventEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// SecurityEventEdges holds the relations/edges for other nodes in the graph.
+type SecurityEventEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SecurityEventEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*SecurityEvent) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case securityevent.FieldSessionID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case securityevent.FieldID:
+			values[i] = new(sql.NullInt64)
+		case securityevent.FieldType, securityevent.FieldIPAddress, securityevent.FieldUserAgent:
+			values[i] = new(sql.NullString)
+		case securityevent.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case securityevent.FieldUserID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the SecurityEvent fields.
+func (se *SecurityEvent) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case securityevent.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			se.ID = int64(value.Int64)
+		case securityevent.FieldUserID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value != nil {
+				se.UserID = *value
+			}
+		case securityevent.FieldType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field type", values[i])
+			} else if value.Valid {
+				se.Type = securityevent.Type(value.String)
+			}
+		case securityevent.FieldSessionID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field session_id", values[i])
+			} else if value.Valid {
+				se.SessionID = new(uuid.UUID)
+				*se.SessionID = *value.S.(*uuid.UUID)
+			}
+		case securityevent.FieldIPAddress:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field ip_address", values[i])
+			} else if value.Valid {
+				se.IPAddress = new(string)
+				*se.IPAddress = value.String
+			}
+		case securityevent.FieldUserAgent:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_agent", values[i])
+			} else if value.Valid {
+				se.UserAgent = new(string)
+				*se.UserAgent = value.String
+			}
+		case securityevent.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				se.CreatedAt = value.Time
+			}
+		default:
+			se.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the SecurityEvent.
+// This includes values selected through modifiers, order, etc.
+func (se *SecurityEvent) Value(name string) (ent.Value, error) {
+	return se.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the SecurityEvent entity.
+func (se *SecurityEvent) QueryUser() *UserQuery {
+	return NewSecurityEventClient(se.config).QueryUser(se)
+}
+
+// Update returns a builder for updating this SecurityEvent.
+// Note that you need to call SecurityEvent.Unwrap() before calling this method if this SecurityEvent
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (se *SecurityEvent) Update() *SecurityEventUpdateOne {
+	return NewSecurityEventClient(se.config).UpdateOne(se)
+}
+
+// Unwrap unwraps the SecurityEvent entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (se *SecurityEvent) Unwrap() *SecurityEvent {
+	_tx, ok := se.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: SecurityEvent is not a transactional entity")
+	}
+	se.config.driver = _tx.drv
+	return se
+}
+
+// String implements the fmt.Stringer.
+func (se *SecurityEvent) String() string {
+	var builder strings.Builder
+	builder.WriteString("SecurityEvent(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", se.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", se.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("type=")
+	builder.WriteString(fmt.Sprintf("%v", se.Type))
+	builder.WriteString(", ")
+	if v := se.SessionID; v != nil {
+		builder.WriteString("session_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := se.IPAddress; v != nil {
+		builder.WriteString("ip_address=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := se.UserAgent; v != nil {
+		builder.WriteString("user_agent=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(se.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SecurityEvents is a parsable slice of SecurityEvent.
+type SecurityEvents []*SecurityEvent
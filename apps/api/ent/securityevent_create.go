@@ -0,0 +1,327 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/securityevent"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/user"
+)
+
+// SecurityEventCreate is the builder for creating a SecurityEvent entity.
+type SecurityEventCreate struct {
+	config
+	mutation *SecurityEventMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (sec *SecurityEventCreate) SetUserID(u uuid.UUID) *SecurityEventCreate {
+	sec.mutation.SetUserID(u)
+	return sec
+}
+
+// SetType sets the "type" field.
+func (sec *SecurityEventCreate) SetType(s securityevent.Type) *SecurityEventCreate {
+	sec.mutation.SetType(s)
+	return sec
+}
+
+// SetSessionID sets the "session_id" field.
+func (sec *SecurityEventCreate) SetSessionID(u uuid.UUID) *SecurityEventCreate {
+	sec.mutation.SetSessionID(u)
+	return sec
+}
+
+// SetNillableSessionID sets the "session_id" field if the given value is not nil.
+func (sec *SecurityEventCreate) SetNillableSessionID(u *uuid.UUID) *SecurityEventCreate {
+	if u != nil {
+		sec.SetSessionID(*u)
+	}
+	return sec
+}
+
+// SetIPAddress sets the "ip_address" field.
+func (sec *SecurityEventCreate) SetIPAddress(s string) *SecurityEventCreate {
+	sec.mutation.SetIPAddress(s)
+	return sec
+}
+
+// SetNillableIPAddress sets the "ip_address" field if the given value is not nil.
+func (sec *SecurityEventCreate) SetNillableIPAddress(s *string) *SecurityEventCreate {
+	if s != nil {
+		sec.SetIPAddress(*s)
+	}
+	return sec
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (sec *SecurityEventCreate) SetUserAgent(s string) *SecurityEventCreate {
+	sec.mutation.SetUserAgent(s)
+	return sec
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (sec *SecurityEventCreate) SetNillableUserAgent(s *string) *SecurityEventCreate {
+	if s != nil {
+		sec.SetUserAgent(*s)
+	}
+	return sec
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (sec *SecurityEventCreate) SetCreatedAt(t time.Time) *SecurityEventCreate {
+	sec.mutation.SetCreatedAt(t)
+	return sec
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (sec *SecurityEventCreate) SetNillableCreatedAt(t *time.Time) *SecurityEventCreate {
+	if t != nil {
+		sec.SetCreatedAt(*t)
+	}
+	return sec
+}
+
+// SetID sets the "id" field.
+func (sec *SecurityEventCreate) SetID(i int64) *SecurityEventCreate {
+	sec.mutation.SetID(i)
+	return sec
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (sec *SecurityEventCreate) SetUser(u *User) *SecurityEventCreate {
+	return sec.SetUserID(u.ID)
+}
+
+// Mutation returns the SecurityEventMutation object of the builder.
+func (sec *SecurityEventCreate) Mutation() *SecurityEventMutation {
+	return sec.mutation
+}
+
+// Save creates the SecurityEvent in the database.
+func (sec *SecurityEventCreate) Save(ctx context.Context) (*SecurityEvent, error) {
+	sec.defaults()
+	return withHooks(ctx, sec.sqlSave, sec.mutation, sec.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (sec *SecurityEventCreate) SaveX(ctx context.Context) *SecurityEvent {
+	v, err := sec.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sec *SecurityEventCreate) Exec(ctx context.Context) error {
+	_, err := sec.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sec *SecurityEventCreate) ExecX(ctx context.Context) {
+	if err := sec.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sec *SecurityEventCreate) defaults() {
+	if _, ok := sec.mutation.CreatedAt(); !ok {
+		v := securityevent.DefaultCreatedAt()
+		sec.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sec *SecurityEventCreate) check() error {
+	if _, ok := sec.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "SecurityEvent.user_id"`)}
+	}
+	if _, ok := sec.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "SecurityEvent.type"`)}
+	}
+	if v, ok := sec.mutation.GetType(); ok {
+		if err := securityevent.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "SecurityEvent.type": %w`, err)}
+		}
+	}
+	if _, ok := sec.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "SecurityEvent.created_at"`)}
+	}
+	if v, ok := sec.mutation.ID(); ok {
+		if err := securityevent.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "SecurityEvent.id": %w`, err)}
+		}
+	}
+	if len(sec.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "SecurityEvent.user"`)}
+	}
+	return nil
+}
+
+func (sec *SecurityEventCreate) sqlSave(ctx context.Context) (*SecurityEvent, error) {
+	if err := sec.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := sec.createSpec()
+	if err := sqlgraph.CreateNode(ctx, sec.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int64(id)
+	}
+	sec.mutation.id = &_node.ID
+	sec.mutation.done = true
+	return _node, nil
+}
+
+func (sec *SecurityEventCreate) createSpec() (*SecurityEvent, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SecurityEvent{config: sec.config}
+		_spec = sqlgraph.NewCreateSpec(securityevent.Table, sqlgraph.NewFieldSpec(securityevent.FieldID, field.TypeInt64))
+	)
+	if id, ok := sec.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := sec.mutation.GetType(); ok {
+		_spec.SetField(securityevent.FieldType, field.TypeEnum, value)
+		_node.Type = value
+	}
+	if value, ok := sec.mutation.SessionID(); ok {
+		_spec.SetField(securityevent.FieldSessionID, field.TypeUUID, value)
+		_node.SessionID = &value
+	}
+	if value, ok := sec.mutation.IPAddress(); ok {
+		_spec.SetField(securityevent.FieldIPAddress, field.TypeString, value)
+		_node.IPAddress = &value
+	}
+	if value, ok := sec.mutation.UserAgent(); ok {
+		_spec.SetField(securityevent.FieldUserAgent, field.TypeString, value)
+		_node.UserAgent = &value
+	}
+	if value, ok := sec.mutation.CreatedAt(); ok {
+		_spec.SetField(securityevent.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := sec.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   securityevent.UserTable,
+			Columns: []string{securityevent.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// SecurityEventCreateBulk is the builder for creating many SecurityEvent entities in bulk.
+type SecurityEventCreateBulk struct {
+	config
+	err      error
+	builders []*SecurityEventCreate
+}
+
+// Save creates the SecurityEvent entities in the database.
+func (secb *SecurityEventCreateBulk) Save(ctx context.Context) ([]*SecurityEvent, error) {
+	if secb.err != nil {
+		return nil, secb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(secb.builders))
+	nodes := make([]*SecurityEvent, len(secb.builders))
+	mutators := make([]Mutator, len(secb.builders))
+	for i := range secb.builders {
+		func(i int, root context.Context) {
+			builder := secb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SecurityEventMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, secb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, secb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, secb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (secb *SecurityEventCreateBulk) SaveX(ctx context.Context) []*SecurityEvent {
+	v, err := secb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (secb *SecurityEventCreateBulk) Exec(ctx context.Context) error {
+	_, err := secb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (secb *SecurityEventCreateBulk) ExecX(ctx context.Context) {
+	if err := secb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/predicate"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/securityevent"
+)
+
+// SecurityEventDelete is the builder for deleting a SecurityEvent entity.
+type SecurityEventDelete struct {
+	config
+	hooks    []Hook
+	mutation *SecurityEventMutation
+}
+
+// Where appends a list predicates to the SecurityEventDelete builder.
+func (sed *SecurityEventDelete) Where(ps ...predicate.SecurityEvent) *SecurityEventDelete {
+	sed.mutation.Where(ps...)
+	return sed
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (sed *SecurityEventDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, sed.sqlExec, sed.mutation, sed.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sed *SecurityEventDelete) ExecX(ctx context.Context) int {
+	n, err := sed.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (sed *SecurityEventDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(securityevent.Table, sqlgraph.NewFieldSpec(securityevent.FieldID, field.TypeInt64))
+	if ps := sed.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, sed.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	sed.mutation.done = true
+	return affected, err
+}
+
+// SecurityEventDeleteOne is the builder for deleting a single SecurityEvent entity.
+type SecurityEventDeleteOne struct {
+	sed *SecurityEventDelete
+}
+
+// Where appends a list predicates to the SecurityEventDelete builder.
+func (sedo *SecurityEventDeleteOne) Where(ps ...predicate.SecurityEvent) *SecurityEventDeleteOne {
+	sedo.sed.mutation.Where(ps...)
+	return sedo
+}
+
+// Exec executes the deletion query.
+func (sedo *SecurityEventDeleteOne) Exec(ctx context.Context) error {
+	n, err := sedo.sed.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{securityevent.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sedo *SecurityEventDeleteOne) ExecX(ctx context.Context) {
+	if err := sedo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,325 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/predicate"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/securityevent"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/user"
+)
+
+// SecurityEventUpdate is the builder for updating SecurityEvent entities.
+type SecurityEventUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SecurityEventMutation
+}
+
+// Where appends a list predicates to the SecurityEventUpdate builder.
+func (seu *SecurityEventUpdate) Where(ps ...predicate.SecurityEvent) *SecurityEventUpdate {
+	seu.mutation.Where(ps...)
+	return seu
+}
+
+// SetUserID sets the "user_id" field.
+func (seu *SecurityEventUpdate) SetUserID(u uuid.UUID) *SecurityEventUpdate {
+	seu.mutation.SetUserID(u)
+	return seu
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (seu *SecurityEventUpdate) SetNillableUserID(u *uuid.UUID) *SecurityEventUpdate {
+	if u != nil {
+		seu.SetUserID(*u)
+	}
+	return seu
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (seu *SecurityEventUpdate) SetUser(u *User) *SecurityEventUpdate {
+	return seu.SetUserID(u.ID)
+}
+
+// Mutation returns the SecurityEventMutation object of the builder.
+func (seu *SecurityEventUpdate) Mutation() *SecurityEventMutation {
+	return seu.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (seu *SecurityEventUpdate) ClearUser() *SecurityEventUpdate {
+	seu.mutation.ClearUser()
+	return seu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (seu *SecurityEventUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, seu.sqlSave, seu.mutation, seu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (seu *SecurityEventUpdate) SaveX(ctx context.Context) int {
+	affected, err := seu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (seu *SecurityEventUpdate) Exec(ctx context.Context) error {
+	_, err := seu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (seu *SecurityEventUpdate) ExecX(ctx context.Context) {
+	if err := seu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (seu *SecurityEventUpdate) check() error {
+	if seu.mutation.UserCleared() && len(seu.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "SecurityEvent.user"`)
+	}
+	return nil
+}
+
+func (seu *SecurityEventUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := seu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(securityevent.Table, securityevent.Columns, sqlgraph.NewFieldSpec(securityevent.FieldID, field.TypeInt64))
+	if ps := seu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if seu.mutation.SessionIDCleared() {
+		_spec.ClearField(securityevent.FieldSessionID, field.TypeUUID)
+	}
+	if seu.mutation.IPAddressCleared() {
+		_spec.ClearField(securityevent.FieldIPAddress, field.TypeString)
+	}
+	if seu.mutation.UserAgentCleared() {
+		_spec.ClearField(securityevent.FieldUserAgent, field.TypeString)
+	}
+	if seu.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   securityevent.UserTable,
+			Columns: []string{securityevent.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := seu.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   securityevent.UserTable,
+			Columns: []string{securityevent.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, seu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{securityevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	seu.mutation.done = true
+	return n, nil
+}
+
+// SecurityEventUpdateOne is the builder for updating a single SecurityEvent entity.
+type SecurityEventUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SecurityEventMutation
+}
+
+// SetUserID sets the "user_id" field.
+func (seuo *SecurityEventUpdateOne) SetUserID(u uuid.UUID) *SecurityEventUpdateOne {
+	seuo.mutation.SetUserID(u)
+	return seuo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (seuo *SecurityEventUpdateOne) SetNillableUserID(u *uuid.UUID) *SecurityEventUpdateOne {
+	if u != nil {
+		seuo.SetUserID(*u)
+	}
+	return seuo
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (seuo *SecurityEventUpdateOne) SetUser(u *User) *SecurityEventUpdateOne {
+	return seuo.SetUserID(u.ID)
+}
+
+// Mutation returns the SecurityEventMutation object of the builder.
+func (seuo *SecurityEventUpdateOne) Mutation() *SecurityEventMutation {
+	return seuo.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (seuo *SecurityEventUpdateOne) ClearUser() *SecurityEventUpdateOne {
+	seuo.mutation.ClearUser()
+	return seuo
+}
+
+// Where appends a list predicates to the SecurityEventUpdate builder.
+func (seuo *SecurityEventUpdateOne) Where(ps ...predicate.SecurityEvent) *SecurityEventUpdateOne {
+	seuo.mutation.Where(ps...)
+	return seuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (seuo *SecurityEventUpdateOne) Select(field string, fields ...string) *SecurityEventUpdateOne {
+	seuo.fields = append([]string{field}, fields...)
+	return seuo
+}
+
+// Save executes the query and returns the updated SecurityEvent entity.
+func (seuo *SecurityEventUpdateOne) Save(ctx context.Context) (*SecurityEvent, error) {
+	return withHooks(ctx, seuo.sqlSave, seuo.mutation, seuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (seuo *SecurityEventUpdateOne) SaveX(ctx context.Context) *SecurityEvent {
+	node, err := seuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (seuo *SecurityEventUpdateOne) Exec(ctx context.Context) error {
+	_, err := seuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (seuo *SecurityEventUpdateOne) ExecX(ctx context.Context) {
+	if err := seuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (seuo *SecurityEventUpdateOne) check() error {
+	if seuo.mutation.UserCleared() && len(seuo.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "SecurityEvent.user"`)
+	}
+	return nil
+}
+
+func (seuo *SecurityEventUpdateOne) sqlSave(ctx context.Context) (_node *SecurityEvent, err error) {
+	if err := seuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(securityevent.Table, securityevent.Columns, sqlgraph.NewFieldSpec(securityevent.FieldID, field.TypeInt64))
+	id, ok := seuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "SecurityEvent.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := seuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, securityevent.FieldID)
+		for _, f := range fields {
+			if !securityevent.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != securityevent.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := seuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if seuo.mutation.SessionIDCleared() {
+		_spec.ClearField(securityevent.FieldSessionID, field.TypeUUID)
+	}
+	if seuo.mutation.IPAddressCleared() {
+		_spec.ClearField(securityevent.FieldIPAddress, field.TypeString)
+	}
+	if seuo.mutation.UserAgentCleared() {
+		_spec.ClearField(securityevent.FieldUserAgent, field.TypeString)
+	}
+	if seuo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   securityevent.UserTable,
+			Columns: []string{securityevent.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := seuo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   securityevent.UserTable,
+			Columns: []string{securityevent.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &SecurityEvent{config: seuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, seuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{securityevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	seuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,208 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/streamticket"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/user"
+)
+
+// StreamTicket is the model entity for the StreamTicket schema.
+type StreamTicket struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int64 `json:"id,omitempty"`
+	// チケットハッシュ
+	TokenHash []byte `json:"-"`
+	// チケットを発行したユーザーID
+	UserID uuid.UUID `json:"user_id,omitempty"`
+	// 発行元のアクセストークンID（jti）
+	TokenID string `json:"token_id,omitempty"`
+	// 発行元のアクセストークンのバージョン
+	TokenVersion int `json:"token_version,omitempty"`
+	// 発行元のアクセストークンのログインセッションID
+	SessionID string `json:"session_id,omitempty"`
+	// 発行元のアクセストークンの有効期限（接続はこの日時に切断される）
+	TokenExpiresAt *time.Time `json:"token_expires_at,omitempty"`
+	// チケットの有効期限
+	ExpiresAt time.Time `json:"expires_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the StreamTicketQuery when eager-loading is set.
+	Edges        StreamTicketEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// StreamTicketEdges holds the relations/edges for other nodes in the graph.
+type StreamTicketEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e StreamTicketEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*StreamTicket) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case streamticket.FieldTokenHash:
+			values[i] = new([]byte)
+		case streamticket.FieldID, streamticket.FieldTokenVersion:
+			values[i] = new(sql.NullInt64)
+		case streamticket.FieldTokenID, streamticket.FieldSessionID:
+			values[i] = new(sql.NullString)
+		case streamticket.FieldTokenExpiresAt, streamticket.FieldExpiresAt:
+			values[i] = new(sql.NullTime)
+		case streamticket.FieldUserID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the StreamTicket fields.
+func (st *StreamTicket) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case streamticket.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			st.ID = int64(value.Int64)
+		case streamticket.FieldTokenHash:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field token_hash", values[i])
+			} else if value != nil {
+				st.TokenHash = *value
+			}
+		case streamticket.FieldUserID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value != nil {
+				st.UserID = *value
+			}
+		case streamticket.FieldTokenID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field token_id", values[i])
+			} else if value.Valid {
+				st.TokenID = value.String
+			}
+		case streamticket.FieldTokenVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field token_version", values[i])
+			} else if value.Valid {
+				st.TokenVersion = int(value.Int64)
+			}
+		case streamticket.FieldSessionID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field session_id", values[i])
+			} else if value.Valid {
+				st.SessionID = value.String
+			}
+		case streamticket.FieldTokenExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field token_expires_at", values[i])
+			} else if value.Valid {
+				st.TokenExpiresAt = new(time.Time)
+				*st.TokenExpiresAt = value.Time
+			}
+		case streamticket.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				st.ExpiresAt = value.Time
+			}
+		default:
+			st.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the StreamTicket.
+// This includes values selected through modifiers, order, etc.
+func (st *StreamTicket) Value(name string) (ent.Value, error) {
+	return st.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the StreamTicket entity.
+func (st *StreamTicket) QueryUser() *UserQuery {
+	return NewStreamTicketClient(st.config).QueryUser(st)
+}
+
+// Update returns a builder for updating this StreamTicket.
+// Note that you need to call StreamTicket.Unwrap() before calling this method if this StreamTicket
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (st *StreamTicket) Update() *StreamTicketUpdateOne {
+	return NewStreamTicketClient(st.config).UpdateOne(st)
+}
+
+// Unwrap unwraps the StreamTicket entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (st *StreamTicket) Unwrap() *StreamTicket {
+	_tx, ok := st.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: StreamTicket is not a transactional entity")
+	}
+	st.config.driver = _tx.drv
+	return st
+}
+
+// String implements the fmt.Stringer.
+func (st *StreamTicket) String() string {
+	var builder strings.Builder
+	builder.WriteString("StreamTicket(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", st.ID))
+	builder.WriteString("token_hash=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", st.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("token_id=")
+	builder.WriteString(st.TokenID)
+	builder.WriteString(", ")
+	builder.WriteString("token_version=")
+	builder.WriteString(fmt.Sprintf("%v", st.TokenVersion))
+	builder.WriteString(", ")
+	builder.WriteString("session_id=")
+	builder.WriteString(st.SessionID)
+	builder.WriteString(", ")
+	if v := st.TokenExpiresAt; v != nil {
+		builder.WriteString("token_expires_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("expires_at=")
+	builder.WriteString(st.ExpiresAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// StreamTickets is a parsable slice of StreamTicket.
+type StreamTickets []*StreamTicket
@@ -0,0 +1,327 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/streamticket"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/user"
+)
+
+// StreamTicketCreate is the builder for creating a StreamTicket entity.
+type StreamTicketCreate struct {
+	config
+	mutation *StreamTicketMutation
+	hooks    []Hook
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (stc *StreamTicketCreate) SetTokenHash(b []byte) *StreamTicketCreate {
+	stc.mutation.SetTokenHash(b)
+	return stc
+}
+
+// SetUserID sets the "user_id" field.
+func (stc *StreamTicketCreate) SetUserID(u uuid.UUID) *StreamTicketCreate {
+	stc.mutation.SetUserID(u)
+	return stc
+}
+
+// SetTokenID sets the "token_id" field.
+func (stc *StreamTicketCreate) SetTokenID(s string) *StreamTicketCreate {
+	stc.mutation.SetTokenID(s)
+	return stc
+}
+
+// SetTokenVersion sets the "token_version" field.
+func (stc *StreamTicketCreate) SetTokenVersion(i int) *StreamTicketCreate {
+	stc.mutation.SetTokenVersion(i)
+	return stc
+}
+
+// SetSessionID sets the "session_id" field.
+func (stc *StreamTicketCreate) SetSessionID(s string) *StreamTicketCreate {
+	stc.mutation.SetSessionID(s)
+	return stc
+}
+
+// SetNillableSessionID sets the "session_id" field if the given value is not nil.
+func (stc *StreamTicketCreate) SetNillableSessionID(s *string) *StreamTicketCreate {
+	if s != nil {
+		stc.SetSessionID(*s)
+	}
+	return stc
+}
+
+// SetTokenExpiresAt sets the "token_expires_at" field.
+func (stc *StreamTicketCreate) SetTokenExpiresAt(t time.Time) *StreamTicketCreate {
+	stc.mutation.SetTokenExpiresAt(t)
+	return stc
+}
+
+// SetNillableTokenExpiresAt sets the "token_expires_at" field if the given value is not nil.
+func (stc *StreamTicketCreate) SetNillableTokenExpiresAt(t *time.Time) *StreamTicketCreate {
+	if t != nil {
+		stc.SetTokenExpiresAt(*t)
+	}
+	return stc
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (stc *StreamTicketCreate) SetExpiresAt(t time.Time) *StreamTicketCreate {
+	stc.mutation.SetExpiresAt(t)
+	return stc
+}
+
+// SetID sets the "id" field.
+func (stc *StreamTicketCreate) SetID(i int64) *StreamTicketCreate {
+	stc.mutation.SetID(i)
+	return stc
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (stc *StreamTicketCreate) SetUser(u *User) *StreamTicketCreate {
+	return stc.SetUserID(u.ID)
+}
+
+// Mutation returns the StreamTicketMutation object of the builder.
+func (stc *StreamTicketCreate) Mutation() *StreamTicketMutation {
+	return stc.mutation
+}
+
+// Save creates the StreamTicket in the database.
+func (stc *StreamTicketCreate) Save(ctx context.Context) (*StreamTicket, error) {
+	return withHooks(ctx, stc.sqlSave, stc.mutation, stc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (stc *StreamTicketCreate) SaveX(ctx context.Context) *StreamTicket {
+	v, err := stc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (stc *StreamTicketCreate) Exec(ctx context.Context) error {
+	_, err := stc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (stc *StreamTicketCreate) ExecX(ctx context.Context) {
+	if err := stc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (stc *StreamTicketCreate) check() error {
+	if _, ok := stc.mutation.TokenHash(); !ok {
+		return &ValidationError{Name: "token_hash", err: errors.New(`ent: missing required field "StreamTicket.token_hash"`)}
+	}
+	if v, ok := stc.mutation.TokenHash(); ok {
+		if err := streamticket.TokenHashValidator(v); err != nil {
+			return &ValidationError{Name: "token_hash", err: fmt.Errorf(`ent: validator failed for field "StreamTicket.token_hash": %w`, err)}
+		}
+	}
+	if _, ok := stc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "StreamTicket.user_id"`)}
+	}
+	if _, ok := stc.mutation.TokenID(); !ok {
+		return &ValidationError{Name: "token_id", err: errors.New(`ent: missing required field "StreamTicket.token_id"`)}
+	}
+	if v, ok := stc.mutation.TokenID(); ok {
+		if err := streamticket.TokenIDValidator(v); err != nil {
+			return &ValidationError{Name: "token_id", err: fmt.Errorf(`ent: validator failed for field "StreamTicket.token_id": %w`, err)}
+		}
+	}
+	if _, ok := stc.mutation.TokenVersion(); !ok {
+		return &ValidationError{Name: "token_version", err: errors.New(`ent: missing required field "StreamTicket.token_version"`)}
+	}
+	if v, ok := stc.mutation.TokenVersion(); ok {
+		if err := streamticket.TokenVersionValidator(v); err != nil {
+			return &ValidationError{Name: "token_version", err: fmt.Errorf(`ent: validator failed for field "StreamTicket.token_version": %w`, err)}
+		}
+	}
+	if _, ok := stc.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "StreamTicket.expires_at"`)}
+	}
+	if v, ok := stc.mutation.ID(); ok {
+		if err := streamticket.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "StreamTicket.id": %w`, err)}
+		}
+	}
+	if len(stc.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "StreamTicket.user"`)}
+	}
+	return nil
+}
+
+func (stc *StreamTicketCreate) sqlSave(ctx context.Context) (*StreamTicket, error) {
+	if err := stc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := stc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, stc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int64(id)
+	}
+	stc.mutation.id = &_node.ID
+	stc.mutation.done = true
+	return _node, nil
+}
+
+func (stc *StreamTicketCreate) createSpec() (*StreamTicket, *sqlgraph.CreateSpec) {
+	var (
+		_node = &StreamTicket{config: stc.config}
+		_spec = sqlgraph.NewCreateSpec(streamticket.Table, sqlgraph.NewFieldSpec(streamticket.FieldID, field.TypeInt64))
+	)
+	if id, ok := stc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := stc.mutation.TokenHash(); ok {
+		_spec.SetField(streamticket.FieldTokenHash, field.TypeBytes, value)
+		_node.TokenHash = value
+	}
+	if value, ok := stc.mutation.TokenID(); ok {
+		_spec.SetField(streamticket.FieldTokenID, field.TypeString, value)
+		_node.TokenID = value
+	}
+	if value, ok := stc.mutation.TokenVersion(); ok {
+		_spec.SetField(streamticket.FieldTokenVersion, field.TypeInt, value)
+		_node.TokenVersion = value
+	}
+	if value, ok := stc.mutation.SessionID(); ok {
+		_spec.SetField(streamticket.FieldSessionID, field.TypeString, value)
+		_node.SessionID = value
+	}
+	if value, ok := stc.mutation.TokenExpiresAt(); ok {
+		_spec.SetField(streamticket.FieldTokenExpiresAt, field.TypeTime, value)
+		_node.TokenExpiresAt = &value
+	}
+	if value, ok := stc.mutation.ExpiresAt(); ok {
+		_spec.SetField(streamticket.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	if nodes := stc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   streamticket.UserTable,
+			Columns: []string{streamticket.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// StreamTicketCreateBulk is the builder for creating many StreamTicket entities in bulk.
+type StreamTicketCreateBulk struct {
+	config
+	err      error
+	builders []*StreamTicketCreate
+}
+
+// Save creates the StreamTicket entities in the database.
+func (stcb *StreamTicketCreateBulk) Save(ctx context.Context) ([]*StreamTicket, error) {
+	if stcb.err != nil {
+		return nil, stcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(stcb.builders))
+	nodes := make([]*StreamTicket, len(stcb.builders))
+	mutators := make([]Mutator, len(stcb.builders))
+	for i := range stcb.builders {
+		func(i int, root context.Context) {
+			builder := stcb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*StreamTicketMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, stcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, stcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, stcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (stcb *StreamTicketCreateBulk) SaveX(ctx context.Context) []*StreamTicket {
+	v, err := stcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (stcb *StreamTicketCreateBulk) Exec(ctx context.Context) error {
+	_, err := stcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (stcb *StreamTicketCreateBulk) ExecX(ctx context.Context) {
+	if err := stcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
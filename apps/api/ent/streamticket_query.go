@@ -0,0 +1,644 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/predicate"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/streamticket"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/user"
+)
+
+// StreamTicketQuery is the builder for querying StreamTicket entities.
+type StreamTicketQuery struct {
+	config
+	ctx        *QueryContext
+	order      []streamticket.OrderOption
+	inters     []Interceptor
+	predicates []predicate.StreamTicket
+	withUser   *UserQuery
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the StreamTicketQuery builder.
+func (stq *StreamTicketQuery) Where(ps ...predicate.StreamTicket) *StreamTicketQuery {
+	stq.predicates = append(stq.predicates, ps...)
+	return stq
+}
+
+// Limit the number of records to be returned by this query.
+func (stq *StreamTicketQuery) Limit(limit int) *StreamTicketQuery {
+	stq.ctx.Limit = &limit
+	return stq
+}
+
+// Offset to start from.
+func (stq *StreamTicketQuery) Offset(offset int) *StreamTicketQuery {
+	stq.ctx.Offset = &offset
+	return stq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (stq *StreamTicketQuery) Unique(unique bool) *StreamTicketQuery {
+	stq.ctx.Unique = &unique
+	return stq
+}
+
+// Order specifies how the records should be ordered.
+func (stq *StreamTicketQuery) Order(o ...streamticket.OrderOption) *StreamTicketQuery {
+	stq.order = append(stq.order, o...)
+	return stq
+}
+
+// QueryUser chains the current query on the "user" edge.
+func (stq *StreamTicketQuery) QueryUser() *UserQuery {
+	query := (&UserClient{config: stq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := stq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := stq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(streamticket.Table, streamticket.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, streamticket.UserTable, streamticket.UserColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(stq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first StreamTicket entity from the query.
+// Returns a *NotFoundError when no StreamTicket was found.
+func (stq *StreamTicketQuery) First(ctx context.Context) (*StreamTicket, error) {
+	nodes, err := stq.Limit(1).All(setContextOp(ctx, stq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{streamticket.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (stq *StreamTicketQuery) FirstX(ctx context.Context) *StreamTicket {
+	node, err := stq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first StreamTicket ID from the query.
+// Returns a *NotFoundError when no StreamTicket ID was found.
+func (stq *StreamTicketQuery) FirstID(ctx context.Context) (id int64, err error) {
+	var ids []int64
+	if ids, err = stq.Limit(1).IDs(setContextOp(ctx, stq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{streamticket.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (stq *StreamTicketQuery) FirstIDX(ctx context.Context) int64 {
+	id, err := stq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single StreamTicket entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one StreamTicket entity is found.
+// Returns a *NotFoundError when no StreamTicket entities are found.
+func (stq *StreamTicketQuery) Only(ctx context.Context) (*StreamTicket, error) {
+	nodes, err := stq.Limit(2).All(setContextOp(ctx, stq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{streamticket.Label}
+	default:
+		return nil, &NotSingularError{streamticket.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (stq *StreamTicketQuery) OnlyX(ctx context.Context) *StreamTicket {
+	node, err := stq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only StreamTicket ID in the query.
+// Returns a *NotSingularError when more than one StreamTicket ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (stq *StreamTicketQuery) OnlyID(ctx context.Context) (id int64, err error) {
+	var ids []int64
+	if ids, err = stq.Limit(2).IDs(setContextOp(ctx, stq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{streamticket.Label}
+	default:
+		err = &NotSingularError{streamticket.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (stq *StreamTicketQuery) OnlyIDX(ctx context.Context) int64 {
+	id, err := stq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of StreamTickets.
+func (stq *StreamTicketQuery) All(ctx context.Context) ([]*StreamTicket, error) {
+	ctx = setContextOp(ctx, stq.ctx, ent.OpQueryAll)
+	if err := stq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*StreamTicket, *StreamTicketQuery]()
+	return withInterceptors[[]*StreamTicket](ctx, stq, qr, stq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (stq *StreamTicketQuery) AllX(ctx context.Context) []*StreamTicket {
+	nodes, err := stq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of StreamTicket IDs.
+func (stq *StreamTicketQuery) IDs(ctx context.Context) (ids []int64, err error) {
+	if stq.ctx.Unique == nil && stq.path != nil {
+		stq.Unique(true)
+	}
+	ctx = setContextOp(ctx, stq.ctx, ent.OpQueryIDs)
+	if err = stq.Select(streamticket.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (stq *StreamTicketQuery) IDsX(ctx context.Context) []int64 {
+	ids, err := stq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (stq *StreamTicketQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, stq.ctx, ent.OpQueryCount)
+	if err := stq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, stq, querierCount[*StreamTicketQuery](), stq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (stq *StreamTicketQuery) CountX(ctx context.Context) int {
+	count, err := stq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (stq *StreamTicketQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, stq.ctx, ent.OpQueryExist)
+	switch _, err := stq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (stq *StreamTicketQuery) ExistX(ctx context.Context) bool {
+	exist, err := stq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the StreamTicketQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (stq *StreamTicketQuery) Clone() *StreamTicketQuery {
+	if stq == nil {
+		return nil
+	}
+	return &StreamTicketQuery{
+		config:     stq.config,
+		ctx:        stq.ctx.Clone(),
+		order:      append([]streamticket.OrderOption{}, stq.order...),
+		inters:     append([]Interceptor{}, stq.inters...),
+		predicates: append([]predicate.StreamTicket{}, stq.predicates...),
+		withUser:   stq.withUser.Clone(),
+		// clone intermediate query.
+		sql:  stq.sql.Clone(),
+		path: stq.path,
+	}
+}
+
+// WithUser tells the query-builder to eager-load the nodes that are connected to
+// the "user" edge. The optional arguments are used to configure the query builder of the edge.
+func (stq *StreamTicketQuery) WithUser(opts ...func(*UserQuery)) *StreamTicketQuery {
+	query := (&UserClient{config: stq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	stq.withUser = query
+	return stq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		TokenHash []byte `json:"token_hash,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.StreamTicket.Query().
+//		GroupBy(streamticket.FieldTokenHash).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (stq *StreamTicketQuery) GroupBy(field string, fields ...string) *StreamTicketGroupBy {
+	stq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &StreamTicketGroupBy{build: stq}
+	grbuild.flds = &stq.ctx.Fields
+	grbuild.label = streamticket.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		TokenHash []byte `json:"token_hash,omitempty"`
+//	}
+//
+//	client.StreamTicket.Query().
+//		Select(streamticket.FieldTokenHash).
+//		Scan(ctx, &v)
+func (stq *StreamTicketQuery) Select(fields ...string) *StreamTicketSelect {
+	stq.ctx.Fields = append(stq.ctx.Fields, fields...)
+	sbuild := &StreamTicketSelect{StreamTicketQuery: stq}
+	sbuild.label = streamticket.Label
+	sbuild.flds, sbuild.scan = &stq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a StreamTicketSelect configured with the given aggregations.
+func (stq *StreamTicketQuery) Aggregate(fns ...AggregateFunc) *StreamTicketSelect {
+	return stq.Select().Aggregate(fns...)
+}
+
+func (stq *StreamTicketQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range stq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, stq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range stq.ctx.Fields {
+		if !streamticket.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if stq.path != nil {
+		prev, err := stq.path(ctx)
+		if err != nil {
+			return err
+		}
+		stq.sql = prev
+	}
+	return nil
+}
+
+func (stq *StreamTicketQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*StreamTicket, error) {
+	var (
+		nodes       = []*StreamTicket{}
+		_spec       = stq.querySpec()
+		loadedTypes = [1]bool{
+			stq.withUser != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*StreamTicket).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &StreamTicket{config: stq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(stq.modifiers) > 0 {
+		_spec.Modifiers = stq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, stq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := stq.withUser; query != nil {
+		if err := stq.loadUser(ctx, query, nodes, nil,
+			func(n *StreamTicket, e *User) { n.Edges.User = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (stq *StreamTicketQuery) loadUser(ctx context.Context, query *UserQuery, nodes []*StreamTicket, init func(*StreamTicket), assign func(*StreamTicket, *User)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*StreamTicket)
+	for i := range nodes {
+		fk := nodes[i].UserID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "user_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (stq *StreamTicketQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := stq.querySpec()
+	if len(stq.modifiers) > 0 {
+		_spec.Modifiers = stq.modifiers
+	}
+	_spec.Node.Columns = stq.ctx.Fields
+	if len(stq.ctx.Fields) > 0 {
+		_spec.Unique = stq.ctx.Unique != nil && *stq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, stq.driver, _spec)
+}
+
+func (stq *StreamTicketQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(streamticket.Table, streamticket.Columns, sqlgraph.NewFieldSpec(streamticket.FieldID, field.TypeInt64))
+	_spec.From = stq.sql
+	if unique := stq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if stq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := stq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, streamticket.FieldID)
+		for i := range fields {
+			if fields[i] != streamticket.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if stq.withUser != nil {
+			_spec.Node.AddColumnOnce(streamticket.FieldUserID)
+		}
+	}
+	if ps := stq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := stq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := stq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := stq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (stq *StreamTicketQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(stq.driver.Dialect())
+	t1 := builder.Table(streamticket.Table)
+	columns := stq.ctx.Fields
+	if len(columns) == 0 {
+		columns = streamticket.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if stq.sql != nil {
+		selector = stq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if stq.ctx.Unique != nil && *stq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range stq.modifiers {
+		m(selector)
+	}
+	for _, p := range stq.predicates {
+		p(selector)
+	}
+	for _, p := range stq.order {
+		p(selector)
+	}
+	if offset := stq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := stq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (stq *StreamTicketQuery) ForUpdate(opts ...sql.LockOption) *StreamTicketQuery {
+	if stq.driver.Dialect() == dialect.Postgres {
+		stq.Unique(false)
+	}
+	stq.modifiers = append(stq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return stq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (stq *StreamTicketQuery) ForShare(opts ...sql.LockOption) *StreamTicketQuery {
+	if stq.driver.Dialect() == dialect.Postgres {
+		stq.Unique(false)
+	}
+	stq.modifiers = append(stq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return stq
+}
+
+// StreamTicketGroupBy is the group-by builder for StreamTicket entities.
+type StreamTicketGroupBy struct {
+	selector
+	build *StreamTicketQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (stgb *StreamTicketGroupBy) Aggregate(fns ...AggregateFunc) *StreamTicketGroupBy {
+	stgb.fns = append(stgb.fns, fns...)
+	return stgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (stgb *StreamTicketGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, stgb.build.ctx, ent.OpQueryGroupBy)
+	if err := stgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*StreamTicketQuery, *StreamTicketGroupBy](ctx, stgb.build, stgb, stgb.build.inters, v)
+}
+
+func (stgb *StreamTicketGroupBy) sqlScan(ctx context.Context, root *StreamTicketQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(stgb.fns))
+	for _, fn := range stgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*stgb.flds)+len(stgb.fns))
+		for _, f := range *stgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*stgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := stgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// StreamTicketSelect is the builder for selecting fields of StreamTicket entities.
+type StreamTicketSelect struct {
+	*StreamTicketQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (sts *StreamTicketSelect) Aggregate(fns ...AggregateFunc) *StreamTicketSelect {
+	sts.fns = append(sts.fns, fns...)
+	return sts
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (sts *StreamTicketSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, sts.ctx, ent.OpQuerySelect)
+	if err := sts.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*StreamTicketQuery, *StreamTicketSelect](ctx, sts.StreamTicketQuery, sts, sts.inters, v)
+}
+
+func (sts *StreamTicketSelect) sqlScan(ctx context.Context, root *StreamTicketQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(sts.fns))
+	for _, fn := range sts.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*sts.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := sts.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,209 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/predicate"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/streamticket"
+)
+
+// StreamTicketUpdate is the builder for updating StreamTicket entities.
+type StreamTicketUpdate struct {
+	config
+	hooks    []Hook
+	mutation *StreamTicketMutation
+}
+
+// Where appends a list predicates to the StreamTicketUpdate builder.
+func (stu *StreamTicketUpdate) Where(ps ...predicate.StreamTicket) *StreamTicketUpdate {
+	stu.mutation.Where(ps...)
+	return stu
+}
+
+// Mutation returns the StreamTicketMutation object of the builder.
+func (stu *StreamTicketUpdate) Mutation() *StreamTicketMutation {
+	return stu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (stu *StreamTicketUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, stu.sqlSave, stu.mutation, stu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (stu *StreamTicketUpdate) SaveX(ctx context.Context) int {
+	affected, err := stu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (stu *StreamTicketUpdate) Exec(ctx context.Context) error {
+	_, err := stu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (stu *StreamTicketUpdate) ExecX(ctx context.Context) {
+	if err := stu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (stu *StreamTicketUpdate) check() error {
+	if stu.mutation.UserCleared() && len(stu.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "StreamTicket.user"`)
+	}
+	return nil
+}
+
+func (stu *StreamTicketUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := stu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(streamticket.Table, streamticket.Columns, sqlgraph.NewFieldSpec(streamticket.FieldID, field.TypeInt64))
+	if ps := stu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if stu.mutation.SessionIDCleared() {
+		_spec.ClearField(streamticket.FieldSessionID, field.TypeString)
+	}
+	if stu.mutation.TokenExpiresAtCleared() {
+		_spec.ClearField(streamticket.FieldTokenExpiresAt, field.TypeTime)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, stu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{streamticket.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	stu.mutation.done = true
+	return n, nil
+}
+
+// StreamTicketUpdateOne is the builder for updating a single StreamTicket entity.
+type StreamTicketUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *StreamTicketMutation
+}
+
+// Mutation returns the StreamTicketMutation object of the builder.
+func (stuo *StreamTicketUpdateOne) Mutation() *StreamTicketMutation {
+	return stuo.mutation
+}
+
+// Where appends a list predicates to the StreamTicketUpdate builder.
+func (stuo *StreamTicketUpdateOne) Where(ps ...predicate.StreamTicket) *StreamTicketUpdateOne {
+	stuo.mutation.Where(ps...)
+	return stuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (stuo *StreamTicketUpdateOne) Select(field string, fields ...string) *StreamTicketUpdateOne {
+	stuo.fields = append([]string{field}, fields...)
+	return stuo
+}
+
+// Save executes the query and returns the updated StreamTicket entity.
+func (stuo *StreamTicketUpdateOne) Save(ctx context.Context) (*StreamTicket, error) {
+	return withHooks(ctx, stuo.sqlSave, stuo.mutation, stuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (stuo *StreamTicketUpdateOne) SaveX(ctx context.Context) *StreamTicket {
+	node, err := stuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (stuo *StreamTicketUpdateOne) Exec(ctx context.Context) error {
+	_, err := stuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (stuo *StreamTicketUpdateOne) ExecX(ctx context.Context) {
+	if err := stuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (stuo *StreamTicketUpdateOne) check() error {
+	if stuo.mutation.UserCleared() && len(stuo.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "StreamTicket.user"`)
+	}
+	return nil
+}
+
+func (stuo *StreamTicketUpdateOne) sqlSave(ctx context.Context) (_node *StreamTicket, err error) {
+	if err := stuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(streamticket.Table, streamticket.Columns, sqlgraph.NewFieldSpec(streamticket.FieldID, field.TypeInt64))
+	id, ok := stuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "StreamTicket.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := stuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, streamticket.FieldID)
+		for _, f := range fields {
+			if !streamticket.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != streamticket.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := stuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if stuo.mutation.SessionIDCleared() {
+		_spec.ClearField(streamticket.FieldSessionID, field.TypeString)
+	}
+	if stuo.mutation.TokenExpiresAtCleared() {
+		_spec.ClearField(streamticket.FieldTokenExpiresAt, field.TypeTime)
+	}
+	_node = &StreamTicket{config: stuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, stuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{streamticket.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	stuo.mutation.done = true
+	return _node, nil
+}
@@ -14,10 +14,30 @@ type Tx struct {
 	config
 	// ChatRoom is the client for interacting with the ChatRoom builders.
 	ChatRoom *ChatRoomClient
+	// Invite is the client for interacting with the Invite builders.
+	Invite *InviteClient
+	// JoinRequest is the client for interacting with the JoinRequest builders.
+	JoinRequest *JoinRequestClient
 	// Message is the client for interacting with the Message builders.
 	Message *MessageClient
+	// MessageReaction is the client for interacting with the MessageReaction builders.
+	MessageReaction *MessageReactionClient
+	// MessageRead is the client for interacting with the MessageRead builders.
+	MessageRead *MessageReadClient
+	// MessageRevision is the client for interacting with the MessageRevision builders.
+	MessageRevision *MessageRevisionClient
+	// RevokedToken is the client for interacting with the RevokedToken builders.
+	RevokedToken *RevokedTokenClient
 	// RoomMember is the client for interacting with the RoomMember builders.
 	RoomMember *RoomMemberClient
+	// RotatedRefreshToken is the client for interacting with the RotatedRefreshToken builders.
+	RotatedRefreshToken *RotatedRefreshTokenClient
+	// SecurityEvent is the client for interacting with the SecurityEvent builders.
+	SecurityEvent *SecurityEventClient
+	// Session is the client for interacting with the Session builders.
+	Session *SessionClient
+	// StreamTicket is the client for interacting with the StreamTicket builders.
+	StreamTicket *StreamTicketClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
 
@@ -152,8 +172,18 @@ func (tx *Tx) Client() *Client {
 
 func (tx *Tx) init() {
 	tx.ChatRoom = NewChatRoomClient(tx.config)
+	tx.Invite = NewInviteClient(tx.config)
+	tx.JoinRequest = NewJoinRequestClient(tx.config)
 	tx.Message = NewMessageClient(tx.config)
+	tx.MessageReaction = NewMessageReactionClient(tx.config)
+	tx.MessageRead = NewMessageReadClient(tx.config)
+	tx.MessageRevision = NewMessageRevisionClient(tx.config)
+	tx.RevokedToken = NewRevokedTokenClient(tx.config)
 	tx.RoomMember = NewRoomMemberClient(tx.config)
+	tx.RotatedRefreshToken = NewRotatedRefreshTokenClient(tx.config)
+	tx.SecurityEvent = NewSecurityEventClient(tx.config)
+	tx.Session = NewSessionClient(tx.config)
+	tx.StreamTicket = NewStreamTicketClient(tx.config)
 	tx.User = NewUserClient(tx.config)
 }
 
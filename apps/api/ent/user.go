@@ -32,10 +32,10 @@ type User struct {
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// 更新日時
 	UpdatedAt time.Time `json:"updated_at,omitempty"`
-	// リフレッシュトークンハッシュ
-	RefreshTokenHash *[]byte `json:"-"`
-	// リフレッシュトークン有効期限
-	RefreshTokenExpiresAt *time.Time `json:"refresh_token_expires_at,omitempty"`
+	// 最終オンライン日時（リアルタイム接続のハートビートで更新）
+	LastSeenAt *time.Time `json:"last_seen_at,omitempty"`
+	// アクセストークンのバージョン（更新すると発行済みのトークンが全て無効になる）
+	TokenVersion int `json:"token_version,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the UserQuery when eager-loading is set.
 	Edges        UserEdges `json:"edges"`
@@ -48,9 +48,27 @@ type UserEdges struct {
 	RoomMembers []*RoomMember `json:"room_members,omitempty"`
 	// Messages holds the value of the messages edge.
 	Messages []*Message `json:"messages,omitempty"`
+	// MessageReads holds the value of the message_reads edge.
+	MessageReads []*MessageRead `json:"message_reads,omitempty"`
+	// MessageReactions holds the value of the message_reactions edge.
+	MessageReactions []*MessageReaction `json:"message_reactions,omitempty"`
+	// MessageRevisions holds the value of the message_revisions edge.
+	MessageRevisions []*MessageRevision `json:"message_revisions,omitempty"`
+	// Invites holds the value of the invites edge.
+	Invites []*Invite `json:"invites,omitempty"`
+	// JoinRequests holds the value of the join_requests edge.
+	JoinRequests []*JoinRequest `json:"join_requests,omitempty"`
+	// Sessions holds the value of the sessions edge.
+	Sessions []*Session `json:"sessions,omitempty"`
+	// SecurityEvents holds the value of the security_events edge.
+	SecurityEvents []*SecurityEvent `json:"security_events,omitempty"`
+	// RevokedTokens holds the value of the revoked_tokens edge.
+	RevokedTokens []*RevokedToken `json:"revoked_tokens,omitempty"`
+	// StreamTickets holds the value of the stream_tickets edge.
+	StreamTickets []*StreamTicket `json:"stream_tickets,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [11]bool
 }
 
 // RoomMembersOrErr returns the RoomMembers value or an error if the edge
@@ -71,16 +89,99 @@ func (e UserEdges) MessagesOrErr() ([]*Message, error) {
 	return nil, &NotLoadedError{edge: "messages"}
 }
 
+// MessageReadsOrErr returns the MessageReads value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) MessageReadsOrErr() ([]*MessageRead, error) {
+	if e.loadedTypes[2] {
+		return e.MessageReads, nil
+	}
+	return nil, &NotLoadedError{edge: "message_reads"}
+}
+
+// MessageReactionsOrErr returns the MessageReactions value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) MessageReactionsOrErr() ([]*MessageReaction, error) {
+	if e.loadedTypes[3] {
+		return e.MessageReactions, nil
+	}
+	return nil, &NotLoadedError{edge: "message_reactions"}
+}
+
+// MessageRevisionsOrErr returns the MessageRevisions value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) MessageRevisionsOrErr() ([]*MessageRevision, error) {
+	if e.loadedTypes[4] {
+		return e.MessageRevisions, nil
+	}
+	return nil, &NotLoadedError{edge: "message_revisions"}
+}
+
+// InvitesOrErr returns the Invites value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) InvitesOrErr() ([]*Invite, error) {
+	if e.loadedTypes[5] {
+		return e.Invites, nil
+	}
+	return nil, &NotLoadedError{edge: "invites"}
+}
+
+// JoinRequestsOrErr returns the JoinRequests value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) JoinRequestsOrErr() ([]*JoinRequest, error) {
+	if e.loadedTypes[6] {
+		return e.JoinRequests, nil
+	}
+	return nil, &NotLoadedError{edge: "join_requests"}
+}
+
+// SessionsOrErr returns the Sessions value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) SessionsOrErr() ([]*Session, error) {
+	if e.loadedTypes[7] {
+		return e.Sessions, nil
+	}
+	return nil, &NotLoadedError{edge: "sessions"}
+}
+
+// SecurityEventsOrErr returns the SecurityEvents value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) SecurityEventsOrErr() ([]*SecurityEvent, error) {
+	if e.loadedTypes[8] {
+		return e.SecurityEvents, nil
+	}
+	return nil, &NotLoadedError{edge: "security_events"}
+}
+
+// RevokedTokensOrErr returns the RevokedTokens value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) RevokedTokensOrErr() ([]*RevokedToken, error) {
+	if e.loadedTypes[9] {
+		return e.RevokedTokens, nil
+	}
+	return nil, &NotLoadedError{edge: "revoked_tokens"}
+}
+
+// StreamTicketsOrErr returns the StreamTickets value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) StreamTicketsOrErr() ([]*StreamTicket, error) {
+	if e.loadedTypes[10] {
+		return e.StreamTickets, nil
+	}
+	return nil, &NotLoadedError{edge: "stream_tickets"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*User) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case user.FieldPasswordHash, user.FieldRefreshTokenHash:
+		case user.FieldPasswordHash:
 			values[i] = new([]byte)
+		case user.FieldTokenVersion:
+			values[i] = new(sql.NullInt64)
 		case user.FieldName, user.FieldEmail, user.FieldProfileImageURL, user.FieldBio:
 			values[i] = new(sql.NullString)
-		case user.FieldCreatedAt, user.FieldUpdatedAt, user.FieldRefreshTokenExpiresAt:
+		case user.FieldCreatedAt, user.FieldUpdatedAt, user.FieldLastSeenAt:
 			values[i] = new(sql.NullTime)
 		case user.FieldID:
 			values[i] = new(uuid.UUID)
@@ -149,18 +250,18 @@ func (u *User) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				u.UpdatedAt = value.Time
 			}
-		case user.FieldRefreshTokenHash:
-			if value, ok := values[i].(*[]byte); !ok {
-				return fmt.Errorf("unexpected type %T for field refresh_token_hash", values[i])
-			} else if value != nil {
-				u.RefreshTokenHash = value
-			}
-		case user.FieldRefreshTokenExpiresAt:
+		case user.FieldLastSeenAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
-				return fmt.Errorf("unexpected type %T for field refresh_token_expires_at", values[i])
+				return fmt.Errorf("unexpected type %T for field last_seen_at", values[i])
+			} else if value.Valid {
+				u.LastSeenAt = new(time.Time)
+				*u.LastSeenAt = value.Time
+			}
+		case user.FieldTokenVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field token_version", values[i])
 			} else if value.Valid {
-				u.RefreshTokenExpiresAt = new(time.Time)
-				*u.RefreshTokenExpiresAt = value.Time
+				u.TokenVersion = int(value.Int64)
 			}
 		default:
 			u.selectValues.Set(columns[i], values[i])
@@ -185,6 +286,51 @@ func (u *User) QueryMessages() *MessageQuery {
 	return NewUserClient(u.config).QueryMessages(u)
 }
 
+// QueryMessageReads queries the "message_reads" edge of the User entity.
+func (u *User) QueryMessageReads() *MessageReadQuery {
+	return NewUserClient(u.config).QueryMessageReads(u)
+}
+
+// QueryMessageReactions queries the "message_reactions" edge of the User entity.
+func (u *User) QueryMessageReactions() *MessageReactionQuery {
+	return NewUserClient(u.config).QueryMessageReactions(u)
+}
+
+// QueryMessageRevisions queries the "message_revisions" edge of the User entity.
+func (u *User) QueryMessageRevisions() *MessageRevisionQuery {
+	return NewUserClient(u.config).QueryMessageRevisions(u)
+}
+
+// QueryInvites queries the "invites" edge of the User entity.
+func (u *User) QueryInvites() *InviteQuery {
+	return NewUserClient(u.config).QueryInvites(u)
+}
+
+// QueryJoinRequests queries the "join_requests" edge of the User entity.
+func (u *User) QueryJoinRequests() *JoinRequestQuery {
+	return NewUserClient(u.config).QueryJoinRequests(u)
+}
+
+// QuerySessions queries the "sessions" edge of the User entity.
+func (u *User) QuerySessions() *SessionQuery {
+	return NewUserClient(u.config).QuerySessions(u)
+}
+
+// QuerySecurityEvents queries the "security_events" edge of the User entity.
+func (u *User) QuerySecurityEvents() *SecurityEventQuery {
+	return NewUserClient(u.config).QuerySecurityEvents(u)
+}
+
+// QueryRevokedTokens queries the "revoked_tokens" edge of the User entity.
+func (u *User) QueryRevokedTokens() *RevokedTokenQuery {
+	return NewUserClient(u.config).QueryRevokedTokens(u)
+}
+
+// QueryStreamTickets queries the "stream_tickets" edge of the User entity.
+func (u *User) QueryStreamTickets() *StreamTicketQuery {
+	return NewUserClient(u.config).QueryStreamTickets(u)
+}
+
 // Update returns a builder for updating this User.
 // Note that you need to call User.Unwrap() before calling this method if this User
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -232,12 +378,13 @@ func (u *User) String() string {
 	builder.WriteString("updated_at=")
 	builder.WriteString(u.UpdatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
-	builder.WriteString("refresh_token_hash=<sensitive>")
-	builder.WriteString(", ")
-	if v := u.RefreshTokenExpiresAt; v != nil {
-		builder.WriteString("refresh_token_expires_at=")
+	if v := u.LastSeenAt; v != nil {
+		builder.WriteString("last_seen_at=")
 		builder.WriteString(v.Format(time.ANSIC))
 	}
+	builder.WriteString(", ")
+	builder.WriteString("token_version=")
+	builder.WriteString(fmt.Sprintf("%v", u.TokenVersion))
 	builder.WriteByte(')')
 	return builder.String()
 }
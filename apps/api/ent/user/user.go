@@ -30,14 +30,32 @@ const (
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
 	FieldUpdatedAt = "updated_at"
-	// FieldRefreshTokenHash holds the string denoting the refresh_token_hash field in the database.
-	FieldRefreshTokenHash = "refresh_token_hash"
-	// FieldRefreshTokenExpiresAt holds the string denoting the refresh_token_expires_at field in the database.
-	FieldRefreshTokenExpiresAt = "refresh_token_expires_at"
+	// FieldLastSeenAt holds the string denoting the last_seen_at field in the database.
+	FieldLastSeenAt = "last_seen_at"
+	// FieldTokenVersion holds the string denoting the token_version field in the database.
+	FieldTokenVersion = "token_version"
 	// EdgeRoomMembers holds the string denoting the room_members edge name in mutations.
 	EdgeRoomMembers = "room_members"
 	// EdgeMessages holds the string denoting the messages edge name in mutations.
 	EdgeMessages = "messages"
+	// EdgeMessageReads holds the string denoting the message_reads edge name in mutations.
+	EdgeMessageReads = "message_reads"
+	// EdgeMessageReactions holds the string denoting the message_reactions edge name in mutations.
+	EdgeMessageReactions = "message_reactions"
+	// EdgeMessageRevisions holds the string denoting the message_revisions edge name in mutations.
+	EdgeMessageRevisions = "message_revisions"
+	// EdgeInvites holds the string denoting the invites edge name in mutations.
+	EdgeInvites = "invites"
+	// EdgeJoinRequests holds the string denoting the join_requests edge name in mutations.
+	EdgeJoinRequests = "join_requests"
+	// EdgeSessions holds the string denoting the sessions edge name in mutations.
+	EdgeSessions = "sessions"
+	// EdgeSecurityEvents holds the string denoting the security_events edge name in mutations.
+	EdgeSecurityEvents = "security_events"
+	// EdgeRevokedTokens holds the string denoting the revoked_tokens edge name in mutations.
+	EdgeRevokedTokens = "revoked_tokens"
+	// EdgeStreamTickets holds the string denoting the stream_tickets edge name in mutations.
+	EdgeStreamTickets = "stream_tickets"
 	// Table holds the table name of the user in the database.
 	Table = "users"
 	// RoomMembersTable is the table that holds the room_members relation/edge.
@@ -54,6 +72,69 @@ const (
 	MessagesInverseTable = "messages"
 	// MessagesColumn is the table column denoting the messages relation/edge.
 	MessagesColumn = "user_id"
+	// MessageReadsTable is the table that holds the message_reads relation/edge.
+	MessageReadsTable = "message_reads"
+	// MessageReadsInverseTable is the table name for the MessageRead entity.
+	// It exists in this package in order to avoid circular dependency with the "messageread" package.
+	MessageReadsInverseTable = "message_reads"
+	// MessageReadsColumn is the table column denoting the message_reads relation/edge.
+	MessageReadsColumn = "user_id"
+	// MessageReactionsTable is the table that holds the message_reactions relation/edge.
+	MessageReactionsTable = "message_reactions"
+	// MessageReactionsInverseTable is the table name for the MessageReaction entity.
+	// It exists in this package in order to avoid circular dependency with the "messagereaction" package.
+	MessageReactionsInverseTable = "message_reactions"
+	// MessageReactionsColumn is the table column denoting the message_reactions relation/edge.
+	MessageReactionsColumn = "user_id"
+	// MessageRevisionsTable is the table that holds the message_revisions relation/edge.
+	MessageRevisionsTable = "message_revisions"
+	// MessageRevisionsInverseTable is the table name for the MessageRevision entity.
+	// It exists in this package in order to avoid circular dependency with the "messagerevision" package.
+	MessageRevisionsInverseTable = "message_revisions"
+	// MessageRevisionsColumn is the table column denoting the message_revisions relation/edge.
+	MessageRevisionsColumn = "editor_id"
+	// InvitesTable is the table that holds the invites relation/edge.
+	InvitesTable = "invites"
+	// InvitesInverseTable is the table name for the Invite entity.
+	// It exists in this package in order to avoid circular dependency with the "invite" package.
+	InvitesInverseTable = "invites"
+	// InvitesColumn is the table column denoting the invites relation/edge.
+	InvitesColumn = "creator_id"
+	// JoinRequestsTable is the table that holds the join_requests relation/edge.
+	JoinRequestsTable = "join_requests"
+	// JoinRequestsInverseTable is the table name for the JoinRequest entity.
+	// It exists in this package in order to avoid circular dependency with the "joinrequest" package.
+	JoinRequestsInverseTable = "join_requests"
+	// JoinRequestsColumn is the table column denoting the join_requests relation/edge.
+	JoinRequestsColumn = "user_id"
+	// SessionsTable is the table that holds the sessions relation/edge.
+	SessionsTable = "sessions"
+	// SessionsInverseTable is the table name for the Session entity.
+	// It exists in this package in order to avoid circular dependency with the "session" package.
+	SessionsInverseTable = "sessions"
+	// SessionsColumn is the table column denoting the sessions relation/edge.
+	SessionsColumn = "user_id"
+	// SecurityEventsTable is the table that holds the security_events relation/edge.
+	SecurityEventsTable = "security_events"
+	// SecurityEventsInverseTable is the table name for the SecurityEvent entity.
+	// It exists in this package in order to avoid circular dependency with the "securityevent" package.
+	SecurityEventsInverseTable = "security_events"
+	// SecurityEventsColumn is the table column denoting the security_events relation/edge.
+	SecurityEventsColumn = "user_id"
+	// RevokedTokensTable is the table that holds the revoked_tokens relation/edge.
+	RevokedTokensTable = "revoked_tokens"
+	// RevokedTokensInverseTable is the table name for the RevokedToken entity.
+	// It exists in this package in order to avoid circular dependency with the "revokedtoken" package.
+	RevokedTokensInverseTable = "revoked_tokens"
+	// RevokedTokensColumn is the table column denoting the revoked_tokens relation/edge.
+	RevokedTokensColumn = "user_id"
+	// StreamTicketsTable is the table that holds the stream_tickets relation/edge.
+	StreamTicketsTable = "stream_tickets"
+	// StreamTicketsInverseTable is the table name for the StreamTicket entity.
+	// It exists in this package in order to avoid circular dependency with the "streamticket" package.
+	StreamTicketsInverseTable = "stream_tickets"
+	// StreamTicketsColumn is the table column denoting the stream_tickets relation/edge.
+	StreamTicketsColumn = "user_id"
 )
 
 // Columns holds all SQL columns for user fields.
@@ -66,8 +147,8 @@ var Columns = []string{
 	FieldBio,
 	FieldCreatedAt,
 	FieldUpdatedAt,
-	FieldRefreshTokenHash,
-	FieldRefreshTokenExpiresAt,
+	FieldLastSeenAt,
+	FieldTokenVersion,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -86,7 +167,7 @@ func ValidColumn(column string) bool {
 //
 //	import _ "github.com/hideaki1979/cc-chat-app/apps/api/ent/runtime"
 var (
-	Hooks [2]ent.Hook
+	Hooks [1]ent.Hook
 	// NameValidator is a validator for the "name" field. It is called by the builders before save.
 	NameValidator func(string) error
 	// EmailValidator is a validator for the "email" field. It is called by the builders before save.
@@ -99,6 +180,10 @@ var (
 	DefaultUpdatedAt func() time.Time
 	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
 	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultTokenVersion holds the default value on creation for the "token_version" field.
+	DefaultTokenVersion int
+	// TokenVersionValidator is a validator for the "token_version" field. It is called by the builders before save.
+	TokenVersionValidator func(int) error
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() uuid.UUID
 )
@@ -141,9 +226,14 @@ func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
 }
 
-// ByRefreshTokenExpiresAt orders the results by the refresh_token_expires_at field.
-func ByRefreshTokenExpiresAt(opts ...sql.OrderTermOption) OrderOption {
-	return sql.OrderByField(FieldRefreshTokenExpiresAt, opts...).ToFunc()
+// ByLastSeenAt orders the results by the last_seen_at field.
+func ByLastSeenAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastSeenAt, opts...).ToFunc()
+}
+
+// ByTokenVersion orders the results by the token_version field.
+func ByTokenVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTokenVersion, opts...).ToFunc()
 }
 
 // ByRoomMembersCount orders the results by room_members count.
@@ -173,6 +263,132 @@ func ByMessages(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newMessagesStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByMessageReadsCount orders the results by message_reads count.
+func ByMessageReadsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newMessageReadsStep(), opts...)
+	}
+}
+
+// ByMessageReads orders the results by message_reads terms.
+func ByMessageReads(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMessageReadsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByMessageReactionsCount orders the results by message_reactions count.
+func ByMessageReactionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newMessageReactionsStep(), opts...)
+	}
+}
+
+// ByMessageReactions orders the results by message_reactions terms.
+func ByMessageReactions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMessageReactionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByMessageRevisionsCount orders the results by message_revisions count.
+func ByMessageRevisionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newMessageRevisionsStep(), opts...)
+	}
+}
+
+// ByMessageRevisions orders the results by message_revisions terms.
+func ByMessageRevisions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMessageRevisionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByInvitesCount orders the results by invites count.
+func ByInvitesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newInvitesStep(), opts...)
+	}
+}
+
+// ByInvites orders the results by invites terms.
+func ByInvites(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newInvitesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByJoinRequestsCount orders the results by join_requests count.
+func ByJoinRequestsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newJoinRequestsStep(), opts...)
+	}
+}
+
+// ByJoinRequests orders the results by join_requests terms.
+func ByJoinRequests(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newJoinRequestsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// BySessionsCount orders the results by sessions count.
+func BySessionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSessionsStep(), opts...)
+	}
+}
+
+// BySessions orders the results by sessions terms.
+func BySessions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSessionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// BySecurityEventsCount orders the results by security_events count.
+func BySecurityEventsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSecurityEventsStep(), opts...)
+	}
+}
+
+// BySecurityEvents orders the results by security_events terms.
+func BySecurityEvents(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSecurityEventsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByRevokedTokensCount orders the results by revoked_tokens count.
+func ByRevokedTokensCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newRevokedTokensStep(), opts...)
+	}
+}
+
+// ByRevokedTokens orders the results by revoked_tokens terms.
+func ByRevokedTokens(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRevokedTokensStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByStreamTicketsCount orders the results by stream_tickets count.
+func ByStreamTicketsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newStreamTicketsStep(), opts...)
+	}
+}
+
+// ByStreamTickets orders the results by stream_tickets terms.
+func ByStreamTickets(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newStreamTicketsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newRoomMembersStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -187,3 +403,66 @@ func newMessagesStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, MessagesTable, MessagesColumn),
 	)
 }
+func newMessageReadsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(MessageReadsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, MessageReadsTable, MessageReadsColumn),
+	)
+}
+func newMessageReactionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(MessageReactionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, MessageReactionsTable, MessageReactionsColumn),
+	)
+}
+func newMessageRevisionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(MessageRevisionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, MessageRevisionsTable, MessageRevisionsColumn),
+	)
+}
+func newInvitesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(InvitesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, InvitesTable, InvitesColumn),
+	)
+}
+func newJoinRequestsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(JoinRequestsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, JoinRequestsTable, JoinRequestsColumn),
+	)
+}
+func newSessionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SessionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, SessionsTable, SessionsColumn),
+	)
+}
+func newSecurityEventsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SecurityEventsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, SecurityEventsTable, SecurityEventsColumn),
+	)
+}
+func newRevokedTokensStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RevokedTokensInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, RevokedTokensTable, RevokedTokensColumn),
+	)
+}
+func newStreamTicketsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(StreamTicketsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, StreamTicketsTable, StreamTicketsColumn),
+	)
+}
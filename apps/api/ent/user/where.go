@@ -91,14 +91,14 @@ func UpdatedAt(v time.Time) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldUpdatedAt, v))
 }
 
-// RefreshTokenHash applies equality check predicate on the "refresh_token_hash" field. It's identical to RefreshTokenHashEQ.
-func RefreshTokenHash(v []byte) predicate.User {
-	return predicate.User(sql.FieldEQ(FieldRefreshTokenHash, v))
+// LastSeenAt applies equality check predicate on the "last_seen_at" field. It's identical to LastSeenAtEQ.
+func LastSeenAt(v time.Time) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldLastSeenAt, v))
 }
 
-// RefreshTokenExpiresAt applies equality check predicate on the "refresh_token_expires_at" field. It's identical to RefreshTokenExpiresAtEQ.
-func RefreshTokenExpiresAt(v time.Time) predicate.User {
-	return predicate.User(sql.FieldEQ(FieldRefreshTokenExpiresAt, v))
+// TokenVersion applies equality check predicate on the "token_version" field. It's identical to TokenVersionEQ.
+func TokenVersion(v int) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldTokenVersion, v))
 }
 
 // NameEQ applies the EQ predicate on the "name" field.
@@ -501,104 +501,94 @@ func UpdatedAtLTE(v time.Time) predicate.User {
 	return predicate.User(sql.FieldLTE(FieldUpdatedAt, v))
 }
 
-// RefreshTokenHashEQ applies the EQ predicate on the "refresh_token_hash" field.
-func RefreshTokenHashEQ(v []byte) predicate.User {
-	return predicate.User(sql.FieldEQ(FieldRefreshTokenHash, v))
+// LastSeenAtEQ applies the EQ predicate on the "last_seen_at" field.
+func LastSeenAtEQ(v time.Time) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldLastSeenAt, v))
 }
 
-// RefreshTokenHashNEQ applies the NEQ predicate on the "refresh_token_hash" field.
-func RefreshTokenHashNEQ(v []byte) predicate.User {
-	return predicate.User(sql.FieldNEQ(FieldRefreshTokenHash, v))
+// LastSeenAtNEQ applies the NEQ predicate on the "last_seen_at" field.
+func LastSeenAtNEQ(v time.Time) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldLastSeenAt, v))
 }
 
-// RefreshTokenHashIn applies the In predicate on the "refresh_token_hash" field.
-func RefreshTokenHashIn(vs ...[]byte) predicate.User {
-	return predicate.User(sql.FieldIn(FieldRefreshTokenHash, vs...))
+// LastSeenAtIn applies the In predicate on the "last_seen_at" field.
+func LastSeenAtIn(vs ...time.Time) predicate.User {
+	return predicate.User(sql.FieldIn(FieldLastSeenAt, vs...))
 }
 
-// RefreshTokenHashNotIn applies the NotIn predicate on the "refresh_token_hash" field.
-func RefreshTokenHashNotIn(vs ...[]byte) predicate.User {
-	return predicate.User(sql.FieldNotIn(FieldRefreshTokenHash, vs...))
+// LastSeenAtNotIn applies the NotIn predicate on the "last_seen_at" field.
+func LastSeenAtNotIn(vs ...time.Time) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldLastSeenAt, vs...))
 }
 
-// RefreshTokenHashGT applies the GT predicate on the "refresh_token_hash" field.
-func RefreshTokenHashGT(v []byte) predicate.User {
-	return predicate.User(sql.FieldGT(FieldRefreshTokenHash, v))
+// LastSeenAtGT applies the GT predicate on the "last_seen_at" field.
+func LastSeenAtGT(v time.Time) predicate.User {
+	return predicate.User(sql.FieldGT(FieldLastSeenAt, v))
 }
 
-// RefreshTokenHashGTE applies the GTE predicate on the "refresh_token_hash" field.
-func RefreshTokenHashGTE(v []byte) predicate.User {
-	return predicate.User(sql.FieldGTE(FieldRefreshTokenHash, v))
+// LastSeenAtGTE applies the GTE predicate on the "last_seen_at" field.
+func LastSeenAtGTE(v time.Time) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldLastSeenAt, v))
 }
 
-// RefreshTokenHashLT applies the LT predicate on the "refresh_token_hash" field.
-func RefreshTokenHashLT(v []byte) predicate.User {
-	return predicate.User(sql.FieldLT(FieldRefreshTokenHash, v))
+// LastSeenAtLT applies the LT predicate on the "last_seen_at" field.
+func LastSeenAtLT(v time.Time) predicate.User {
+	return predicate.User(sql.FieldLT(FieldLastSeenAt, v))
 }
 
-// RefreshTokenHashLTE applies the LTE predicate on the "refresh_token_hash" field.
-func RefreshTokenHashLTE(v []byte) predicate.User {
-	return predicate.User(sql.FieldLTE(FieldRefreshTokenHash, v))
+// LastSeenAtLTE applies the LTE predicate on the "last_seen_at" field.
+func LastSeenAtLTE(v time.Time) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldLastSeenAt, v))
 }
 
-// RefreshTokenHashIsNil applies the IsNil predicate on the "refresh_token_hash" field.
-func RefreshTokenHashIsNil() predicate.User {
-	return predicate.User(sql.FieldIsNull(FieldRefreshTokenHash))
+// LastSeenAtIsNil applies the IsNil predicate on the "last_seen_at" field.
+func LastSeenAtIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldLastSeenAt))
 }
 
-// RefreshTokenHashNotNil applies the NotNil predicate on the "refresh_token_hash" field.
-func RefreshTokenHashNotNil() predicate.User {
-	return predicate.User(sql.FieldNotNull(FieldRefreshTokenHash))
+// LastSeenAtNotNil applies the NotNil predicate on the "last_seen_at" field.
+func LastSeenAtNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldLastSeenAt))
 }
 
-// RefreshTokenExpiresAtEQ applies the EQ predicate on the "refresh_token_expires_at" field.
-func RefreshTokenExpiresAtEQ(v time.Time) predicate.User {
-	return predicate.User(sql.FieldEQ(FieldRefreshTokenExpiresAt, v))
+// TokenVersionEQ applies the EQ predicate on the "token_version" field.
+func TokenVersionEQ(v int) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldTokenVersion, v))
 }
 
-// RefreshTokenExpiresAtNEQ applies the NEQ predicate on the "refresh_token_expires_at" field.
-func RefreshTokenExpiresAtNEQ(v time.Time) predicate.User {
-	return predicate.User(sql.FieldNEQ(FieldRefreshTokenExpiresAt, v))
+// TokenVersionNEQ applies the NEQ predicate on the "token_version" field.
+func TokenVersionNEQ(v int) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldTokenVersion, v))
 }
 
-// RefreshTokenExpiresAtIn applies the In predicate on the "refresh_token_expires_at" field.
-func RefreshTokenExpiresAtIn(vs ...time.Time) predicate.User {
-	return predicate.User(sql.FieldIn(FieldRefreshTokenExpiresAt, vs...))
+// TokenVersionIn applies the In predicate on the "token_version" field.
+func TokenVersionIn(vs ...int) predicate.User {
+	return predicate.User(sql.FieldIn(FieldTokenVersion, vs...))
 }
 
-// RefreshTokenExpiresAtNotIn applies the NotIn predicate on the "refresh_token_expires_at" field.
-func RefreshTokenExpiresAtNotIn(vs ...time.Time) predicate.User {
-	return predicate.User(sql.FieldNotIn(FieldRefreshTokenExpiresAt, vs...))
+// TokenVersionNotIn applies the NotIn predicate on the "token_version" field.
+func TokenVersionNotIn(vs ...int) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldTokenVersion, vs...))
 }
 
-// RefreshTokenExpiresAtGT applies the GT predicate on the "refresh_token_expires_at" field.
-func RefreshTokenExpiresAtGT(v time.Time) predicate.User {
-	return predicate.User(sql.FieldGT(FieldRefreshTokenExpiresAt, v))
+// TokenVersionGT applies the GT predicate on the "token_version" field.
+func TokenVersionGT(v int) predicate.User {
+	return predicate.User(sql.FieldGT(FieldTokenVersion, v))
 }
 
-// RefreshTokenExpiresAtGTE applies the GTE predicate on the "refresh_token_expires_at" field.
-func RefreshTokenExpiresAtGTE(v time.Time) predicate.User {
-	return predicate.User(sql.FieldGTE(FieldRefreshTokenExpiresAt, v))
+// TokenVersionGTE applies the GTE predicate on the "token_version" field.
+func TokenVersionGTE(v int) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldTokenVersion, v))
 }
 
-// RefreshTokenExpiresAtLT applies the LT predicate on the "refresh_token_expires_at" field.
-func RefreshTokenExpiresAtLT(v time.Time) predicate.User {
-	return predicate.User(sql.FieldLT(FieldRefreshTokenExpiresAt, v))
+// TokenVersionLT applies the LT predicate on the "token_version" field.
+func TokenVersionLT(v int) predicate.User {
+	return predicate.User(sql.FieldLT(FieldTokenVersion, v))
 }
 
-// RefreshTokenExpiresAtLTE applies the LTE predicate on the "refresh_token_expires_at" field.
-func RefreshTokenExpiresAtLTE(v time.Time) predicate.User {
-	return predicate.User(sql.FieldLTE(FieldRefreshTokenExpiresAt, v))
-}
-
-// RefreshTokenExpiresAtIsNil applies the IsNil predicate on the "refresh_token_expires_at" field.
-func RefreshTokenExpiresAtIsNil() predicate.User {
-	return predicate.User(sql.FieldIsNull(FieldRefreshTokenExpiresAt))
-}
-
-// RefreshTokenExpiresAtNotNil applies the NotNil predicate on the "refresh_token_expires_at" field.
-func RefreshTokenExpiresAtNotNil() predicate.User {
-	return predicate.User(sql.FieldNotNull(FieldRefreshTokenExpiresAt))
+// TokenVersionLTE applies the LTE predicate on the "token_version" field.
+func TokenVersionLTE(v int) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldTokenVersion, v))
 }
 
 // HasRoomMembers applies the HasEdge predicate on the "room_members" edge.
@@ -647,6 +637,213 @@ func HasMessagesWith(preds ...predicate.Message) predicate.User {
 	})
 }
 
+// HasMessageReads applies the HasEdge predicate on the "message_reads" edge.
+func HasMessageReads() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, MessageReadsTable, MessageReadsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMessageReadsWith applies the HasEdge predicate on the "message_reads" edge with a given conditions (other predicates).
+func HasMessageReadsWith(preds ...predicate.MessageRead) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newMessageReadsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasMessageReactions applies the HasEdge predicate on the "message_reactions" edge.
+func HasMessageReactions() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, MessageReactionsTable, MessageReactionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMessageReactionsWith applies the HasEdge predicate on the "message_reactions" edge with a given conditions (other predicates).
+func HasMessageReactionsWith(preds ...predicate.MessageReaction) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newMessageReactionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasMessageRevisions applies the HasEdge predicate on the "message_revisions" edge.
+func HasMessageRevisions() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, MessageRevisionsTable, MessageRevisionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMessageRevisionsWith applies the HasEdge predicate on the "message_revisions" edge with a given conditions (other predicates).
+func HasMessageRevisionsWith(preds ...predicate.MessageRevision) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newMessageRevisionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasInvites applies the HasEdge predicate on the "invites" edge.
+func HasInvites() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, InvitesTable, InvitesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasInvitesWith applies the HasEdge predicate on the "invites" edge with a given conditions (other predicates).
+func HasInvitesWith(preds ...predicate.Invite) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newInvitesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasJoinRequests applies the HasEdge predicate on the "join_requests" edge.
+func HasJoinRequests() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, JoinRequestsTable, JoinRequestsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasJoinRequestsWith applies the HasEdge predicate on the "join_requests" edge with a given conditions (other predicates).
+func HasJoinRequestsWith(preds ...predicate.JoinRequest) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newJoinRequestsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasSessions applies the HasEdge predicate on the "sessions" edge.
+func HasSessions() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, SessionsTable, SessionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSessionsWith applies the HasEdge predicate on the "sessions" edge with a given conditions (other predicates).
+func HasSessionsWith(preds ...predicate.Session) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newSessionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasSecurityEvents applies the HasEdge predicate on the "security_events" edge.
+func HasSecurityEvents() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, SecurityEventsTable, SecurityEventsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSecurityEventsWith applies the HasEdge predicate on the "security_events" edge with a given conditions (other predicates).
+func HasSecurityEventsWith(preds ...predicate.SecurityEvent) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newSecurityEventsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasRevokedTokens applies the HasEdge predicate on the "revoked_tokens" edge.
+func HasRevokedTokens() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, RevokedTokensTable, RevokedTokensColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRevokedTokensWith applies the HasEdge predicate on the "revoked_tokens" edge with a given conditions (other predicates).
+func HasRevokedTokensWith(preds ...predicate.RevokedToken) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newRevokedTokensStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasStreamTickets applies the HasEdge predicate on the "stream_tickets" edge.
+func HasStreamTickets() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, StreamTicketsTable, StreamTicketsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasStreamTicketsWith applies the HasEdge predicate on the "stream_tickets" edge with a given conditions (other predicates).
+func HasStreamTicketsWith(preds ...predicate.StreamTicket) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newStreamTicketsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.User) predicate.User {
 	return predicate.User(sql.AndPredicates(predicates...))
@@ -11,8 +11,17 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/invite"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/joinrequest"
 	"github.com/hideaki1979/cc-chat-app/apps/api/ent/message"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/messagereaction"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/messageread"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/messagerevision"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/revokedtoken"
 	"github.com/hideaki1979/cc-chat-app/apps/api/ent/roommember"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/securityevent"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/session"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/streamticket"
 	"github.com/hideaki1979/cc-chat-app/apps/api/ent/user"
 )
 
@@ -97,22 +106,30 @@ func (uc *UserCreate) SetNillableUpdatedAt(t *time.Time) *UserCreate {
 	return uc
 }
 
-// SetRefreshTokenHash sets the "refresh_token_hash" field.
-func (uc *UserCreate) SetRefreshTokenHash(b []byte) *UserCreate {
-	uc.mutation.SetRefreshTokenHash(b)
+// SetLastSeenAt sets the "last_seen_at" field.
+func (uc *UserCreate) SetLastSeenAt(t time.Time) *UserCreate {
+	uc.mutation.SetLastSeenAt(t)
 	return uc
 }
 
-// SetRefreshTokenExpiresAt sets the "refresh_token_expires_at" field.
-func (uc *UserCreate) SetRefreshTokenExpiresAt(t time.Time) *UserCreate {
-	uc.mutation.SetRefreshTokenExpiresAt(t)
+// SetNillableLastSeenAt sets the "last_seen_at" field if the given value is not nil.
+func (uc *UserCreate) SetNillableLastSeenAt(t *time.Time) *UserCreate {
+	if t != nil {
+		uc.SetLastSeenAt(*t)
+	}
 	return uc
 }
 
-// SetNillableRefreshTokenExpiresAt sets the "refresh_token_expires_at" field if the given value is not nil.
-func (uc *UserCreate) SetNillableRefreshTokenExpiresAt(t *time.Time) *UserCreate {
-	if t != nil {
-		uc.SetRefreshTokenExpiresAt(*t)
+// SetTokenVersion sets the "token_version" field.
+func (uc *UserCreate) SetTokenVersion(i int) *UserCreate {
+	uc.mutation.SetTokenVersion(i)
+	return uc
+}
+
+// SetNillableTokenVersion sets the "token_version" field if the given value is not nil.
+func (uc *UserCreate) SetNillableTokenVersion(i *int) *UserCreate {
+	if i != nil {
+		uc.SetTokenVersion(*i)
 	}
 	return uc
 }
@@ -161,6 +178,141 @@ func (uc *UserCreate) AddMessages(m ...*Message) *UserCreate {
 	return uc.AddMessageIDs(ids...)
 }
 
+// AddMessageReadIDs adds the "message_reads" edge to the MessageRead entity by IDs.
+func (uc *UserCreate) AddMessageReadIDs(ids ...int64) *UserCreate {
+	uc.mutation.AddMessageReadIDs(ids...)
+	return uc
+}
+
+// AddMessageReads adds the "message_reads" edges to the MessageRead entity.
+func (uc *UserCreate) AddMessageReads(m ...*MessageRead) *UserCreate {
+	ids := make([]int64, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return uc.AddMessageReadIDs(ids...)
+}
+
+// AddMessageReactionIDs adds the "message_reactions" edge to the MessageReaction entity by IDs.
+func (uc *UserCreate) AddMessageReactionIDs(ids ...int64) *UserCreate {
+	uc.mutation.AddMessageReactionIDs(ids...)
+	return uc
+}
+
+// AddMessageReactions adds the "message_reactions" edges to the MessageReaction entity.
+func (uc *UserCreate) AddMessageReactions(m ...*MessageReaction) *UserCreate {
+	ids := make([]int64, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return uc.AddMessageReactionIDs(ids...)
+}
+
+// AddMessageRevisionIDs adds the "message_revisions" edge to the MessageRevision entity by IDs.
+func (uc *UserCreate) AddMessageRevisionIDs(ids ...int64) *UserCreate {
+	uc.mutation.AddMessageRevisionIDs(ids...)
+	return uc
+}
+
+// AddMessageRevisions adds the "message_revisions" edges to the MessageRevision entity.
+func (uc *UserCreate) AddMessageRevisions(m ...*MessageRevision) *UserCreate {
+	ids := make([]int64, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return uc.AddMessageRevisionIDs(ids...)
+}
+
+// AddInviteIDs adds the "invites" edge to the Invite entity by IDs.
+func (uc *UserCreate) AddInviteIDs(ids ...uuid.UUID) *UserCreate {
+	uc.mutation.AddInviteIDs(ids...)
+	return uc
+}
+
+// AddInvites adds the "invites" edges to the Invite entity.
+func (uc *UserCreate) AddInvites(i ...*Invite) *UserCreate {
+	ids := make([]uuid.UUID, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return uc.AddInviteIDs(ids...)
+}
+
+// AddJoinRequestIDs adds the "join_requests" edge to the JoinRequest entity by IDs.
+func (uc *UserCreate) AddJoinRequestIDs(ids ...int64) *UserCreate {
+	uc.mutation.AddJoinRequestIDs(ids...)
+	return uc
+}
+
+// AddJoinRequests adds the "join_requests" edges to the JoinRequest entity.
+func (uc *UserCreate) AddJoinRequests(j ...*JoinRequest) *UserCreate {
+	ids := make([]int64, len(j))
+	for i := range j {
+		ids[i] = j[i].ID
+	}
+	return uc.AddJoinRequestIDs(ids...)
+}
+
+// AddSessionIDs adds the "sessions" edge to the Session entity by IDs.
+func (uc *UserCreate) AddSessionIDs(ids ...uuid.UUID) *UserCreate {
+	uc.mutation.AddSessionIDs(ids...)
+	return uc
+}
+
+// AddSessions adds the "sessions" edges to the Session entity.
+func (uc *UserCreate) AddSessions(s ...*Session) *UserCreate {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return uc.AddSessionIDs(ids...)
+}
+
+// AddSecurityEventIDs adds the "security_events" edge to the SecurityEvent entity by IDs.
+func (uc *UserCreate) AddSecurityEventIDs(ids ...int64) *UserCreate {
+	uc.mutation.AddSecurityEventIDs(ids...)
+	return uc
+}
+
+// AddSecurityEvents adds the "security_events" edges to the SecurityEvent entity.
+func (uc *UserCreate) AddSecurityEvents(s ...*SecurityEvent) *UserCreate {
+	ids := make([]int64, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return uc.AddSecurityEventIDs(ids...)
+}
+
+// AddRevokedTokenIDs adds the "revoked_tokens" edge to the RevokedToken entity by IDs.
+func (uc *UserCreate) AddRevokedTokenIDs(ids ...string) *UserCreate {
+	uc.mutation.AddRevokedTokenIDs(ids...)
+	return uc
+}
+
+// AddRevokedTokens adds the "revoked_tokens" edges to the RevokedToken entity.
+func (uc *UserCreate) AddRevokedTokens(r ...*RevokedToken) *UserCreate {
+	ids := make([]string, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return uc.AddRevokedTokenIDs(ids...)
+}
+
+// AddStreamTicketIDs adds the "stream_tickets" edge to the StreamTicket entity by IDs.
+func (uc *UserCreate) AddStreamTicketIDs(ids ...int64) *UserCreate {
+	uc.mutation.AddStreamTicketIDs(ids...)
+	return uc
+}
+
+// AddStreamTickets adds the "stream_tickets" edges to the StreamTicket entity.
+func (uc *UserCreate) AddStreamTickets(s ...*StreamTicket) *UserCreate {
+	ids := make([]int64, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return uc.AddStreamTicketIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (uc *UserCreate) Mutation() *UserMutation {
 	return uc.mutation
@@ -212,6 +364,10 @@ func (uc *UserCreate) defaults() error {
 		v := user.DefaultUpdatedAt()
 		uc.mutation.SetUpdatedAt(v)
 	}
+	if _, ok := uc.mutation.TokenVersion(); !ok {
+		v := user.DefaultTokenVersion
+		uc.mutation.SetTokenVersion(v)
+	}
 	if _, ok := uc.mutation.ID(); !ok {
 		if user.DefaultID == nil {
 			return fmt.Errorf("ent: uninitialized user.DefaultID (forgotten import ent/runtime?)")
@@ -254,6 +410,14 @@ func (uc *UserCreate) check() error {
 	if _, ok := uc.mutation.UpdatedAt(); !ok {
 		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "User.updated_at"`)}
 	}
+	if _, ok := uc.mutation.TokenVersion(); !ok {
+		return &ValidationError{Name: "token_version", err: errors.New(`ent: missing required field "User.token_version"`)}
+	}
+	if v, ok := uc.mutation.TokenVersion(); ok {
+		if err := user.TokenVersionValidator(v); err != nil {
+			return &ValidationError{Name: "token_version", err: fmt.Errorf(`ent: validator failed for field "User.token_version": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -317,13 +481,13 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		_spec.SetField(user.FieldUpdatedAt, field.TypeTime, value)
 		_node.UpdatedAt = value
 	}
-	if value, ok := uc.mutation.RefreshTokenHash(); ok {
-		_spec.SetField(user.FieldRefreshTokenHash, field.TypeBytes, value)
-		_node.RefreshTokenHash = &value
+	if value, ok := uc.mutation.LastSeenAt(); ok {
+		_spec.SetField(user.FieldLastSeenAt, field.TypeTime, value)
+		_node.LastSeenAt = &value
 	}
-	if value, ok := uc.mutation.RefreshTokenExpiresAt(); ok {
-		_spec.SetField(user.FieldRefreshTokenExpiresAt, field.TypeTime, value)
-		_node.RefreshTokenExpiresAt = &value
+	if value, ok := uc.mutation.TokenVersion(); ok {
+		_spec.SetField(user.FieldTokenVersion, field.TypeInt, value)
+		_node.TokenVersion = value
 	}
 	if nodes := uc.mutation.RoomMembersIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
@@ -357,6 +521,150 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := uc.mutation.MessageReadsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MessageReadsTable,
+			Columns: []string{user.MessageReadsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messageread.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.MessageReactionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MessageReactionsTable,
+			Columns: []string{user.MessageReactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagereaction.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.MessageRevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MessageRevisionsTable,
+			Columns: []string{user.MessageRevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagerevision.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.InvitesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.InvitesTable,
+			Columns: []string{user.InvitesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invite.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.JoinRequestsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.JoinRequestsTable,
+			Columns: []string{user.JoinRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(joinrequest.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.SessionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SessionsTable,
+			Columns: []string{user.SessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(session.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.SecurityEventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SecurityEventsTable,
+			Columns: []string{user.SecurityEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(securityevent.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.RevokedTokensIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.RevokedTokensTable,
+			Columns: []string{user.RevokedTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(revokedtoken.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.StreamTicketsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.StreamTicketsTable,
+			Columns: []string{user.StreamTicketsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(streamticket.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
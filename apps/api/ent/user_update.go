@@ -12,9 +12,18 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/invite"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/joinrequest"
 	"github.com/hideaki1979/cc-chat-app/apps/api/ent/message"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/messagereaction"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/messageread"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/messagerevision"
 	"github.com/hideaki1979/cc-chat-app/apps/api/ent/predicate"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/revokedtoken"
 	"github.com/hideaki1979/cc-chat-app/apps/api/ent/roommember"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/securityevent"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/session"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/streamticket"
 	"github.com/hideaki1979/cc-chat-app/apps/api/ent/user"
 )
 
@@ -111,35 +120,44 @@ func (uu *UserUpdate) SetUpdatedAt(t time.Time) *UserUpdate {
 	return uu
 }
 
-// SetRefreshTokenHash sets the "refresh_token_hash" field.
-func (uu *UserUpdate) SetRefreshTokenHash(b []byte) *UserUpdate {
-	uu.mutation.SetRefreshTokenHash(b)
+// SetLastSeenAt sets the "last_seen_at" field.
+func (uu *UserUpdate) SetLastSeenAt(t time.Time) *UserUpdate {
+	uu.mutation.SetLastSeenAt(t)
 	return uu
 }
 
-// ClearRefreshTokenHash clears the value of the "refresh_token_hash" field.
-func (uu *UserUpdate) ClearRefreshTokenHash() *UserUpdate {
-	uu.mutation.ClearRefreshTokenHash()
+// SetNillableLastSeenAt sets the "last_seen_at" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableLastSeenAt(t *time.Time) *UserUpdate {
+	if t != nil {
+		uu.SetLastSeenAt(*t)
+	}
 	return uu
 }
 
-// SetRefreshTokenExpiresAt sets the "refresh_token_expires_at" field.
-func (uu *UserUpdate) SetRefreshTokenExpiresAt(t time.Time) *UserUpdate {
-	uu.mutation.SetRefreshTokenExpiresAt(t)
+// ClearLastSeenAt clears the value of the "last_seen_at" field.
+func (uu *UserUpdate) ClearLastSeenAt() *UserUpdate {
+	uu.mutation.ClearLastSeenAt()
 	return uu
 }
 
-// SetNillableRefreshTokenExpiresAt sets the "refresh_token_expires_at" field if the given value is not nil.
-func (uu *UserUpdate) SetNillableRefreshTokenExpiresAt(t *time.Time) *UserUpdate {
-	if t != nil {
-		uu.SetRefreshTokenExpiresAt(*t)
+// SetTokenVersion sets the "token_version" field.
+func (uu *UserUpdate) SetTokenVersion(i int) *UserUpdate {
+	uu.mutation.ResetTokenVersion()
+	uu.mutation.SetTokenVersion(i)
+	return uu
+}
+
+// SetNillableTokenVersion sets the "token_version" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableTokenVersion(i *int) *UserUpdate {
+	if i != nil {
+		uu.SetTokenVersion(*i)
 	}
 	return uu
 }
 
-// ClearRefreshTokenExpiresAt clears the value of the "refresh_token_expires_at" field.
-func (uu *UserUpdate) ClearRefreshTokenExpiresAt() *UserUpdate {
-	uu.mutation.ClearRefreshTokenExpiresAt()
+// AddTokenVersion adds i to the "token_version" field.
+func (uu *UserUpdate) AddTokenVersion(i int) *UserUpdate {
+	uu.mutation.AddTokenVersion(i)
 	return uu
 }
 
@@ -173,6 +191,141 @@ func (uu *UserUpdate) AddMessages(m ...*Message) *UserUpdate {
 	return uu.AddMessageIDs(ids...)
 }
 
+// AddMessageReadIDs adds the "message_reads" edge to the MessageRead entity by IDs.
+func (uu *UserUpdate) AddMessageReadIDs(ids ...int64) *UserUpdate {
+	uu.mutation.AddMessageReadIDs(ids...)
+	return uu
+}
+
+// AddMessageReads adds the "message_reads" edges to the MessageRead entity.
+func (uu *UserUpdate) AddMessageReads(m ...*MessageRead) *UserUpdate {
+	ids := make([]int64, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return uu.AddMessageReadIDs(ids...)
+}
+
+// AddMessageReactionIDs adds the "message_reactions" edge to the MessageReaction entity by IDs.
+func (uu *UserUpdate) AddMessageReactionIDs(ids ...int64) *UserUpdate {
+	uu.mutation.AddMessageReactionIDs(ids...)
+	return uu
+}
+
+// AddMessageReactions adds the "message_reactions" edges to the MessageReaction entity.
+func (uu *UserUpdate) AddMessageReactions(m ...*MessageReaction) *UserUpdate {
+	ids := make([]int64, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return uu.AddMessageReactionIDs(ids...)
+}
+
+// AddMessageRevisionIDs adds the "message_revisions" edge to the MessageRevision entity by IDs.
+func (uu *UserUpdate) AddMessageRevisionIDs(ids ...int64) *UserUpdate {
+	uu.mutation.AddMessageRevisionIDs(ids...)
+	return uu
+}
+
+// AddMessageRevisions adds the "message_revisions" edges to the MessageRevision entity.
+func (uu *UserUpdate) AddMessageRevisions(m ...*MessageRevision) *UserUpdate {
+	ids := make([]int64, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return uu.AddMessageRevisionIDs(ids...)
+}
+
+// AddInviteIDs adds the "invites" edge to the Invite entity by IDs.
+func (uu *UserUpdate) AddInviteIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.AddInviteIDs(ids...)
+	return uu
+}
+
+// AddInvites adds the "invites" edges to the Invite entity.
+func (uu *UserUpdate) AddInvites(i ...*Invite) *UserUpdate {
+	ids := make([]uuid.UUID, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return uu.AddInviteIDs(ids...)
+}
+
+// AddJoinRequestIDs adds the "join_requests" edge to the JoinRequest entity by IDs.
+func (uu *UserUpdate) AddJoinRequestIDs(ids ...int64) *UserUpdate {
+	uu.mutation.AddJoinRequestIDs(ids...)
+	return uu
+}
+
+// AddJoinRequests adds the "join_requests" edges to the JoinRequest entity.
+func (uu *UserUpdate) AddJoinRequests(j ...*JoinRequest) *UserUpdate {
+	ids := make([]int64, len(j))
+	for i := range j {
+		ids[i] = j[i].ID
+	}
+	return uu.AddJoinRequestIDs(ids...)
+}
+
+// AddSessionIDs adds the "sessions" edge to the Session entity by IDs.
+func (uu *UserUpdate) AddSessionIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.AddSessionIDs(ids...)
+	return uu
+}
+
+// AddSessions adds the "sessions" edges to the Session entity.
+func (uu *UserUpdate) AddSessions(s ...*Session) *UserUpdate {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return uu.AddSessionIDs(ids...)
+}
+
+// AddSecurityEventIDs adds the "security_events" edge to the SecurityEvent entity by IDs.
+func (uu *UserUpdate) AddSecurityEventIDs(ids ...int64) *UserUpdate {
+	uu.mutation.AddSecurityEventIDs(ids...)
+	return uu
+}
+
+// AddSecurityEvents adds the "security_events" edges to the SecurityEvent entity.
+func (uu *UserUpdate) AddSecurityEvents(s ...*SecurityEvent) *UserUpdate {
+	ids := make([]int64, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return uu.AddSecurityEventIDs(ids...)
+}
+
+// AddRevokedTokenIDs adds the "revoked_tokens" edge to the RevokedToken entity by IDs.
+func (uu *UserUpdate) AddRevokedTokenIDs(ids ...string) *UserUpdate {
+	uu.mutation.AddRevokedTokenIDs(ids...)
+	return uu
+}
+
+// AddRevokedTokens adds the "revoked_tokens" edges to the RevokedToken entity.
+func (uu *UserUpdate) AddRevokedTokens(r ...*RevokedToken) *UserUpdate {
+	ids := make([]string, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return uu.AddRevokedTokenIDs(ids...)
+}
+
+// AddStreamTicketIDs adds the "stream_tickets" edge to the StreamTicket entity by IDs.
+func (uu *UserUpdate) AddStreamTicketIDs(ids ...int64) *UserUpdate {
+	uu.mutation.AddStreamTicketIDs(ids...)
+	return uu
+}
+
+// AddStreamTickets adds the "stream_tickets" edges to the StreamTicket entity.
+func (uu *UserUpdate) AddStreamTickets(s ...*StreamTicket) *UserUpdate {
+	ids := make([]int64, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return uu.AddStreamTicketIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (uu *UserUpdate) Mutation() *UserMutation {
 	return uu.mutation
@@ -220,6 +373,195 @@ func (uu *UserUpdate) RemoveMessages(m ...*Message) *UserUpdate {
 	return uu.RemoveMessageIDs(ids...)
 }
 
+// ClearMessageReads clears all "message_reads" edges to the MessageRead entity.
+func (uu *UserUpdate) ClearMessageReads() *UserUpdate {
+	uu.mutation.ClearMessageReads()
+	return uu
+}
+
+// RemoveMessageReadIDs removes the "message_reads" edge to MessageRead entities by IDs.
+func (uu *UserUpdate) RemoveMessageReadIDs(ids ...int64) *UserUpdate {
+	uu.mutation.RemoveMessageReadIDs(ids...)
+	return uu
+}
+
+// RemoveMessageReads removes "message_reads" edges to MessageRead entities.
+func (uu *UserUpdate) RemoveMessageReads(m ...*MessageRead) *UserUpdate {
+	ids := make([]int64, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return uu.RemoveMessageReadIDs(ids...)
+}
+
+// ClearMessageReactions clears all "message_reactions" edges to the MessageReaction entity.
+func (uu *UserUpdate) ClearMessageReactions() *UserUpdate {
+	uu.mutation.ClearMessageReactions()
+	return uu
+}
+
+// RemoveMessageReactionIDs removes the "message_reactions" edge to MessageReaction entities by IDs.
+func (uu *UserUpdate) RemoveMessageReactionIDs(ids ...int64) *UserUpdate {
+	uu.mutation.RemoveMessageReactionIDs(ids...)
+	return uu
+}
+
+// RemoveMessageReactions removes "message_reactions" edges to MessageReaction entities.
+func (uu *UserUpdate) RemoveMessageReactions(m ...*MessageReaction) *UserUpdate {
+	ids := make([]int64, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return uu.RemoveMessageReactionIDs(ids...)
+}
+
+// ClearMessageRevisions clears all "message_revisions" edges to the MessageRevision entity.
+func (uu *UserUpdate) ClearMessageRevisions() *UserUpdate {
+	uu.mutation.ClearMessageRevisions()
+	return uu
+}
+
+// RemoveMessageRevisionIDs removes the "message_revisions" edge to MessageRevision entities by IDs.
+func (uu *UserUpdate) RemoveMessageRevisionIDs(ids ...int64) *UserUpdate {
+	uu.mutation.RemoveMessageRevisionIDs(ids...)
+	return uu
+}
+
+// RemoveMessageRevisions removes "message_revisions" edges to MessageRevision entities.
+func (uu *UserUpdate) RemoveMessageRevisions(m ...*MessageRevision) *UserUpdate {
+	ids := make([]int64, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return uu.RemoveMessageRevisionIDs(ids...)
+}
+
+// ClearInvites clears all "invites" edges to the Invite entity.
+func (uu *UserUpdate) ClearInvites() *UserUpdate {
+	uu.mutation.ClearInvites()
+	return uu
+}
+
+// RemoveInviteIDs removes the "invites" edge to Invite entities by IDs.
+func (uu *UserUpdate) RemoveInviteIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.RemoveInviteIDs(ids...)
+	return uu
+}
+
+// RemoveInvites removes "invites" edges to Invite entities.
+func (uu *UserUpdate) RemoveInvites(i ...*Invite) *UserUpdate {
+	ids := make([]uuid.UUID, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return uu.RemoveInviteIDs(ids...)
+}
+
+// ClearJoinRequests clears all "join_requests" edges to the JoinRequest entity.
+func (uu *UserUpdate) ClearJoinRequests() *UserUpdate {
+	uu.mutation.ClearJoinRequests()
+	return uu
+}
+
+// RemoveJoinRequestIDs removes the "join_requests" edge to JoinRequest entities by IDs.
+func (uu *UserUpdate) RemoveJoinRequestIDs(ids ...int64) *UserUpdate {
+	uu.mutation.RemoveJoinRequestIDs(ids...)
+	return uu
+}
+
+// RemoveJoinRequests removes "join_requests" edges to JoinRequest entities.
+func (uu *UserUpdate) RemoveJoinRequests(j ...*JoinRequest) *UserUpdate {
+	ids := make([]int64, len(j))
+	for i := range j {
+		ids[i] = j[i].ID
+	}
+	return uu.RemoveJoinRequestIDs(ids...)
+}
+
+// ClearSessions clears all "sessions" edges to the Session entity.
+func (uu *UserUpdate) ClearSessions() *UserUpdate {
+	uu.mutation.ClearSessions()
+	return uu
+}
+
+// RemoveSessionIDs removes the "sessions" edge to Session entities by IDs.
+func (uu *UserUpdate) RemoveSessionIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.RemoveSessionIDs(ids...)
+	return uu
+}
+
+// RemoveSessions removes "sessions" edges to Session entities.
+func (uu *UserUpdate) RemoveSessions(s ...*Session) *UserUpdate {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return uu.RemoveSessionIDs(ids...)
+}
+
+// ClearSecurityEvents clears all "security_events" edges to the SecurityEvent entity.
+func (uu *UserUpdate) ClearSecurityEvents() *UserUpdate {
+	uu.mutation.ClearSecurityEvents()
+	return uu
+}
+
+// RemoveSecurityEventIDs removes the "security_events" edge to SecurityEvent entities by IDs.
+func (uu *UserUpdate) RemoveSecurityEventIDs(ids ...int64) *UserUpdate {
+	uu.mutation.RemoveSecurityEventIDs(ids...)
+	return uu
+}
+
+// RemoveSecurityEvents removes "security_events" edges to SecurityEvent entities.
+func (uu *UserUpdate) RemoveSecurityEvents(s ...*SecurityEvent) *UserUpdate {
+	ids := make([]int64, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return uu.RemoveSecurityEventIDs(ids...)
+}
+
+// ClearRevokedTokens clears all "revoked_tokens" edges to the RevokedToken entity.
+func (uu *UserUpdate) ClearRevokedTokens() *UserUpdate {
+	uu.mutation.ClearRevokedTokens()
+	return uu
+}
+
+// RemoveRevokedTokenIDs removes the "revoked_tokens" edge to RevokedToken entities by IDs.
+func (uu *UserUpdate) RemoveRevokedTokenIDs(ids ...string) *UserUpdate {
+	uu.mutation.RemoveRevokedTokenIDs(ids...)
+	return uu
+}
+
+// RemoveRevokedTokens removes "revoked_tokens" edges to RevokedToken entities.
+func (uu *UserUpdate) RemoveRevokedTokens(r ...*RevokedToken) *UserUpdate {
+	ids := make([]string, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return uu.RemoveRevokedTokenIDs(ids...)
+}
+
+// ClearStreamTickets clears all "stream_tickets" edges to the StreamTicket entity.
+func (uu *UserUpdate) ClearStreamTickets() *UserUpdate {
+	uu.mutation.ClearStreamTickets()
+	return uu
+}
+
+// RemoveStreamTicketIDs removes the "stream_tickets" edge to StreamTicket entities by IDs.
+func (uu *UserUpdate) RemoveStreamTicketIDs(ids ...int64) *UserUpdate {
+	uu.mutation.RemoveStreamTicketIDs(ids...)
+	return uu
+}
+
+// RemoveStreamTickets removes "stream_tickets" edges to StreamTicket entities.
+func (uu *UserUpdate) RemoveStreamTickets(s ...*StreamTicket) *UserUpdate {
+	ids := make([]int64, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return uu.RemoveStreamTicketIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (uu *UserUpdate) Save(ctx context.Context) (int, error) {
 	if err := uu.defaults(); err != nil {
@@ -279,6 +621,11 @@ func (uu *UserUpdate) check() error {
 			return &ValidationError{Name: "password_hash", err: fmt.Errorf(`ent: validator failed for field "User.password_hash": %w`, err)}
 		}
 	}
+	if v, ok := uu.mutation.TokenVersion(); ok {
+		if err := user.TokenVersionValidator(v); err != nil {
+			return &ValidationError{Name: "token_version", err: fmt.Errorf(`ent: validator failed for field "User.token_version": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -318,17 +665,17 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := uu.mutation.UpdatedAt(); ok {
 		_spec.SetField(user.FieldUpdatedAt, field.TypeTime, value)
 	}
-	if value, ok := uu.mutation.RefreshTokenHash(); ok {
-		_spec.SetField(user.FieldRefreshTokenHash, field.TypeBytes, value)
+	if value, ok := uu.mutation.LastSeenAt(); ok {
+		_spec.SetField(user.FieldLastSeenAt, field.TypeTime, value)
 	}
-	if uu.mutation.RefreshTokenHashCleared() {
-		_spec.ClearField(user.FieldRefreshTokenHash, field.TypeBytes)
+	if uu.mutation.LastSeenAtCleared() {
+		_spec.ClearField(user.FieldLastSeenAt, field.TypeTime)
 	}
-	if value, ok := uu.mutation.RefreshTokenExpiresAt(); ok {
-		_spec.SetField(user.FieldRefreshTokenExpiresAt, field.TypeTime, value)
+	if value, ok := uu.mutation.TokenVersion(); ok {
+		_spec.SetField(user.FieldTokenVersion, field.TypeInt, value)
 	}
-	if uu.mutation.RefreshTokenExpiresAtCleared() {
-		_spec.ClearField(user.FieldRefreshTokenExpiresAt, field.TypeTime)
+	if value, ok := uu.mutation.AddedTokenVersion(); ok {
+		_spec.AddField(user.FieldTokenVersion, field.TypeInt, value)
 	}
 	if uu.mutation.RoomMembersCleared() {
 		edge := &sqlgraph.EdgeSpec{
@@ -420,213 +767,951 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if n, err = sqlgraph.UpdateNodes(ctx, uu.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{user.Label}
-		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+	if uu.mutation.MessageReadsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MessageReadsTable,
+			Columns: []string{user.MessageReadsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messageread.FieldID, field.TypeInt64),
+			},
 		}
-		return 0, err
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	uu.mutation.done = true
-	return n, nil
-}
-
-// UserUpdateOne is the builder for updating a single User entity.
-type UserUpdateOne struct {
-	config
-	fields   []string
-	hooks    []Hook
-	mutation *UserMutation
-}
-
-// SetName sets the "name" field.
-func (uuo *UserUpdateOne) SetName(s string) *UserUpdateOne {
-	uuo.mutation.SetName(s)
-	return uuo
-}
-
-// SetNillableName sets the "name" field if the given value is not nil.
-func (uuo *UserUpdateOne) SetNillableName(s *string) *UserUpdateOne {
-	if s != nil {
-		uuo.SetName(*s)
+	if nodes := uu.mutation.RemovedMessageReadsIDs(); len(nodes) > 0 && !uu.mutation.MessageReadsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MessageReadsTable,
+			Columns: []string{user.MessageReadsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messageread.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return uuo
-}
-
-// SetEmail sets the "email" field.
-func (uuo *UserUpdateOne) SetEmail(s string) *UserUpdateOne {
-	uuo.mutation.SetEmail(s)
-	return uuo
-}
-
-// SetNillableEmail sets the "email" field if the given value is not nil.
-func (uuo *UserUpdateOne) SetNillableEmail(s *string) *UserUpdateOne {
-	if s != nil {
-		uuo.SetEmail(*s)
+	if nodes := uu.mutation.MessageReadsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MessageReadsTable,
+			Columns: []string{user.MessageReadsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messageread.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return uuo
-}
-
-// SetPasswordHash sets the "password_hash" field.
-func (uuo *UserUpdateOne) SetPasswordHash(b []byte) *UserUpdateOne {
-	uuo.mutation.SetPasswordHash(b)
-	return uuo
-}
-
-// SetProfileImageURL sets the "profile_image_url" field.
-func (uuo *UserUpdateOne) SetProfileImageURL(s string) *UserUpdateOne {
-	uuo.mutation.SetProfileImageURL(s)
-	return uuo
-}
-
-// SetNillableProfileImageURL sets the "profile_image_url" field if the given value is not nil.
-func (uuo *UserUpdateOne) SetNillableProfileImageURL(s *string) *UserUpdateOne {
-	if s != nil {
-		uuo.SetProfileImageURL(*s)
+	if uu.mutation.MessageReactionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MessageReactionsTable,
+			Columns: []string{user.MessageReactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagereaction.FieldID, field.TypeInt64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
+	if nodes := uu.mutation.RemovedMessageReactionsIDs(); len(nodes) > 0 && !uu.mutation.MessageReactionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MessageReactionsTable,
+			Columns: []string{user.MessageReactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagereaction.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.MessageReactionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MessageReactionsTable,
+			Columns: []string{user.MessageReactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagereaction.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.MessageRevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MessageRevisionsTable,
+			Columns: []string{user.MessageRevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagerevision.FieldID, field.TypeInt64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedMessageRevisionsIDs(); len(nodes) > 0 && !uu.mutation.MessageRevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MessageRevisionsTable,
+			Columns: []string{user.MessageRevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagerevision.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.MessageRevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MessageRevisionsTable,
+			Columns: []string{user.MessageRevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagerevision.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.InvitesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.InvitesTable,
+			Columns: []string{user.InvitesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invite.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedInvitesIDs(); len(nodes) > 0 && !uu.mutation.InvitesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.InvitesTable,
+			Columns: []string{user.InvitesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invite.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.InvitesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.InvitesTable,
+			Columns: []string{user.InvitesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invite.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.JoinRequestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.JoinRequestsTable,
+			Columns: []string{user.JoinRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(joinrequest.FieldID, field.TypeInt64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedJoinRequestsIDs(); len(nodes) > 0 && !uu.mutation.JoinRequestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.JoinRequestsTable,
+			Columns: []string{user.JoinRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(joinrequest.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.JoinRequestsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.JoinRequestsTable,
+			Columns: []string{user.JoinRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(joinrequest.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.SessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SessionsTable,
+			Columns: []string{user.SessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(session.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedSessionsIDs(); len(nodes) > 0 && !uu.mutation.SessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SessionsTable,
+			Columns: []string{user.SessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(session.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.SessionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SessionsTable,
+			Columns: []string{user.SessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(session.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.SecurityEventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SecurityEventsTable,
+			Columns: []string{user.SecurityEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(securityevent.FieldID, field.TypeInt64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedSecurityEventsIDs(); len(nodes) > 0 && !uu.mutation.SecurityEventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SecurityEventsTable,
+			Columns: []string{user.SecurityEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(securityevent.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.SecurityEventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SecurityEventsTable,
+			Columns: []string{user.SecurityEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(securityevent.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.RevokedTokensCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.RevokedTokensTable,
+			Columns: []string{user.RevokedTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(revokedtoken.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedRevokedTokensIDs(); len(nodes) > 0 && !uu.mutation.RevokedTokensCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.RevokedTokensTable,
+			Columns: []string{user.RevokedTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(revokedtoken.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RevokedTokensIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.RevokedTokensTable,
+			Columns: []string{user.RevokedTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(revokedtoken.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.StreamTicketsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.StreamTicketsTable,
+			Columns: []string{user.StreamTicketsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(streamticket.FieldID, field.TypeInt64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedStreamTicketsIDs(); len(nodes) > 0 && !uu.mutation.StreamTicketsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.StreamTicketsTable,
+			Columns: []string{user.StreamTicketsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(streamticket.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.StreamTicketsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.StreamTicketsTable,
+			Columns: []string{user.StreamTicketsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(streamticket.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, uu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{user.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	uu.mutation.done = true
+	return n, nil
+}
+
+// UserUpdateOne is the builder for updating a single User entity.
+type UserUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *UserMutation
+}
+
+// SetName sets the "name" field.
+func (uuo *UserUpdateOne) SetName(s string) *UserUpdateOne {
+	uuo.mutation.SetName(s)
 	return uuo
 }
 
-// ClearProfileImageURL clears the value of the "profile_image_url" field.
-func (uuo *UserUpdateOne) ClearProfileImageURL() *UserUpdateOne {
-	uuo.mutation.ClearProfileImageURL()
+// SetNillableName sets the "name" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableName(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetName(*s)
+	}
+	return uuo
+}
+
+// SetEmail sets the "email" field.
+func (uuo *UserUpdateOne) SetEmail(s string) *UserUpdateOne {
+	uuo.mutation.SetEmail(s)
+	return uuo
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableEmail(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetEmail(*s)
+	}
+	return uuo
+}
+
+// SetPasswordHash sets the "password_hash" field.
+func (uuo *UserUpdateOne) SetPasswordHash(b []byte) *UserUpdateOne {
+	uuo.mutation.SetPasswordHash(b)
+	return uuo
+}
+
+// SetProfileImageURL sets the "profile_image_url" field.
+func (uuo *UserUpdateOne) SetProfileImageURL(s string) *UserUpdateOne {
+	uuo.mutation.SetProfileImageURL(s)
+	return uuo
+}
+
+// SetNillableProfileImageURL sets the "profile_image_url" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableProfileImageURL(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetProfileImageURL(*s)
+	}
+	return uuo
+}
+
+// ClearProfileImageURL clears the value of the "profile_image_url" field.
+func (uuo *UserUpdateOne) ClearProfileImageURL() *UserUpdateOne {
+	uuo.mutation.ClearProfileImageURL()
+	return uuo
+}
+
+// SetBio sets the "bio" field.
+func (uuo *UserUpdateOne) SetBio(s string) *UserUpdateOne {
+	uuo.mutation.SetBio(s)
+	return uuo
+}
+
+// SetNillableBio sets the "bio" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableBio(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetBio(*s)
+	}
+	return uuo
+}
+
+// ClearBio clears the value of the "bio" field.
+func (uuo *UserUpdateOne) ClearBio() *UserUpdateOne {
+	uuo.mutation.ClearBio()
+	return uuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (uuo *UserUpdateOne) SetUpdatedAt(t time.Time) *UserUpdateOne {
+	uuo.mutation.SetUpdatedAt(t)
+	return uuo
+}
+
+// SetLastSeenAt sets the "last_seen_at" field.
+func (uuo *UserUpdateOne) SetLastSeenAt(t time.Time) *UserUpdateOne {
+	uuo.mutation.SetLastSeenAt(t)
+	return uuo
+}
+
+// SetNillableLastSeenAt sets the "last_seen_at" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableLastSeenAt(t *time.Time) *UserUpdateOne {
+	if t != nil {
+		uuo.SetLastSeenAt(*t)
+	}
+	return uuo
+}
+
+// ClearLastSeenAt clears the value of the "last_seen_at" field.
+func (uuo *UserUpdateOne) ClearLastSeenAt() *UserUpdateOne {
+	uuo.mutation.ClearLastSeenAt()
+	return uuo
+}
+
+// SetTokenVersion sets the "token_version" field.
+func (uuo *UserUpdateOne) SetTokenVersion(i int) *UserUpdateOne {
+	uuo.mutation.ResetTokenVersion()
+	uuo.mutation.SetTokenVersion(i)
+	return uuo
+}
+
+// SetNillableTokenVersion sets the "token_version" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableTokenVersion(i *int) *UserUpdateOne {
+	if i != nil {
+		uuo.SetTokenVersion(*i)
+	}
+	return uuo
+}
+
+// AddTokenVersion adds i to the "token_version" field.
+func (uuo *UserUpdateOne) AddTokenVersion(i int) *UserUpdateOne {
+	uuo.mutation.AddTokenVersion(i)
+	return uuo
+}
+
+// AddRoomMemberIDs adds the "room_members" edge to the RoomMember entity by IDs.
+func (uuo *UserUpdateOne) AddRoomMemberIDs(ids ...int64) *UserUpdateOne {
+	uuo.mutation.AddRoomMemberIDs(ids...)
+	return uuo
+}
+
+// AddRoomMembers adds the "room_members" edges to the RoomMember entity.
+func (uuo *UserUpdateOne) AddRoomMembers(r ...*RoomMember) *UserUpdateOne {
+	ids := make([]int64, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return uuo.AddRoomMemberIDs(ids...)
+}
+
+// AddMessageIDs adds the "messages" edge to the Message entity by IDs.
+func (uuo *UserUpdateOne) AddMessageIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.AddMessageIDs(ids...)
+	return uuo
+}
+
+// AddMessages adds the "messages" edges to the Message entity.
+func (uuo *UserUpdateOne) AddMessages(m ...*Message) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return uuo.AddMessageIDs(ids...)
+}
+
+// AddMessageReadIDs adds the "message_reads" edge to the MessageRead entity by IDs.
+func (uuo *UserUpdateOne) AddMessageReadIDs(ids ...int64) *UserUpdateOne {
+	uuo.mutation.AddMessageReadIDs(ids...)
+	return uuo
+}
+
+// AddMessageReads adds the "message_reads" edges to the MessageRead entity.
+func (uuo *UserUpdateOne) AddMessageReads(m ...*MessageRead) *UserUpdateOne {
+	ids := make([]int64, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return uuo.AddMessageReadIDs(ids...)
+}
+
+// AddMessageReactionIDs adds the "message_reactions" edge to the MessageReaction entity by IDs.
+func (uuo *UserUpdateOne) AddMessageReactionIDs(ids ...int64) *UserUpdateOne {
+	uuo.mutation.AddMessageReactionIDs(ids...)
+	return uuo
+}
+
+// AddMessageReactions adds the "message_reactions" edges to the MessageReaction entity.
+func (uuo *UserUpdateOne) AddMessageReactions(m ...*MessageReaction) *UserUpdateOne {
+	ids := make([]int64, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return uuo.AddMessageReactionIDs(ids...)
+}
+
+// AddMessageRevisionIDs adds the "message_revisions" edge to the MessageRevision entity by IDs.
+func (uuo *UserUpdateOne) AddMessageRevisionIDs(ids ...int64) *UserUpdateOne {
+	uuo.mutation.AddMessageRevisionIDs(ids...)
+	return uuo
+}
+
+// AddMessageRevisions adds the "message_revisions" edges to the MessageRevision entity.
+func (uuo *UserUpdateOne) AddMessageRevisions(m ...*MessageRevision) *UserUpdateOne {
+	ids := make([]int64, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return uuo.AddMessageRevisionIDs(ids...)
+}
+
+// AddInviteIDs adds the "invites" edge to the Invite entity by IDs.
+func (uuo *UserUpdateOne) AddInviteIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.AddInviteIDs(ids...)
+	return uuo
+}
+
+// AddInvites adds the "invites" edges to the Invite entity.
+func (uuo *UserUpdateOne) AddInvites(i ...*Invite) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return uuo.AddInviteIDs(ids...)
+}
+
+// AddJoinRequestIDs adds the "join_requests" edge to the JoinRequest entity by IDs.
+func (uuo *UserUpdateOne) AddJoinRequestIDs(ids ...int64) *UserUpdateOne {
+	uuo.mutation.AddJoinRequestIDs(ids...)
+	return uuo
+}
+
+// AddJoinRequests adds the "join_requests" edges to the JoinRequest entity.
+func (uuo *UserUpdateOne) AddJoinRequests(j ...*JoinRequest) *UserUpdateOne {
+	ids := make([]int64, len(j))
+	for i := range j {
+		ids[i] = j[i].ID
+	}
+	return uuo.AddJoinRequestIDs(ids...)
+}
+
+// AddSessionIDs adds the "sessions" edge to the Session entity by IDs.
+func (uuo *UserUpdateOne) AddSessionIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.AddSessionIDs(ids...)
+	return uuo
+}
+
+// AddSessions adds the "sessions" edges to the Session entity.
+func (uuo *UserUpdateOne) AddSessions(s ...*Session) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return uuo.AddSessionIDs(ids...)
+}
+
+// AddSecurityEventIDs adds the "security_events" edge to the SecurityEvent entity by IDs.
+func (uuo *UserUpdateOne) AddSecurityEventIDs(ids ...int64) *UserUpdateOne {
+	uuo.mutation.AddSecurityEventIDs(ids...)
+	return uuo
+}
+
+// AddSecurityEvents adds the "security_events" edges to the SecurityEvent entity.
+func (uuo *UserUpdateOne) AddSecurityEvents(s ...*SecurityEvent) *UserUpdateOne {
+	ids := make([]int64, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return uuo.AddSecurityEventIDs(ids...)
+}
+
+// AddRevokedTokenIDs adds the "revoked_tokens" edge to the RevokedToken entity by IDs.
+func (uuo *UserUpdateOne) AddRevokedTokenIDs(ids ...string) *UserUpdateOne {
+	uuo.mutation.AddRevokedTokenIDs(ids...)
+	return uuo
+}
+
+// AddRevokedTokens adds the "revoked_tokens" edges to the RevokedToken entity.
+func (uuo *UserUpdateOne) AddRevokedTokens(r ...*RevokedToken) *UserUpdateOne {
+	ids := make([]string, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return uuo.AddRevokedTokenIDs(ids...)
+}
+
+// AddStreamTicketIDs adds the "stream_tickets" edge to the StreamTicket entity by IDs.
+func (uuo *UserUpdateOne) AddStreamTicketIDs(ids ...int64) *UserUpdateOne {
+	uuo.mutation.AddStreamTicketIDs(ids...)
+	return uuo
+}
+
+// AddStreamTickets adds the "stream_tickets" edges to the StreamTicket entity.
+func (uuo *UserUpdateOne) AddStreamTickets(s ...*StreamTicket) *UserUpdateOne {
+	ids := make([]int64, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return uuo.AddStreamTicketIDs(ids...)
+}
+
+// Mutation returns the UserMutation object of the builder.
+func (uuo *UserUpdateOne) Mutation() *UserMutation {
+	return uuo.mutation
+}
+
+// ClearRoomMembers clears all "room_members" edges to the RoomMember entity.
+func (uuo *UserUpdateOne) ClearRoomMembers() *UserUpdateOne {
+	uuo.mutation.ClearRoomMembers()
+	return uuo
+}
+
+// RemoveRoomMemberIDs removes the "room_members" edge to RoomMember entities by IDs.
+func (uuo *UserUpdateOne) RemoveRoomMemberIDs(ids ...int64) *UserUpdateOne {
+	uuo.mutation.RemoveRoomMemberIDs(ids...)
+	return uuo
+}
+
+// RemoveRoomMembers removes "room_members" edges to RoomMember entities.
+func (uuo *UserUpdateOne) RemoveRoomMembers(r ...*RoomMember) *UserUpdateOne {
+	ids := make([]int64, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return uuo.RemoveRoomMemberIDs(ids...)
+}
+
+// ClearMessages clears all "messages" edges to the Message entity.
+func (uuo *UserUpdateOne) ClearMessages() *UserUpdateOne {
+	uuo.mutation.ClearMessages()
+	return uuo
+}
+
+// RemoveMessageIDs removes the "messages" edge to Message entities by IDs.
+func (uuo *UserUpdateOne) RemoveMessageIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.RemoveMessageIDs(ids...)
+	return uuo
+}
+
+// RemoveMessages removes "messages" edges to Message entities.
+func (uuo *UserUpdateOne) RemoveMessages(m ...*Message) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return uuo.RemoveMessageIDs(ids...)
+}
+
+// ClearMessageReads clears all "message_reads" edges to the MessageRead entity.
+func (uuo *UserUpdateOne) ClearMessageReads() *UserUpdateOne {
+	uuo.mutation.ClearMessageReads()
+	return uuo
+}
+
+// RemoveMessageReadIDs removes the "message_reads" edge to MessageRead entities by IDs.
+func (uuo *UserUpdateOne) RemoveMessageReadIDs(ids ...int64) *UserUpdateOne {
+	uuo.mutation.RemoveMessageReadIDs(ids...)
+	return uuo
+}
+
+// RemoveMessageReads removes "message_reads" edges to MessageRead entities.
+func (uuo *UserUpdateOne) RemoveMessageReads(m ...*MessageRead) *UserUpdateOne {
+	ids := make([]int64, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return uuo.RemoveMessageReadIDs(ids...)
+}
+
+// ClearMessageReactions clears all "message_reactions" edges to the MessageReaction entity.
+func (uuo *UserUpdateOne) ClearMessageReactions() *UserUpdateOne {
+	uuo.mutation.ClearMessageReactions()
+	return uuo
+}
+
+// RemoveMessageReactionIDs removes the "message_reactions" edge to MessageReaction entities by IDs.
+func (uuo *UserUpdateOne) RemoveMessageReactionIDs(ids ...int64) *UserUpdateOne {
+	uuo.mutation.RemoveMessageReactionIDs(ids...)
+	return uuo
+}
+
+// RemoveMessageReactions removes "message_reactions" edges to MessageReaction entities.
+func (uuo *UserUpdateOne) RemoveMessageReactions(m ...*MessageReaction) *UserUpdateOne {
+	ids := make([]int64, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return uuo.RemoveMessageReactionIDs(ids...)
+}
+
+// ClearMessageRevisions clears all "message_revisions" edges to the MessageRevision entity.
+func (uuo *UserUpdateOne) ClearMessageRevisions() *UserUpdateOne {
+	uuo.mutation.ClearMessageRevisions()
 	return uuo
 }
 
-// SetBio sets the "bio" field.
-func (uuo *UserUpdateOne) SetBio(s string) *UserUpdateOne {
-	uuo.mutation.SetBio(s)
+// RemoveMessageRevisionIDs removes the "message_revisions" edge to MessageRevision entities by IDs.
+func (uuo *UserUpdateOne) RemoveMessageRevisionIDs(ids ...int64) *UserUpdateOne {
+	uuo.mutation.RemoveMessageRevisionIDs(ids...)
 	return uuo
 }
 
-// SetNillableBio sets the "bio" field if the given value is not nil.
-func (uuo *UserUpdateOne) SetNillableBio(s *string) *UserUpdateOne {
-	if s != nil {
-		uuo.SetBio(*s)
+// RemoveMessageRevisions removes "message_revisions" edges to MessageRevision entities.
+func (uuo *UserUpdateOne) RemoveMessageRevisions(m ...*MessageRevision) *UserUpdateOne {
+	ids := make([]int64, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
 	}
-	return uuo
+	return uuo.RemoveMessageRevisionIDs(ids...)
 }
 
-// ClearBio clears the value of the "bio" field.
-func (uuo *UserUpdateOne) ClearBio() *UserUpdateOne {
-	uuo.mutation.ClearBio()
+// ClearInvites clears all "invites" edges to the Invite entity.
+func (uuo *UserUpdateOne) ClearInvites() *UserUpdateOne {
+	uuo.mutation.ClearInvites()
 	return uuo
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (uuo *UserUpdateOne) SetUpdatedAt(t time.Time) *UserUpdateOne {
-	uuo.mutation.SetUpdatedAt(t)
+// RemoveInviteIDs removes the "invites" edge to Invite entities by IDs.
+func (uuo *UserUpdateOne) RemoveInviteIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.RemoveInviteIDs(ids...)
 	return uuo
 }
 
-// SetRefreshTokenHash sets the "refresh_token_hash" field.
-func (uuo *UserUpdateOne) SetRefreshTokenHash(b []byte) *UserUpdateOne {
-	uuo.mutation.SetRefreshTokenHash(b)
-	return uuo
+// RemoveInvites removes "invites" edges to Invite entities.
+func (uuo *UserUpdateOne) RemoveInvites(i ...*Invite) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return uuo.RemoveInviteIDs(ids...)
 }
 
-// ClearRefreshTokenHash clears the value of the "refresh_token_hash" field.
-func (uuo *UserUpdateOne) ClearRefreshTokenHash() *UserUpdateOne {
-	uuo.mutation.ClearRefreshTokenHash()
+// ClearJoinRequests clears all "join_requests" edges to the JoinRequest entity.
+func (uuo *UserUpdateOne) ClearJoinRequests() *UserUpdateOne {
+	uuo.mutation.ClearJoinRequests()
 	return uuo
 }
 
-// SetRefreshTokenExpiresAt sets the "refresh_token_expires_at" field.
-func (uuo *UserUpdateOne) SetRefreshTokenExpiresAt(t time.Time) *UserUpdateOne {
-	uuo.mutation.SetRefreshTokenExpiresAt(t)
+// RemoveJoinRequestIDs removes the "join_requests" edge to JoinRequest entities by IDs.
+func (uuo *UserUpdateOne) RemoveJoinRequestIDs(ids ...int64) *UserUpdateOne {
+	uuo.mutation.RemoveJoinRequestIDs(ids...)
 	return uuo
 }
 
-// SetNillableRefreshTokenExpiresAt sets the "refresh_token_expires_at" field if the given value is not nil.
-func (uuo *UserUpdateOne) SetNillableRefreshTokenExpiresAt(t *time.Time) *UserUpdateOne {
-	if t != nil {
-		uuo.SetRefreshTokenExpiresAt(*t)
+// RemoveJoinRequests removes "join_requests" edges to JoinRequest entities.
+func (uuo *UserUpdateOne) RemoveJoinRequests(j ...*JoinRequest) *UserUpdateOne {
+	ids := make([]int64, len(j))
+	for i := range j {
+		ids[i] = j[i].ID
 	}
-	return uuo
+	return uuo.RemoveJoinRequestIDs(ids...)
 }
 
-// ClearRefreshTokenExpiresAt clears the value of the "refresh_token_expires_at" field.
-func (uuo *UserUpdateOne) ClearRefreshTokenExpiresAt() *UserUpdateOne {
-	uuo.mutation.ClearRefreshTokenExpiresAt()
+// ClearSessions clears all "sessions" edges to the Session entity.
+func (uuo *UserUpdateOne) ClearSessions() *UserUpdateOne {
+	uuo.mutation.ClearSessions()
 	return uuo
 }
 
-// AddRoomMemberIDs adds the "room_members" edge to the RoomMember entity by IDs.
-func (uuo *UserUpdateOne) AddRoomMemberIDs(ids ...int64) *UserUpdateOne {
-	uuo.mutation.AddRoomMemberIDs(ids...)
+// RemoveSessionIDs removes the "sessions" edge to Session entities by IDs.
+func (uuo *UserUpdateOne) RemoveSessionIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.RemoveSessionIDs(ids...)
 	return uuo
 }
 
-// AddRoomMembers adds the "room_members" edges to the RoomMember entity.
-func (uuo *UserUpdateOne) AddRoomMembers(r ...*RoomMember) *UserUpdateOne {
-	ids := make([]int64, len(r))
-	for i := range r {
-		ids[i] = r[i].ID
+// RemoveSessions removes "sessions" edges to Session entities.
+func (uuo *UserUpdateOne) RemoveSessions(s ...*Session) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
 	}
-	return uuo.AddRoomMemberIDs(ids...)
+	return uuo.RemoveSessionIDs(ids...)
 }
 
-// AddMessageIDs adds the "messages" edge to the Message entity by IDs.
-func (uuo *UserUpdateOne) AddMessageIDs(ids ...uuid.UUID) *UserUpdateOne {
-	uuo.mutation.AddMessageIDs(ids...)
+// ClearSecurityEvents clears all "security_events" edges to the SecurityEvent entity.
+func (uuo *UserUpdateOne) ClearSecurityEvents() *UserUpdateOne {
+	uuo.mutation.ClearSecurityEvents()
 	return uuo
 }
 
-// AddMessages adds the "messages" edges to the Message entity.
-func (uuo *UserUpdateOne) AddMessages(m ...*Message) *UserUpdateOne {
-	ids := make([]uuid.UUID, len(m))
-	for i := range m {
-		ids[i] = m[i].ID
-	}
-	return uuo.AddMessageIDs(ids...)
+// RemoveSecurityEventIDs removes the "security_events" edge to SecurityEvent entities by IDs.
+func (uuo *UserUpdateOne) RemoveSecurityEventIDs(ids ...int64) *UserUpdateOne {
+	uuo.mutation.RemoveSecurityEventIDs(ids...)
+	return uuo
 }
 
-// Mutation returns the UserMutation object of the builder.
-func (uuo *UserUpdateOne) Mutation() *UserMutation {
-	return uuo.mutation
+// RemoveSecurityEvents removes "security_events" edges to SecurityEvent entities.
+func (uuo *UserUpdateOne) RemoveSecurityEvents(s ...*SecurityEvent) *UserUpdateOne {
+	ids := make([]int64, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return uuo.RemoveSecurityEventIDs(ids...)
 }
 
-// ClearRoomMembers clears all "room_members" edges to the RoomMember entity.
-func (uuo *UserUpdateOne) ClearRoomMembers() *UserUpdateOne {
-	uuo.mutation.ClearRoomMembers()
+// ClearRevokedTokens clears all "revoked_tokens" edges to the RevokedToken entity.
+func (uuo *UserUpdateOne) ClearRevokedTokens() *UserUpdateOne {
+	uuo.mutation.ClearRevokedTokens()
 	return uuo
 }
 
-// RemoveRoomMemberIDs removes the "room_members" edge to RoomMember entities by IDs.
-func (uuo *UserUpdateOne) RemoveRoomMemberIDs(ids ...int64) *UserUpdateOne {
-	uuo.mutation.RemoveRoomMemberIDs(ids...)
+// RemoveRevokedTokenIDs removes the "revoked_tokens" edge to RevokedToken entities by IDs.
+func (uuo *UserUpdateOne) RemoveRevokedTokenIDs(ids ...string) *UserUpdateOne {
+	uuo.mutation.RemoveRevokedTokenIDs(ids...)
 	return uuo
 }
 
-// RemoveRoomMembers removes "room_members" edges to RoomMember entities.
-func (uuo *UserUpdateOne) RemoveRoomMembers(r ...*RoomMember) *UserUpdateOne {
-	ids := make([]int64, len(r))
+// RemoveRevokedTokens removes "revoked_tokens" edges to RevokedToken entities.
+func (uuo *UserUpdateOne) RemoveRevokedTokens(r ...*RevokedToken) *UserUpdateOne {
+	ids := make([]string, len(r))
 	for i := range r {
 		ids[i] = r[i].ID
 	}
-	return uuo.RemoveRoomMemberIDs(ids...)
+	return uuo.RemoveRevokedTokenIDs(ids...)
 }
 
-// ClearMessages clears all "messages" edges to the Message entity.
-func (uuo *UserUpdateOne) ClearMessages() *UserUpdateOne {
-	uuo.mutation.ClearMessages()
+// ClearStreamTickets clears all "stream_tickets" edges to the StreamTicket entity.
+func (uuo *UserUpdateOne) ClearStreamTickets() *UserUpdateOne {
+	uuo.mutation.ClearStreamTickets()
 	return uuo
 }
 
-// RemoveMessageIDs removes the "messages" edge to Message entities by IDs.
-func (uuo *UserUpdateOne) RemoveMessageIDs(ids ...uuid.UUID) *UserUpdateOne {
-	uuo.mutation.RemoveMessageIDs(ids...)
+// RemoveStreamTicketIDs removes the "stream_tickets" edge to StreamTicket entities by IDs.
+func (uuo *UserUpdateOne) RemoveStreamTicketIDs(ids ...int64) *UserUpdateOne {
+	uuo.mutation.RemoveStreamTicketIDs(ids...)
 	return uuo
 }
 
-// RemoveMessages removes "messages" edges to Message entities.
-func (uuo *UserUpdateOne) RemoveMessages(m ...*Message) *UserUpdateOne {
-	ids := make([]uuid.UUID, len(m))
-	for i := range m {
-		ids[i] = m[i].ID
+// RemoveStreamTickets removes "stream_tickets" edges to StreamTicket entities.
+func (uuo *UserUpdateOne) RemoveStreamTickets(s ...*StreamTicket) *UserUpdateOne {
+	ids := make([]int64, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
 	}
-	return uuo.RemoveMessageIDs(ids...)
+	return uuo.RemoveStreamTicketIDs(ids...)
 }
 
 // Where appends a list predicates to the UserUpdate builder.
@@ -691,106 +1776,516 @@ func (uuo *UserUpdateOne) check() error {
 			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "User.name": %w`, err)}
 		}
 	}
-	if v, ok := uuo.mutation.Email(); ok {
-		if err := user.EmailValidator(v); err != nil {
-			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "User.email": %w`, err)}
+	if v, ok := uuo.mutation.Email(); ok {
+		if err := user.EmailValidator(v); err != nil {
+			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "User.email": %w`, err)}
+		}
+	}
+	if v, ok := uuo.mutation.PasswordHash(); ok {
+		if err := user.PasswordHashValidator(v); err != nil {
+			return &ValidationError{Name: "password_hash", err: fmt.Errorf(`ent: validator failed for field "User.password_hash": %w`, err)}
+		}
+	}
+	if v, ok := uuo.mutation.TokenVersion(); ok {
+		if err := user.TokenVersionValidator(v); err != nil {
+			return &ValidationError{Name: "token_version", err: fmt.Errorf(`ent: validator failed for field "User.token_version": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
+	if err := uuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(user.Table, user.Columns, sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID))
+	id, ok := uuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "User.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := uuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, user.FieldID)
+		for _, f := range fields {
+			if !user.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != user.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := uuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := uuo.mutation.Name(); ok {
+		_spec.SetField(user.FieldName, field.TypeString, value)
+	}
+	if value, ok := uuo.mutation.Email(); ok {
+		_spec.SetField(user.FieldEmail, field.TypeString, value)
+	}
+	if value, ok := uuo.mutation.PasswordHash(); ok {
+		_spec.SetField(user.FieldPasswordHash, field.TypeBytes, value)
+	}
+	if value, ok := uuo.mutation.ProfileImageURL(); ok {
+		_spec.SetField(user.FieldProfileImageURL, field.TypeString, value)
+	}
+	if uuo.mutation.ProfileImageURLCleared() {
+		_spec.ClearField(user.FieldProfileImageURL, field.TypeString)
+	}
+	if value, ok := uuo.mutation.Bio(); ok {
+		_spec.SetField(user.FieldBio, field.TypeString, value)
+	}
+	if uuo.mutation.BioCleared() {
+		_spec.ClearField(user.FieldBio, field.TypeString)
+	}
+	if value, ok := uuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(user.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := uuo.mutation.LastSeenAt(); ok {
+		_spec.SetField(user.FieldLastSeenAt, field.TypeTime, value)
+	}
+	if uuo.mutation.LastSeenAtCleared() {
+		_spec.ClearField(user.FieldLastSeenAt, field.TypeTime)
+	}
+	if value, ok := uuo.mutation.TokenVersion(); ok {
+		_spec.SetField(user.FieldTokenVersion, field.TypeInt, value)
+	}
+	if value, ok := uuo.mutation.AddedTokenVersion(); ok {
+		_spec.AddField(user.FieldTokenVersion, field.TypeInt, value)
+	}
+	if uuo.mutation.RoomMembersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.RoomMembersTable,
+			Columns: []string{user.RoomMembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roommember.FieldID, field.TypeInt64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedRoomMembersIDs(); len(nodes) > 0 && !uuo.mutation.RoomMembersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.RoomMembersTable,
+			Columns: []string{user.RoomMembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roommember.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RoomMembersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.RoomMembersTable,
+			Columns: []string{user.RoomMembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roommember.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uuo.mutation.MessagesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MessagesTable,
+			Columns: []string{user.MessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedMessagesIDs(); len(nodes) > 0 && !uuo.mutation.MessagesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MessagesTable,
+			Columns: []string{user.MessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.MessagesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MessagesTable,
+			Columns: []string{user.MessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uuo.mutation.MessageReadsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MessageReadsTable,
+			Columns: []string{user.MessageReadsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messageread.FieldID, field.TypeInt64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedMessageReadsIDs(); len(nodes) > 0 && !uuo.mutation.MessageReadsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MessageReadsTable,
+			Columns: []string{user.MessageReadsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messageread.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.MessageReadsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MessageReadsTable,
+			Columns: []string{user.MessageReadsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messageread.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uuo.mutation.MessageReactionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MessageReactionsTable,
+			Columns: []string{user.MessageReactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagereaction.FieldID, field.TypeInt64),
+			},
 		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if v, ok := uuo.mutation.PasswordHash(); ok {
-		if err := user.PasswordHashValidator(v); err != nil {
-			return &ValidationError{Name: "password_hash", err: fmt.Errorf(`ent: validator failed for field "User.password_hash": %w`, err)}
+	if nodes := uuo.mutation.RemovedMessageReactionsIDs(); len(nodes) > 0 && !uuo.mutation.MessageReactionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MessageReactionsTable,
+			Columns: []string{user.MessageReactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagereaction.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return nil
-}
-
-func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
-	if err := uuo.check(); err != nil {
-		return _node, err
+	if nodes := uuo.mutation.MessageReactionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MessageReactionsTable,
+			Columns: []string{user.MessageReactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagereaction.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	_spec := sqlgraph.NewUpdateSpec(user.Table, user.Columns, sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID))
-	id, ok := uuo.mutation.ID()
-	if !ok {
-		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "User.id" for update`)}
+	if uuo.mutation.MessageRevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MessageRevisionsTable,
+			Columns: []string{user.MessageRevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagerevision.FieldID, field.TypeInt64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	_spec.Node.ID.Value = id
-	if fields := uuo.fields; len(fields) > 0 {
-		_spec.Node.Columns = make([]string, 0, len(fields))
-		_spec.Node.Columns = append(_spec.Node.Columns, user.FieldID)
-		for _, f := range fields {
-			if !user.ValidColumn(f) {
-				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
-			}
-			if f != user.FieldID {
-				_spec.Node.Columns = append(_spec.Node.Columns, f)
-			}
+	if nodes := uuo.mutation.RemovedMessageRevisionsIDs(); len(nodes) > 0 && !uuo.mutation.MessageRevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MessageRevisionsTable,
+			Columns: []string{user.MessageRevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagerevision.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if ps := uuo.mutation.predicates; len(ps) > 0 {
-		_spec.Predicate = func(selector *sql.Selector) {
-			for i := range ps {
-				ps[i](selector)
-			}
+	if nodes := uuo.mutation.MessageRevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MessageRevisionsTable,
+			Columns: []string{user.MessageRevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagerevision.FieldID, field.TypeInt64),
+			},
 		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if value, ok := uuo.mutation.Name(); ok {
-		_spec.SetField(user.FieldName, field.TypeString, value)
+	if uuo.mutation.InvitesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.InvitesTable,
+			Columns: []string{user.InvitesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invite.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := uuo.mutation.Email(); ok {
-		_spec.SetField(user.FieldEmail, field.TypeString, value)
+	if nodes := uuo.mutation.RemovedInvitesIDs(); len(nodes) > 0 && !uuo.mutation.InvitesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.InvitesTable,
+			Columns: []string{user.InvitesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invite.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := uuo.mutation.PasswordHash(); ok {
-		_spec.SetField(user.FieldPasswordHash, field.TypeBytes, value)
+	if nodes := uuo.mutation.InvitesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.InvitesTable,
+			Columns: []string{user.InvitesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invite.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if value, ok := uuo.mutation.ProfileImageURL(); ok {
-		_spec.SetField(user.FieldProfileImageURL, field.TypeString, value)
+	if uuo.mutation.JoinRequestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.JoinRequestsTable,
+			Columns: []string{user.JoinRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(joinrequest.FieldID, field.TypeInt64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if uuo.mutation.ProfileImageURLCleared() {
-		_spec.ClearField(user.FieldProfileImageURL, field.TypeString)
+	if nodes := uuo.mutation.RemovedJoinRequestsIDs(); len(nodes) > 0 && !uuo.mutation.JoinRequestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.JoinRequestsTable,
+			Columns: []string{user.JoinRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(joinrequest.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := uuo.mutation.Bio(); ok {
-		_spec.SetField(user.FieldBio, field.TypeString, value)
+	if nodes := uuo.mutation.JoinRequestsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.JoinRequestsTable,
+			Columns: []string{user.JoinRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(joinrequest.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if uuo.mutation.BioCleared() {
-		_spec.ClearField(user.FieldBio, field.TypeString)
+	if uuo.mutation.SessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SessionsTable,
+			Columns: []string{user.SessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(session.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := uuo.mutation.UpdatedAt(); ok {
-		_spec.SetField(user.FieldUpdatedAt, field.TypeTime, value)
+	if nodes := uuo.mutation.RemovedSessionsIDs(); len(nodes) > 0 && !uuo.mutation.SessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SessionsTable,
+			Columns: []string{user.SessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(session.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := uuo.mutation.RefreshTokenHash(); ok {
-		_spec.SetField(user.FieldRefreshTokenHash, field.TypeBytes, value)
+	if nodes := uuo.mutation.SessionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SessionsTable,
+			Columns: []string{user.SessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(session.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if uuo.mutation.RefreshTokenHashCleared() {
-		_spec.ClearField(user.FieldRefreshTokenHash, field.TypeBytes)
+	if uuo.mutation.SecurityEventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SecurityEventsTable,
+			Columns: []string{user.SecurityEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(securityevent.FieldID, field.TypeInt64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := uuo.mutation.RefreshTokenExpiresAt(); ok {
-		_spec.SetField(user.FieldRefreshTokenExpiresAt, field.TypeTime, value)
+	if nodes := uuo.mutation.RemovedSecurityEventsIDs(); len(nodes) > 0 && !uuo.mutation.SecurityEventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SecurityEventsTable,
+			Columns: []string{user.SecurityEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(securityevent.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if uuo.mutation.RefreshTokenExpiresAtCleared() {
-		_spec.ClearField(user.FieldRefreshTokenExpiresAt, field.TypeTime)
+	if nodes := uuo.mutation.SecurityEventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SecurityEventsTable,
+			Columns: []string{user.SecurityEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(securityevent.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if uuo.mutation.RoomMembersCleared() {
+	if uuo.mutation.RevokedTokensCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.RoomMembersTable,
-			Columns: []string{user.RoomMembersColumn},
+			Table:   user.RevokedTokensTable,
+			Columns: []string{user.RevokedTokensColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(roommember.FieldID, field.TypeInt64),
+				IDSpec: sqlgraph.NewFieldSpec(revokedtoken.FieldID, field.TypeString),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uuo.mutation.RemovedRoomMembersIDs(); len(nodes) > 0 && !uuo.mutation.RoomMembersCleared() {
+	if nodes := uuo.mutation.RemovedRevokedTokensIDs(); len(nodes) > 0 && !uuo.mutation.RevokedTokensCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.RoomMembersTable,
-			Columns: []string{user.RoomMembersColumn},
+			Table:   user.RevokedTokensTable,
+			Columns: []string{user.RevokedTokensColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(roommember.FieldID, field.TypeInt64),
+				IDSpec: sqlgraph.NewFieldSpec(revokedtoken.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -798,15 +2293,15 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uuo.mutation.RoomMembersIDs(); len(nodes) > 0 {
+	if nodes := uuo.mutation.RevokedTokensIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.RoomMembersTable,
-			Columns: []string{user.RoomMembersColumn},
+			Table:   user.RevokedTokensTable,
+			Columns: []string{user.RevokedTokensColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(roommember.FieldID, field.TypeInt64),
+				IDSpec: sqlgraph.NewFieldSpec(revokedtoken.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -814,28 +2309,28 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if uuo.mutation.MessagesCleared() {
+	if uuo.mutation.StreamTicketsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.MessagesTable,
-			Columns: []string{user.MessagesColumn},
+			Table:   user.StreamTicketsTable,
+			Columns: []string{user.StreamTicketsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(streamticket.FieldID, field.TypeInt64),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uuo.mutation.RemovedMessagesIDs(); len(nodes) > 0 && !uuo.mutation.MessagesCleared() {
+	if nodes := uuo.mutation.RemovedStreamTicketsIDs(); len(nodes) > 0 && !uuo.mutation.StreamTicketsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.MessagesTable,
-			Columns: []string{user.MessagesColumn},
+			Table:   user.StreamTicketsTable,
+			Columns: []string{user.StreamTicketsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(streamticket.FieldID, field.TypeInt64),
 			},
 		}
 		for _, k := range nodes {
@@ -843,15 +2338,15 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uuo.mutation.MessagesIDs(); len(nodes) > 0 {
+	if nodes := uuo.mutation.StreamTicketsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.MessagesTable,
-			Columns: []string{user.MessagesColumn},
+			Table:   user.StreamTicketsTable,
+			Columns: []string{user.StreamTicketsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(streamticket.FieldID, field.TypeInt64),
 			},
 		}
 		for _, k := range nodes {
@@ -5,30 +5,19 @@ import (
 	"crypto/sha256"
 	"encoding/base64"
 	"errors"
-	"os"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
 	"golang.org/x/crypto/bcrypt"
 )
 
-// JWT秘密鍵を環境変数から取得
-func getJWTSecret() []byte {
-	secret := os.Getenv("JWT_SECRET")
-	if secret == "" {
-		panic("JWT_SECRETの環境変数を設定してください。")
-	}
-
-	if len(secret) < 32 {
-		panic("JWT_SECRETは32文字以上の十分に強度のある値を設定してください。")
-	}
-	return []byte(secret)
-}
-
 // JWTクレーム構造体
 type Claims struct {
-	UserID string `json:"user_id"`
-	Email  string `json:"email"`
+	UserID       string `json:"user_id"`
+	Email        string `json:"email"`
+	SessionID    string `json:"sid,omitempty"` // 発行元のログインセッションID（セッション失効時にトークンも無効にする）
+	TokenVersion int    `json:"ver"`           // 発行時のユーザーのトークンバージョン（RevocationStore.RevokeUserで一括失効）
 	jwt.RegisteredClaims
 }
 
@@ -47,32 +36,38 @@ func CheckPasswordHash(password string, hashedPassword []byte) bool {
 	return err == nil
 }
 
-// JWTトークンを生成する
-func GenerateJWT(userID, email string) (string, error) {
+// JWTトークンを生成する（sessionIDは発行元のログインセッションID、tokenVersionはユーザーの現在のトークンバージョン）
+func GenerateJWT(userID, email, sessionID string, tokenVersion int) (string, error) {
 	claims := &Claims{
-		UserID: userID,
-		Email:  email,
+		UserID:       userID,
+		Email:        email,
+		SessionID:    sessionID,
+		TokenVersion: tokenVersion,
 		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        uuid.NewString(),                                  // 個別に失効させるためのトークンID（jti）
 			ExpiresAt: jwt.NewNumericDate(time.Now().Add(1 * time.Hour)), // 1時間有効
 			IssuedAt:  jwt.NewNumericDate(time.Now()),
 			Issuer:    "chat-app",
 		},
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	return token.SignedString(getJWTSecret())
+	keys, err := currentKeySet()
+	if err != nil {
+		return "", err
+	}
+	return keys.Sign(claims)
 }
 
 // JWTトークンを検証する
 func ValidateJWT(tokenString string) (*Claims, error) {
+	keys, err := currentKeySet()
+	if err != nil {
+		return nil, err
+	}
+
 	claims := &Claims{}
 
-	token, err := jwt.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (interface{}, error) {
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, errors.New("unexpected signing method")
-		}
-		return getJWTSecret(), nil
-	}, jwt.WithIssuer("chat-app"), jwt.WithLeeway(30*time.Second))
+	token, err := jwt.ParseWithClaims(tokenString, claims, keys.keyFunc, jwt.WithIssuer("chat-app"), jwt.WithLeeway(30*time.Second))
 
 	if err != nil {
 		return nil, err
@@ -100,7 +95,43 @@ func HashRefreshToken(token string) []byte {
 	return sum[:]
 }
 
+// RefreshTokenTTL リフレッシュトークンの有効期間
+const RefreshTokenTTL = 7 * 24 * time.Hour
+
 // リフレッシュトークンの有効期限を取得（7日間）
 func GetRefreshTokenExpiry() time.Time {
-	return time.Now().Add(7 * 24 * time.Hour)
+	return time.Now().Add(RefreshTokenTTL)
+}
+
+// 招待トークンを生成する（URLに含めるためパディングなしのランダムな32バイト文字列）
+func GenerateInviteToken() (string, error) {
+	bytes := make([]byte, 32)
+	if _, err := rand.Read(bytes); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(bytes), nil
+}
+
+// 招待トークンをハッシュ化する（リフレッシュトークンと同様にDBにはハッシュのみ保存する）
+func HashInviteToken(token string) []byte {
+	sum := sha256.Sum256([]byte(token))
+	return sum[:]
+}
+
+// StreamTicketTTL ストリーミング接続用チケットの有効期間（発行直後の接続にのみ使用する）
+const StreamTicketTTL = 30 * time.Second
+
+// ストリーミング接続用チケットを生成する（URLに含めるためパディングなしのランダムな32バイト文字列）
+func GenerateStreamTicket() (string, error) {
+	bytes := make([]byte, 32)
+	if _, err := rand.Read(bytes); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(bytes), nil
+}
+
+// ストリーミング接続用チケットをハッシュ化する（DBにはハッシュのみ保存する）
+func HashStreamTicket(ticket string) []byte {
+	sum := sha256.Sum256([]byte(ticket))
+	return sum[:]
 }
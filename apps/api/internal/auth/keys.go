@@ -0,0 +1,272 @@
+package auth
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync/atomic"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// 鍵設定の環境変数
+const (
+	jwtSecretKey       = "JWT_SECRET"         // HS256の共有鍵（鍵ディレクトリ未設定時の開発用、設定時はkidなしの旧トークンの検証のみ）
+	jwtKeysDirKey      = "JWT_KEYS_DIR"       // 署名鍵・検証鍵（<kid>.pem）を配置するディレクトリ
+	jwtSigningKeyIDKey = "JWT_SIGNING_KEY_ID" // 署名に使用する鍵のkid（秘密鍵が1つのみの場合は省略可）
+)
+
+// RSA鍵の最小ビット数
+const minRSAKeyBits = 2048
+
+// verificationKey kidごとの検証鍵と署名アルゴリズム
+type verificationKey struct {
+	method jwt.SigningMethod
+	key    crypto.PublicKey
+}
+
+// KeySet JWTの署名鍵と検証鍵の集合
+// 署名鍵は1つのみで、ローテーション前の鍵は検証鍵として残し発行済みのトークンを有効期限まで検証できるようにする
+type KeySet struct {
+	signingKID    string
+	signingMethod jwt.SigningMethod
+	signingKey    crypto.PrivateKey
+	verifyKeys    map[string]verificationKey
+	hmacSecret    []byte // kidを持たないHS256トークンの検証用
+}
+
+// JWK 公開鍵のJSON Web Key表現
+type JWK struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	Crv string `json:"crv,omitempty"` // OKP（Ed25519）のみ
+	X   string `json:"x,omitempty"`   // OKP（Ed25519）のみ
+	N   string `json:"n,omitempty"`   // RSAのみ
+	E   string `json:"e,omitempty"`   // RSAのみ
+}
+
+// JWKS 公開鍵一覧（/.well-known/jwks.jsonのレスポンス）
+type JWKS struct {
+	Keys []JWK `json:"keys"`
+}
+
+// configuredKeys GenerateJWT・ValidateJWTで使用する鍵
+var configuredKeys atomic.Pointer[KeySet]
+
+// SetKeySet GenerateJWT・ValidateJWTで使用する鍵を設定（nilの場合は呼び出しごとに環境変数から読み込む）
+func SetKeySet(keys *KeySet) {
+	configuredKeys.Store(keys)
+}
+
+// currentKeySet 設定済みの鍵、未設定の場合は環境変数から読み込んだ鍵を取得
+func currentKeySet() (*KeySet, error) {
+	if keys := configuredKeys.Load(); keys != nil {
+		return keys, nil
+	}
+	return LoadKeySetFromEnv()
+}
+
+// LoadKeySetFromEnv 環境変数の設定から鍵を読み込む
+// JWT_KEYS_DIRが設定されている場合は非対称鍵で署名し、未設定の場合はJWT_SECRETによるHS256で署名する
+func LoadKeySetFromEnv() (*KeySet, error) {
+	secret := os.Getenv(jwtSecretKey)
+	if secret != "" && len(secret) < 32 {
+		return nil, errors.New("JWT_SECRETは32文字以上の十分に強度のある値を設定してください。")
+	}
+
+	dir := os.Getenv(jwtKeysDirKey)
+	if dir == "" {
+		if secret == "" {
+			return nil, errors.New("JWT_KEYS_DIRまたはJWT_SECRETの環境変数を設定してください。")
+		}
+		return &KeySet{
+			signingMethod: jwt.SigningMethodHS256,
+			signingKey:    []byte(secret),
+			verifyKeys:    map[string]verificationKey{},
+			hmacSecret:    []byte(secret),
+		}, nil
+	}
+
+	keys, err := LoadKeySetFromDir(dir, os.Getenv(jwtSigningKeyIDKey))
+	if err != nil {
+		return nil, err
+	}
+	// 非対称鍵への移行前に発行されたトークンを有効期限まで検証できるようにする
+	if secret != "" {
+		keys.hmacSecret = []byte(secret)
+	}
+	return keys, nil
+}
+
+// LoadKeySetFromDir ディレクトリ内の<kid>.pemファイルから鍵を読み込む
+// 秘密鍵（RSA・Ed25519）は署名・検証に、公開鍵は検証のみに使用する
+// signingKIDが空の場合、秘密鍵が1つのみであればその鍵で署名する
+func LoadKeySetFromDir(dir, signingKID string) (*KeySet, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.pem"))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(paths)
+
+	keys := &KeySet{verifyKeys: make(map[string]verificationKey, len(paths))}
+	privateKeys := make(map[string]crypto.PrivateKey)
+	for _, path := range paths {
+		kid := strings.TrimSuffix(filepath.Base(path), ".pem")
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		privateKey, publicKey, err := parseKeyPEM(data)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+		method, err := signingMethodFor(publicKey)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+
+		keys.verifyKeys[kid] = verificationKey{method: method, key: publicKey}
+		if privateKey != nil {
+			privateKeys[kid] = privateKey
+		}
+	}
+
+	if len(keys.verifyKeys) == 0 {
+		return nil, fmt.Errorf("%sに鍵ファイル（<kid>.pem）がありません", dir)
+	}
+
+	if signingKID == "" {
+		if len(privateKeys) != 1 {
+			return nil, errors.New("署名に使用する鍵をJWT_SIGNING_KEY_IDで指定してください。")
+		}
+		for kid := range privateKeys {
+			signingKID = kid
+		}
+	}
+	signingKey, ok := privateKeys[signingKID]
+	if !ok {
+		return nil, fmt.Errorf("署名鍵 %q の秘密鍵が見つかりません", signingKID)
+	}
+
+	keys.signingKID = signingKID
+	keys.signingKey = signingKey
+	keys.signingMethod = keys.verifyKeys[signingKID].method
+	return keys, nil
+}
+
+// parseKeyPEM PEM形式の秘密鍵（PKCS#8・PKCS#1）または公開鍵（PKIX）を解析
+// 秘密鍵の場合は対応する公開鍵もあわせて返す
+func parseKeyPEM(data []byte) (crypto.PrivateKey, crypto.PublicKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, nil, errors.New("PEM形式の鍵ではありません")
+	}
+
+	switch block.Type {
+	case "PRIVATE KEY":
+		key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+		if err != nil {
+			return nil, nil, err
+		}
+		signer, ok := key.(crypto.Signer)
+		if !ok {
+			return nil, nil, errors.New("署名に使用できない秘密鍵です")
+		}
+		return key, signer.Public(), nil
+	case "RSA PRIVATE KEY":
+		key, err := x509.ParsePKCS1PrivateKey(block.Bytes)
+		if err != nil {
+			return nil, nil, err
+		}
+		return key, key.Public(), nil
+	case "PUBLIC KEY":
+		key, err := x509.ParsePKIXPublicKey(block.Bytes)
+		if err != nil {
+			return nil, nil, err
+		}
+		return nil, key, nil
+	}
+	return nil, nil, fmt.Errorf("未対応のPEMブロックです: %s", block.Type)
+}
+
+// signingMethodFor 公開鍵の種類に対応する署名アルゴリズム（RSA: RS256、Ed25519: EdDSA）
+func signingMethodFor(publicKey crypto.PublicKey) (jwt.SigningMethod, error) {
+	switch key := publicKey.(type) {
+	case *rsa.PublicKey:
+		if key.N.BitLen() < minRSAKeyBits {
+			return nil, fmt.Errorf("RSA鍵は%dビット以上にしてください", minRSAKeyBits)
+		}
+		return jwt.SigningMethodRS256, nil
+	case ed25519.PublicKey:
+		return jwt.SigningMethodEdDSA, nil
+	}
+	return nil, fmt.Errorf("未対応の鍵の種類です: %T", publicKey)
+}
+
+// Sign クレームに署名してトークンを作成（非対称鍵の場合はヘッダーにkidを設定）
+func (k *KeySet) Sign(claims jwt.Claims) (string, error) {
+	token := jwt.NewWithClaims(k.signingMethod, claims)
+	if k.signingKID != "" {
+		token.Header["kid"] = k.signingKID
+	}
+	return token.SignedString(k.signingKey)
+}
+
+// keyFunc トークンのkidとアルゴリズムに対応する検証鍵を返す
+func (k *KeySet) keyFunc(token *jwt.Token) (interface{}, error) {
+	kid, _ := token.Header["kid"].(string)
+	if kid == "" {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok || k.hmacSecret == nil {
+			return nil, errors.New("unexpected signing method")
+		}
+		return k.hmacSecret, nil
+	}
+
+	key, ok := k.verifyKeys[kid]
+	if !ok {
+		return nil, fmt.Errorf("unknown key id: %s", kid)
+	}
+	if token.Method.Alg() != key.method.Alg() {
+		return nil, errors.New("unexpected signing method")
+	}
+	return key.key, nil
+}
+
+// JWKS 検証用の公開鍵一覧（HS256の共有鍵は含めない）
+func (k *KeySet) JWKS() JWKS {
+	kids := make([]string, 0, len(k.verifyKeys))
+	for kid := range k.verifyKeys {
+		kids = append(kids, kid)
+	}
+	sort.Strings(kids)
+
+	jwks := JWKS{Keys: make([]JWK, 0, len(kids))}
+	for _, kid := range kids {
+		key := k.verifyKeys[kid]
+		jwk := JWK{Kid: kid, Use: "sig", Alg: key.method.Alg()}
+		switch publicKey := key.key.(type) {
+		case *rsa.PublicKey:
+			jwk.Kty = "RSA"
+			jwk.N = base64.RawURLEncoding.EncodeToString(publicKey.N.Bytes())
+			jwk.E = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(publicKey.E)).Bytes())
+		case ed25519.PublicKey:
+			jwk.Kty = "OKP"
+			jwk.Crv = "Ed25519"
+			jwk.X = base64.RawURLEncoding.EncodeToString(publicKey)
+		}
+		jwks.Keys = append(jwks.Keys, jwk)
+	}
+	return jwks
+}
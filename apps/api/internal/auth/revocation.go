@@ -0,0 +1,236 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/predicate"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/revokedtoken"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/rotatedrefreshtoken"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/session"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/user"
+)
+
+// RevocationStore アクセストークンの失効状態を管理するストア
+type RevocationStore interface {
+	// Revoke トークンID（jti）を指定してトークンを有効期限まで失効させる
+	Revoke(ctx context.Context, claims *Claims) error
+	// RevokeUser ユーザーのトークンバージョンを更新し、発行済みのトークンとセッションを全て失効させる
+	RevokeUser(ctx context.Context, userID uuid.UUID) error
+	// IsRevoked トークンIDまたはトークンバージョンによってトークンが失効しているか
+	IsRevoked(ctx context.Context, claims *Claims) (bool, error)
+}
+
+// DefaultRevocationCacheTTL 失効状態をメモリにキャッシュする時間
+// 他のインスタンスで行われた失効は最大でこの時間だけ遅れて反映される
+const DefaultRevocationCacheTTL = 10 * time.Second
+
+// キャッシュのエントリ数がこの値を超えたら期限切れのエントリを削除する
+const revocationCacheSweepSize = 10000
+
+type cachedRevocation struct {
+	revoked bool
+	until   time.Time
+}
+
+type cachedVersion struct {
+	version int
+	until   time.Time
+}
+
+// DBRevocationStore データベースに失効情報を保存し、結果をメモリにキャッシュするRevocationStore
+type DBRevocationStore struct {
+	client *ent.Client
+	ttl    time.Duration
+
+	mu       sync.Mutex
+	tokens   map[string]cachedRevocation
+	versions map[uuid.UUID]cachedVersion
+}
+
+// NewDBRevocationStore 新しいDBRevocationStoreを作成（ttlが0以下の場合はキャッシュしない）
+func NewDBRevocationStore(client *ent.Client, ttl time.Duration) *DBRevocationStore {
+	return &DBRevocationStore{
+		client:   client,
+		ttl:      ttl,
+		tokens:   make(map[string]cachedRevocation),
+		versions: make(map[uuid.UUID]cachedVersion),
+	}
+}
+
+// Revoke トークンIDを失効リストに追加（期限切れの記録はあわせて削除）
+func (s *DBRevocationStore) Revoke(ctx context.Context, claims *Claims) error {
+	if claims.ID == "" || claims.ExpiresAt == nil {
+		return nil
+	}
+	userID, err := uuid.Parse(claims.UserID)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	if _, err := s.client.RevokedToken.Delete().
+		Where(revokedtoken.ExpiresAtLT(now)).
+		Exec(ctx); err != nil {
+		return err
+	}
+	err = s.client.RevokedToken.Create().
+		SetID(claims.ID).
+		SetUserID(userID).
+		SetExpiresAt(claims.ExpiresAt.Time).
+		Exec(ctx)
+	// 既に失効済みの場合は一意制約違反になるが、結果は同じため成功として扱う
+	if err != nil && !ent.IsConstraintError(err) {
+		return err
+	}
+
+	// 失効したトークンは有効期限まで失効したままのため、期限までキャッシュできる
+	s.mu.Lock()
+	s.tokens[claims.ID] = cachedRevocation{revoked: true, until: claims.ExpiresAt.Time}
+	s.mu.Unlock()
+	return nil
+}
+
+// RevokeUser ユーザーのトークンバージョンを1つ進め、全てのセッションを削除する
+// セッションが残っているとリフレッシュで新しいバージョンのトークンが発行できてしまうため、同じトランザクションで行う
+func (s *DBRevocationStore) RevokeUser(ctx context.Context, userID uuid.UUID) error {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	updated, err := tx.User.UpdateOneID(userID).
+		AddTokenVersion(1).
+		Save(ctx)
+	if err == nil {
+		_, err = RevokeSessions(ctx, tx.Client(), session.UserID(userID))
+	}
+	if err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			err = fmt.Errorf("%w: rolling back transaction: %v", err, rerr)
+		}
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	s.versions[userID] = cachedVersion{version: updated.TokenVersion, until: time.Now().Add(s.ttl)}
+	s.mu.Unlock()
+	return nil
+}
+
+// RevokeSessions 条件に一致するセッションを、ローテーション済みのリフレッシュトークン（トークンファミリー）とあわせて削除
+// 複数の削除を行うため、呼び出し側でトランザクション内のクライアントを渡す
+func RevokeSessions(ctx context.Context, client *ent.Client, ps ...predicate.Session) (int, error) {
+	ids, err := client.Session.Query().Where(ps...).IDs(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if len(ids) == 0 {
+		return 0, nil
+	}
+
+	if _, err := client.RotatedRefreshToken.Delete().
+		Where(rotatedrefreshtoken.SessionIDIn(ids...)).
+		Exec(ctx); err != nil {
+		return 0, err
+	}
+	return client.Session.Delete().Where(session.IDIn(ids...)).Exec(ctx)
+}
+
+// IsRevoked トークンが失効リストに含まれるか、ユーザーの現在のトークンバージョンより古いか確認
+// jtiを持たないトークンはトークンバージョンのみ確認する
+func (s *DBRevocationStore) IsRevoked(ctx context.Context, claims *Claims) (bool, error) {
+	userID, err := uuid.Parse(claims.UserID)
+	if err != nil {
+		return true, nil
+	}
+
+	version, err := s.tokenVersion(ctx, userID)
+	if ent.IsNotFound(err) {
+		// 削除済みのユーザーのトークンは全て失効扱い
+		return true, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	if claims.TokenVersion < version {
+		return true, nil
+	}
+
+	if claims.ID == "" {
+		return false, nil
+	}
+	return s.tokenRevoked(ctx, claims.ID)
+}
+
+// tokenVersion ユーザーの現在のトークンバージョンを取得
+func (s *DBRevocationStore) tokenVersion(ctx context.Context, userID uuid.UUID) (int, error) {
+	now := time.Now()
+	s.mu.Lock()
+	cached, ok := s.versions[userID]
+	s.mu.Unlock()
+	if ok && now.Before(cached.until) {
+		return cached.version, nil
+	}
+
+	u, err := s.client.User.Query().
+		Where(user.ID(userID)).
+		Select(user.FieldTokenVersion).
+		Only(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	s.mu.Lock()
+	s.sweep(now)
+	s.versions[userID] = cachedVersion{version: u.TokenVersion, until: now.Add(s.ttl)}
+	s.mu.Unlock()
+	return u.TokenVersion, nil
+}
+
+// tokenRevoked トークンIDが失効リストに含まれるか
+func (s *DBRevocationStore) tokenRevoked(ctx context.Context, jti string) (bool, error) {
+	now := time.Now()
+	s.mu.Lock()
+	cached, ok := s.tokens[jti]
+	s.mu.Unlock()
+	if ok && now.Before(cached.until) {
+		return cached.revoked, nil
+	}
+
+	revoked, err := s.client.RevokedToken.Query().
+		Where(revokedtoken.ID(jti)).
+		Exist(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	s.mu.Lock()
+	s.sweep(now)
+	s.tokens[jti] = cachedRevocation{revoked: revoked, until: now.Add(s.ttl)}
+	s.mu.Unlock()
+	return revoked, nil
+}
+
+// sweep キャッシュが大きくなった場合に期限切れのエントリを削除（ロックを取得した状態で呼び出す）
+func (s *DBRevocationStore) sweep(now time.Time) {
+	if len(s.tokens)+len(s.versions) < revocationCacheSweepSize {
+		return
+	}
+	for jti, cached := range s.tokens {
+		if !now.Before(cached.until) {
+			delete(s.tokens, jti)
+		}
+	}
+	for userID, cached := range s.versions {
+		if !now.Before(cached.until) {
+			delete(s.versions, userID)
+		}
+	}
+}
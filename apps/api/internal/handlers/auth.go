@@ -2,6 +2,7 @@ package handlers
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -10,20 +11,43 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/hideaki1979/cc-chat-app/apps/api/ent"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/rotatedrefreshtoken"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/securityevent"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/session"
 	"github.com/hideaki1979/cc-chat-app/apps/api/ent/user"
 	"github.com/hideaki1979/cc-chat-app/apps/api/internal/auth"
 	"github.com/hideaki1979/cc-chat-app/apps/api/internal/middleware"
 	"github.com/hideaki1979/cc-chat-app/apps/api/internal/models"
+	"github.com/hideaki1979/cc-chat-app/apps/api/internal/realtime"
 	"github.com/hideaki1979/cc-chat-app/apps/api/util"
 	"github.com/labstack/echo/v4"
 )
 
 // AuthHandler 認証関連のハンドラー構造体
-type AuthHandler struct{}
+type AuthHandler struct {
+	hub         *realtime.Hub        // ユーザーのオンライン状態の参照用（nilの場合は全員オフライン）
+	revocations auth.RevocationStore // ログアウト時のアクセストークン失効用（nilの場合は失効させない）
+}
 
 // NewAuthHandler 新しいAuthHandlerインスタンスを作成
-func NewAuthHandler() *AuthHandler {
-	return &AuthHandler{}
+func NewAuthHandler(hub *realtime.Hub, revocations auth.RevocationStore) *AuthHandler {
+	return &AuthHandler{hub: hub, revocations: revocations}
+}
+
+// createSession 端末ごとのログインセッションを作成（DBにはリフレッシュトークンのハッシュのみ保存）
+func createSession(ctx context.Context, client *ent.Client, c echo.Context, userID uuid.UUID, deviceLabel, refreshToken string) (*ent.Session, error) {
+	create := client.Session.Create().
+		SetUserID(userID).
+		SetRefreshTokenHash(auth.HashRefreshToken(refreshToken)).
+		SetExpiresAt(auth.GetRefreshTokenExpiry()).
+		SetIPAddress(c.RealIP())
+	if deviceLabel != "" {
+		create = create.SetDeviceLabel(deviceLabel)
+	}
+	if userAgent := c.Request().UserAgent(); userAgent != "" {
+		create = create.SetUserAgent(userAgent)
+	}
+	return create.Save(ctx)
 }
 
 // Register ユーザー登録ハンドラー
@@ -83,18 +107,22 @@ func (h *AuthHandler) Register(c echo.Context) error {
 		})
 	}
 
-	// リフレッシュトークンをハッシュ化してDB保存用に準備
-	hashedRefreshToken := auth.HashRefreshToken(refreshToken)
-	refreshTokenExpiry := auth.GetRefreshTokenExpiry()
-
-	// 新しいユーザーを作成（IDは自動生成）
-	newUser, err := client.User.Create().
-		SetName(req.Name).
-		SetEmail(req.Email).
-		SetPasswordHash(hashedPassword).
-		SetRefreshTokenHash(hashedRefreshToken).
-		SetRefreshTokenExpiresAt(refreshTokenExpiry).
-		Save(ctx)
+	// 新しいユーザーとログインセッションを同じトランザクションで作成（IDは自動生成）
+	var newUser *ent.User
+	var newSession *ent.Session
+	err = withTx(ctx, client, func(tx *ent.Tx) error {
+		var err error
+		newUser, err = tx.User.Create().
+			SetName(req.Name).
+			SetEmail(req.Email).
+			SetPasswordHash(hashedPassword).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+		newSession, err = createSession(ctx, tx.Client(), c, newUser.ID, req.DeviceLabel, refreshToken)
+		return err
+	})
 	if err != nil {
 		c.Logger().Errorf("create user error: %v", err)
 		return c.JSON(http.StatusInternalServerError, models.ErrorResponse{
@@ -104,7 +132,7 @@ func (h *AuthHandler) Register(c echo.Context) error {
 	}
 
 	// JWTトークン生成
-	token, err := auth.GenerateJWT(newUser.ID.String(), newUser.Email)
+	token, err := auth.GenerateJWT(newUser.ID.String(), newUser.Email, newSession.ID.String(), newUser.TokenVersion)
 	if err != nil {
 		c.Logger().Errorf("generate jwt error: %v", err)
 		return c.JSON(http.StatusInternalServerError, models.ErrorResponse{
@@ -181,15 +209,6 @@ func (h *AuthHandler) Login(c echo.Context) error {
 		})
 	}
 
-	// JWTトークン生成
-	token, err := auth.GenerateJWT(existingUser.ID.String(), existingUser.Email)
-	if err != nil {
-		return c.JSON(http.StatusInternalServerError, models.ErrorResponse{
-			Message: "JWTトークンの生成中にエラーが発生しました",
-			Code:    "TOKEN_ERROR",
-		})
-	}
-
 	// リフレッシュトークン生成
 	refreshToken, err := auth.GenerateRefreshToken()
 	if err != nil {
@@ -199,14 +218,8 @@ func (h *AuthHandler) Login(c echo.Context) error {
 		})
 	}
 
-	// データベースにリフレッシュトークンを保存
-	// リフレッシュトークンをハッシュ化してDB更新
-	hashedRefreshToken := auth.HashRefreshToken(refreshToken)
-	refreshTokenExpiry := auth.GetRefreshTokenExpiry()
-	_, err = client.User.UpdateOne(existingUser).
-		SetRefreshTokenHash(hashedRefreshToken).
-		SetRefreshTokenExpiresAt(refreshTokenExpiry).
-		Save(ctx)
+	// 端末ごとのセッションとしてリフレッシュトークンを保存（他の端末のセッションはそのまま）
+	newSession, err := createSession(ctx, client, c, existingUser.ID, req.DeviceLabel, refreshToken)
 	if err != nil {
 		return c.JSON(http.StatusInternalServerError, models.ErrorResponse{
 			Message: "リフレッシュトークンの保存に失敗しました",
@@ -214,6 +227,15 @@ func (h *AuthHandler) Login(c echo.Context) error {
 		})
 	}
 
+	// JWTトークン生成（セッションを失効させるとこのトークンも無効になる）
+	token, err := auth.GenerateJWT(existingUser.ID.String(), existingUser.Email, newSession.ID.String(), existingUser.TokenVersion)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Message: "JWTトークンの生成中にエラーが発生しました",
+			Code:    "TOKEN_ERROR",
+		})
+	}
+
 	// リフレッシュトークンをhttpOnly Cookieに設定
 	cookie := &http.Cookie{
 		Name:     "refresh_token",
@@ -248,16 +270,14 @@ func (h *AuthHandler) Logout(c echo.Context) error {
 	client := c.Get("db").(*ent.Client)
 	ctx := context.Background()
 
-	// Cookieからリフレッシュトークンを取得してDBから削除
+	// Cookieからリフレッシュトークンを取得してこの端末のセッションのみ削除
 	cookie, err := c.Cookie("refresh_token")
 	if err == nil && cookie.Value != "" {
-		// リフレッシュトークンに基づいてユーザーを検索し、トークンをクリア
 		hashedToken := auth.HashRefreshToken(cookie.Value)
-		_, updateErr := client.User.Update().
-			Where(user.RefreshTokenHashEQ(hashedToken)).
-			ClearRefreshTokenHash().
-			ClearRefreshTokenExpiresAt().
-			Save(ctx)
+		updateErr := withTx(ctx, client, func(tx *ent.Tx) error {
+			_, err := auth.RevokeSessions(ctx, tx.Client(), session.RefreshTokenHashEQ(hashedToken))
+			return err
+		})
 		if updateErr != nil {
 			// DBエラーがあってもクライアント側はクリアする
 			// サーバーエラーは内部ログのみ
@@ -265,6 +285,25 @@ func (h *AuthHandler) Logout(c echo.Context) error {
 		}
 	}
 
+	// アクセストークンが送信された場合は有効期限を待たずに失効させ、この端末のリアルタイム接続を切断する
+	authHeader := c.Request().Header.Get("Authorization")
+	if tokenString, ok := strings.CutPrefix(authHeader, "Bearer "); ok && tokenString != "" {
+		if claims, err := auth.ValidateJWT(tokenString); err == nil {
+			if h.revocations != nil {
+				if err := h.revocations.Revoke(ctx, claims); err != nil {
+					c.Logger().Errorf("revoke access token error: %v", err)
+				}
+			}
+			userUUID, userErr := uuid.Parse(claims.UserID)
+			sessionUUID, sessionErr := uuid.Parse(claims.SessionID)
+			if userErr == nil && sessionErr == nil {
+				if err := h.hub.DisconnectSession(ctx, userUUID, sessionUUID); err != nil {
+					c.Logger().Errorf("disconnect session error: %v", err)
+				}
+			}
+		}
+	}
+
 	// リフレッシュトークンCookieを削除
 	clearCookie := &http.Cookie{
 		Name:     "refresh_token",
@@ -364,9 +403,10 @@ func (h *AuthHandler) RefreshToken(c echo.Context) error {
 	client := c.Get("db").(*ent.Client)
 	ctx := context.Background()
 
-	// リフレッシュトークンでユーザーを検索
-	existingUser, err := client.User.Query().
-		Where(user.RefreshTokenHashEQ(hashedRefreshToken)).
+	// リフレッシュトークンでセッションを検索
+	existingSession, err := client.Session.Query().
+		Where(session.RefreshTokenHashEQ(hashedRefreshToken)).
+		WithUser().
 		Only(ctx)
 	if err != nil {
 		if !ent.IsNotFound(err) {
@@ -375,6 +415,22 @@ func (h *AuthHandler) RefreshToken(c echo.Context) error {
 				Code:    "DATABASE_ERROR",
 			})
 		}
+
+		// ローテーション済みのトークンが再利用された場合は盗用とみなす
+		rotated, err := client.RotatedRefreshToken.Query().
+			Where(rotatedrefreshtoken.TokenHashEQ(hashedRefreshToken)).
+			WithSession().
+			Only(ctx)
+		if err == nil {
+			return h.handleRefreshTokenReuse(c, client, rotated.Edges.Session)
+		}
+		if !ent.IsNotFound(err) {
+			return c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+				Message: "DBエラーが発生しました",
+				Code:    "DATABASE_ERROR",
+			})
+		}
+
 		return c.JSON(http.StatusUnauthorized, models.ErrorResponse{
 			Message: "リフレッシュトークンが無効です",
 			Code:    "INVALID_REFRESH_TOKEN",
@@ -382,12 +438,12 @@ func (h *AuthHandler) RefreshToken(c echo.Context) error {
 	}
 
 	// リフレッシュトークンの有効期限を確認
-	if existingUser.RefreshTokenExpiresAt == nil || time.Now().After(*existingUser.RefreshTokenExpiresAt) {
-		// 期限切れの場合、DBからトークンをクリア（セキュリティ強化）
-		_, _ = client.User.UpdateOne(existingUser).
-			ClearRefreshTokenHash().
-			ClearRefreshTokenExpiresAt().
-			Save(ctx)
+	if time.Now().After(existingSession.ExpiresAt) {
+		// 期限切れの場合、セッションを削除（セキュリティ強化）
+		_ = withTx(ctx, client, func(tx *ent.Tx) error {
+			_, err := auth.RevokeSessions(ctx, tx.Client(), session.ID(existingSession.ID))
+			return err
+		})
 
 		return c.JSON(http.StatusUnauthorized, models.ErrorResponse{
 			Message: "リフレッシュトークンが期限切れです",
@@ -396,7 +452,8 @@ func (h *AuthHandler) RefreshToken(c echo.Context) error {
 	}
 
 	// 新しいアクセストークンを生成
-	newAccessToken, err := auth.GenerateJWT(existingUser.ID.String(), existingUser.Email)
+	existingUser := existingSession.Edges.User
+	newAccessToken, err := auth.GenerateJWT(existingUser.ID.String(), existingUser.Email, existingSession.ID.String(), existingUser.TokenVersion)
 	if err != nil {
 		return c.JSON(http.StatusInternalServerError, models.ErrorResponse{
 			Message: "新しいJWTトークンの生成中にエラーが発生しました",
@@ -413,14 +470,51 @@ func (h *AuthHandler) RefreshToken(c echo.Context) error {
 		})
 	}
 
-	// データベースのリフレッシュトークンを更新
+	// セッションのリフレッシュトークンを更新
 	// 新しいリフレッシュトークンをハッシュ化
 	newHashedRefreshToken := auth.HashRefreshToken(newRefreshToken)
 	refreshTokenExpiry := auth.GetRefreshTokenExpiry()
-	_, err = client.User.UpdateOne(existingUser).
-		SetRefreshTokenHash(newHashedRefreshToken).
-		SetRefreshTokenExpiresAt(refreshTokenExpiry).
-		Save(ctx)
+	// 使用済みのトークンはファミリーに記録し、再利用を検知できるようにする
+	err = withTx(ctx, client, func(tx *ent.Tx) error {
+		// 同じトークンで同時にリフレッシュされた場合、先に更新した方のみ成功させる
+		// 後から更新しようとした方は再利用ではなく競合のため、セッションは失効させない
+		updated, err := tx.Session.Update().
+			Where(
+				session.ID(existingSession.ID),
+				session.RefreshTokenHashEQ(hashedRefreshToken),
+			).
+			SetRefreshTokenHash(newHashedRefreshToken).
+			SetExpiresAt(refreshTokenExpiry).
+			SetLastUsedAt(time.Now()).
+			SetIPAddress(c.RealIP()).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+		if updated == 0 {
+			return errRefreshTokenRotated
+		}
+		if err := tx.RotatedRefreshToken.Create().
+			SetSessionID(existingSession.ID).
+			SetTokenHash(hashedRefreshToken).
+			Exec(ctx); err != nil {
+			return err
+		}
+		// 有効期間を過ぎたトークンは盗用されても使用できないため、再利用の検知用に記録を残す必要はない
+		_, err = tx.RotatedRefreshToken.Delete().
+			Where(
+				rotatedrefreshtoken.SessionID(existingSession.ID),
+				rotatedrefreshtoken.RotatedAtLT(time.Now().Add(-auth.RefreshTokenTTL)),
+			).
+			Exec(ctx)
+		return err
+	})
+	if errors.Is(err, errRefreshTokenRotated) {
+		return c.JSON(http.StatusUnauthorized, models.ErrorResponse{
+			Message: "リフレッシュトークンが無効です",
+			Code:    "INVALID_REFRESH_TOKEN",
+		})
+	}
 	if err != nil {
 		return c.JSON(http.StatusInternalServerError, models.ErrorResponse{
 			Message: "Failed to update refresh token",
@@ -449,6 +543,60 @@ func (h *AuthHandler) RefreshToken(c echo.Context) error {
 	return c.JSON(http.StatusOK, response)
 }
 
+// errRefreshTokenRotated 同時に行われた別のリフレッシュによって、既にトークンがローテーションされた
+var errRefreshTokenRotated = errors.New("refresh token already rotated")
+
+// handleRefreshTokenReuse リフレッシュトークンの再利用を検知した場合に、トークンファミリー（セッション）全体を失効させる
+// 正規のクライアントと攻撃者のどちらが先に使用したか区別できないため、セキュリティイベントを記録して両方を再ログインさせる
+func (h *AuthHandler) handleRefreshTokenReuse(c echo.Context, client *ent.Client, family *ent.Session) error {
+	ctx := context.Background()
+
+	err := withTx(ctx, client, func(tx *ent.Tx) error {
+		create := tx.SecurityEvent.Create().
+			SetUserID(family.UserID).
+			SetType(securityevent.TypeRefreshTokenReuse).
+			SetSessionID(family.ID).
+			SetIPAddress(c.RealIP())
+		if userAgent := c.Request().UserAgent(); userAgent != "" {
+			create = create.SetUserAgent(userAgent)
+		}
+		if err := create.Exec(ctx); err != nil {
+			return err
+		}
+		_, err := auth.RevokeSessions(ctx, tx.Client(), session.ID(family.ID))
+		return err
+	})
+	if err != nil {
+		c.Logger().Errorf("revoke reused refresh token family error: %v", err)
+		return c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Message: "DBエラーが発生しました",
+			Code:    "DATABASE_ERROR",
+		})
+	}
+	c.Logger().Warnf("refresh token reuse detected: user=%s session=%s ip=%s", family.UserID, family.ID, c.RealIP())
+
+	// 失効したセッションのトークンで確立したリアルタイム接続も切断する
+	if err := h.hub.DisconnectSession(ctx, family.UserID, family.ID); err != nil {
+		c.Logger().Errorf("disconnect session error: %v", err)
+	}
+
+	// リフレッシュトークンCookieを削除
+	c.SetCookie(&http.Cookie{
+		Name:     "refresh_token",
+		Value:    "",
+		Path:     "/",
+		MaxAge:   -1,
+		HttpOnly: true,
+		Secure:   util.IsProduction(),
+		SameSite: http.SameSiteLaxMode,
+	})
+
+	return c.JSON(http.StatusUnauthorized, models.ErrorResponse{
+		Message: "リフレッシュトークンが再利用されたため、セッションを無効にしました。再度ログインしてください",
+		Code:    "REFRESH_TOKEN_REUSED",
+	})
+}
+
 // UpdateProfile プロフィール更新ハンドラー（JWT認証が必要）
 func (h *AuthHandler) UpdateProfile(c echo.Context) error {
 	// JWTミドルウェアで設定されたユーザー情報を取得
@@ -599,6 +747,8 @@ func (h *AuthHandler) SearchUsers(c echo.Context) error {
 			Name:            user.Name,
 			Email:           user.Email,
 			ProfileImageURL: user.ProfileImageURL,
+			Presence:        string(h.hub.PresenceOf(user.ID)),
+			LastSeenAt:      user.LastSeenAt,
 		}
 	}
 
@@ -2,27 +2,43 @@ package handlers
 
 import (
 	"context"
+	"errors"
 	"net/http"
 	"strconv"
 	"time"
 
+	"entgo.io/ent/dialect/sql"
 	"github.com/google/uuid"
 	"github.com/hideaki1979/cc-chat-app/apps/api/ent"
 	"github.com/hideaki1979/cc-chat-app/apps/api/ent/chatroom"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/invite"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/joinrequest"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/message"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/messagereaction"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/messageread"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/messagerevision"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/predicate"
 	"github.com/hideaki1979/cc-chat-app/apps/api/ent/roommember"
 	"github.com/hideaki1979/cc-chat-app/apps/api/ent/user"
 	"github.com/hideaki1979/cc-chat-app/apps/api/internal/models"
+	"github.com/hideaki1979/cc-chat-app/apps/api/internal/realtime"
 	"github.com/labstack/echo/v4"
 )
 
+// DMルームの保存用の名前（表示名は相手の名前から決まる）
+const directMessageRoomName = "Direct Message"
+
 // ChatRoomHandler チャットルーム関連のハンドラー
 type ChatRoomHandler struct {
-	client *ent.Client
+	client          *ent.Client
+	hub             *realtime.Hub   // メンバーのオンライン状態の参照用（nilの場合は全員オフライン）
+	emptyRoomPolicy EmptyRoomPolicy // 最後のメンバーが退出したルームの扱い
+	maxRoomSize     int             // ルームの最大メンバー数（0は無制限）
 }
 
 // NewChatRoomHandler ChatRoomHandlerのコンストラクタ
-func NewChatRoomHandler(client *ent.Client) *ChatRoomHandler {
-	return &ChatRoomHandler{client: client}
+func NewChatRoomHandler(client *ent.Client, hub *realtime.Hub, emptyRoomPolicy EmptyRoomPolicy, maxRoomSize int) *ChatRoomHandler {
+	return &ChatRoomHandler{client: client, hub: hub, emptyRoomPolicy: emptyRoomPolicy, maxRoomSize: maxRoomSize}
 }
 
 // CreateChatRoom チャットルーム作成
@@ -37,6 +53,11 @@ func (h *ChatRoomHandler) CreateChatRoom(c echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
 	}
 
+	// 1対1のDMは同じ2人のルームが重複しないよう、専用のエンドポイントでのみ作成する
+	if !req.IsGroupChat {
+		return echo.NewHTTPError(http.StatusBadRequest, "Use POST /api/dm/:user_id to create a direct message")
+	}
+
 	// UUID検証
 	if err := models.ValidateUUIDs(req.MemberIDs); err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, "Invalid UUID format in member_ids")
@@ -66,6 +87,10 @@ func (h *ChatRoomHandler) CreateChatRoom(c echo.Context) error {
 		memberSet[memberUUID] = struct{}{}
 	}
 
+	if h.maxRoomSize > 0 && len(memberSet) > h.maxRoomSize {
+		return errRoomFull(h.maxRoomSize)
+	}
+
 	memberUUIDs := make([]uuid.UUID, 0, len(memberSet))
 	
 	for u := range memberSet {
@@ -85,19 +110,27 @@ func (h *ChatRoomHandler) CreateChatRoom(c echo.Context) error {
 	}
 
 	// チャットルーム作成
-	room, err := tx.ChatRoom.Create().
+	roomCreate := tx.ChatRoom.Create().
 		SetName(req.Name).
-		SetIsGroupChat(req.IsGroupChat).
-		Save(ctx)
+		SetIsGroupChat(req.IsGroupChat)
+	if req.Visibility != "" {
+		roomCreate = roomCreate.SetVisibility(chatroom.Visibility(req.Visibility))
+	}
+	room, err := roomCreate.Save(ctx)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to create chat room")
 	}
 
-	// メンバー追加
+	// メンバー追加（作成者はオーナー）
 	for _, memberUUID := range memberUUIDs {
+		role := roommember.RoleMember
+		if memberUUID == currentUserUUID {
+			role = roommember.RoleOwner
+		}
 		_, err := tx.RoomMember.Create().
 			SetRoomID(room.ID).
 			SetUserID(memberUUID).
+			SetRole(role).
 			SetJoinedAt(time.Now()).
 			Save(ctx)
 		if err != nil {
@@ -105,6 +138,15 @@ func (h *ChatRoomHandler) CreateChatRoom(c echo.Context) error {
 		}
 	}
 
+	// ルーム作成をシステムメッセージとして記録
+	err = recordSystemMessage(ctx, tx, room.ID, currentUserUUID, models.SystemMessagePayload{
+		Event: models.SystemEventRoomCreated,
+		Name:  &room.Name,
+	})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to record system message")
+	}
+
 	// コミット
 	if err := tx.Commit(); err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to commit transaction")
@@ -121,12 +163,13 @@ func (h *ChatRoomHandler) CreateChatRoom(c echo.Context) error {
 		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get created room")
 	}
 
-	response := models.ConvertToChatRoomResponse(roomWithMembers)
+	response := models.ConvertToChatRoomResponseFor(roomWithMembers, currentUserUUID)
+	h.hub.FillPresence(response.Members)
 	return c.JSON(http.StatusCreated, response)
 }
 
 // GetChatRooms ユーザーが参加しているチャットルーム一覧取得
-// GET /api/chatrooms
+// GET /api/chatrooms?archived=&muted=&pinned=
 func (h *ChatRoomHandler) GetChatRooms(c echo.Context) error {
 	userID := c.Get("user_id").(string)
 	userUUID, err := uuid.Parse(userID)
@@ -144,18 +187,34 @@ func (h *ChatRoomHandler) GetChatRooms(c echo.Context) error {
 		pageSize = 20
 	}
 
+	// アーカイブ・ミュート・ピン留めでの絞り込み
+	filters, err := roomListFilters(c, time.Now())
+	if err != nil {
+		return err
+	}
+
 	ctx := context.Background()
 
-	// ユーザーが参加しているルームを取得
-	rooms, err := h.client.ChatRoom.Query().
-		Where(chatroom.HasRoomMembersWith(roommember.UserID(userUUID))).
-		WithMessages(func(q *ent.MessageQuery) {
-			q.WithSender().
-				Order(ent.Desc("created_at")).
-				Limit(1) // 最新メッセージのみ
+	// ユーザーが参加しているルームを取得（ピン留めしたルームを先頭に、最終アクティビティの新しい順に表示）
+	memberships, err := h.client.RoomMember.Query().
+		Where(roommember.UserID(userUUID)).
+		Where(filters...).
+		WithRoom(func(q *ent.ChatRoomQuery) {
+			q.WithLastMessage(func(q *ent.MessageQuery) {
+				q.WithSender()
+			}).
+				WithRoomMembers(func(q *ent.RoomMemberQuery) {
+					// DMの表示名に相手の名前を使うため、ユーザー名のみ読み込む
+					q.WithUser(func(uq *ent.UserQuery) {
+						uq.Select(user.FieldName)
+					})
+				})
 		}).
-		WithRoomMembers().
-		Order(ent.Desc(chatroom.FieldUpdatedAt)).
+		Order(
+			roommember.ByPinnedOrder(sql.OrderNullsLast()),
+			roommember.ByRoomField(chatroom.FieldLastMessageAt, sql.OrderDesc(), sql.OrderNullsLast()),
+			roommember.ByRoomField(chatroom.FieldCreatedAt, sql.OrderDesc()),
+		).
 		Offset((page - 1) * pageSize).
 		Limit(pageSize).
 		All(ctx)
@@ -163,12 +222,26 @@ func (h *ChatRoomHandler) GetChatRooms(c echo.Context) error {
 		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get chat rooms")
 	}
 
+	rooms := make([]*ent.ChatRoom, len(memberships))
+	for i, m := range memberships {
+		rooms[i] = m.Edges.Room
+	}
+
+	// 未読数を取得
+	unreadCounts, err := h.countUnread(ctx, userUUID, rooms)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to count unread messages")
+	}
+
 	// レスポンス作成
+	now := time.Now()
 	responses := make([]*models.ChatRoomListResponse, len(rooms))
 	for i, room := range rooms {
 		// メンバー数を取得
 		memberCount := len(room.Edges.RoomMembers)
-		responses[i] = models.ConvertToChatRoomListResponse(room, memberCount)
+		responses[i] = models.ConvertToChatRoomListResponseFor(room, memberCount, userUUID)
+		responses[i].UnreadCount = unreadCounts[room.ID]
+		responses[i].MemberSettings = models.ConvertToMemberSettings(memberships[i], now)
 	}
 
 	return c.JSON(http.StatusOK, map[string]interface{}{
@@ -181,6 +254,110 @@ func (h *ChatRoomHandler) GetChatRooms(c echo.Context) error {
 	})
 }
 
+// roomListFilters ルーム一覧の絞り込み条件をクエリパラメータから作成
+// archivedは未指定時にfalse（アーカイブしたルームを除外）、muted・pinnedは未指定時に絞り込まない
+func roomListFilters(c echo.Context, now time.Time) ([]predicate.RoomMember, error) {
+	parse := func(name string) (*bool, error) {
+		v := c.QueryParam(name)
+		if v == "" {
+			return nil, nil
+		}
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			return nil, echo.NewHTTPError(http.StatusBadRequest, "Invalid "+name+" parameter")
+		}
+		return &b, nil
+	}
+
+	archived, err := parse("archived")
+	if err != nil {
+		return nil, err
+	}
+	muted, err := parse("muted")
+	if err != nil {
+		return nil, err
+	}
+	pinned, err := parse("pinned")
+	if err != nil {
+		return nil, err
+	}
+
+	filters := []predicate.RoomMember{roommember.Archived(archived != nil && *archived)}
+	if muted != nil {
+		if *muted {
+			filters = append(filters, roommember.MutedUntilGT(now))
+		} else {
+			filters = append(filters, roommember.Or(roommember.MutedUntilIsNil(), roommember.MutedUntilLTE(now)))
+		}
+	}
+	if pinned != nil {
+		if *pinned {
+			filters = append(filters, roommember.PinnedOrderNotNil())
+		} else {
+			filters = append(filters, roommember.PinnedOrderIsNil())
+		}
+	}
+	return filters, nil
+}
+
+// UpdateMemberSettings ルームに対するログインユーザーの設定（アーカイブ・ミュート・ピン留め）を変更
+// PUT /api/chatrooms/:id/settings
+func (h *ChatRoomHandler) UpdateMemberSettings(c echo.Context) error {
+	roomUUID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid room ID")
+	}
+
+	var req models.UpdateMemberSettingsRequest
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid request body")
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	if req.Unpin && req.PinnedOrder != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Specify either pinned_order or unpin")
+	}
+
+	userUUID, err := getUserUUID(c)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+
+	member, err := roomMember(ctx, h.client, roomUUID, userUUID)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	update := h.client.RoomMember.UpdateOne(member)
+	if req.Archived != nil {
+		update = update.SetArchived(*req.Archived)
+	}
+	if req.MutedUntil != nil {
+		if req.MutedUntil.After(now) {
+			update = update.SetMutedUntil(*req.MutedUntil)
+		} else {
+			update = update.ClearMutedUntil()
+		}
+	}
+	if req.Unpin {
+		update = update.ClearPinnedOrder()
+	} else if req.PinnedOrder != nil {
+		update = update.SetPinnedOrder(*req.PinnedOrder)
+	}
+
+	member, err = update.Save(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to update room settings")
+	}
+
+	return c.JSON(http.StatusOK, models.ConvertToMemberSettings(member, now))
+}
+
 // GetChatRoom チャットルーム詳細取得
 // GET /api/chatrooms/:id
 func (h *ChatRoomHandler) GetChatRoom(c echo.Context) error {
@@ -218,10 +395,8 @@ func (h *ChatRoomHandler) GetChatRoom(c echo.Context) error {
 		WithRoomMembers(func(q *ent.RoomMemberQuery) {
 			q.WithUser()
 		}).
-		WithMessages(func(q *ent.MessageQuery) {
-			q.WithSender().
-				Order(ent.Desc("created_at")).
-				Limit(1) // 最新メッセージのみ
+		WithLastMessage(func(q *ent.MessageQuery) {
+			q.WithSender()
 		}).
 		Only(ctx)
 	if err != nil {
@@ -231,7 +406,8 @@ func (h *ChatRoomHandler) GetChatRoom(c echo.Context) error {
 		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get chat room")
 	}
 
-	response := models.ConvertToChatRoomResponse(room)
+	response := models.ConvertToChatRoomResponseFor(room, userUUID)
+	h.hub.FillPresence(response.Members)
 	return c.JSON(http.StatusOK, response)
 }
 
@@ -252,6 +428,9 @@ func (h *ChatRoomHandler) UpdateChatRoom(c echo.Context) error {
 	if err := c.Validate(&req); err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
 	}
+	if req.UseDefaultEditWindow && req.EditWindowSeconds != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Specify either edit_window_seconds or use_default_edit_window")
+	}
 
 	userID := c.Get("user_id").(string)
 	userUUID, err := uuid.Parse(userID)
@@ -261,51 +440,97 @@ func (h *ChatRoomHandler) UpdateChatRoom(c echo.Context) error {
 
 	ctx := context.Background()
 
-	// ユーザーがそのルームのメンバーかチェック
-	isMember, err := h.client.RoomMember.Query().
-		Where(
-			roommember.RoomID(roomUUID),
-			roommember.UserID(userUUID),
-		).
-		Exist(ctx)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to check membership")
-	}
-	if !isMember {
-		return echo.NewHTTPError(http.StatusForbidden, "You are not a member of this room")
+	// ルーム設定の変更権限をチェック
+	if _, err := authorizeRoom(ctx, h.client, roomUUID, userUUID, ActionUpdateRoom); err != nil {
+		return err
 	}
 
-	// 更新処理
-	updateQuery := h.client.ChatRoom.UpdateOneID(roomUUID)
-	if req.Name != nil {
-		updateQuery = updateQuery.SetName(*req.Name)
-	}
+	// ルームの更新と変更内容のシステムメッセージを同じトランザクションで記録
+	err = withTx(ctx, h.client, func(tx *ent.Tx) error {
+		room, err := tx.ChatRoom.Get(ctx, roomUUID)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return echo.NewHTTPError(http.StatusNotFound, "Chat room not found")
+			}
+			return err
+		}
+
+		updateQuery := tx.ChatRoom.UpdateOne(room)
+		if req.Name != nil {
+			updateQuery = updateQuery.SetName(*req.Name)
+		}
+		if req.Visibility != nil {
+			updateQuery = updateQuery.SetVisibility(chatroom.Visibility(*req.Visibility))
+		}
+		if req.UseDefaultEditWindow {
+			updateQuery = updateQuery.ClearEditWindowSeconds()
+		} else if req.EditWindowSeconds != nil {
+			updateQuery = updateQuery.SetEditWindowSeconds(*req.EditWindowSeconds)
+		}
+		// 空文字の場合は削除
+		if req.Description != nil {
+			if *req.Description == "" {
+				updateQuery = updateQuery.ClearDescription()
+			} else {
+				updateQuery = updateQuery.SetDescription(*req.Description)
+			}
+		}
+		if req.AvatarURL != nil {
+			if *req.AvatarURL == "" {
+				updateQuery = updateQuery.ClearAvatarURL()
+			} else {
+				updateQuery = updateQuery.SetAvatarURL(*req.AvatarURL)
+			}
+		}
+		oldTopic := ""
+		if room.Topic != nil {
+			oldTopic = *room.Topic
+		}
+		topicChanged := req.Topic != nil && *req.Topic != oldTopic
+		if topicChanged {
+			if *req.Topic == "" {
+				updateQuery = updateQuery.ClearTopic()
+			} else {
+				updateQuery = updateQuery.SetTopic(*req.Topic)
+			}
+		}
+
+		if err := updateQuery.Exec(ctx); err != nil {
+			return err
+		}
 
-	room, err := updateQuery.Save(ctx)
+		// 名前とトピックの変更をシステムメッセージとして記録
+		if req.Name != nil && *req.Name != room.Name {
+			err := recordSystemMessage(ctx, tx, roomUUID, userUUID, models.SystemMessagePayload{
+				Event:   models.SystemEventRoomRenamed,
+				Name:    req.Name,
+				OldName: &room.Name,
+			})
+			if err != nil {
+				return err
+			}
+		}
+		if !topicChanged {
+			return nil
+		}
+		return recordSystemMessage(ctx, tx, roomUUID, userUUID, models.SystemMessagePayload{
+			Event: models.SystemEventTopicChanged,
+			Topic: req.Topic,
+		})
+	})
 	if err != nil {
-		if ent.IsNotFound(err) {
-			return echo.NewHTTPError(http.StatusNotFound, "Chat room not found")
+		var httpErr *echo.HTTPError
+		if errors.As(err, &httpErr) {
+			return httpErr
 		}
 		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to update chat room")
 	}
 
 	// 更新後の詳細データを取得
-	updatedRoom, err := h.client.ChatRoom.Query().
-		Where(chatroom.ID(room.ID)).
-		WithRoomMembers(func(q *ent.RoomMemberQuery) {
-			q.WithUser()
-		}).
-		WithMessages(func(q *ent.MessageQuery) {
-			q.WithSender().
-				Order(ent.Desc("created_at")).
-				Limit(1)
-		}).
-		Only(ctx)
+	response, err := h.getRoomResponse(ctx, roomUUID, userUUID)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get updated room")
 	}
-
-	response := models.ConvertToChatRoomResponse(updatedRoom)
 	return c.JSON(http.StatusOK, response)
 }
 
@@ -340,18 +565,9 @@ func (h *ChatRoomHandler) AddMember(c echo.Context) error {
 
 	ctx := context.Background()
 
-	// ユーザーがそのルームのメンバーかチェック
-	isMember, err := h.client.RoomMember.Query().
-		Where(
-			roommember.RoomID(roomUUID),
-			roommember.UserID(userUUID),
-		).
-		Exist(ctx)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to check membership")
-	}
-	if !isMember {
-		return echo.NewHTTPError(http.StatusForbidden, "You are not a member of this room")
+	// メンバーの追加権限をチェック
+	if _, err := authorizeRoom(ctx, h.client, roomUUID, userUUID, ActionAddMember); err != nil {
+		return err
 	}
 
 	// 追加するユーザーの存在チェック
@@ -379,13 +595,32 @@ func (h *ChatRoomHandler) AddMember(c echo.Context) error {
 		return echo.NewHTTPError(http.StatusConflict, "User is already a member")
 	}
 
-	// メンバー追加
-	_, err = h.client.RoomMember.Create().
-		SetRoomID(roomUUID).
-		SetUserID(newMemberUUID).
-		SetJoinedAt(time.Now()).
-		Save(ctx)
+	// メンバー追加とシステムメッセージの記録を同じトランザクションで行う
+	err = withTx(ctx, h.client, func(tx *ent.Tx) error {
+		if err := checkRoomCapacity(ctx, tx, roomUUID, 1, h.maxRoomSize); err != nil {
+			return err
+		}
+		err := tx.RoomMember.Create().
+			SetRoomID(roomUUID).
+			SetUserID(newMemberUUID).
+			SetJoinedAt(time.Now()).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+		return recordSystemMessage(ctx, tx, roomUUID, userUUID, models.SystemMessagePayload{
+			Event:   models.SystemEventMemberAdded,
+			UserIDs: []string{newMemberUUID.String()},
+		})
+	})
 	if err != nil {
+		var httpErr *echo.HTTPError
+		if errors.As(err, &httpErr) {
+			return httpErr
+		}
+		if ent.IsConstraintError(err) {
+			return echo.NewHTTPError(http.StatusConflict, "User is already a member")
+		}
 		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to add member")
 	}
 
@@ -417,41 +652,535 @@ func (h *ChatRoomHandler) RemoveMember(c echo.Context) error {
 
 	ctx := context.Background()
 
-	// ユーザーがそのルームのメンバーかチェック
-	isMember, err := h.client.RoomMember.Query().
-		Where(
-			roommember.RoomID(roomUUID),
-			roommember.UserID(userUUID),
-		).
+	// 権限チェック・メンバー削除・システムメッセージの記録を同じトランザクションで行う
+	// 同時に役割が変更されても変更後の役割で判定するよう、ルームをロックしてから両者の役割を読み込む
+	err = withTx(ctx, h.client, func(tx *ent.Tx) error {
+		if err := lockRoom(ctx, tx, roomUUID); err != nil {
+			return err
+		}
+
+		// ユーザーがそのルームのメンバーかチェック
+		actor, err := roomMember(ctx, tx.Client(), roomUUID, userUUID)
+		if err != nil {
+			return err
+		}
+
+		// 削除対象がメンバーかチェック
+		targetMember, err := findRoomMember(ctx, tx, roomUUID, targetUserUUID)
+		if err != nil {
+			return err
+		}
+
+		if targetUserUUID == userUUID {
+			// 自分自身の削除（退出）はオーナー以外なら可能
+			if targetMember.Role == roommember.RoleOwner {
+				return echo.NewHTTPError(http.StatusBadRequest, "Transfer ownership before leaving the room")
+			}
+		} else {
+			// 他のメンバーの削除は権限があり、かつ自分より下位の役割のメンバーのみ可能
+			if !Can(actor.Role, ActionRemoveMember) {
+				return errPermissionDenied
+			}
+			if !outranks(actor.Role, targetMember.Role) {
+				return echo.NewHTTPError(http.StatusForbidden, "You cannot remove a member with an equal or higher role")
+			}
+		}
+
+		if err := tx.RoomMember.DeleteOne(targetMember).Exec(ctx); err != nil {
+			return err
+		}
+		payload := models.SystemMessagePayload{Event: models.SystemEventMemberLeft}
+		if targetUserUUID != userUUID {
+			payload = models.SystemMessagePayload{
+				Event:   models.SystemEventMemberRemoved,
+				UserIDs: []string{targetUserUUID.String()},
+			}
+		}
+		return recordSystemMessage(ctx, tx, roomUUID, userUUID, payload)
+	})
+	if err != nil {
+		var httpErr *echo.HTTPError
+		if errors.As(err, &httpErr) {
+			return httpErr
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to remove member")
+	}
+
+	return c.JSON(http.StatusOK, map[string]string{
+		"message": "Member removed successfully",
+	})
+}
+
+// LeaveRoom ログインユーザーがチャットルームから退出
+// オーナーが退出した場合は参加日時が最も古いメンバーにオーナーを引き継ぎ、
+// 最後のメンバーが退出した場合はポリシーに従ってルームをアーカイブまたは削除する
+// POST /api/chatrooms/:id/leave
+func (h *ChatRoomHandler) LeaveRoom(c echo.Context) error {
+	roomID := c.Param("id")
+	roomUUID, err := uuid.Parse(roomID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid room ID")
+	}
+
+	userUUID, err := getUserUUID(c)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+
+	// メンバー削除・オーナー引き継ぎ・ルームの後処理を同じトランザクションで行う
+	// 同時に退出したメンバーが互いの削除を見落とさないよう、先にルームをロックする
+	err = withTx(ctx, h.client, func(tx *ent.Tx) error {
+		if err := lockRoom(ctx, tx, roomUUID); err != nil {
+			return err
+		}
+		member, err := roomMember(ctx, tx.Client(), roomUUID, userUUID)
+		if err != nil {
+			return err
+		}
+		if err := tx.RoomMember.DeleteOne(member).Exec(ctx); err != nil {
+			return err
+		}
+
+		// 残りのメンバーを参加日時順に取得
+		next, err := tx.RoomMember.Query().
+			Where(roommember.RoomID(roomUUID)).
+			Order(ent.Asc(roommember.FieldJoinedAt), ent.Asc(roommember.FieldID)).
+			First(ctx)
+		if ent.IsNotFound(err) {
+			return h.cleanupEmptyRoom(ctx, tx, roomUUID)
+		}
+		if err != nil {
+			return err
+		}
+
+		err = recordSystemMessage(ctx, tx, roomUUID, userUUID, models.SystemMessagePayload{
+			Event: models.SystemEventMemberLeft,
+		})
+		if err != nil || member.Role != roommember.RoleOwner {
+			return err
+		}
+		return tx.RoomMember.UpdateOne(next).
+			SetRole(roommember.RoleOwner).
+			Exec(ctx)
+	})
+	if err != nil {
+		var httpErr *echo.HTTPError
+		if errors.As(err, &httpErr) {
+			return httpErr
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to leave room")
+	}
+
+	return c.JSON(http.StatusOK, map[string]string{
+		"message": "Left room successfully",
+	})
+}
+
+// cleanupEmptyRoom メンバーがいなくなったルームをポリシーに従ってアーカイブまたは削除
+func (h *ChatRoomHandler) cleanupEmptyRoom(ctx context.Context, tx *ent.Tx, roomUUID uuid.UUID) error {
+	if h.emptyRoomPolicy != EmptyRoomDelete {
+		return tx.ChatRoom.UpdateOneID(roomUUID).
+			SetArchivedAt(time.Now()).
+			Exec(ctx)
+	}
+
+	// メッセージに紐づくデータから順に削除
+	inRoom := messagerevision.HasMessageWith(message.RoomID(roomUUID))
+	if _, err := tx.MessageRevision.Delete().Where(inRoom).Exec(ctx); err != nil {
+		return err
+	}
+	if _, err := tx.MessageReaction.Delete().
+		Where(messagereaction.HasMessageWith(message.RoomID(roomUUID))).
+		Exec(ctx); err != nil {
+		return err
+	}
+	if _, err := tx.MessageRead.Delete().Where(messageread.RoomID(roomUUID)).Exec(ctx); err != nil {
+		return err
+	}
+	if _, err := tx.Message.Delete().Where(message.RoomID(roomUUID)).Exec(ctx); err != nil {
+		return err
+	}
+	if _, err := tx.Invite.Delete().Where(invite.RoomID(roomUUID)).Exec(ctx); err != nil {
+		return err
+	}
+	if _, err := tx.JoinRequest.Delete().Where(joinrequest.RoomID(roomUUID)).Exec(ctx); err != nil {
+		return err
+	}
+	return tx.ChatRoom.DeleteOneID(roomUUID).Exec(ctx)
+}
+
+// UpdateMemberRole メンバーの役割変更（adminへの昇格・memberへの降格）
+// PUT /api/chatrooms/:id/members/:user_id/role
+func (h *ChatRoomHandler) UpdateMemberRole(c echo.Context) error {
+	roomID := c.Param("id")
+	roomUUID, err := uuid.Parse(roomID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid room ID")
+	}
+
+	targetUserUUID, err := uuid.Parse(c.Param("user_id"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid user ID")
+	}
+
+	var req models.UpdateMemberRoleRequest
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid request body")
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	userUUID, err := getUserUUID(c)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+
+	// 権限チェック・役割の更新・システムメッセージの記録を同じトランザクションで行う
+	// 同時に役割が変更されても変更後の役割で判定するよう、ルームをロックしてから両者の役割を読み込む
+	err = withTx(ctx, h.client, func(tx *ent.Tx) error {
+		if err := lockRoom(ctx, tx, roomUUID); err != nil {
+			return err
+		}
+
+		// 役割の変更権限をチェック
+		actor, err := authorizeRoom(ctx, tx.Client(), roomUUID, userUUID, ActionChangeRole)
+		if err != nil {
+			return err
+		}
+
+		// 変更対象がメンバーかチェック
+		targetMember, err := findRoomMember(ctx, tx, roomUUID, targetUserUUID)
+		if err != nil {
+			return err
+		}
+
+		// 自分自身やオーナーの役割は変更できない（オーナーの変更は譲渡で行う）
+		if !outranks(actor.Role, targetMember.Role) {
+			return echo.NewHTTPError(http.StatusForbidden, "You cannot change the role of a member with an equal or higher role")
+		}
+
+		if targetMember.Role == roommember.Role(req.Role) {
+			return nil
+		}
+		if err := tx.RoomMember.UpdateOne(targetMember).
+			SetRole(roommember.Role(req.Role)).
+			Exec(ctx); err != nil {
+			return err
+		}
+		return recordSystemMessage(ctx, tx, roomUUID, userUUID, models.SystemMessagePayload{
+			Event:   models.SystemEventRoleChanged,
+			UserIDs: []string{targetUserUUID.String()},
+			Role:    &req.Role,
+		})
+	})
+	if err != nil {
+		var httpErr *echo.HTTPError
+		if errors.As(err, &httpErr) {
+			return httpErr
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to update member role")
+	}
+
+	response, err := h.getRoomResponse(ctx, roomUUID, userUUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get updated room")
+	}
+	return c.JSON(http.StatusOK, response)
+}
+
+// TransferOwnership オーナー権限の譲渡（元のオーナーはadminになる）
+// POST /api/chatrooms/:id/transfer-ownership
+func (h *ChatRoomHandler) TransferOwnership(c echo.Context) error {
+	roomID := c.Param("id")
+	roomUUID, err := uuid.Parse(roomID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid room ID")
+	}
+
+	var req models.TransferOwnershipRequest
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid request body")
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	newOwnerUUID, err := uuid.Parse(req.UserID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid user ID")
+	}
+
+	userUUID, err := getUserUUID(c)
+	if err != nil {
+		return err
+	}
+	if newOwnerUUID == userUUID {
+		return echo.NewHTTPError(http.StatusBadRequest, "You are already the owner of this room")
+	}
+
+	ctx := context.Background()
+
+	// 譲渡先と元のオーナーの役割を同じトランザクションで更新
+	// 同時に譲渡・役割変更されても現在のオーナーのみが譲渡できるよう、ルームをロックしてから両者の役割を読み込む
+	err = withTx(ctx, h.client, func(tx *ent.Tx) error {
+		if err := lockRoom(ctx, tx, roomUUID); err != nil {
+			return err
+		}
+
+		// 譲渡権限をチェック
+		owner, err := authorizeRoom(ctx, tx.Client(), roomUUID, userUUID, ActionTransferOwnership)
+		if err != nil {
+			return err
+		}
+
+		// 譲渡先がメンバーかチェック
+		newOwner, err := findRoomMember(ctx, tx, roomUUID, newOwnerUUID)
+		if err != nil {
+			return err
+		}
+
+		if err := tx.RoomMember.UpdateOne(newOwner).
+			SetRole(roommember.RoleOwner).
+			Exec(ctx); err != nil {
+			return err
+		}
+		if err := tx.RoomMember.UpdateOne(owner).
+			SetRole(roommember.RoleAdmin).
+			Exec(ctx); err != nil {
+			return err
+		}
+		return recordSystemMessage(ctx, tx, roomUUID, userUUID, models.SystemMessagePayload{
+			Event:   models.SystemEventOwnerChanged,
+			UserIDs: []string{newOwnerUUID.String()},
+		})
+	})
+	if err != nil {
+		var httpErr *echo.HTTPError
+		if errors.As(err, &httpErr) {
+			return httpErr
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to transfer ownership")
+	}
+
+	response, err := h.getRoomResponse(ctx, roomUUID, userUUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get updated room")
+	}
+	return c.JSON(http.StatusOK, response)
+}
+
+// GetOrCreateDirectMessage 指定ユーザーとの1対1のDMルームを取得（存在しない場合は作成）
+// POST /api/dm/:user_id
+func (h *ChatRoomHandler) GetOrCreateDirectMessage(c echo.Context) error {
+	targetUUID, err := uuid.Parse(c.Param("user_id"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid user ID")
+	}
+
+	userUUID, err := getUserUUID(c)
+	if err != nil {
+		return err
+	}
+	if targetUUID == userUUID {
+		return echo.NewHTTPError(http.StatusBadRequest, "Cannot create a direct message with yourself")
+	}
+
+	ctx := context.Background()
+
+	// 相手ユーザーの存在チェック
+	exists, err := h.client.User.Query().
+		Where(user.ID(targetUUID)).
 		Exist(ctx)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to check membership")
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to check user existence")
 	}
-	if !isMember {
-		return echo.NewHTTPError(http.StatusForbidden, "You are not a member of this room")
+	if !exists {
+		return echo.NewHTTPError(http.StatusNotFound, "User not found")
+	}
+
+	key := models.DirectMessageKey(userUUID, targetUUID)
+	status := http.StatusOK
+	var roomUUID uuid.UUID
+	err = withTx(ctx, h.client, func(tx *ent.Tx) error {
+		room, err := tx.ChatRoom.Query().
+			Where(chatroom.DmKey(key)).
+			Only(ctx)
+		if ent.IsNotFound(err) {
+			room, err = tx.ChatRoom.Create().
+				SetName(directMessageRoomName).
+				SetIsGroupChat(false).
+				SetDmKey(key).
+				Save(ctx)
+			status = http.StatusCreated
+		}
+		if err != nil {
+			return err
+		}
+		roomUUID = room.ID
+
+		// 退出済みの参加者がいれば再参加させる
+		joined, err := tx.RoomMember.Query().
+			Where(roommember.RoomID(room.ID)).
+			Select(roommember.FieldUserID).
+			All(ctx)
+		if err != nil {
+			return err
+		}
+		missing := map[uuid.UUID]bool{userUUID: true, targetUUID: true}
+		for _, m := range joined {
+			delete(missing, m.UserID)
+		}
+		for memberUUID := range missing {
+			if err := tx.RoomMember.Create().
+				SetRoomID(room.ID).
+				SetUserID(memberUUID).
+				SetJoinedAt(time.Now()).
+				Exec(ctx); err != nil {
+				return err
+			}
+		}
+		if len(missing) > 0 && room.ArchivedAt != nil {
+			if err := tx.ChatRoom.UpdateOne(room).
+				ClearArchivedAt().
+				Exec(ctx); err != nil {
+				return err
+			}
+		}
+
+		// 作成時は会話の開始を、再参加時は参加者ごとの参加・追加を記録する
+		if status == http.StatusCreated {
+			return recordSystemMessage(ctx, tx, room.ID, userUUID, models.SystemMessagePayload{
+				Event: models.SystemEventRoomCreated,
+			})
+		}
+		if missing[userUUID] {
+			if err := recordSystemMessage(ctx, tx, room.ID, userUUID, models.SystemMessagePayload{
+				Event: models.SystemEventMemberJoined,
+			}); err != nil {
+				return err
+			}
+		}
+		if missing[targetUUID] {
+			return recordSystemMessage(ctx, tx, room.ID, userUUID, models.SystemMessagePayload{
+				Event:   models.SystemEventMemberAdded,
+				UserIDs: []string{targetUUID.String()},
+			})
+		}
+		return nil
+	})
+	if ent.IsConstraintError(err) {
+		// 同じ2人のDMが同時に作成された場合は、作成済みのルームを返す
+		var room *ent.ChatRoom
+		room, err = h.client.ChatRoom.Query().
+			Where(chatroom.DmKey(key)).
+			Only(ctx)
+		if err == nil {
+			roomUUID, status = room.ID, http.StatusOK
+		}
+	}
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get direct message")
+	}
+
+	response, err := h.getRoomResponse(ctx, roomUUID, userUUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get direct message")
+	}
+	return c.JSON(status, response)
+}
+
+// getRoomResponse ルームの詳細レスポンスをメンバー・最新メッセージ込みで取得
+func (h *ChatRoomHandler) getRoomResponse(ctx context.Context, roomUUID, viewerUUID uuid.UUID) (*models.ChatRoomResponse, error) {
+	room, err := h.client.ChatRoom.Query().
+		Where(chatroom.ID(roomUUID)).
+		WithRoomMembers(func(q *ent.RoomMemberQuery) {
+			q.WithUser()
+		}).
+		WithLastMessage(func(q *ent.MessageQuery) {
+			q.WithSender()
+		}).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	response := models.ConvertToChatRoomResponseFor(room, viewerUUID)
+	h.hub.FillPresence(response.Members)
+	return response, nil
+}
+
+// countUnread ルームごとの未読メッセージ数を取得
+// 既読位置の取得と、ルームごとの既読位置より後のメッセージ数の集計の2クエリで行う
+func (h *ChatRoomHandler) countUnread(ctx context.Context, userUUID uuid.UUID, rooms []*ent.ChatRoom) (map[uuid.UUID]int, error) {
+	counts := make(map[uuid.UUID]int, len(rooms))
+	if len(rooms) == 0 {
+		return counts, nil
 	}
 
-	// 削除対象がメンバーかチェック
-	targetMember, err := h.client.RoomMember.Query().
+	roomIDs := make([]uuid.UUID, len(rooms))
+	for i, room := range rooms {
+		roomIDs[i] = room.ID
+	}
+
+	reads, err := h.client.MessageRead.Query().
 		Where(
-			roommember.RoomID(roomUUID),
-			roommember.UserID(targetUserUUID),
+			messageread.UserID(userUUID),
+			messageread.RoomIDIn(roomIDs...),
 		).
-		Only(ctx)
+		WithMessage(func(q *ent.MessageQuery) {
+			q.Select(message.FieldCreatedAt)
+		}).
+		All(ctx)
 	if err != nil {
-		if ent.IsNotFound(err) {
-			return echo.NewHTTPError(http.StatusNotFound, "Member not found")
+		return nil, err
+	}
+
+	readAt := make(map[uuid.UUID]models.MessageCursor, len(reads))
+	for _, read := range reads {
+		if read.Edges.Message != nil {
+			readAt[read.RoomID] = models.NewMessageCursor(read.Edges.Message)
+		}
+	}
+
+	// 既読位置がないルームは全メッセージが未読
+	// 作成日時が同じメッセージもIDで順序付けし、タイムラインの並び順と一致させる
+	predicates := make([]predicate.Message, len(roomIDs))
+	for i, roomID := range roomIDs {
+		if cursor, ok := readAt[roomID]; ok {
+			predicates[i] = message.And(message.RoomID(roomID), messageAfter(cursor))
+		} else {
+			predicates[i] = message.RoomID(roomID)
 		}
-		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to find member")
 	}
 
-	// メンバー削除
-	err = h.client.RoomMember.DeleteOne(targetMember).Exec(ctx)
+	var results []struct {
+		RoomID uuid.UUID `json:"room_id"`
+		Count  int       `json:"count"`
+	}
+	err = h.client.Message.Query().
+		Where(
+			message.Or(predicates...),
+			message.UserIDNEQ(userUUID),      // 自分のメッセージは未読に含めない
+			message.TypeEQ(message.TypeUser), // システムメッセージは未読に含めない
+			message.ParentIDIsNil(),          // タイムラインと同じく、スレッドの返信は未読に含めない
+			message.DeletedAtIsNil(),
+		).
+		GroupBy(message.FieldRoomID).
+		Aggregate(ent.Count()).
+		Scan(ctx, &results)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to remove member")
+		return nil, err
 	}
 
-	return c.JSON(http.StatusOK, map[string]string{
-		"message": "Member removed successfully",
-	})
-}
\ No newline at end of file
+	for _, r := range results {
+		counts[r.RoomID] = r.Count
+	}
+	return counts, nil
+}
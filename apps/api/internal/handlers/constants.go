@@ -1,5 +1,103 @@
 package handlers
 
-import "time"
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
 
-const MessageEditTimeLimit = 5 * time.Minute	// 大文字なのでエクスポートされる（小文字だとされない）
\ No newline at end of file
+const MessageEditTimeLimit = 5 * time.Minute	// 大文字なのでエクスポートされる（小文字だとされない）
+
+// メッセージ編集可能時間の特殊値（ChatRoom.edit_window_secondsと同じ意味）
+const (
+	EditWindowDisabled  time.Duration = 0  // 編集不可
+	EditWindowUnlimited time.Duration = -1 // 無期限
+)
+
+// ParseEditWindow 設定値からメッセージ編集可能時間を解析（"unlimited"・"disabled"・"10m"などの形式）
+func ParseEditWindow(s string) (time.Duration, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "":
+		return MessageEditTimeLimit, nil
+	case "unlimited", "-1":
+		return EditWindowUnlimited, nil
+	case "disabled", "0":
+		return EditWindowDisabled, nil
+	}
+
+	d, err := time.ParseDuration(strings.TrimSpace(s))
+	if err != nil {
+		return 0, fmt.Errorf("invalid edit window %q: %w", s, err)
+	}
+	if d < time.Second {
+		return 0, fmt.Errorf("invalid edit window %q: must be at least 1s", s)
+	}
+	return d.Truncate(time.Second), nil
+}
+
+// editWindowFromSeconds ルームの設定値（秒）を編集可能時間に変換（nilの場合はデフォルト値）
+func editWindowFromSeconds(seconds *int, defaultWindow time.Duration) time.Duration {
+	if seconds == nil {
+		return defaultWindow
+	}
+	if *seconds < 0 {
+		return EditWindowUnlimited
+	}
+	return time.Duration(*seconds) * time.Second
+}
+
+// formatEditWindow 編集可能時間をエラーメッセージ用の文字列に変換（例: "5 minutes"）
+func formatEditWindow(d time.Duration) string {
+	unit := func(n int64, name string) string {
+		if n == 1 {
+			return fmt.Sprintf("1 %s", name)
+		}
+		return fmt.Sprintf("%d %ss", n, name)
+	}
+	switch {
+	case d%time.Hour == 0:
+		return unit(int64(d/time.Hour), "hour")
+	case d%time.Minute == 0:
+		return unit(int64(d/time.Minute), "minute")
+	}
+	return unit(int64(d/time.Second), "second")
+}
+
+// DefaultMaxRoomSize ルームの最大メンバー数のデフォルト値
+const DefaultMaxRoomSize = 1000
+
+// ParseMaxRoomSize 設定値からルームの最大メンバー数を解析（未設定時はデフォルト値、0は無制限）
+func ParseMaxRoomSize(s string) (int, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return DefaultMaxRoomSize, nil
+	}
+	n, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid max room size %q: %w", s, err)
+	}
+	if n < 0 {
+		return 0, fmt.Errorf("invalid max room size %q: must not be negative", s)
+	}
+	return n, nil
+}
+
+// EmptyRoomPolicy 最後のメンバーが退出したルームの扱い
+type EmptyRoomPolicy string
+
+const (
+	EmptyRoomArchive EmptyRoomPolicy = "archive" // ルームとメッセージを残してアーカイブする
+	EmptyRoomDelete  EmptyRoomPolicy = "delete"  // ルームとメッセージを削除する
+)
+
+// ParseEmptyRoomPolicy 設定値から空になったルームの扱いを解析（未設定時はアーカイブ）
+func ParseEmptyRoomPolicy(s string) (EmptyRoomPolicy, error) {
+	switch policy := EmptyRoomPolicy(strings.ToLower(strings.TrimSpace(s))); policy {
+	case "":
+		return EmptyRoomArchive, nil
+	case EmptyRoomArchive, EmptyRoomDelete:
+		return policy, nil
+	}
+	return "", fmt.Errorf("invalid empty room policy %q: must be %q or %q", s, EmptyRoomArchive, EmptyRoomDelete)
+}
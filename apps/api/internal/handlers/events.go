@@ -0,0 +1,216 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/message"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/roommember"
+	"github.com/hideaki1979/cc-chat-app/apps/api/internal/models"
+	"github.com/hideaki1979/cc-chat-app/apps/api/internal/realtime"
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	// SSEのキープアライブ送信間隔（プロキシによるアイドル切断を防ぐ）
+	eventStreamKeepAlive = 30 * time.Second
+	// Last-Event-IDによる再送の上限件数（超える場合はresyncイベントを送信）
+	maxReplayEvents = 500
+	// 再送時に遡る余裕時間（配信順とDB書き込み順のずれを吸収する）
+	replayMargin = 5 * time.Second
+)
+
+// EventStreamHandler Server-Sent Eventsによるイベント配信ハンドラー
+type EventStreamHandler struct {
+	client *ent.Client
+	hub    *realtime.Hub
+}
+
+// NewEventStreamHandler EventStreamHandlerのコンストラクタ
+func NewEventStreamHandler(client *ent.Client, hub *realtime.Hub) *EventStreamHandler {
+	return &EventStreamHandler{client: client, hub: hub}
+}
+
+// Stream 参加ルームのイベントをSSEで配信する（WebSocketが使えない環境向け）
+// GET /api/events?ticket=<stream_ticket>
+func (h *EventStreamHandler) Stream(c echo.Context) error {
+	userUUID, err := getUserUUID(c)
+	if err != nil {
+		return err
+	}
+
+	// 再接続時の再送開始位置
+	var since *time.Time
+	if lastEventID := c.Request().Header.Get("Last-Event-ID"); lastEventID != "" {
+		t, err := realtime.ParseEventID(lastEventID)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, "Invalid Last-Event-ID")
+		}
+		since = &t
+	}
+
+	// 再送中に発生したイベントを取りこぼさないよう、先に購読を開始する
+	sub, ok := h.hub.Subscribe(userUUID, streamCredential(c))
+	if !ok {
+		return echo.NewHTTPError(http.StatusServiceUnavailable, "Event stream is not available")
+	}
+	defer sub.Close()
+
+	res := c.Response()
+	res.Header().Set(echo.HeaderContentType, "text/event-stream")
+	res.Header().Set(echo.HeaderCacheControl, "no-cache")
+	res.Header().Set(echo.HeaderConnection, "keep-alive")
+	res.Header().Set("X-Accel-Buffering", "no") // nginx等のバッファリングを無効化
+	res.WriteHeader(http.StatusOK)
+	res.Flush()
+
+	ctx := c.Request().Context()
+
+	if since != nil {
+		if err := h.replay(ctx, c, userUUID, *since); err != nil {
+			c.Logger().Errorf("replay events error: %v", err)
+			return nil
+		}
+	}
+
+	ticker := time.NewTicker(eventStreamKeepAlive)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case f, ok := <-sub.Events():
+			if !ok {
+				// Hubにより切断された
+				return nil
+			}
+			if err := writeSSE(res, f); err != nil {
+				return nil
+			}
+		case <-ticker.C:
+			if _, err := fmt.Fprint(res, ": keep-alive\n\n"); err != nil {
+				return nil
+			}
+			res.Flush()
+			// SSEはクライアントから送信できないため、接続の継続をハートビートとみなす
+			sub.Touch()
+		}
+	}
+}
+
+// Typing ルームでの入力開始・終了を通知する（SSEクライアント向け。WebSocketではtyping_start/typing_stopを送信する）
+// POST /api/chatrooms/:room_id/typing
+func (h *EventStreamHandler) Typing(c echo.Context) error {
+	userUUID, err := getUserUUID(c)
+	if err != nil {
+		return err
+	}
+
+	roomID := c.Param("room_id")
+	roomUUID, err := uuid.Parse(roomID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid room ID")
+	}
+
+	var req models.TypingRequest
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid request body")
+	}
+
+	if err := h.hub.SetTyping(c.Request().Context(), roomUUID, userUUID, req.Typing); err != nil {
+		if errors.Is(err, realtime.ErrNotRoomMember) {
+			return echo.NewHTTPError(http.StatusForbidden, "You are not a member of this room")
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to send typing notification")
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// replay 指定時刻以降に作成・編集・削除されたメッセージをmessagesテーブルから再送する
+func (h *EventStreamHandler) replay(ctx context.Context, c echo.Context, userUUID uuid.UUID, since time.Time) error {
+	memberships, err := h.client.RoomMember.Query().
+		Where(roommember.UserID(userUUID)).
+		Select(roommember.FieldRoomID).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	if len(memberships) == 0 {
+		return nil
+	}
+
+	roomIDs := make([]uuid.UUID, len(memberships))
+	for i, m := range memberships {
+		roomIDs[i] = m.RoomID
+	}
+
+	messages, err := h.client.Message.Query().
+		Where(
+			message.RoomIDIn(roomIDs...),
+			message.UpdatedAtGT(since.Add(-replayMargin)),
+		).
+		WithSender().
+		WithReplyTo(withReplyToSender).
+		Order(ent.Asc(message.FieldUpdatedAt), ent.Asc(message.FieldID)).
+		Limit(maxReplayEvents + 1).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+
+	// 再送件数が多すぎる場合はクライアントに全件再取得を促す
+	if len(messages) > maxReplayEvents {
+		return h.writeEvent(c, realtime.Event{Type: realtime.EventResync})
+	}
+
+	for _, msg := range messages {
+		event := realtime.Event{
+			ID:     realtime.EventIDFromTime(msg.UpdatedAt),
+			RoomID: msg.RoomID.String(),
+		}
+		switch {
+		case msg.DeletedAt != nil:
+			event.Type = realtime.EventMessageDeleted
+			event.Data = map[string]interface{}{
+				"id":         msg.ID.String(),
+				"deleted_at": *msg.DeletedAt,
+			}
+		case msg.CreatedAt.After(since):
+			event.Type = realtime.EventMessageCreated
+			event.Data = models.ConvertToMessageResponse(msg)
+		default:
+			event.Type = realtime.EventMessageUpdated
+			event.Data = models.ConvertToMessageResponse(msg)
+		}
+
+		if err := h.writeEvent(c, event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeEvent イベントをエンコードしてSSEとして書き込む
+func (h *EventStreamHandler) writeEvent(c echo.Context, event realtime.Event) error {
+	f, err := event.Encode()
+	if err != nil {
+		return err
+	}
+	return writeSSE(c.Response(), f)
+}
+
+// writeSSE フレームをSSE形式で書き込みフラッシュする
+func writeSSE(res *echo.Response, f *realtime.Frame) error {
+	if _, err := fmt.Fprintf(res, "id: %s\ndata: %s\n\n", f.ID(), f.Data()); err != nil {
+		return err
+	}
+	res.Flush()
+	return nil
+}
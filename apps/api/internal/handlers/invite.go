@@ -0,0 +1,291 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/chatroom"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/invite"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/roommember"
+	"github.com/hideaki1979/cc-chat-app/apps/api/internal/auth"
+	"github.com/hideaki1979/cc-chat-app/apps/api/internal/models"
+	"github.com/labstack/echo/v4"
+)
+
+// 招待リンクの有効期限のデフォルト値
+const defaultInviteExpiry = 7 * 24 * time.Hour
+
+// InviteHandler 招待リンク関連のハンドラー
+type InviteHandler struct {
+	client      *ent.Client
+	maxRoomSize int // ルームの最大メンバー数（0は無制限）
+}
+
+// NewInviteHandler InviteHandlerのコンストラクタ
+func NewInviteHandler(client *ent.Client, maxRoomSize int) *InviteHandler {
+	return &InviteHandler{client: client, maxRoomSize: maxRoomSize}
+}
+
+// CreateInvite 招待リンク作成（トークンはレスポンスでのみ返し、DBにはハッシュを保存）
+// POST /api/chatrooms/:id/invites
+func (h *InviteHandler) CreateInvite(c echo.Context) error {
+	roomUUID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid room ID")
+	}
+
+	var req models.CreateInviteRequest
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid request body")
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	userUUID, err := getUserUUID(c)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+
+	// 招待の管理権限をチェック
+	if _, err := authorizeRoom(ctx, h.client, roomUUID, userUUID, ActionManageInvites); err != nil {
+		return err
+	}
+
+	// アーカイブされたルームへの招待は作成できない
+	archived, err := h.client.ChatRoom.Query().
+		Where(
+			chatroom.ID(roomUUID),
+			chatroom.ArchivedAtNotNil(),
+		).
+		Exist(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get chat room")
+	}
+	if archived {
+		return echo.NewHTTPError(http.StatusConflict, "Chat room is archived")
+	}
+
+	token, err := auth.GenerateInviteToken()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to generate invite token")
+	}
+
+	expiry := defaultInviteExpiry
+	if req.ExpiresInHours > 0 {
+		expiry = time.Duration(req.ExpiresInHours) * time.Hour
+	}
+
+	inv, err := h.client.Invite.Create().
+		SetRoomID(roomUUID).
+		SetCreatorID(userUUID).
+		SetTokenHash(auth.HashInviteToken(token)).
+		SetExpiresAt(time.Now().Add(expiry)).
+		SetNillableMaxUses(req.MaxUses).
+		Save(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to create invite")
+	}
+
+	response := models.ConvertToInviteResponse(inv)
+	response.Token = token
+	return c.JSON(http.StatusCreated, response)
+}
+
+// GetInvites ルームの招待リンク一覧取得（トークンは含めない）
+// GET /api/chatrooms/:id/invites
+func (h *InviteHandler) GetInvites(c echo.Context) error {
+	roomUUID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid room ID")
+	}
+
+	userUUID, err := getUserUUID(c)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+
+	if _, err := authorizeRoom(ctx, h.client, roomUUID, userUUID, ActionManageInvites); err != nil {
+		return err
+	}
+
+	invites, err := h.client.Invite.Query().
+		Where(invite.RoomID(roomUUID)).
+		Order(ent.Desc(invite.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get invites")
+	}
+
+	responses := make([]*models.InviteResponse, len(invites))
+	for i, inv := range invites {
+		responses[i] = models.ConvertToInviteResponse(inv)
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"invites": responses,
+	})
+}
+
+// RevokeInvite 招待リンクの無効化
+// DELETE /api/chatrooms/:id/invites/:invite_id
+func (h *InviteHandler) RevokeInvite(c echo.Context) error {
+	roomUUID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid room ID")
+	}
+
+	inviteUUID, err := uuid.Parse(c.Param("invite_id"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid invite ID")
+	}
+
+	userUUID, err := getUserUUID(c)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+
+	if _, err := authorizeRoom(ctx, h.client, roomUUID, userUUID, ActionManageInvites); err != nil {
+		return err
+	}
+
+	n, err := h.client.Invite.Update().
+		Where(
+			invite.ID(inviteUUID),
+			invite.RoomID(roomUUID),
+		).
+		SetRevoked(true).
+		Save(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to revoke invite")
+	}
+	if n == 0 {
+		return echo.NewHTTPError(http.StatusNotFound, "Invite not found")
+	}
+
+	return c.JSON(http.StatusOK, map[string]string{
+		"message": "Invite revoked successfully",
+	})
+}
+
+// AcceptInvite 招待リンクからルームに参加（既にメンバーの場合は使用回数を消費しない）
+// POST /api/invites/:token/accept
+func (h *InviteHandler) AcceptInvite(c echo.Context) error {
+	token := c.Param("token")
+	if token == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid invite token")
+	}
+
+	userUUID, err := getUserUUID(c)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+
+	var roomUUID uuid.UUID
+	err = withTx(ctx, h.client, func(tx *ent.Tx) error {
+		inv, err := tx.Invite.Query().
+			Where(invite.TokenHash(auth.HashInviteToken(token))).
+			Only(ctx)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return echo.NewHTTPError(http.StatusNotFound, "Invite not found")
+			}
+			return err
+		}
+		roomUUID = inv.RoomID
+
+		if inv.Revoked || time.Now().After(inv.ExpiresAt) {
+			return echo.NewHTTPError(http.StatusGone, "Invite is no longer valid")
+		}
+
+		// アーカイブと同時に参加しないよう、ルームをロックしてから確認する
+		if err := lockRoom(ctx, tx, inv.RoomID); err != nil {
+			return err
+		}
+		room, err := tx.ChatRoom.Get(ctx, inv.RoomID)
+		if err != nil {
+			return err
+		}
+		if room.ArchivedAt != nil {
+			return echo.NewHTTPError(http.StatusGone, "Chat room is archived")
+		}
+
+		alreadyMember, err := tx.RoomMember.Query().
+			Where(
+				roommember.RoomID(inv.RoomID),
+				roommember.UserID(userUUID),
+			).
+			Exist(ctx)
+		if err != nil {
+			return err
+		}
+		if alreadyMember {
+			return nil
+		}
+
+		// 同時に使用された場合でも最大使用回数を超えないよう、条件付きで使用回数を加算
+		n, err := tx.Invite.Update().
+			Where(
+				invite.ID(inv.ID),
+				underMaxUses(),
+			).
+			AddUseCount(1).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			return echo.NewHTTPError(http.StatusGone, "Invite is no longer valid")
+		}
+
+		if err := checkRoomCapacity(ctx, tx, inv.RoomID, 1, h.maxRoomSize); err != nil {
+			return err
+		}
+		if err := tx.RoomMember.Create().
+			SetRoomID(inv.RoomID).
+			SetUserID(userUUID).
+			SetJoinedAt(time.Now()).
+			Exec(ctx); err != nil {
+			return err
+		}
+		return recordSystemMessage(ctx, tx, inv.RoomID, userUUID, models.SystemMessagePayload{
+			Event: models.SystemEventMemberJoined,
+		})
+	})
+	if err != nil {
+		var httpErr *echo.HTTPError
+		if errors.As(err, &httpErr) {
+			return httpErr
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to accept invite")
+	}
+
+	return c.JSON(http.StatusOK, map[string]string{
+		"message": "Joined room successfully",
+		"room_id": roomUUID.String(),
+	})
+}
+
+// underMaxUses 使用回数が最大使用回数に達していない招待（最大使用回数がnullの場合は無制限）
+func underMaxUses() func(*sql.Selector) {
+	return func(s *sql.Selector) {
+		s.Where(sql.Or(
+			sql.IsNull(s.C(invite.FieldMaxUses)),
+			sql.ColumnsLT(s.C(invite.FieldUseCount), s.C(invite.FieldMaxUses)),
+		))
+	}
+}
@@ -0,0 +1,336 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/chatroom"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/joinrequest"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/roommember"
+	"github.com/hideaki1979/cc-chat-app/apps/api/internal/models"
+	"github.com/labstack/echo/v4"
+)
+
+// DiscoverRooms 参加可能な公開ルーム一覧取得（名前で検索可能）
+// GET /api/chatrooms/discover?q=&page=&page_size=
+func (h *ChatRoomHandler) DiscoverRooms(c echo.Context) error {
+	userUUID, err := getUserUUID(c)
+	if err != nil {
+		return err
+	}
+
+	// クエリパラメータ
+	page, _ := strconv.Atoi(c.QueryParam("page"))
+	if page <= 0 {
+		page = 1
+	}
+	pageSize, _ := strconv.Atoi(c.QueryParam("page_size"))
+	if pageSize <= 0 || pageSize > 100 {
+		pageSize = 20
+	}
+
+	ctx := context.Background()
+
+	query := h.client.ChatRoom.Query().
+		Where(
+			chatroom.VisibilityIn(chatroom.VisibilityPublic, chatroom.VisibilityRequestToJoin),
+			chatroom.DmKeyIsNil(),
+			chatroom.ArchivedAtIsNil(),
+		)
+	if q := strings.TrimSpace(c.QueryParam("q")); q != "" {
+		query = query.Where(chatroom.NameContainsFold(q))
+	}
+
+	rooms, err := query.
+		WithRoomMembers(func(q *ent.RoomMemberQuery) {
+			q.Select(roommember.FieldRoomID, roommember.FieldUserID)
+		}).
+		Order(ent.Desc(chatroom.FieldUpdatedAt)).
+		Offset((page - 1) * pageSize).
+		Limit(pageSize).
+		All(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get public rooms")
+	}
+
+	responses := make([]*models.DiscoverRoomResponse, len(rooms))
+	for i, room := range rooms {
+		response := &models.DiscoverRoomResponse{
+			ID:          room.ID.String(),
+			Name:        room.Name,
+			Visibility:  string(room.Visibility),
+			MemberCount: len(room.Edges.RoomMembers),
+			CreatedAt:   room.CreatedAt,
+		}
+		for _, rm := range room.Edges.RoomMembers {
+			if rm.UserID == userUUID {
+				response.IsMember = true
+				break
+			}
+		}
+		responses[i] = response
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"rooms": responses,
+		"pagination": map[string]interface{}{
+			"page":      page,
+			"page_size": pageSize,
+			"total":     len(responses),
+		},
+	})
+}
+
+// JoinRoom 公開ルームへの参加（request_to_joinのルームは参加申請を作成）
+// POST /api/chatrooms/:id/join
+func (h *ChatRoomHandler) JoinRoom(c echo.Context) error {
+	roomUUID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid room ID")
+	}
+
+	userUUID, err := getUserUUID(c)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+
+	// 非公開ルームの存在は明かさない
+	room, err := h.client.ChatRoom.Query().
+		Where(
+			chatroom.ID(roomUUID),
+			chatroom.VisibilityNEQ(chatroom.VisibilityPrivate),
+			chatroom.DmKeyIsNil(),
+			chatroom.ArchivedAtIsNil(),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return echo.NewHTTPError(http.StatusNotFound, "Chat room not found")
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get chat room")
+	}
+
+	alreadyMember, err := h.client.RoomMember.Query().
+		Where(
+			roommember.RoomID(roomUUID),
+			roommember.UserID(userUUID),
+		).
+		Exist(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to check membership")
+	}
+	if alreadyMember {
+		return echo.NewHTTPError(http.StatusConflict, "You are already a member of this room")
+	}
+
+	if room.Visibility == chatroom.VisibilityPublic {
+		// 最大メンバー数のチェックと参加を同じトランザクションで行う
+		err := withTx(ctx, h.client, func(tx *ent.Tx) error {
+			if err := checkRoomCapacity(ctx, tx, roomUUID, 1, h.maxRoomSize); err != nil {
+				return err
+			}
+			if err := tx.RoomMember.Create().
+				SetRoomID(roomUUID).
+				SetUserID(userUUID).
+				SetJoinedAt(time.Now()).
+				Exec(ctx); err != nil {
+				return err
+			}
+			return recordSystemMessage(ctx, tx, roomUUID, userUUID, models.SystemMessagePayload{
+				Event: models.SystemEventMemberJoined,
+			})
+		})
+		if err != nil {
+			var httpErr *echo.HTTPError
+			if errors.As(err, &httpErr) {
+				return httpErr
+			}
+			if ent.IsConstraintError(err) {
+				return echo.NewHTTPError(http.StatusConflict, "You are already a member of this room")
+			}
+			return echo.NewHTTPError(http.StatusInternalServerError, "Failed to join room")
+		}
+		return c.JSON(http.StatusCreated, map[string]string{
+			"message": "Joined room successfully",
+			"room_id": roomUUID.String(),
+		})
+	}
+
+	// 参加申請を作成（却下・退出後の再申請は既存の申請を承認待ちに戻す）
+	existing, err := h.client.JoinRequest.Query().
+		Where(
+			joinrequest.RoomID(roomUUID),
+			joinrequest.UserID(userUUID),
+		).
+		Only(ctx)
+	var request *ent.JoinRequest
+	switch {
+	case ent.IsNotFound(err):
+		request, err = h.client.JoinRequest.Create().
+			SetRoomID(roomUUID).
+			SetUserID(userUUID).
+			Save(ctx)
+	case err == nil && existing.Status == joinrequest.StatusPending:
+		request = existing
+	case err == nil:
+		request, err = h.client.JoinRequest.UpdateOne(existing).
+			SetStatus(joinrequest.StatusPending).
+			ClearDecidedBy().
+			ClearDecidedAt().
+			Save(ctx)
+	}
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to request to join")
+	}
+
+	return c.JSON(http.StatusAccepted, models.ConvertToJoinRequestResponse(request))
+}
+
+// GetJoinRequests 承認待ちの参加申請一覧取得
+// GET /api/chatrooms/:id/join-requests
+func (h *ChatRoomHandler) GetJoinRequests(c echo.Context) error {
+	roomUUID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid room ID")
+	}
+
+	userUUID, err := getUserUUID(c)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+
+	if _, err := authorizeRoom(ctx, h.client, roomUUID, userUUID, ActionReviewJoinRequest); err != nil {
+		return err
+	}
+
+	requests, err := h.client.JoinRequest.Query().
+		Where(
+			joinrequest.RoomID(roomUUID),
+			joinrequest.StatusEQ(joinrequest.StatusPending),
+		).
+		WithUser().
+		Order(ent.Asc(joinrequest.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get join requests")
+	}
+
+	responses := make([]*models.JoinRequestResponse, len(requests))
+	for i, request := range requests {
+		responses[i] = models.ConvertToJoinRequestResponse(request)
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"join_requests": responses,
+	})
+}
+
+// ApproveJoinRequest 参加申請の承認（申請者をメンバーに追加）
+// POST /api/chatrooms/:id/join-requests/:request_id/approve
+func (h *ChatRoomHandler) ApproveJoinRequest(c echo.Context) error {
+	return h.decideJoinRequest(c, joinrequest.StatusApproved)
+}
+
+// RejectJoinRequest 参加申請の却下
+// POST /api/chatrooms/:id/join-requests/:request_id/reject
+func (h *ChatRoomHandler) RejectJoinRequest(c echo.Context) error {
+	return h.decideJoinRequest(c, joinrequest.StatusRejected)
+}
+
+// decideJoinRequest 承認待ちの参加申請を承認または却下
+func (h *ChatRoomHandler) decideJoinRequest(c echo.Context, status joinrequest.Status) error {
+	roomUUID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid room ID")
+	}
+
+	requestID, err := strconv.ParseInt(c.Param("request_id"), 10, 64)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid join request ID")
+	}
+
+	userUUID, err := getUserUUID(c)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+
+	if _, err := authorizeRoom(ctx, h.client, roomUUID, userUUID, ActionReviewJoinRequest); err != nil {
+		return err
+	}
+
+	// 申請状態の更新とメンバー追加を同じトランザクションで行う
+	var request *ent.JoinRequest
+	err = withTx(ctx, h.client, func(tx *ent.Tx) error {
+		// 承認待ちの申請のみ更新する（同時に処理された場合は後の処理を失敗させる）
+		n, err := tx.JoinRequest.Update().
+			Where(
+				joinrequest.ID(requestID),
+				joinrequest.RoomID(roomUUID),
+				joinrequest.StatusEQ(joinrequest.StatusPending),
+			).
+			SetStatus(status).
+			SetDecidedBy(userUUID).
+			SetDecidedAt(time.Now()).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			return echo.NewHTTPError(http.StatusNotFound, "Join request not found")
+		}
+
+		request, err = tx.JoinRequest.Get(ctx, requestID)
+		if err != nil {
+			return err
+		}
+		if status != joinrequest.StatusApproved {
+			return nil
+		}
+
+		alreadyMember, err := tx.RoomMember.Query().
+			Where(
+				roommember.RoomID(roomUUID),
+				roommember.UserID(request.UserID),
+			).
+			Exist(ctx)
+		if err != nil || alreadyMember {
+			return err
+		}
+		if err := checkRoomCapacity(ctx, tx, roomUUID, 1, h.maxRoomSize); err != nil {
+			return err
+		}
+		if err := tx.RoomMember.Create().
+			SetRoomID(roomUUID).
+			SetUserID(request.UserID).
+			SetJoinedAt(time.Now()).
+			Exec(ctx); err != nil {
+			return err
+		}
+		// 承認したメンバーが申請者を追加したものとして記録する
+		return recordSystemMessage(ctx, tx, roomUUID, userUUID, models.SystemMessagePayload{
+			Event:   models.SystemEventMemberAdded,
+			UserIDs: []string{request.UserID.String()},
+		})
+	})
+	if err != nil {
+		var httpErr *echo.HTTPError
+		if errors.As(err, &httpErr) {
+			return httpErr
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to update join request")
+	}
+
+	return c.JSON(http.StatusOK, models.ConvertToJoinRequestResponse(request))
+}
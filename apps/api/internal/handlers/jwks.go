@@ -0,0 +1,27 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/hideaki1979/cc-chat-app/apps/api/internal/auth"
+	"github.com/labstack/echo/v4"
+)
+
+// JWKSのキャッシュ時間（鍵のローテーション時は、新しい鍵での署名開始までにこの時間以上空ける）
+const jwksCacheControl = "public, max-age=300"
+
+// JWKSHandler トークン検証用の公開鍵配信ハンドラー構造体
+type JWKSHandler struct {
+	keys *auth.KeySet
+}
+
+// NewJWKSHandler 新しいJWKSHandlerインスタンスを作成
+func NewJWKSHandler(keys *auth.KeySet) *JWKSHandler {
+	return &JWKSHandler{keys: keys}
+}
+
+// JWKS 他のサービスがアクセストークンを検証するための公開鍵一覧を返す（認証不要）
+func (h *JWKSHandler) JWKS(c echo.Context) error {
+	c.Response().Header().Set(echo.HeaderCacheControl, jwksCacheControl)
+	return c.JSON(http.StatusOK, h.keys.JWKS())
+}
@@ -0,0 +1,227 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/roommember"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/user"
+	"github.com/hideaki1979/cc-chat-app/apps/api/internal/models"
+	"github.com/labstack/echo/v4"
+)
+
+// BulkUpdateMembers メンバーの一括追加・削除（ユーザーごとの結果を返す）
+// POST /api/chatrooms/:id/members/bulk
+func (h *ChatRoomHandler) BulkUpdateMembers(c echo.Context) error {
+	roomUUID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid room ID")
+	}
+
+	var req models.BulkMembersRequest
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid request body")
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	addUUIDs := uniqueUUIDs(req.Add)
+	removeUUIDs := uniqueUUIDs(req.Remove)
+	if len(addUUIDs) == 0 && len(removeUUIDs) == 0 {
+		return echo.NewHTTPError(http.StatusBadRequest, "Specify users to add or remove")
+	}
+	adding := make(map[uuid.UUID]bool, len(addUUIDs))
+	for _, id := range addUUIDs {
+		adding[id] = true
+	}
+	for _, id := range removeUUIDs {
+		if adding[id] {
+			return echo.NewHTTPError(http.StatusBadRequest, "A user cannot be both added and removed")
+		}
+	}
+
+	userUUID, err := getUserUUID(c)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+
+	var results []models.BulkMemberResult
+	err = withTx(ctx, h.client, func(tx *ent.Tx) error {
+		actor, err := roomMember(ctx, tx.Client(), roomUUID, userUUID)
+		if err != nil {
+			return err
+		}
+
+		// 対象ユーザーの存在と現在のメンバー情報をまとめて取得
+		targets := append(append([]uuid.UUID{}, addUUIDs...), removeUUIDs...)
+		existingIDs, err := tx.User.Query().
+			Where(user.IDIn(targets...)).
+			IDs(ctx)
+		if err != nil {
+			return err
+		}
+		existing := make(map[uuid.UUID]bool, len(existingIDs))
+		for _, id := range existingIDs {
+			existing[id] = true
+		}
+		members, err := tx.RoomMember.Query().
+			Where(
+				roommember.RoomID(roomUUID),
+				roommember.UserIDIn(targets...),
+			).
+			All(ctx)
+		if err != nil {
+			return err
+		}
+		memberOf := make(map[uuid.UUID]*ent.RoomMember, len(members))
+		for _, m := range members {
+			memberOf[m.UserID] = m
+		}
+
+		result := func(id uuid.UUID, status string) {
+			results = append(results, models.BulkMemberResult{UserID: id.String(), Status: status})
+		}
+
+		var added []uuid.UUID
+		for _, id := range addUUIDs {
+			switch {
+			case !Can(actor.Role, ActionAddMember):
+				result(id, models.BulkMemberForbidden)
+			case !existing[id]:
+				result(id, models.BulkMemberNotFound)
+			case memberOf[id] != nil:
+				result(id, models.BulkMemberAlreadyMember)
+			default:
+				added = append(added, id)
+				result(id, models.BulkMemberAdded)
+			}
+		}
+
+		// 自分自身の削除は退出APIで行う
+		var removed []*ent.RoomMember
+		for _, id := range removeUUIDs {
+			target := memberOf[id]
+			switch {
+			case id == userUUID || !Can(actor.Role, ActionRemoveMember):
+				result(id, models.BulkMemberForbidden)
+			case !existing[id]:
+				result(id, models.BulkMemberNotFound)
+			case target == nil:
+				result(id, models.BulkMemberNotMember)
+			case !outranks(actor.Role, target.Role):
+				result(id, models.BulkMemberForbidden)
+			default:
+				removed = append(removed, target)
+				result(id, models.BulkMemberRemoved)
+			}
+		}
+
+		if err := checkRoomCapacity(ctx, tx, roomUUID, len(added)-len(removed), h.maxRoomSize); err != nil {
+			return err
+		}
+
+		if len(removed) > 0 {
+			ids := make([]int64, len(removed))
+			userIDs := make([]string, len(removed))
+			for i, m := range removed {
+				ids[i] = m.ID
+				userIDs[i] = m.UserID.String()
+			}
+			if _, err := tx.RoomMember.Delete().Where(roommember.IDIn(ids...)).Exec(ctx); err != nil {
+				return err
+			}
+			err := recordSystemMessage(ctx, tx, roomUUID, userUUID, models.SystemMessagePayload{
+				Event:   models.SystemEventMemberRemoved,
+				UserIDs: userIDs,
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		if len(added) > 0 {
+			now := time.Now()
+			builders := make([]*ent.RoomMemberCreate, len(added))
+			userIDs := make([]string, len(added))
+			for i, id := range added {
+				builders[i] = tx.RoomMember.Create().
+					SetRoomID(roomUUID).
+					SetUserID(id).
+					SetJoinedAt(now)
+				userIDs[i] = id.String()
+			}
+			if err := tx.RoomMember.CreateBulk(builders...).Exec(ctx); err != nil {
+				return err
+			}
+			return recordSystemMessage(ctx, tx, roomUUID, userUUID, models.SystemMessagePayload{
+				Event:   models.SystemEventMemberAdded,
+				UserIDs: userIDs,
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		var httpErr *echo.HTTPError
+		if errors.As(err, &httpErr) {
+			return httpErr
+		}
+		if ent.IsConstraintError(err) {
+			return echo.NewHTTPError(http.StatusConflict, "Room members were changed concurrently")
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to update members")
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"results": results,
+	})
+}
+
+// checkRoomCapacity メンバーをadding人追加した後に最大メンバー数を超えないかチェック（0は無制限）
+// 同時に追加された場合でも上限を超えないよう、ルームをロックしてから数える（メンバーの追加は同じトランザクションで行う）
+func checkRoomCapacity(ctx context.Context, tx *ent.Tx, roomID uuid.UUID, adding, maxRoomSize int) error {
+	if maxRoomSize <= 0 || adding <= 0 {
+		return nil
+	}
+	if err := lockRoom(ctx, tx, roomID); err != nil {
+		return err
+	}
+	count, err := tx.RoomMember.Query().
+		Where(roommember.RoomID(roomID)).
+		Count(ctx)
+	if err != nil {
+		return err
+	}
+	if count+adding > maxRoomSize {
+		return errRoomFull(maxRoomSize)
+	}
+	return nil
+}
+
+// errRoomFull 最大メンバー数を超える場合のエラー
+func errRoomFull(maxRoomSize int) error {
+	return echo.NewHTTPError(http.StatusConflict, fmt.Sprintf("Room cannot have more than %d members", maxRoomSize))
+}
+
+// uniqueUUIDs UUID文字列を重複を除いて変換（バリデーション済みのため、不正な値は無視）
+func uniqueUUIDs(ids []string) []uuid.UUID {
+	seen := make(map[uuid.UUID]bool, len(ids))
+	result := make([]uuid.UUID, 0, len(ids))
+	for _, id := range ids {
+		u, err := uuid.Parse(id)
+		if err != nil || seen[u] {
+			continue
+		}
+		seen[u] = true
+		result = append(result, u)
+	}
+	return result
+}
@@ -2,13 +2,18 @@ package handlers
 
 import (
 	"context"
+	"fmt"
 	"net/http"
-	"strconv"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/hideaki1979/cc-chat-app/apps/api/ent"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/chatroom"
 	"github.com/hideaki1979/cc-chat-app/apps/api/ent/message"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/messagereaction"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/messageread"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/messagerevision"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/predicate"
 	"github.com/hideaki1979/cc-chat-app/apps/api/ent/roommember"
 	"github.com/hideaki1979/cc-chat-app/apps/api/internal/models"
 	"github.com/labstack/echo/v4"
@@ -16,12 +21,13 @@ import (
 
 // MessageHandler メッセージ関連のハンドラー
 type MessageHandler struct {
-	client *ent.Client
+	client            *ent.Client
+	defaultEditWindow time.Duration // ルームで編集可能時間が未設定の場合に使用
 }
 
 // NewMessageHandler MessageHandlerのコンストラクタ
-func NewMessageHandler(client *ent.Client) *MessageHandler {
-	return &MessageHandler{client: client}
+func NewMessageHandler(client *ent.Client, defaultEditWindow time.Duration) *MessageHandler {
+	return &MessageHandler{client: client, defaultEditWindow: defaultEditWindow}
 }
 
 // SendMessage メッセージ送信
@@ -77,15 +83,49 @@ func (h *MessageHandler) SendMessage(c echo.Context) error {
 		messageBuilder = messageBuilder.SetFileURL(req.FileURL)
 	}
 
+	// 返信の場合は同じルームの返信先メッセージを確認
+	if req.ReplyToID != "" {
+		replyToUUID, err := uuid.Parse(req.ReplyToID)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, "Invalid reply target message ID")
+		}
+		parent, err := h.client.Message.Query().
+			Where(
+				message.ID(replyToUUID),
+				message.RoomID(roomUUID),
+			).
+			Only(ctx)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return echo.NewHTTPError(http.StatusBadRequest, "Reply target message not found in this room")
+			}
+			return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get reply target message")
+		}
+		if parent.DeletedAt != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, "Cannot reply to a deleted message")
+		}
+		// 返信先は引用表示用にスレッドの親とは別に保持する
+		messageBuilder = messageBuilder.SetReplyToID(parent.ID)
+		// スレッドは1階層のみ（返信への返信はスレッドの親への返信とする）
+		if !req.QuoteOnly {
+			parentID := parent.ID
+			if parent.ParentID != nil {
+				parentID = *parent.ParentID
+			}
+			messageBuilder = messageBuilder.SetParentID(parentID)
+		}
+	}
+
 	msg, err := messageBuilder.Save(ctx)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to send message")
 	}
 
-	// 送信者情報を含めてメッセージを再取得
+	// 送信者・返信先情報を含めてメッセージを再取得
 	messageWithSender, err := h.client.Message.Query().
 		Where(message.ID(msg.ID)).
 		WithSender().
+		WithReplyTo(withReplyToSender).
 		Only(ctx)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get sent message")
@@ -95,8 +135,9 @@ func (h *MessageHandler) SendMessage(c echo.Context) error {
 	return c.JSON(http.StatusCreated, response)
 }
 
-// GetMessages チャットルームのメッセージ一覧取得
-// GET /api/chatrooms/:room_id/messages
+// GetMessages チャットルームのメッセージ一覧取得（新しい順）
+// before・afterにはレスポンスのカーソル、aroundにはメッセージIDを指定する
+// GET /api/chatrooms/:room_id/messages?limit=&before=|after=|around=
 func (h *MessageHandler) GetMessages(c echo.Context) error {
 	roomID := c.Param("room_id")
 	roomUUID, err := uuid.Parse(roomID)
@@ -110,22 +151,26 @@ func (h *MessageHandler) GetMessages(c echo.Context) error {
 	}
 
 	// クエリパラメータ
-	page, _ := strconv.Atoi(c.QueryParam("page"))
-	if page <= 0 {
-		page = 1
+	var params models.MessageListParams
+	if err := c.Bind(&params); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid query parameters")
 	}
-	pageSize, _ := strconv.Atoi(c.QueryParam("page_size"))
-	if pageSize <= 0 || pageSize > 100 {
-		pageSize = 50
+	if err := c.Validate(&params); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	if params.Limit == 0 {
+		params.Limit = 50
 	}
 
-	beforeStr := c.QueryParam("before")
-	var beforeTime *time.Time
-	if beforeStr != "" {
-		if parsedTime, err := time.Parse(time.RFC3339, beforeStr); err == nil {
-			beforeTime = &parsedTime
+	specified := 0
+	for _, v := range []string{params.Before, params.After, params.Around} {
+		if v != "" {
+			specified++
 		}
 	}
+	if specified > 1 {
+		return echo.NewHTTPError(http.StatusBadRequest, "Only one of before, after or around can be specified")
+	}
 
 	ctx := context.Background()
 
@@ -143,45 +188,200 @@ func (h *MessageHandler) GetMessages(c echo.Context) error {
 		return echo.NewHTTPError(http.StatusForbidden, "You are not a member of this room")
 	}
 
-	// メッセージクエリ作成
-	query := h.client.Message.Query().
-		Where(
-			message.RoomID(roomUUID),
-			message.DeletedAtIsNil(), // 論理削除されていないメッセージのみ
-		).
-		WithSender()
+	// メッセージクエリ作成（論理削除されていない、スレッドの返信以外のメッセージのみ）
+	query := func() *ent.MessageQuery {
+		return h.client.Message.Query().
+			Where(
+				message.RoomID(roomUUID),
+				message.DeletedAtIsNil(),
+				message.ParentIDIsNil(),
+			)
+	}
+	newestFirst := []message.OrderOption{ent.Desc(message.FieldCreatedAt), ent.Desc(message.FieldID)}
+	oldestFirst := []message.OrderOption{ent.Asc(message.FieldCreatedAt), ent.Asc(message.FieldID)}
+
+	var (
+		messages []*ent.Message
+		hasOlder bool
+		hasNewer bool
+		hasMore  bool
+	)
+	switch {
+	case params.After != "":
+		cursor, err := models.ParseMessageCursor(params.After)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, "Invalid after cursor")
+		}
+		// カーソルに近い順に取得し、新しい順に並べ替える
+		newer, err := query().
+			Where(messageAfter(cursor)).
+			WithSender().
+			WithReplyTo(withReplyToSender).
+			WithReactions(withReactionOrder).
+			Order(oldestFirst...).
+			Limit(params.Limit + 1).
+			All(ctx)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get messages")
+		}
+		hasNewer = len(newer) > params.Limit
+		if hasNewer {
+			newer = newer[:params.Limit]
+		}
+		messages = reverseMessages(newer)
+		hasMore = hasNewer
+		if len(messages) > 0 {
+			hasOlder, err = query().Where(messageBefore(models.NewMessageCursor(messages[len(messages)-1]))).Exist(ctx)
+			if err != nil {
+				return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get messages")
+			}
+		}
 
-	// before パラメータがある場合、その時刻より前のメッセージを取得
-	if beforeTime != nil {
-		query = query.Where(message.CreatedAtLT(*beforeTime))
-	}
+	case params.Around != "":
+		targetUUID, err := uuid.Parse(params.Around)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, "Invalid around message ID")
+		}
+		target, err := query().
+			Where(message.ID(targetUUID)).
+			WithSender().
+			WithReplyTo(withReplyToSender).
+			WithReactions(withReactionOrder).
+			Only(ctx)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return echo.NewHTTPError(http.StatusNotFound, "Message not found")
+			}
+			return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get message")
+		}
 
-	// メッセージを取得（新しい順）
-	messages, err := query.
-		Order(ent.Desc(message.FieldCreatedAt)).
-		Offset((page - 1) * pageSize).
-		Limit(pageSize).
-		All(ctx)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get messages")
+		// 指定メッセージの前後をほぼ同数ずつ取得
+		olderLimit := (params.Limit - 1) / 2
+		newerLimit := params.Limit - 1 - olderLimit
+		cursor := models.NewMessageCursor(target)
+		older, err := query().
+			Where(messageBefore(cursor)).
+			WithSender().
+			WithReplyTo(withReplyToSender).
+			WithReactions(withReactionOrder).
+			Order(newestFirst...).
+			Limit(olderLimit + 1).
+			All(ctx)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get messages")
+		}
+		newer, err := query().
+			Where(messageAfter(cursor)).
+			WithSender().
+			WithReplyTo(withReplyToSender).
+			WithReactions(withReactionOrder).
+			Order(oldestFirst...).
+			Limit(newerLimit + 1).
+			All(ctx)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get messages")
+		}
+
+		hasOlder = len(older) > olderLimit
+		if hasOlder {
+			older = older[:olderLimit]
+		}
+		hasNewer = len(newer) > newerLimit
+		if hasNewer {
+			newer = newer[:newerLimit]
+		}
+		messages = append(append(reverseMessages(newer), target), older...)
+		hasMore = hasOlder || hasNewer
+
+	default:
+		q := query()
+		if params.Before != "" {
+			cursor, err := models.ParseMessageCursor(params.Before)
+			if err != nil {
+				return echo.NewHTTPError(http.StatusBadRequest, "Invalid before cursor")
+			}
+			q = q.Where(messageBefore(cursor))
+		}
+		older, err := q.
+			WithSender().
+			WithReplyTo(withReplyToSender).
+			WithReactions(withReactionOrder).
+			Order(newestFirst...).
+			Limit(params.Limit + 1).
+			All(ctx)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get messages")
+		}
+		hasOlder = len(older) > params.Limit
+		if hasOlder {
+			older = older[:params.Limit]
+		}
+		messages = older
+		hasMore = hasOlder
+		if params.Before != "" && len(messages) > 0 {
+			hasNewer, err = query().Where(messageAfter(models.NewMessageCursor(messages[0]))).Exist(ctx)
+			if err != nil {
+				return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get messages")
+			}
+		}
 	}
 
 	// レスポンス作成
 	responses := make([]*models.MessageResponse, len(messages))
 	for i, msg := range messages {
-		responses[i] = models.ConvertToMessageResponse(msg)
+		responses[i] = models.ConvertToMessageResponseFor(msg, userUUID)
+	}
+
+	pagination := models.MessagePagination{
+		Limit:   params.Limit,
+		HasMore: hasMore,
+	}
+	if hasOlder {
+		next := models.NewMessageCursor(messages[len(messages)-1]).Encode()
+		pagination.NextCursor = &next
+	}
+	if hasNewer {
+		prev := models.NewMessageCursor(messages[0]).Encode()
+		pagination.PrevCursor = &prev
 	}
 
 	return c.JSON(http.StatusOK, map[string]interface{}{
-		"messages": responses,
-		"pagination": map[string]interface{}{
-			"page":      page,
-			"page_size": pageSize,
-			"total":     len(responses),
-		},
+		"messages":   responses,
+		"pagination": pagination,
 	})
 }
 
+// messageBefore カーソルより古いメッセージの条件（作成日時が同じ場合はIDで順序付け）
+func messageBefore(cursor models.MessageCursor) predicate.Message {
+	return message.Or(
+		message.CreatedAtLT(cursor.CreatedAt),
+		message.And(
+			message.CreatedAt(cursor.CreatedAt),
+			message.IDLT(cursor.ID),
+		),
+	)
+}
+
+// messageAfter カーソルより新しいメッセージの条件（作成日時が同じ場合はIDで順序付け）
+func messageAfter(cursor models.MessageCursor) predicate.Message {
+	return message.Or(
+		message.CreatedAtGT(cursor.CreatedAt),
+		message.And(
+			message.CreatedAt(cursor.CreatedAt),
+			message.IDGT(cursor.ID),
+		),
+	)
+}
+
+// reverseMessages 古い順に取得したメッセージを新しい順に並べ替える
+func reverseMessages(messages []*ent.Message) []*ent.Message {
+	reversed := make([]*ent.Message, len(messages))
+	for i, msg := range messages {
+		reversed[len(messages)-1-i] = msg
+	}
+	return reversed
+}
+
 // GetMessage メッセージ詳細取得
 // GET /api/messages/:id
 func (h *MessageHandler) GetMessage(c echo.Context) error {
@@ -198,37 +398,13 @@ func (h *MessageHandler) GetMessage(c echo.Context) error {
 
 	ctx := context.Background()
 
-	// メッセージ取得
-	msg, err := h.client.Message.Query().
-		Where(
-			message.ID(messageUUID),
-			message.DeletedAtIsNil(),
-		).
-		WithSender().
-		WithRoom().
-		Only(ctx)
+	// メッセージ取得とメンバーチェック
+	msg, err := h.getMemberMessage(ctx, messageUUID, userUUID)
 	if err != nil {
-		if ent.IsNotFound(err) {
-			return echo.NewHTTPError(http.StatusNotFound, "Message not found")
-		}
-		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get message")
-	}
-
-	// ユーザーがそのルームのメンバーかチェック
-	isMember, err := h.client.RoomMember.Query().
-		Where(
-			roommember.RoomID(msg.RoomID),
-			roommember.UserID(userUUID),
-		).
-		Exist(ctx)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to check membership")
-	}
-	if !isMember {
-		return echo.NewHTTPError(http.StatusForbidden, "You are not a member of this room")
+		return err
 	}
 
-	response := models.ConvertToMessageResponse(msg)
+	response := models.ConvertToMessageResponseFor(msg, userUUID)
 	return c.JSON(http.StatusOK, response)
 }
 
@@ -275,15 +451,41 @@ func (h *MessageHandler) UpdateMessage(c echo.Context) error {
 	if msg.UserID != userUUID {
 		return echo.NewHTTPError(http.StatusForbidden, "You can only update your own messages")
 	}
-
-	// メッセージ更新（作成から5分以内のみ編集可能）
-	if time.Since(msg.CreatedAt) > MessageEditTimeLimit {
-		return echo.NewHTTPError(http.StatusBadRequest, "Message can only be edited within 5 minutes")
+	if msg.Type != message.TypeUser {
+		return echo.NewHTTPError(http.StatusForbidden, "System messages cannot be edited")
 	}
 
-	updatedMsg, err := h.client.Message.UpdateOneID(messageUUID).
-		SetContent(req.Content).
-		Save(ctx)
+	// ルームの編集可能時間をチェック
+	room, err := h.client.ChatRoom.Query().
+		Where(chatroom.ID(msg.RoomID)).
+		Select(chatroom.FieldEditWindowSeconds).
+		Only(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get chat room")
+	}
+	editWindow := editWindowFromSeconds(room.EditWindowSeconds, h.defaultEditWindow)
+	switch {
+	case editWindow == EditWindowDisabled:
+		return echo.NewHTTPError(http.StatusForbidden, map[string]interface{}{
+			"message":             "Editing messages is disabled in this room",
+			"edit_window_seconds": 0,
+		})
+	case editWindow > 0 && time.Since(msg.CreatedAt) > editWindow:
+		return echo.NewHTTPError(http.StatusBadRequest, map[string]interface{}{
+			"message":             fmt.Sprintf("Message can only be edited within %s", formatEditWindow(editWindow)),
+			"edit_window_seconds": int(editWindow / time.Second),
+		})
+	}
+
+	// 編集履歴の保存と同じトランザクションで更新
+	var updatedMsg *ent.Message
+	err = withTx(ctx, h.client, func(tx *ent.Tx) error {
+		var err error
+		updatedMsg, err = tx.Message.UpdateOneID(messageUUID).
+			SetContent(req.Content).
+			Save(ctx)
+		return err
+	})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to update message")
 	}
@@ -292,12 +494,14 @@ func (h *MessageHandler) UpdateMessage(c echo.Context) error {
 	updatedMsgWithSender, err := h.client.Message.Query().
 		Where(message.ID(updatedMsg.ID)).
 		WithSender().
+		WithReplyTo(withReplyToSender).
+		WithReactions(withReactionOrder).
 		Only(ctx)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get updated message")
 	}
 
-	response := models.ConvertToMessageResponse(updatedMsgWithSender)
+	response := models.ConvertToMessageResponseFor(updatedMsgWithSender, userUUID)
 	return c.JSON(http.StatusOK, response)
 }
 
@@ -335,6 +539,9 @@ func (h *MessageHandler) DeleteMessage(c echo.Context) error {
 	if msg.UserID != userUUID {
 		return echo.NewHTTPError(http.StatusForbidden, "You can only delete your own messages")
 	}
+	if msg.Type != message.TypeUser {
+		return echo.NewHTTPError(http.StatusForbidden, "System messages cannot be deleted")
+	}
 
 	// 論理削除
 	now := time.Now()
@@ -350,6 +557,435 @@ func (h *MessageHandler) DeleteMessage(c echo.Context) error {
 	})
 }
 
+// MarkAsRead ルームをメッセージまで既読にする（既読位置は後退しない）
+// POST /api/messages/:id/read
+func (h *MessageHandler) MarkAsRead(c echo.Context) error {
+	messageID := c.Param("id")
+	messageUUID, err := uuid.Parse(messageID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid message ID")
+	}
+
+	userUUID, err := getUserUUID(c)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+
+	// メッセージ取得とメンバーチェック
+	msg, err := h.getMemberMessage(ctx, messageUUID, userUUID)
+	if err != nil {
+		return err
+	}
+
+	read, err := h.markRead(ctx, userUUID, msg)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to mark message as read")
+	}
+
+	response := models.ConvertToReadPositionResponse(read)
+	return c.JSON(http.StatusOK, response)
+}
+
+// markRead ルームの既読位置をメッセージまで進める（既読位置より古いメッセージの場合は変更しない）
+// 同時に初めて既読にされた場合は一意制約違反になるため、作成済みの既読位置を取得し直して更新する
+func (h *MessageHandler) markRead(ctx context.Context, userUUID uuid.UUID, msg *ent.Message) (*ent.MessageRead, error) {
+	for retried := false; ; retried = true {
+		// 現在の既読位置を取得
+		read, err := h.client.MessageRead.Query().
+			Where(
+				messageread.UserID(userUUID),
+				messageread.RoomID(msg.RoomID),
+			).
+			WithMessage().
+			Only(ctx)
+		if ent.IsNotFound(err) {
+			read, err = h.client.MessageRead.Create().
+				SetRoomID(msg.RoomID).
+				SetUserID(userUUID).
+				SetMessageID(msg.ID).
+				Save(ctx)
+			if ent.IsConstraintError(err) && !retried {
+				continue
+			}
+			return read, err
+		}
+		if err != nil {
+			return nil, err
+		}
+		cursor := models.NewMessageCursor(msg)
+		if !models.NewMessageCursor(read.Edges.Message).Before(cursor) {
+			return read, nil
+		}
+
+		// 既読位置より新しいメッセージの場合のみ更新（同時に更新された場合も既読位置を戻さない）
+		_, err = h.client.MessageRead.Update().
+			Where(
+				messageread.ID(read.ID),
+				messageread.HasMessageWith(messageBefore(cursor)),
+			).
+			SetMessageID(msg.ID).
+			SetReadAt(time.Now()).
+			Save(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return h.client.MessageRead.Get(ctx, read.ID)
+	}
+}
+
+// GetMessageReaders メッセージを既読にしたメンバー一覧取得（送信者本人を除く）
+// GET /api/messages/:id/reads
+func (h *MessageHandler) GetMessageReaders(c echo.Context) error {
+	messageID := c.Param("id")
+	messageUUID, err := uuid.Parse(messageID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid message ID")
+	}
+
+	userUUID, err := getUserUUID(c)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+
+	// メッセージ取得とメンバーチェック
+	msg, err := h.getMemberMessage(ctx, messageUUID, userUUID)
+	if err != nil {
+		return err
+	}
+
+	// 既読位置がこのメッセージ以降のメンバーを取得
+	reads, err := h.client.MessageRead.Query().
+		Where(
+			messageread.RoomID(msg.RoomID),
+			messageread.UserIDNEQ(msg.UserID),
+			messageread.HasMessageWith(message.Not(messageBefore(models.NewMessageCursor(msg)))),
+		).
+		WithUser().
+		Order(ent.Asc(messageread.FieldReadAt)).
+		All(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get message readers")
+	}
+
+	responses := make([]*models.MessageReaderResponse, len(reads))
+	for i, read := range reads {
+		responses[i] = models.ConvertToMessageReaderResponse(read)
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"readers": responses,
+		"total":   len(responses),
+	})
+}
+
+// GetReplies スレッドの返信一覧取得（古い順）
+// 親メッセージが論理削除されていても、返信は親を削除済みとして表示できるよう取得できる
+// GET /api/messages/:id/replies?limit=&after=
+func (h *MessageHandler) GetReplies(c echo.Context) error {
+	messageID := c.Param("id")
+	messageUUID, err := uuid.Parse(messageID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid message ID")
+	}
+
+	userUUID, err := getUserUUID(c)
+	if err != nil {
+		return err
+	}
+
+	var params models.MessageListParams
+	if err := c.Bind(&params); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid query parameters")
+	}
+	if err := c.Validate(&params); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	if params.Limit == 0 {
+		params.Limit = 50
+	}
+
+	ctx := context.Background()
+
+	// 親メッセージ取得（論理削除済みも含む）とメンバーチェック
+	parent, err := h.client.Message.Query().
+		Where(message.ID(messageUUID)).
+		WithSender().
+		WithReplyTo(withReplyToSender).
+		WithReactions(withReactionOrder).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return echo.NewHTTPError(http.StatusNotFound, "Message not found")
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get message")
+	}
+	if err := h.requireMember(ctx, parent.RoomID, userUUID); err != nil {
+		return err
+	}
+
+	query := h.client.Message.Query().
+		Where(
+			message.ParentID(parent.ID),
+			message.DeletedAtIsNil(),
+		)
+	if params.After != "" {
+		cursor, err := models.ParseMessageCursor(params.After)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, "Invalid after cursor")
+		}
+		query = query.Where(messageAfter(cursor))
+	}
+
+	replies, err := query.
+		WithSender().
+		WithReplyTo(withReplyToSender).
+		WithReactions(withReactionOrder).
+		Order(ent.Asc(message.FieldCreatedAt), ent.Asc(message.FieldID)).
+		Limit(params.Limit + 1).
+		All(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get replies")
+	}
+
+	hasMore := len(replies) > params.Limit
+	if hasMore {
+		replies = replies[:params.Limit]
+	}
+
+	// レスポンス作成
+	responses := make([]*models.MessageResponse, len(replies))
+	for i, reply := range replies {
+		responses[i] = models.ConvertToMessageResponseFor(reply, userUUID)
+	}
+
+	var nextCursor *string
+	if hasMore {
+		next := models.NewMessageCursor(replies[len(replies)-1]).Encode()
+		nextCursor = &next
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"parent":  models.ConvertToMessageResponseFor(parent, userUUID),
+		"replies": responses,
+		"pagination": map[string]interface{}{
+			"limit":       params.Limit,
+			"next_cursor": nextCursor, // 続きの返信の取得用（afterに指定）
+			"has_more":    hasMore,
+		},
+	})
+}
+
+// GetRevisions メッセージの編集履歴取得（古い順）
+// GET /api/messages/:id/revisions
+func (h *MessageHandler) GetRevisions(c echo.Context) error {
+	messageID := c.Param("id")
+	messageUUID, err := uuid.Parse(messageID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid message ID")
+	}
+
+	userUUID, err := getUserUUID(c)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+
+	// メッセージ取得とメンバーチェック
+	msg, err := h.getMemberMessage(ctx, messageUUID, userUUID)
+	if err != nil {
+		return err
+	}
+
+	revisions, err := h.client.MessageRevision.Query().
+		Where(messagerevision.MessageID(msg.ID)).
+		Order(ent.Asc(messagerevision.FieldCreatedAt), ent.Asc(messagerevision.FieldID)).
+		All(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get message revisions")
+	}
+
+	responses := make([]*models.MessageRevisionResponse, len(revisions))
+	for i, revision := range revisions {
+		responses[i] = models.ConvertToMessageRevisionResponse(revision)
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"message":   models.ConvertToMessageResponseFor(msg, userUUID),
+		"revisions": responses,
+	})
+}
+
+// AddReaction メッセージにリアクションを追加
+// POST /api/messages/:id/reactions
+func (h *MessageHandler) AddReaction(c echo.Context) error {
+	messageID := c.Param("id")
+	messageUUID, err := uuid.Parse(messageID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid message ID")
+	}
+
+	var req models.ReactionRequest
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid request body")
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	userUUID, err := getUserUUID(c)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+
+	// メッセージ取得とメンバーチェック
+	msg, err := h.getMemberMessage(ctx, messageUUID, userUUID)
+	if err != nil {
+		return err
+	}
+
+	status := http.StatusCreated
+	_, err = h.client.MessageReaction.Create().
+		SetMessageID(msg.ID).
+		SetUserID(userUUID).
+		SetEmoji(req.Emoji).
+		Save(ctx)
+	if err != nil {
+		if !ent.IsConstraintError(err) {
+			return echo.NewHTTPError(http.StatusInternalServerError, "Failed to add reaction")
+		}
+		// 既にリアクション済みの場合は何もしない
+		status = http.StatusOK
+	}
+
+	return h.reactionsResponse(ctx, c, status, msg.ID, userUUID)
+}
+
+// RemoveReaction メッセージから自分のリアクションを削除
+// DELETE /api/messages/:id/reactions?emoji=
+func (h *MessageHandler) RemoveReaction(c echo.Context) error {
+	messageID := c.Param("id")
+	messageUUID, err := uuid.Parse(messageID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid message ID")
+	}
+
+	var req models.ReactionRequest
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid request body")
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	userUUID, err := getUserUUID(c)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+
+	// メッセージ取得とメンバーチェック
+	msg, err := h.getMemberMessage(ctx, messageUUID, userUUID)
+	if err != nil {
+		return err
+	}
+
+	deleted, err := h.client.MessageReaction.Delete().
+		Where(
+			messagereaction.MessageID(msg.ID),
+			messagereaction.UserID(userUUID),
+			messagereaction.Emoji(req.Emoji),
+		).
+		Exec(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to remove reaction")
+	}
+	if deleted == 0 {
+		return echo.NewHTTPError(http.StatusNotFound, "Reaction not found")
+	}
+
+	return h.reactionsResponse(ctx, c, http.StatusOK, msg.ID, userUUID)
+}
+
+// reactionsResponse メッセージの最新のリアクション集計を返す
+func (h *MessageHandler) reactionsResponse(ctx context.Context, c echo.Context, status int, messageUUID, userUUID uuid.UUID) error {
+	reactions, err := h.client.MessageReaction.Query().
+		Where(messagereaction.MessageID(messageUUID)).
+		Order(ent.Asc(messagereaction.FieldCreatedAt), ent.Asc(messagereaction.FieldID)).
+		All(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get reactions")
+	}
+
+	return c.JSON(status, map[string]interface{}{
+		"message_id": messageUUID.String(),
+		"reactions":  models.SummarizeReactions(reactions, userUUID),
+	})
+}
+
+// getMemberMessage 論理削除されていないメッセージを送信者・リアクション込みで取得し、ユーザーがそのルームのメンバーかチェックする
+// 戻り値のエラーはそのままハンドラーから返せるHTTPエラー
+func (h *MessageHandler) getMemberMessage(ctx context.Context, messageUUID, userUUID uuid.UUID) (*ent.Message, error) {
+	msg, err := h.client.Message.Query().
+		Where(
+			message.ID(messageUUID),
+			message.DeletedAtIsNil(),
+		).
+		WithSender().
+		WithReplyTo(withReplyToSender).
+		WithReactions(withReactionOrder).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, echo.NewHTTPError(http.StatusNotFound, "Message not found")
+		}
+		return nil, echo.NewHTTPError(http.StatusInternalServerError, "Failed to get message")
+	}
+
+	// ユーザーがそのルームのメンバーかチェック
+	if err := h.requireMember(ctx, msg.RoomID, userUUID); err != nil {
+		return nil, err
+	}
+
+	return msg, nil
+}
+
+// requireMember ユーザーがルームのメンバーかチェックする（メンバーでない場合は403エラー）
+func (h *MessageHandler) requireMember(ctx context.Context, roomUUID, userUUID uuid.UUID) error {
+	isMember, err := h.client.RoomMember.Query().
+		Where(
+			roommember.RoomID(roomUUID),
+			roommember.UserID(userUUID),
+		).
+		Exist(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to check membership")
+	}
+	if !isMember {
+		return echo.NewHTTPError(http.StatusForbidden, "You are not a member of this room")
+	}
+	return nil
+}
+
+// withReplyToSender 返信先メッセージを送信者込みで読み込む
+func withReplyToSender(q *ent.MessageQuery) {
+	q.WithSender()
+}
+
+// withReactionOrder リアクションを作成順に読み込む
+func withReactionOrder(q *ent.MessageReactionQuery) {
+	q.Order(ent.Asc(messagereaction.FieldCreatedAt), ent.Asc(messagereaction.FieldID))
+}
+
 // 安全に user_id を取り出し UUID へ変換するヘルパー関数
 func getUserUUID(c echo.Context) (uuid.UUID, error) {
 	v := c.Get("user_id")
@@ -0,0 +1,115 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/roommember"
+	"github.com/labstack/echo/v4"
+)
+
+// RoomAction ルームに対する操作
+type RoomAction string
+
+const (
+	ActionUpdateRoom        RoomAction = "update_room"         // ルーム名・設定の変更
+	ActionAddMember         RoomAction = "add_member"          // メンバーの追加
+	ActionRemoveMember      RoomAction = "remove_member"       // メンバーの削除
+	ActionChangeRole        RoomAction = "change_role"         // メンバーの役割変更
+	ActionTransferOwnership RoomAction = "transfer_ownership"  // オーナー権限の譲渡
+	ActionManageInvites     RoomAction = "manage_invites"      // 招待リンクの作成・一覧・無効化
+	ActionReviewJoinRequest RoomAction = "review_join_request" // 参加申請の承認・却下
+)
+
+// roomPermissions 役割ごとに許可された操作
+var roomPermissions = map[roommember.Role]map[RoomAction]bool{
+	roommember.RoleOwner: {
+		ActionUpdateRoom:        true,
+		ActionAddMember:         true,
+		ActionRemoveMember:      true,
+		ActionChangeRole:        true,
+		ActionTransferOwnership: true,
+		ActionManageInvites:     true,
+		ActionReviewJoinRequest: true,
+	},
+	roommember.RoleAdmin: {
+		ActionUpdateRoom:        true,
+		ActionAddMember:         true,
+		ActionRemoveMember:      true,
+		ActionManageInvites:     true,
+		ActionReviewJoinRequest: true,
+	},
+	roommember.RoleMember: {},
+}
+
+// Can 役割が操作を許可されているか
+func Can(role roommember.Role, action RoomAction) bool {
+	return roomPermissions[role][action]
+}
+
+// roleRank 役割の優先度（自分より下位の役割のメンバーのみ操作できる）
+func roleRank(role roommember.Role) int {
+	switch role {
+	case roommember.RoleOwner:
+		return 2
+	case roommember.RoleAdmin:
+		return 1
+	}
+	return 0
+}
+
+// outranks actorがtargetを操作できる役割か
+func outranks(actor, target roommember.Role) bool {
+	return roleRank(actor) > roleRank(target)
+}
+
+// authorizeRoom ユーザーがルームのメンバーで、操作を許可されているかチェックし、メンバー情報を返す
+func authorizeRoom(ctx context.Context, client *ent.Client, roomUUID, userUUID uuid.UUID, action RoomAction) (*ent.RoomMember, error) {
+	member, err := roomMember(ctx, client, roomUUID, userUUID)
+	if err != nil {
+		return nil, err
+	}
+	if !Can(member.Role, action) {
+		return nil, errPermissionDenied
+	}
+	return member, nil
+}
+
+// roomMember ユーザーのルームメンバー情報を取得（メンバーでない場合は403）
+func roomMember(ctx context.Context, client *ent.Client, roomUUID, userUUID uuid.UUID) (*ent.RoomMember, error) {
+	member, err := client.RoomMember.Query().
+		Where(
+			roommember.RoomID(roomUUID),
+			roommember.UserID(userUUID),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, echo.NewHTTPError(http.StatusForbidden, "You are not a member of this room")
+		}
+		return nil, echo.NewHTTPError(http.StatusInternalServerError, "Failed to check membership")
+	}
+	return member, nil
+}
+
+// findRoomMember 操作対象のルームメンバー情報を取得（メンバーでない場合は404）
+func findRoomMember(ctx context.Context, tx *ent.Tx, roomUUID, userUUID uuid.UUID) (*ent.RoomMember, error) {
+	member, err := tx.RoomMember.Query().
+		Where(
+			roommember.RoomID(roomUUID),
+			roommember.UserID(userUUID),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, echo.NewHTTPError(http.StatusNotFound, "Member not found")
+		}
+		return nil, echo.NewHTTPError(http.StatusInternalServerError, "Failed to find member")
+	}
+	return member, nil
+}
+
+// errPermissionDenied 役割に操作権限がない場合のエラー
+var errPermissionDenied = echo.NewHTTPError(http.StatusForbidden, "You do not have permission to perform this action")
@@ -0,0 +1,222 @@
+package handlers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/session"
+	"github.com/hideaki1979/cc-chat-app/apps/api/internal/auth"
+	"github.com/hideaki1979/cc-chat-app/apps/api/internal/models"
+	"github.com/labstack/echo/v4"
+)
+
+// currentSession JWTミドルウェアで設定されたユーザーIDとリクエスト元のセッションIDを取得
+// セッションに紐づかないトークンの場合、セッションIDはuuid.Nil
+func currentSession(c echo.Context) (uuid.UUID, uuid.UUID, error) {
+	userID, ok := c.Get("user_id").(string)
+	if !ok {
+		return uuid.Nil, uuid.Nil, c.JSON(http.StatusUnauthorized, models.ErrorResponse{
+			Message: "認証が必要です",
+			Code:    "NOT_AUTHENTICATED",
+		})
+	}
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Message: "無効なユーザーIDです",
+			Code:    "INVALID_USER_ID",
+		})
+	}
+
+	sessionUUID := uuid.Nil
+	if sessionID, ok := c.Get("session_id").(string); ok {
+		sessionUUID, _ = uuid.Parse(sessionID)
+	}
+	return userUUID, sessionUUID, nil
+}
+
+// ListSessions ログイン中の端末（有効なセッション）一覧取得ハンドラー（JWT認証が必要）
+func (h *AuthHandler) ListSessions(c echo.Context) error {
+	userUUID, sessionUUID, err := currentSession(c)
+	if err != nil || c.Response().Committed {
+		return err
+	}
+
+	client := c.Get("db").(*ent.Client)
+	ctx := c.Request().Context()
+
+	// 期限切れのセッションはリフレッシュできないため一覧に含めない
+	sessions, err := client.Session.Query().
+		Where(
+			session.UserID(userUUID),
+			session.ExpiresAtGT(time.Now()),
+		).
+		Order(ent.Desc(session.FieldLastUsedAt)).
+		All(ctx)
+	if err != nil {
+		c.Logger().Errorf("list sessions error: %v", err)
+		return c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Message: "DBエラーが発生しました",
+			Code:    "DATABASE_ERROR",
+		})
+	}
+
+	response := models.SessionListResponse{
+		Sessions: make([]models.SessionInfo, len(sessions)),
+	}
+	for i, s := range sessions {
+		response.Sessions[i] = models.SessionInfo{
+			ID:          s.ID.String(),
+			DeviceLabel: s.DeviceLabel,
+			UserAgent:   s.UserAgent,
+			IPAddress:   s.IPAddress,
+			CreatedAt:   s.CreatedAt,
+			LastUsedAt:  s.LastUsedAt,
+			ExpiresAt:   s.ExpiresAt,
+			Current:     s.ID == sessionUUID,
+		}
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// RevokeSession 指定した端末のセッションを失効させるハンドラー（JWT認証が必要）
+// セッションを削除するとリフレッシュできなくなり、そのセッションから発行されたアクセストークンも拒否される
+func (h *AuthHandler) RevokeSession(c echo.Context) error {
+	userUUID, _, err := currentSession(c)
+	if err != nil || c.Response().Committed {
+		return err
+	}
+
+	sessionUUID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Message: "無効なセッションIDです",
+			Code:    "INVALID_SESSION_ID",
+		})
+	}
+
+	client := c.Get("db").(*ent.Client)
+	ctx := c.Request().Context()
+
+	// 他のユーザーのセッションは存在しないものとして扱う
+	var deleted int
+	err = withTx(ctx, client, func(tx *ent.Tx) error {
+		var err error
+		deleted, err = auth.RevokeSessions(ctx, tx.Client(),
+			session.ID(sessionUUID),
+			session.UserID(userUUID),
+		)
+		return err
+	})
+	if err != nil {
+		c.Logger().Errorf("revoke session error: %v", err)
+		return c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Message: "DBエラーが発生しました",
+			Code:    "DATABASE_ERROR",
+		})
+	}
+	if deleted == 0 {
+		return c.JSON(http.StatusNotFound, models.ErrorResponse{
+			Message: "セッションが見つかりません",
+			Code:    "SESSION_NOT_FOUND",
+		})
+	}
+
+	// 失効したセッションのトークンで確立したリアルタイム接続も切断する
+	if err := h.hub.DisconnectSession(ctx, userUUID, sessionUUID); err != nil {
+		c.Logger().Errorf("disconnect session error: %v", err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// RevokeOtherSessions リクエスト元以外の全端末からログアウトするハンドラー（JWT認証が必要）
+func (h *AuthHandler) RevokeOtherSessions(c echo.Context) error {
+	userUUID, sessionUUID, err := currentSession(c)
+	if err != nil || c.Response().Committed {
+		return err
+	}
+
+	// セッションに紐づかないトークンでは残すセッションを特定できない
+	if sessionUUID == uuid.Nil {
+		return c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Message: "現在のセッションを特定できません。再ログインしてください",
+			Code:    "SESSION_REQUIRED",
+		})
+	}
+
+	client := c.Get("db").(*ent.Client)
+	ctx := c.Request().Context()
+
+	// 切断するリアルタイム接続を特定するため、失効させるセッションのIDを先に取得する
+	var revokedIDs []uuid.UUID
+	err = withTx(ctx, client, func(tx *ent.Tx) error {
+		var err error
+		revokedIDs, err = tx.Session.Query().
+			Where(
+				session.UserID(userUUID),
+				session.IDNEQ(sessionUUID),
+			).
+			IDs(ctx)
+		if err != nil || len(revokedIDs) == 0 {
+			return err
+		}
+		_, err = auth.RevokeSessions(ctx, tx.Client(), session.IDIn(revokedIDs...))
+		return err
+	})
+	if err != nil {
+		c.Logger().Errorf("revoke other sessions error: %v", err)
+		return c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Message: "DBエラーが発生しました",
+			Code:    "DATABASE_ERROR",
+		})
+	}
+
+	for _, revokedID := range revokedIDs {
+		if err := h.hub.DisconnectSession(ctx, userUUID, revokedID); err != nil {
+			c.Logger().Errorf("disconnect session error: %v", err)
+		}
+	}
+
+	return c.JSON(http.StatusOK, map[string]int{
+		"revoked": len(revokedIDs),
+	})
+}
+
+// RevokeAllSessions リクエスト元を含む全端末からログアウトするハンドラー（JWT認証が必要）
+// トークンバージョンを更新し、セッションに紐づかないアクセストークンもあわせて失効させる
+func (h *AuthHandler) RevokeAllSessions(c echo.Context) error {
+	userUUID, _, err := currentSession(c)
+	if err != nil || c.Response().Committed {
+		return err
+	}
+
+	client := c.Get("db").(*ent.Client)
+	ctx := c.Request().Context()
+
+	if h.revocations != nil {
+		err = h.revocations.RevokeUser(ctx, userUUID)
+	} else {
+		err = withTx(ctx, client, func(tx *ent.Tx) error {
+			_, err := auth.RevokeSessions(ctx, tx.Client(), session.UserID(userUUID))
+			return err
+		})
+	}
+	if err != nil {
+		c.Logger().Errorf("revoke all sessions error: %v", err)
+		return c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Message: "DBエラーが発生しました",
+			Code:    "DATABASE_ERROR",
+		})
+	}
+
+	// 失効前に確立したリアルタイム接続も切断する
+	if err := h.hub.DisconnectUser(ctx, userUUID); err != nil {
+		c.Logger().Errorf("disconnect user error: %v", err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
@@ -0,0 +1,85 @@
+package handlers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/streamticket"
+	"github.com/hideaki1979/cc-chat-app/apps/api/internal/auth"
+	"github.com/hideaki1979/cc-chat-app/apps/api/internal/models"
+	"github.com/labstack/echo/v4"
+)
+
+// CreateStreamTicket WebSocket・SSE接続用の使い捨てチケット発行ハンドラー（JWT認証が必要）
+// ブラウザのWebSocket/EventSource APIはヘッダーを設定できないため、アクセストークンの代わりにチケットをURLで渡す
+// POST /api/stream-tickets
+func (h *AuthHandler) CreateStreamTicket(c echo.Context) error {
+	claims, ok := c.Get("token_claims").(*auth.Claims)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, models.ErrorResponse{
+			Message: "認証が必要です",
+			Code:    "NOT_AUTHENTICATED",
+		})
+	}
+	userUUID, err := uuid.Parse(claims.UserID)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Message: "無効なユーザーIDです",
+			Code:    "INVALID_USER_ID",
+		})
+	}
+
+	ticket, err := auth.GenerateStreamTicket()
+	if err != nil {
+		c.Logger().Errorf("generate stream ticket error: %v", err)
+		return c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Message: "チケットの生成に失敗しました",
+			Code:    "TICKET_GENERATION_ERROR",
+		})
+	}
+
+	client := c.Get("db").(*ent.Client)
+	ctx := c.Request().Context()
+	now := time.Now()
+	expiresAt := now.Add(auth.StreamTicketTTL)
+
+	err = withTx(ctx, client, func(tx *ent.Tx) error {
+		// 使用されずに期限切れになったチケットを削除
+		if _, err := tx.StreamTicket.Delete().
+			Where(
+				streamticket.UserID(userUUID),
+				streamticket.ExpiresAtLT(now),
+			).
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		create := tx.StreamTicket.Create().
+			SetTokenHash(auth.HashStreamTicket(ticket)).
+			SetUserID(userUUID).
+			SetTokenID(claims.ID).
+			SetTokenVersion(claims.TokenVersion).
+			SetExpiresAt(expiresAt)
+		if claims.SessionID != "" {
+			create.SetSessionID(claims.SessionID)
+		}
+		if claims.ExpiresAt != nil {
+			create.SetTokenExpiresAt(claims.ExpiresAt.Time)
+		}
+		return create.Exec(ctx)
+	})
+	if err != nil {
+		c.Logger().Errorf("create stream ticket error: %v", err)
+		return c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Message: "DBエラーが発生しました",
+			Code:    "DATABASE_ERROR",
+		})
+	}
+
+	return c.JSON(http.StatusCreated, models.StreamTicketResponse{
+		Ticket:    ticket,
+		ExpiresAt: expiresAt,
+	})
+}
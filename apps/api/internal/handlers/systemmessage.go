@@ -0,0 +1,50 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/google/uuid"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/message"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/user"
+	"github.com/hideaki1979/cc-chat-app/apps/api/internal/models"
+)
+
+// recordSystemMessage ルームの変更をシステムメッセージとして記録（変更と同じトランザクションで呼び出す）
+// 送信者は変更を行ったユーザーとし、文面には記録時点のユーザー名を使う
+func recordSystemMessage(ctx context.Context, tx *ent.Tx, roomID, actorID uuid.UUID, payload models.SystemMessagePayload) error {
+	payload.ActorID = actorID.String()
+
+	userIDs := []uuid.UUID{actorID}
+	for _, id := range payload.UserIDs {
+		userUUID, err := uuid.Parse(id)
+		if err != nil {
+			return err
+		}
+		userIDs = append(userIDs, userUUID)
+	}
+	users, err := tx.User.Query().
+		Where(user.IDIn(userIDs...)).
+		Select(user.FieldName).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	names := make(map[string]string, len(users))
+	for _, u := range users {
+		names[u.ID.String()] = u.Name
+	}
+
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	return tx.Message.Create().
+		SetRoomID(roomID).
+		SetUserID(actorID).
+		SetType(message.TypeSystem).
+		SetPayload(data).
+		SetContent(models.FormatSystemMessage(payload, names)).
+		Exec(ctx)
+}
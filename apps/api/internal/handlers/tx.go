@@ -0,0 +1,46 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/chatroom"
+	"github.com/labstack/echo/v4"
+)
+
+// withTx トランザクション内で関数を実行する（エラーまたはpanic時はロールバック）
+func withTx(ctx context.Context, client *ent.Client, fn func(tx *ent.Tx) error) error {
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			_ = tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	if err := fn(tx); err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			err = fmt.Errorf("%w: rolling back transaction: %v", err, rerr)
+		}
+		return err
+	}
+	return tx.Commit()
+}
+
+// lockRoom ルームの行をトランザクション終了までロックし、同じルームのメンバー構成の変更を直列化する
+func lockRoom(ctx context.Context, tx *ent.Tx, roomID uuid.UUID) error {
+	_, err := tx.ChatRoom.Query().
+		Where(chatroom.ID(roomID)).
+		ForUpdate().
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return echo.NewHTTPError(http.StatusNotFound, "Chat room not found")
+	}
+	return err
+}
@@ -0,0 +1,73 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gorilla/websocket"
+	"github.com/hideaki1979/cc-chat-app/apps/api/internal/auth"
+	"github.com/hideaki1979/cc-chat-app/apps/api/internal/middleware"
+	"github.com/hideaki1979/cc-chat-app/apps/api/internal/realtime"
+	"github.com/labstack/echo/v4"
+)
+
+// WebSocketHandler WebSocket接続のハンドラー
+type WebSocketHandler struct {
+	hub      *realtime.Hub
+	upgrader websocket.Upgrader
+}
+
+// NewWebSocketHandler WebSocketHandlerのコンストラクタ
+// allowOriginsにはCORS設定と同じオリジン一覧を渡す
+func NewWebSocketHandler(hub *realtime.Hub, allowOrigins []string) *WebSocketHandler {
+	allowed := make(map[string]bool, len(allowOrigins))
+	for _, origin := range allowOrigins {
+		allowed[origin] = true
+	}
+
+	return &WebSocketHandler{
+		hub: hub,
+		upgrader: websocket.Upgrader{
+			ReadBufferSize:  1024,
+			WriteBufferSize: 1024,
+			CheckOrigin: func(r *http.Request) bool {
+				origin := r.Header.Get("Origin")
+				// Originヘッダーのないクライアント（ブラウザ以外）は許可
+				return origin == "" || allowed[origin]
+			},
+		},
+	}
+}
+
+// Connect WebSocket接続を確立し、参加ルームのイベントを配信する
+// GET /ws?ticket=<stream_ticket>
+func (h *WebSocketHandler) Connect(c echo.Context) error {
+	userUUID, err := getUserUUID(c)
+	if err != nil {
+		return err
+	}
+
+	conn, err := h.upgrader.Upgrade(c.Response(), c.Request(), nil)
+	if err != nil {
+		// Upgrader側でエラーレスポンスを送信済み
+		c.Logger().Errorf("websocket upgrade error: %v", err)
+		return nil
+	}
+
+	h.hub.ServeClient(conn, userUUID, streamCredential(c))
+	return nil
+}
+
+// streamCredential JWTミドルウェアで設定されたトークン情報から、接続後の失効を検知するための認証情報を作成
+func streamCredential(c echo.Context) realtime.Credential {
+	var cred realtime.Credential
+	if claims, ok := c.Get("token_claims").(*auth.Claims); ok {
+		cred.SessionID = claims.SessionID
+		if claims.ExpiresAt != nil {
+			cred.ExpiresAt = claims.ExpiresAt.Time
+		}
+	}
+	if check, ok := c.Get("token_check").(middleware.TokenCheck); ok {
+		cred.Validate = check
+	}
+	return cred
+}
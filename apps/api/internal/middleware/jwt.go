@@ -1,59 +1,210 @@
 package middleware
 
 import (
+	"context"
+	"errors"
 	"net/http"
 	"strings"
+	"time"
 
+	"github.com/google/uuid"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/session"
 	"github.com/hideaki1979/cc-chat-app/apps/api/internal/auth"
 	"github.com/hideaki1979/cc-chat-app/apps/api/internal/models"
 	"github.com/labstack/echo/v4"
 )
 
+// JWTAuthOption JWTAuthの挙動を変更するオプション
+type JWTAuthOption func(*jwtAuthConfig)
+
+type jwtAuthConfig struct {
+	ticketParam string
+	revocations auth.RevocationStore
+}
+
+// WithStreamTicket Authorizationヘッダーがない場合に指定したクエリパラメータの使い捨てチケットで認証する
+// ブラウザのWebSocket/EventSource APIはヘッダーを設定できないため、ストリーミング系エンドポイント専用に使用する
+// アクセストークンをURLに含めるとアクセスログ等に残るため、POST /api/stream-ticketsで発行した短命のチケットのみ受け付ける
+func WithStreamTicket(param string) JWTAuthOption {
+	return func(cfg *jwtAuthConfig) {
+		cfg.ticketParam = param
+	}
+}
+
+// WithRevocationStore 失効したトークン（jti・トークンバージョン）を拒否する
+func WithRevocationStore(store auth.RevocationStore) JWTAuthOption {
+	return func(cfg *jwtAuthConfig) {
+		cfg.revocations = store
+	}
+}
+
+// TokenCheck 認証に使用したトークンとセッションが現在も有効か確認する関数
+// 接続を維持するストリーミング系エンドポイントで、接続後の失効を検知するために使用する
+type TokenCheck func(ctx context.Context) (bool, error)
+
 // JWTAuth JWT認証ミドルウェア
-func JWTAuth() echo.MiddlewareFunc {
+// 認証に成功すると、ユーザー情報に加えてトークンのクレーム（"token_claims"）と再確認用のTokenCheck（"token_check"）をコンテキストに設定する
+func JWTAuth(opts ...JWTAuthOption) echo.MiddlewareFunc {
+	cfg := &jwtAuthConfig{}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
 	return func(next echo.HandlerFunc) echo.HandlerFunc {
 		return func(c echo.Context) error {
+			client, _ := c.Get("db").(*ent.Client)
+
 			// Authorizationヘッダーを取得
 			authHeader := c.Request().Header.Get("Authorization")
-			if authHeader == "" {
-				return c.JSON(http.StatusUnauthorized, models.ErrorResponse{
-					Message: "Missing authorization header",
-					Code:    "MISSING_AUTH_HEADER",
-				})
+
+			// チケットでの認証が許可されている場合
+			var claims *auth.Claims
+			if authHeader == "" && cfg.ticketParam != "" {
+				if ticket := c.QueryParam(cfg.ticketParam); ticket != "" {
+					var err error
+					claims, err = consumeStreamTicket(c.Request().Context(), client, ticket)
+					if err != nil {
+						c.Logger().Errorf("consume stream ticket error: %v", err)
+						return c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+							Message: "Failed to verify ticket",
+							Code:    "TICKET_CHECK_ERROR",
+						})
+					}
+					if claims == nil {
+						return c.JSON(http.StatusUnauthorized, models.ErrorResponse{
+							Message: "Invalid or expired ticket",
+							Code:    "INVALID_TICKET",
+						})
+					}
+				}
 			}
 
-			// "Bearer " プレフィックスをチェック
-			const bearerPrefix = "Bearer "
-			if !strings.HasPrefix(authHeader, bearerPrefix) {
-				return c.JSON(http.StatusUnauthorized, models.ErrorResponse{
-					Message: "Invalid authorization header format",
-					Code:    "INVALID_AUTH_HEADER",
-				})
+			// チケットがない場合はAuthorizationヘッダーのトークンで認証
+			if claims == nil {
+				if authHeader == "" {
+					return c.JSON(http.StatusUnauthorized, models.ErrorResponse{
+						Message: "Missing authorization header",
+						Code:    "MISSING_AUTH_HEADER",
+					})
+				}
+
+				// "Bearer " プレフィックスをチェック
+				const bearerPrefix = "Bearer "
+				if !strings.HasPrefix(authHeader, bearerPrefix) {
+					return c.JSON(http.StatusUnauthorized, models.ErrorResponse{
+						Message: "Invalid authorization header format",
+						Code:    "INVALID_AUTH_HEADER",
+					})
+				}
+
+				// トークンを抽出
+				tokenString := authHeader[len(bearerPrefix):]
+				if tokenString == "" {
+					return c.JSON(http.StatusUnauthorized, models.ErrorResponse{
+						Message: "Missing token",
+						Code:    "MISSING_TOKEN",
+					})
+				}
+
+				// トークンを検証
+				var err error
+				claims, err = auth.ValidateJWT(tokenString)
+				if err != nil {
+					return c.JSON(http.StatusUnauthorized, models.ErrorResponse{
+						Message: "Invalid or expired token",
+						Code:    "INVALID_TOKEN",
+					})
+				}
 			}
 
-			// トークンを抽出
-			tokenString := authHeader[len(bearerPrefix):]
-			if tokenString == "" {
-				return c.JSON(http.StatusUnauthorized, models.ErrorResponse{
-					Message: "Missing token",
-					Code:    "MISSING_TOKEN",
-				})
+			// ログアウト・アカウント停止等で失効させたトークンを拒否
+			if cfg.revocations != nil {
+				revoked, err := cfg.revocations.IsRevoked(c.Request().Context(), claims)
+				if err != nil {
+					c.Logger().Errorf("check token revocation error: %v", err)
+					return c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+						Message: "Failed to verify token",
+						Code:    "TOKEN_CHECK_ERROR",
+					})
+				}
+				if revoked {
+					return c.JSON(http.StatusUnauthorized, models.ErrorResponse{
+						Message: "Token has been revoked",
+						Code:    "TOKEN_REVOKED",
+					})
+				}
 			}
 
-			// トークンを検証
-			claims, err := auth.ValidateJWT(tokenString)
-			if err != nil {
-				return c.JSON(http.StatusUnauthorized, models.ErrorResponse{
-					Message: "Invalid or expired token",
-					Code:    "INVALID_TOKEN",
-				})
+			// ログインセッションから発行されたトークンの場合、セッションが失効していないか確認
+			if claims.SessionID != "" {
+				active, err := sessionActive(c.Request().Context(), client, claims)
+				if err != nil {
+					c.Logger().Errorf("check session error: %v", err)
+					return c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+						Message: "Failed to verify session",
+						Code:    "SESSION_CHECK_ERROR",
+					})
+				}
+				if !active {
+					return c.JSON(http.StatusUnauthorized, models.ErrorResponse{
+						Message: "Session has been revoked",
+						Code:    "SESSION_REVOKED",
+					})
+				}
+				c.Set("session_id", claims.SessionID)
 			}
 
 			// ユーザー情報をコンテキストに設定
 			c.Set("user_id", claims.UserID)
 			c.Set("user_email", claims.Email)
+			c.Set("token_claims", claims)
+			c.Set("token_check", tokenCheck(cfg, client, claims))
 
 			return next(c)
 		}
 	}
-}
\ No newline at end of file
+}
+
+// tokenCheck 有効期限・失効（jti・トークンバージョン）・発行元セッションを再確認するTokenCheckを作成
+func tokenCheck(cfg *jwtAuthConfig, client *ent.Client, claims *auth.Claims) TokenCheck {
+	return func(ctx context.Context) (bool, error) {
+		if claims.ExpiresAt != nil && !time.Now().Before(claims.ExpiresAt.Time) {
+			return false, nil
+		}
+		if cfg.revocations != nil {
+			revoked, err := cfg.revocations.IsRevoked(ctx, claims)
+			if err != nil || revoked {
+				return false, err
+			}
+		}
+		if claims.SessionID != "" {
+			return sessionActive(ctx, client, claims)
+		}
+		return true, nil
+	}
+}
+
+// sessionActive トークンの発行元セッションが存在し、有効期限内か確認
+func sessionActive(ctx context.Context, client *ent.Client, claims *auth.Claims) (bool, error) {
+	if client == nil {
+		return false, errors.New("database client not found in context")
+	}
+
+	sessionID, err := uuid.Parse(claims.SessionID)
+	if err != nil {
+		return false, nil
+	}
+	userID, err := uuid.Parse(claims.UserID)
+	if err != nil {
+		return false, nil
+	}
+
+	return client.Session.Query().
+		Where(
+			session.ID(sessionID),
+			session.UserID(userID),
+			session.ExpiresAtGT(time.Now()),
+		).
+		Exist(ctx)
+}
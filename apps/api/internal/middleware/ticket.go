@@ -0,0 +1,60 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/streamticket"
+	"github.com/hideaki1979/cc-chat-app/apps/api/internal/auth"
+)
+
+// consumeStreamTicket チケットを使用済みにし、発行元のアクセストークンのクレームを返す
+// 存在しない・使用済み・期限切れのチケット、または発行元のトークンが期限切れの場合はnilを返す
+func consumeStreamTicket(ctx context.Context, client *ent.Client, ticket string) (*auth.Claims, error) {
+	if client == nil {
+		return nil, errors.New("database client not found in context")
+	}
+
+	t, err := client.StreamTicket.Query().
+		Where(streamticket.TokenHash(auth.HashStreamTicket(ticket))).
+		WithUser().
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// 同じチケットで同時に接続された場合も、削除できた1件のみ認証する
+	n, err := client.StreamTicket.Delete().
+		Where(streamticket.ID(t.ID)).
+		Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	if n == 0 || !now.Before(t.ExpiresAt) {
+		return nil, nil
+	}
+	if t.TokenExpiresAt != nil && !now.Before(*t.TokenExpiresAt) {
+		return nil, nil
+	}
+
+	claims := &auth.Claims{
+		UserID:       t.UserID.String(),
+		Email:        t.Edges.User.Email,
+		SessionID:    t.SessionID,
+		TokenVersion: t.TokenVersion,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID: t.TokenID,
+		},
+	}
+	if t.TokenExpiresAt != nil {
+		claims.ExpiresAt = jwt.NewNumericDate(*t.TokenExpiresAt)
+	}
+	return claims, nil
+}
@@ -0,0 +1,137 @@
+package migration
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/chatroom"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/message"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/roommember"
+)
+
+// Backfill スキーマのマイグレーション後に、追加したカラムの既存データを補完する
+// 補完が必要なデータのみ更新するため、マイグレーションのたびに実行してよい
+func Backfill(ctx context.Context, client *ent.Client) error {
+	owners, err := BackfillRoomOwners(ctx, client)
+	if err != nil {
+		return fmt.Errorf("backfill room owners: %w", err)
+	}
+	if owners > 0 {
+		log.Printf("Backfilled owners of %d rooms", owners)
+	}
+
+	rooms, err := BackfillLastMessages(ctx, client)
+	if err != nil {
+		return fmt.Errorf("backfill last messages: %w", err)
+	}
+	if rooms > 0 {
+		log.Printf("Backfilled last messages of %d rooms", rooms)
+	}
+
+	replies, err := BackfillReplyTargets(ctx, client)
+	if err != nil {
+		return fmt.Errorf("backfill reply targets: %w", err)
+	}
+	if replies > 0 {
+		log.Printf("Backfilled reply targets of %d messages", replies)
+	}
+	return nil
+}
+
+// BackfillRoomOwners オーナーのいないルームで、最も早く参加したメンバーをオーナーにする
+// role追加前のメンバーは全てmemberになるため、誰もルームを管理できなくなるのを防ぐ
+// DMのルーム（dm_keyあり）は全員が対等なため対象外
+func BackfillRoomOwners(ctx context.Context, client *ent.Client) (int, error) {
+	roomIDs, err := client.ChatRoom.Query().
+		Where(
+			chatroom.DmKeyIsNil(),
+			chatroom.HasRoomMembers(),
+			chatroom.Not(chatroom.HasRoomMembersWith(roommember.RoleEQ(roommember.RoleOwner))),
+		).
+		IDs(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, roomID := range roomIDs {
+		earliest, err := client.RoomMember.Query().
+			Where(roommember.RoomID(roomID)).
+			Order(ent.Asc(roommember.FieldJoinedAt), ent.Asc(roommember.FieldID)).
+			First(ctx)
+		if err != nil {
+			return 0, err
+		}
+		if err := client.RoomMember.UpdateOne(earliest).
+			SetRole(roommember.RoleOwner).
+			Exec(ctx); err != nil {
+			return 0, err
+		}
+	}
+	return len(roomIDs), nil
+}
+
+// BackfillLastMessages 最新メッセージが未設定のルームに、削除されていない最新のメッセージ（スレッドの返信を除く）を設定する
+// 最新メッセージはメッセージ作成時のフックで更新されるため、カラム追加前のルームのみが対象になる
+func BackfillLastMessages(ctx context.Context, client *ent.Client) (int, error) {
+	roomIDs, err := client.ChatRoom.Query().
+		Where(
+			chatroom.LastMessageIDIsNil(),
+			chatroom.HasMessagesWith(
+				message.DeletedAtIsNil(),
+				message.ParentIDIsNil(),
+			),
+		).
+		IDs(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, roomID := range roomIDs {
+		latest, err := client.Message.Query().
+			Where(
+				message.RoomID(roomID),
+				message.DeletedAtIsNil(),
+				message.ParentIDIsNil(),
+			).
+			Order(ent.Desc(message.FieldCreatedAt), ent.Desc(message.FieldID)).
+			First(ctx)
+		if err != nil {
+			return 0, err
+		}
+		if err := client.ChatRoom.UpdateOneID(roomID).
+			SetLastMessageAt(latest.CreatedAt).
+			SetLastMessageID(latest.ID).
+			Exec(ctx); err != nil {
+			return 0, err
+		}
+	}
+	return len(roomIDs), nil
+}
+
+// BackfillReplyTargets 返信先が未設定のスレッドの返信に、スレッドの親を返信先として設定する
+// reply_to_id追加前は親メッセージを引用表示していたため、既存の返信の表示を変えない
+func BackfillReplyTargets(ctx context.Context, client *ent.Client) (int, error) {
+	replies, err := client.Message.Query().
+		Where(
+			message.ParentIDNotNil(),
+			message.ReplyToIDIsNil(),
+		).
+		Select(message.FieldParentID, message.FieldUpdatedAt).
+		All(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, reply := range replies {
+		// 補完はメッセージの更新ではないため、更新日時（SSEの再送対象の判定に使用）は変えない
+		if err := client.Message.UpdateOneID(reply.ID).
+			SetReplyToID(*reply.ParentID).
+			SetUpdatedAt(reply.UpdatedAt).
+			Exec(ctx); err != nil {
+			return 0, err
+		}
+	}
+	return len(replies), nil
+}
@@ -7,12 +7,14 @@ type RegisterRequest struct {
 	Name     string `json:"name" validate:"required,min=2,max=30,username"`
 	Email    string `json:"email" validate:"required,email"`
 	Password string `json:"password" validate:"required,min=8,password_complex"`
+	DeviceLabel string `json:"device_label,omitempty" validate:"omitempty,max=100"` // 端末名（セッション一覧の表示用）
 }
 
 // ユーザーログイン用のリクエスト構造体
 type LoginRequest struct {
 	Email    string `json:"email" validate:"required,email"`
 	Password string `json:"password" validate:"required,min=8"`
+	DeviceLabel string `json:"device_label,omitempty" validate:"omitempty,max=100"` // 端末名（セッション一覧の表示用）
 }
 
 // 認証レスポンス構造体（refresh_tokenはCookieで送信されるためレスポンスに含まない）
@@ -63,10 +65,12 @@ type UserSearchResponse struct {
 
 // ユーザー検索結果（簡易ユーザー情報）
 type UserSearchResult struct {
-	ID              string  `json:"id"`
-	Name            string  `json:"name"`
-	Email           string  `json:"email"`
-	ProfileImageURL *string `json:"profile_image_url,omitempty"`
+	ID              string     `json:"id"`
+	Name            string     `json:"name"`
+	Email           string     `json:"email"`
+	ProfileImageURL *string    `json:"profile_image_url,omitempty"`
+	Presence        string     `json:"presence"`               // オンライン状態（online/away/offline）
+	LastSeenAt      *time.Time `json:"last_seen_at,omitempty"` // 最終オンライン日時
 }
 
 // アバター画像アップロードレスポンス構造体
@@ -80,3 +84,26 @@ type ErrorResponse struct {
 	Message string `json:"message"`
 	Code    string `json:"code,omitempty"`
 }
+
+// ログインセッション情報構造体（リフレッシュトークンは含まない）
+type SessionInfo struct {
+	ID          string    `json:"id"`
+	DeviceLabel *string   `json:"device_label,omitempty"`
+	UserAgent   *string   `json:"user_agent,omitempty"`
+	IPAddress   *string   `json:"ip_address,omitempty"`
+	CreatedAt   time.Time `json:"created_at"`
+	LastUsedAt  time.Time `json:"last_used_at"`
+	ExpiresAt   time.Time `json:"expires_at"`
+	Current     bool      `json:"current"` // リクエスト元のセッションかどうか
+}
+
+// セッション一覧レスポンス構造体
+type SessionListResponse struct {
+	Sessions []SessionInfo `json:"sessions"`
+}
+
+// ストリーミング接続用チケットのレスポンス構造体（/ws・/api/eventsの?ticket=に指定する）
+type StreamTicketResponse struct {
+	Ticket    string    `json:"ticket"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
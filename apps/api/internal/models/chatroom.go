@@ -12,11 +12,18 @@ type CreateChatRoomRequest struct {
 	Name        string    `json:"name" validate:"required,min=1,max=100"`
 	IsGroupChat bool      `json:"is_group_chat"`
 	MemberIDs   []string  `json:"member_ids" validate:"required,min=1"`
+	Visibility  string    `json:"visibility,omitempty" validate:"omitempty,oneof=private public request_to_join"` // 未指定時はprivate
 }
 
 // UpdateChatRoomRequest チャットルーム更新リクエスト
 type UpdateChatRoomRequest struct {
-	Name *string `json:"name,omitempty" validate:"omitempty,min=1,max=100"`
+	Name                 *string `json:"name,omitempty" validate:"omitempty,min=1,max=100"`
+	EditWindowSeconds    *int    `json:"edit_window_seconds,omitempty" validate:"omitempty,min=-1"` // 0は編集不可、-1は無期限
+	UseDefaultEditWindow bool    `json:"use_default_edit_window,omitempty"`                         // trueの場合はサーバーのデフォルト値に戻す
+	Visibility           *string `json:"visibility,omitempty" validate:"omitempty,oneof=private public request_to_join"`
+	Description          *string `json:"description,omitempty" validate:"omitempty,max=500"` // 空文字で削除
+	Topic                *string `json:"topic,omitempty" validate:"omitempty,max=250"`       // 空文字で削除（変更はシステムメッセージとして記録）
+	AvatarURL            *string `json:"avatar_url,omitempty" validate:"omitempty,url|eq="`  // 空文字で削除
 }
 
 // AddMemberRequest メンバー追加リクエスト
@@ -24,6 +31,43 @@ type AddMemberRequest struct {
 	UserID string `json:"user_id" validate:"required,uuid"`
 }
 
+// BulkMembersRequest メンバーの一括追加・削除リクエスト
+type BulkMembersRequest struct {
+	Add    []string `json:"add,omitempty" validate:"omitempty,max=100,dive,uuid"`
+	Remove []string `json:"remove,omitempty" validate:"omitempty,max=100,dive,uuid"`
+}
+
+// メンバーの一括追加・削除のユーザーごとの結果
+const (
+	BulkMemberAdded         = "added"
+	BulkMemberRemoved       = "removed"
+	BulkMemberAlreadyMember = "already_member"
+	BulkMemberNotMember     = "not_member"
+	BulkMemberNotFound      = "not_found"
+	BulkMemberForbidden     = "forbidden"
+)
+
+// BulkMemberResult メンバーの一括追加・削除のユーザーごとの結果
+type BulkMemberResult struct {
+	UserID string `json:"user_id"`
+	Status string `json:"status"`
+}
+
+// UpdateMemberRoleRequest メンバーの役割変更リクエスト（オーナーの変更は譲渡で行う）
+type UpdateMemberRoleRequest struct {
+	Role string `json:"role" validate:"required,oneof=admin member"`
+}
+
+// TransferOwnershipRequest オーナー権限の譲渡リクエスト
+type TransferOwnershipRequest struct {
+	UserID string `json:"user_id" validate:"required,uuid"`
+}
+
+// TypingRequest 入力中状態の通知リクエスト
+type TypingRequest struct {
+	Typing bool `json:"typing"`
+}
+
 // ChatRoomResponse チャットルーム詳細レスポンス
 type ChatRoomResponse struct {
 	ID          string              `json:"id"`
@@ -33,6 +77,13 @@ type ChatRoomResponse struct {
 	UpdatedAt   time.Time           `json:"updated_at"`
 	Members     []ChatRoomMember    `json:"members,omitempty"`
 	LastMessage *LastMessageInfo   `json:"last_message,omitempty"`
+	EditWindowSeconds *int         `json:"edit_window_seconds"` // メッセージ編集可能時間（nullはサーバーのデフォルト）
+	ArchivedAt  *time.Time         `json:"archived_at,omitempty"` // アーカイブ日時
+	IsDirectMessage bool           `json:"is_direct_message"`     // 1対1のダイレクトメッセージか（nameは相手の名前）
+	Visibility  string             `json:"visibility"`            // 公開設定（private/public/request_to_join）
+	Description *string            `json:"description,omitempty"`
+	Topic       *string            `json:"topic,omitempty"`
+	AvatarURL   *string            `json:"avatar_url,omitempty"`
 }
 
 // ChatRoomListResponse チャットルーム一覧レスポンス
@@ -43,15 +94,91 @@ type ChatRoomListResponse struct {
 	CreatedAt   time.Time         `json:"created_at"`
 	UpdatedAt   time.Time         `json:"updated_at"`
 	LastMessage *LastMessageInfo  `json:"last_message,omitempty"`
+	LastMessageAt *time.Time      `json:"last_message_at,omitempty"` // 最終アクティビティ日時（一覧の並び順）
+	Topic       *string           `json:"topic,omitempty"`
+	AvatarURL   *string           `json:"avatar_url,omitempty"`
 	MemberCount int               `json:"member_count"`
+	UnreadCount int               `json:"unread_count"` // 既読位置より後の他メンバーのメッセージ数
+	IsDirectMessage bool          `json:"is_direct_message"` // 1対1のダイレクトメッセージか（nameは相手の名前）
+	MemberSettings
+}
+
+// MemberSettings ルームに対するログインユーザーの設定（アーカイブ・ミュート・ピン留め）
+type MemberSettings struct {
+	Archived    bool       `json:"archived"`
+	Muted       bool       `json:"muted"`                 // 現在ミュート中か
+	MutedUntil  *time.Time `json:"muted_until,omitempty"` // ミュートの期限
+	PinnedOrder *int       `json:"pinned_order"`          // ピン留め順（nullはピン留めなし）
+}
+
+// UpdateMemberSettingsRequest ルームに対するログインユーザーの設定変更リクエスト（指定した項目のみ変更）
+type UpdateMemberSettingsRequest struct {
+	Archived    *bool      `json:"archived,omitempty"`
+	MutedUntil  *time.Time `json:"muted_until,omitempty"` // 過去の日時を指定するとミュート解除
+	PinnedOrder *int       `json:"pinned_order,omitempty" validate:"omitempty,min=0"`
+	Unpin       bool       `json:"unpin,omitempty"` // trueの場合はピン留めを解除
+}
+
+// ConvertToMemberSettings EntのRoomMemberをメンバー設定に変換
+func ConvertToMemberSettings(member *ent.RoomMember, now time.Time) MemberSettings {
+	settings := MemberSettings{
+		Archived:    member.Archived,
+		PinnedOrder: member.PinnedOrder,
+	}
+	if member.MutedUntil != nil && member.MutedUntil.After(now) {
+		settings.Muted = true
+		settings.MutedUntil = member.MutedUntil
+	}
+	return settings
 }
 
 // ChatRoomMember チャットルームメンバー情報
 type ChatRoomMember struct {
-	UserID   string    `json:"user_id"`
-	Name     string    `json:"name"`
-	Email    string    `json:"email"`
-	JoinedAt time.Time `json:"joined_at"`
+	UserID     string     `json:"user_id"`
+	Name       string     `json:"name"`
+	Email      string     `json:"email"`
+	JoinedAt   time.Time  `json:"joined_at"`
+	Role       string     `json:"role"`                   // ルーム内の役割（owner/admin/member）
+	Presence   string     `json:"presence"`               // オンライン状態（online/away/offline）
+	LastSeenAt *time.Time `json:"last_seen_at,omitempty"` // 最終オンライン日時
+}
+
+// DiscoverRoomResponse 公開ルーム一覧レスポンス
+type DiscoverRoomResponse struct {
+	ID          string    `json:"id"`
+	Name        string    `json:"name"`
+	Visibility  string    `json:"visibility"` // public: すぐに参加可能、request_to_join: 承認が必要
+	MemberCount int       `json:"member_count"`
+	IsMember    bool      `json:"is_member"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// JoinRequestResponse ルームへの参加申請レスポンス
+type JoinRequestResponse struct {
+	ID        int64      `json:"id"`
+	RoomID    string     `json:"room_id"`
+	UserID    string     `json:"user_id"`
+	Name      string     `json:"name,omitempty"`
+	Status    string     `json:"status"`
+	CreatedAt time.Time  `json:"created_at"`
+	DecidedAt *time.Time `json:"decided_at,omitempty"`
+}
+
+// ConvertToJoinRequestResponse EntのJoinRequestをレスポンス形式に変換
+func ConvertToJoinRequestResponse(request *ent.JoinRequest) *JoinRequestResponse {
+	response := &JoinRequestResponse{
+		ID:        request.ID,
+		RoomID:    request.RoomID.String(),
+		UserID:    request.UserID.String(),
+		Status:    string(request.Status),
+		CreatedAt: request.CreatedAt,
+		DecidedAt: request.DecidedAt,
+	}
+	// ユーザー情報がロードされている場合
+	if request.Edges.User != nil {
+		response.Name = request.Edges.User.Name
+	}
+	return response
 }
 
 // LastMessageInfo 最新メッセージ情報
@@ -69,6 +196,43 @@ type ChatRoomListParams struct {
 	PageSize int `query:"page_size" validate:"min=1,max=100"`
 }
 
+// DirectMessageKey 2人のユーザーのダイレクトメッセージの識別キー（順序によらず同じ値になる）
+func DirectMessageKey(a, b uuid.UUID) string {
+	x, y := a.String(), b.String()
+	if x > y {
+		x, y = y, x
+	}
+	return x + ":" + y
+}
+
+// DirectMessageName 閲覧ユーザーから見たルームの表示名（DMの場合は相手の名前）
+func DirectMessageName(room *ent.ChatRoom, viewerID uuid.UUID) string {
+	if room.DmKey == nil {
+		return room.Name
+	}
+	// メンバーとユーザー情報がロードされている場合
+	for _, rm := range room.Edges.RoomMembers {
+		if rm.UserID != viewerID && rm.Edges.User != nil {
+			return rm.Edges.User.Name
+		}
+	}
+	return room.Name
+}
+
+// ConvertToChatRoomResponseFor 閲覧ユーザーから見た表示名でレスポンス形式に変換
+func ConvertToChatRoomResponseFor(room *ent.ChatRoom, viewerID uuid.UUID) *ChatRoomResponse {
+	response := ConvertToChatRoomResponse(room)
+	response.Name = DirectMessageName(room, viewerID)
+	return response
+}
+
+// ConvertToChatRoomListResponseFor 閲覧ユーザーから見た表示名でリスト用レスポンス形式に変換
+func ConvertToChatRoomListResponseFor(room *ent.ChatRoom, memberCount int, viewerID uuid.UUID) *ChatRoomListResponse {
+	response := ConvertToChatRoomListResponse(room, memberCount)
+	response.Name = DirectMessageName(room, viewerID)
+	return response
+}
+
 // ConvertToChatRoomResponse EntのChatRoomをレスポンス形式に変換
 func ConvertToChatRoomResponse(room *ent.ChatRoom) *ChatRoomResponse {
 	response := &ChatRoomResponse{
@@ -77,6 +241,13 @@ func ConvertToChatRoomResponse(room *ent.ChatRoom) *ChatRoomResponse {
 		IsGroupChat: room.IsGroupChat,
 		CreatedAt:   room.CreatedAt,
 		UpdatedAt:   room.UpdatedAt,
+		EditWindowSeconds: room.EditWindowSeconds,
+		ArchivedAt:  room.ArchivedAt,
+		IsDirectMessage: room.DmKey != nil,
+		Visibility:  string(room.Visibility),
+		Description: room.Description,
+		Topic:       room.Topic,
+		AvatarURL:   room.AvatarURL,
 	}
 
 	// メンバー情報がロードされている場合
@@ -86,11 +257,13 @@ func ConvertToChatRoomResponse(room *ent.ChatRoom) *ChatRoomResponse {
 			member := ChatRoomMember{
 				UserID:   rm.UserID.String(),
 				JoinedAt: rm.JoinedAt,
+				Role:     string(rm.Role),
 			}
 			// ユーザー情報がロードされている場合
 			if rm.Edges.User != nil {
 				member.Name = rm.Edges.User.Name
 				member.Email = rm.Edges.User.Email
+				member.LastSeenAt = rm.Edges.User.LastSeenAt
 			}
 			members[i] = member
 		}
@@ -98,18 +271,8 @@ func ConvertToChatRoomResponse(room *ent.ChatRoom) *ChatRoomResponse {
 	}
 
 	// 最新メッセージ情報がロードされている場合
-	if len(room.Edges.Messages) > 0 {
-		lastMsg := room.Edges.Messages[0] // 最新メッセージ（created_at DESC順でソート済み想定）
-		response.LastMessage = &LastMessageInfo{
-			ID:        lastMsg.ID.String(),
-			Content:   lastMsg.Content,
-			SenderID:  lastMsg.UserID.String(),
-			CreatedAt: lastMsg.CreatedAt,
-		}
-		// 送信者情報がロードされている場合
-		if lastMsg.Edges.Sender != nil {
-			response.LastMessage.SenderName = lastMsg.Edges.Sender.Name
-		}
+	if room.Edges.LastMessage != nil {
+		response.LastMessage = convertToLastMessageInfo(room.Edges.LastMessage)
 	}
 
 	return response
@@ -124,26 +287,35 @@ func ConvertToChatRoomListResponse(room *ent.ChatRoom, memberCount int) *ChatRoo
 		CreatedAt:   room.CreatedAt,
 		UpdatedAt:   room.UpdatedAt,
 		MemberCount: memberCount,
+		IsDirectMessage: room.DmKey != nil,
+		LastMessageAt: room.LastMessageAt,
+		Topic:       room.Topic,
+		AvatarURL:   room.AvatarURL,
 	}
 
 	// 最新メッセージ情報がロードされている場合
-	if len(room.Edges.Messages) > 0 {
-		lastMsg := room.Edges.Messages[0] // 最新メッセージ（created_at DESC順でソート済み想定）
-		response.LastMessage = &LastMessageInfo{
-			ID:        lastMsg.ID.String(),
-			Content:   lastMsg.Content,
-			SenderID:  lastMsg.UserID.String(),
-			CreatedAt: lastMsg.CreatedAt,
-		}
-		// 送信者情報がロードされている場合
-		if lastMsg.Edges.Sender != nil {
-			response.LastMessage.SenderName = lastMsg.Edges.Sender.Name
-		}
+	if room.Edges.LastMessage != nil {
+		response.LastMessage = convertToLastMessageInfo(room.Edges.LastMessage)
 	}
 
 	return response
 }
 
+// convertToLastMessageInfo Entのメッセージを最新メッセージ情報に変換
+func convertToLastMessageInfo(lastMsg *ent.Message) *LastMessageInfo {
+	info := &LastMessageInfo{
+		ID:        lastMsg.ID.String(),
+		Content:   lastMsg.Content,
+		SenderID:  lastMsg.UserID.String(),
+		CreatedAt: lastMsg.CreatedAt,
+	}
+	// 送信者情報がロードされている場合
+	if lastMsg.Edges.Sender != nil {
+		info.SenderName = lastMsg.Edges.Sender.Name
+	}
+	return info
+}
+
 // ValidateUUIDs UUID文字列のバリデーション
 func ValidateUUIDs(uuidStrs []string) error {
 	for _, uuidStr := range uuidStrs {
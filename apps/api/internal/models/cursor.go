@@ -0,0 +1,63 @@
+package models
+
+import (
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent"
+)
+
+// ErrInvalidCursor 不正なカーソル文字列
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// MessageCursor メッセージ一覧のキーセットページネーション用カーソル
+// 作成日時が同じメッセージも順序が一意に決まるよう、(created_at, id) の組で位置を表す
+type MessageCursor struct {
+	CreatedAt time.Time
+	ID        uuid.UUID
+}
+
+// NewMessageCursor メッセージの位置を表すカーソルを作成
+func NewMessageCursor(msg *ent.Message) MessageCursor {
+	return MessageCursor{CreatedAt: msg.CreatedAt, ID: msg.ID}
+}
+
+// Before otherより古い位置かどうか（作成日時が同じ場合はIDで比較）
+func (c MessageCursor) Before(other MessageCursor) bool {
+	if !c.CreatedAt.Equal(other.CreatedAt) {
+		return c.CreatedAt.Before(other.CreatedAt)
+	}
+	return bytes.Compare(c.ID[:], other.ID[:]) < 0
+}
+
+// Encode クライアントへ返す不透明なカーソル文字列に変換
+func (c MessageCursor) Encode() string {
+	raw := strconv.FormatInt(c.CreatedAt.UnixNano(), 10) + ":" + c.ID.String()
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// ParseMessageCursor カーソル文字列を解析
+func ParseMessageCursor(s string) (MessageCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return MessageCursor{}, ErrInvalidCursor
+	}
+	nanos, id, ok := strings.Cut(string(raw), ":")
+	if !ok {
+		return MessageCursor{}, ErrInvalidCursor
+	}
+	n, err := strconv.ParseInt(nanos, 10, 64)
+	if err != nil {
+		return MessageCursor{}, ErrInvalidCursor
+	}
+	messageUUID, err := uuid.Parse(id)
+	if err != nil {
+		return MessageCursor{}, ErrInvalidCursor
+	}
+	return MessageCursor{CreatedAt: time.Unix(0, n), ID: messageUUID}, nil
+}
@@ -0,0 +1,40 @@
+package models
+
+import (
+	"time"
+
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent"
+)
+
+// CreateInviteRequest 招待リンク作成リクエスト
+type CreateInviteRequest struct {
+	ExpiresInHours int  `json:"expires_in_hours,omitempty" validate:"omitempty,min=1,max=720"` // 未指定時は7日間
+	MaxUses        *int `json:"max_uses,omitempty" validate:"omitempty,min=1"`                 // 未指定時は無制限
+}
+
+// InviteResponse 招待リンクレスポンス
+type InviteResponse struct {
+	ID        string    `json:"id"`
+	RoomID    string    `json:"room_id"`
+	CreatorID string    `json:"creator_id"`
+	Token     string    `json:"token,omitempty"` // 作成時のみ返す（DBにはハッシュのみ保存）
+	ExpiresAt time.Time `json:"expires_at"`
+	MaxUses   *int      `json:"max_uses"`
+	UseCount  int       `json:"use_count"`
+	Revoked   bool      `json:"revoked"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// ConvertToInviteResponse EntのInviteをレスポンス形式に変換
+func ConvertToInviteResponse(invite *ent.Invite) *InviteResponse {
+	return &InviteResponse{
+		ID:        invite.ID.String(),
+		RoomID:    invite.RoomID.String(),
+		CreatorID: invite.CreatorID.String(),
+		ExpiresAt: invite.ExpiresAt,
+		MaxUses:   invite.MaxUses,
+		UseCount:  invite.UseCount,
+		Revoked:   invite.Revoked,
+		CreatedAt: invite.CreatedAt,
+	}
+}
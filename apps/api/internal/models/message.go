@@ -1,15 +1,26 @@
 package models
 
 import (
+	"encoding/json"
+	"fmt"
+	"strings"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/hideaki1979/cc-chat-app/apps/api/ent"
 )
 
 // SendMessageRequest メッセージ送信リクエスト
 type SendMessageRequest struct {
-	Content string `json:"content" validate:"required,min=1,max=2000"`
-	FileURL string `json:"file_url,omitempty" validate:"omitempty,url"`
+	Content   string `json:"content" validate:"required,min=1,max=2000"`
+	FileURL   string `json:"file_url,omitempty" validate:"omitempty,url"`
+	ReplyToID string `json:"reply_to_id,omitempty" validate:"omitempty,uuid"` // 返信先メッセージID（引用表示し、スレッドへの返信とする）
+	QuoteOnly bool   `json:"quote_only,omitempty"`                           // trueの場合はスレッドに入れず、ルームのタイムラインに引用として投稿する
+}
+
+// ReactionRequest リアクション追加・削除リクエスト（削除時はクエリパラメータで指定）
+type ReactionRequest struct {
+	Emoji string `json:"emoji" query:"emoji" validate:"required,max=32"`
 }
 
 // UpdateMessageRequest メッセージ更新リクエスト
@@ -17,17 +28,122 @@ type UpdateMessageRequest struct {
 	Content string `json:"content" validate:"required,min=1,max=2000"`
 }
 
+// システムメッセージのイベント種別
+const (
+	SystemEventRoomCreated   = "room_created"
+	SystemEventRoomRenamed   = "room_renamed"
+	SystemEventTopicChanged  = "topic_changed"
+	SystemEventMemberAdded   = "member_added"
+	SystemEventMemberRemoved = "member_removed"
+	SystemEventMemberLeft    = "member_left"
+	SystemEventMemberJoined  = "member_joined"
+	SystemEventRoleChanged   = "role_changed"
+	SystemEventOwnerChanged  = "ownership_transferred"
+)
+
+// SystemMessagePayload システムメッセージの構造化データ
+type SystemMessagePayload struct {
+	Event   string   `json:"event"`
+	ActorID string   `json:"actor_id"`           // 変更を行ったユーザー
+	UserIDs []string `json:"user_ids,omitempty"` // 追加・削除・役割変更・オーナー譲渡の対象ユーザー
+	Name    *string  `json:"name,omitempty"`     // ルーム名（作成・名前変更時）
+	OldName *string  `json:"old_name,omitempty"` // 変更前のルーム名
+	Topic   *string  `json:"topic,omitempty"`    // 変更後のトピック（削除時は空文字）
+	Role    *string  `json:"role,omitempty"`     // 変更後の役割（役割変更時）
+}
+
+// FormatSystemMessage 構造化データを表示できないクライアント向けにシステムメッセージの文面を作成
+// namesはユーザーIDから表示名への対応
+func FormatSystemMessage(payload SystemMessagePayload, names map[string]string) string {
+	actor := names[payload.ActorID]
+	users := make([]string, len(payload.UserIDs))
+	for i, id := range payload.UserIDs {
+		users[i] = names[id]
+	}
+
+	switch payload.Event {
+	case SystemEventRoomCreated:
+		// DMはルーム名を持たない
+		if payload.Name == nil {
+			return fmt.Sprintf("%s started the conversation", actor)
+		}
+		return fmt.Sprintf("%s created the room %q", actor, *payload.Name)
+	case SystemEventRoomRenamed:
+		return fmt.Sprintf("%s renamed the room to %q", actor, derefString(payload.Name))
+	case SystemEventTopicChanged:
+		if derefString(payload.Topic) == "" {
+			return fmt.Sprintf("%s cleared the topic", actor)
+		}
+		return fmt.Sprintf("%s changed the topic to: %s", actor, *payload.Topic)
+	case SystemEventMemberAdded:
+		return fmt.Sprintf("%s added %s", actor, strings.Join(users, ", "))
+	case SystemEventMemberRemoved:
+		return fmt.Sprintf("%s removed %s", actor, strings.Join(users, ", "))
+	case SystemEventMemberLeft:
+		return fmt.Sprintf("%s left the room", actor)
+	case SystemEventMemberJoined:
+		return fmt.Sprintf("%s joined the room", actor)
+	case SystemEventRoleChanged:
+		return fmt.Sprintf("%s changed the role of %s to %s", actor, strings.Join(users, ", "), derefString(payload.Role))
+	case SystemEventOwnerChanged:
+		return fmt.Sprintf("%s transferred ownership to %s", actor, strings.Join(users, ", "))
+	}
+	return payload.Event
+}
+
+// derefString 文字列ポインタの値（nilの場合は空文字）
+func derefString(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
 // MessageResponse メッセージレスポンス
 type MessageResponse struct {
 	ID        string    `json:"id"`
 	RoomID    string    `json:"room_id"`
 	UserID    string    `json:"user_id"`
 	Content   string    `json:"content"`
+	Type      string    `json:"type"` // メッセージ種別（user/system/bot）
+	Payload   json.RawMessage `json:"payload,omitempty"` // システムメッセージの構造化データ（SystemMessagePayload）
 	FileURL   *string   `json:"file_url,omitempty"`
 	CreatedAt time.Time `json:"created_at"`
 	UpdatedAt time.Time `json:"updated_at"`
 	DeletedAt *time.Time `json:"deleted_at,omitempty"`
 	Sender    *MessageSender `json:"sender"`
+	Reactions []ReactionSummary `json:"reactions,omitempty"`
+	ParentID    *string    `json:"parent_id,omitempty"`     // スレッドの親メッセージID
+	ReplyToID   *string    `json:"reply_to_id,omitempty"`   // 引用表示する返信先メッセージID
+	ReplyTo     *ReplyTo   `json:"reply_to,omitempty"`      // 引用表示用の返信先メッセージ情報
+	ReplyCount  int        `json:"reply_count"`             // スレッドの返信数
+	LastReplyAt *time.Time `json:"last_reply_at,omitempty"` // スレッドの最終返信日時
+	Edited      bool       `json:"edited"`                  // 編集済みかどうか
+	EditedAt    *time.Time `json:"edited_at,omitempty"`     // 最終編集日時
+}
+
+// MessageRevisionResponse メッセージの編集履歴レスポンス
+type MessageRevisionResponse struct {
+	ID       int64     `json:"id"`
+	Content  string    `json:"content"` // 編集前の内容
+	EditorID string    `json:"editor_id"`
+	EditedAt time.Time `json:"edited_at"`
+}
+
+// ReplyTo 返信先メッセージの引用情報（削除済みの場合は内容を含めない）
+type ReplyTo struct {
+	ID         string `json:"id"`
+	SenderID   string `json:"sender_id"`
+	SenderName string `json:"sender_name"`
+	Content    string `json:"content"`
+	Deleted    bool   `json:"deleted"`
+}
+
+// ReactionSummary 絵文字ごとのリアクション集計
+type ReactionSummary struct {
+	Emoji   string `json:"emoji"`
+	Count   int    `json:"count"`
+	Reacted bool   `json:"reacted"` // 閲覧ユーザー自身がリアクションしているか
 }
 
 // MessageSender メッセージ送信者情報
@@ -37,11 +153,104 @@ type MessageSender struct {
 	ProfileImageURL  *string `json:"profile_image_url,omitempty"`
 }
 
-// MessageListParams メッセージ一覧取得パラメータ
+// MessageListParams メッセージ一覧取得パラメータ（before・after・aroundはいずれか1つのみ指定可能）
 type MessageListParams struct {
-	Page     int `query:"page" validate:"min=1"`
-	PageSize int `query:"page_size" validate:"min=1,max=100"`
-	Before   string `query:"before,omitempty"` // 指定した日時より前のメッセージを取得
+	Limit  int    `query:"limit" validate:"omitempty,min=1,max=100"`
+	Before string `query:"before"` // カーソルより古いメッセージを取得
+	After  string `query:"after"`  // カーソルより新しいメッセージを取得
+	Around string `query:"around"` // 指定したメッセージIDの前後のメッセージを取得
+}
+
+// MessagePagination メッセージ一覧のページネーション情報
+type MessagePagination struct {
+	Limit      int     `json:"limit"`
+	NextCursor *string `json:"next_cursor"` // より古いメッセージの取得用（beforeに指定）
+	PrevCursor *string `json:"prev_cursor"` // より新しいメッセージの取得用（afterに指定）
+	HasMore    bool    `json:"has_more"`    // 取得方向にさらにメッセージがあるか
+}
+
+// ReadPositionResponse ルームの既読位置レスポンス
+type ReadPositionResponse struct {
+	RoomID    string    `json:"room_id"`
+	MessageID string    `json:"message_id"` // 最後に読んだメッセージID
+	ReadAt    time.Time `json:"read_at"`
+}
+
+// MessageReaderResponse メッセージの既読者情報
+type MessageReaderResponse struct {
+	UserID          string    `json:"user_id"`
+	Name            string    `json:"name"`
+	ProfileImageURL *string   `json:"profile_image_url,omitempty"`
+	ReadAt          time.Time `json:"read_at"`
+}
+
+// 引用表示する親メッセージ内容の最大文字数
+const replyPreviewLength = 100
+
+// ConvertToMessageResponseFor 閲覧ユーザーのリアクション状態を含めてレスポンス形式に変換
+func ConvertToMessageResponseFor(message *ent.Message, viewerID uuid.UUID) *MessageResponse {
+	response := ConvertToMessageResponse(message)
+
+	// リアクション情報がロードされている場合
+	if message.Edges.Reactions != nil {
+		response.Reactions = SummarizeReactions(message.Edges.Reactions, viewerID)
+	}
+
+	return response
+}
+
+// SummarizeReactions リアクションを絵文字ごとに集計（最初にリアクションされた順）
+func SummarizeReactions(reactions []*ent.MessageReaction, viewerID uuid.UUID) []ReactionSummary {
+	summaries := make([]ReactionSummary, 0, len(reactions))
+	index := make(map[string]int, len(reactions))
+	for _, r := range reactions {
+		i, ok := index[r.Emoji]
+		if !ok {
+			i = len(summaries)
+			index[r.Emoji] = i
+			summaries = append(summaries, ReactionSummary{Emoji: r.Emoji})
+		}
+		summaries[i].Count++
+		if r.UserID == viewerID {
+			summaries[i].Reacted = true
+		}
+	}
+	return summaries
+}
+
+// ConvertToMessageRevisionResponse EntのMessageRevisionをレスポンス形式に変換
+func ConvertToMessageRevisionResponse(revision *ent.MessageRevision) *MessageRevisionResponse {
+	return &MessageRevisionResponse{
+		ID:       revision.ID,
+		Content:  revision.Content,
+		EditorID: revision.EditorID.String(),
+		EditedAt: revision.CreatedAt,
+	}
+}
+
+// ConvertToReadPositionResponse EntのMessageReadを既読位置レスポンス形式に変換
+func ConvertToReadPositionResponse(read *ent.MessageRead) *ReadPositionResponse {
+	return &ReadPositionResponse{
+		RoomID:    read.RoomID.String(),
+		MessageID: read.MessageID.String(),
+		ReadAt:    read.ReadAt,
+	}
+}
+
+// ConvertToMessageReaderResponse EntのMessageReadを既読者情報に変換
+func ConvertToMessageReaderResponse(read *ent.MessageRead) *MessageReaderResponse {
+	response := &MessageReaderResponse{
+		UserID: read.UserID.String(),
+		ReadAt: read.ReadAt,
+	}
+
+	// ユーザー情報がロードされている場合
+	if read.Edges.User != nil {
+		response.Name = read.Edges.User.Name
+		response.ProfileImageURL = read.Edges.User.ProfileImageURL
+	}
+
+	return response
 }
 
 // ConvertToMessageResponse EntのMessageをレスポンス形式に変換
@@ -51,8 +260,14 @@ func ConvertToMessageResponse(message *ent.Message) *MessageResponse {
 		RoomID:    message.RoomID.String(),
 		UserID:    message.UserID.String(),
 		Content:   message.Content,
+		Type:      string(message.Type),
+		Payload:   message.Payload,
 		CreatedAt: message.CreatedAt,
 		UpdatedAt: message.UpdatedAt,
+		ReplyCount:  message.ReplyCount,
+		LastReplyAt: message.LastReplyAt,
+		Edited:      message.EditedAt != nil,
+		EditedAt:    message.EditedAt,
 	}
 
 	// ファイルURL設定
@@ -60,9 +275,40 @@ func ConvertToMessageResponse(message *ent.Message) *MessageResponse {
 		response.FileURL = message.FileURL
 	}
 
-	// 論理削除日時設定
+	// 論理削除日時設定（削除済みメッセージの内容は返さない）
 	if message.DeletedAt != nil {
 		response.DeletedAt = message.DeletedAt
+		response.Content = ""
+		response.FileURL = nil
+	}
+
+	// スレッドの親設定
+	if message.ParentID != nil {
+		parentID := message.ParentID.String()
+		response.ParentID = &parentID
+	}
+	// 返信先設定
+	if message.ReplyToID != nil {
+		replyToID := message.ReplyToID.String()
+		response.ReplyToID = &replyToID
+	}
+	// 返信先メッセージ情報がロードされている場合
+	if replyTo := message.Edges.ReplyTo; replyTo != nil {
+		response.ReplyTo = &ReplyTo{
+			ID:       replyTo.ID.String(),
+			SenderID: replyTo.UserID.String(),
+			Deleted:  replyTo.DeletedAt != nil,
+		}
+		if !response.ReplyTo.Deleted {
+			content := []rune(replyTo.Content)
+			if len(content) > replyPreviewLength {
+				content = content[:replyPreviewLength]
+			}
+			response.ReplyTo.Content = string(content)
+		}
+		if replyTo.Edges.Sender != nil {
+			response.ReplyTo.SenderName = replyTo.Edges.Sender.Name
+		}
 	}
 
 	// 送信者情報設定
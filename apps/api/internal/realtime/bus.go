@@ -0,0 +1,113 @@
+package realtime
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// Notification インスタンス間で共有するイベント通知
+// PostgreSQLのNOTIFYペイロード上限（8000バイト）に収まるようIDのみを持ち、
+// 受信側のインスタンスがDBから最新の内容を読み込んでクライアントへ配信する
+type Notification struct {
+	Type      EventType `json:"type"`
+	RoomID    string    `json:"room_id,omitempty"`
+	MessageID string    `json:"message_id,omitempty"`
+	UserID    string    `json:"user_id,omitempty"`
+	// リアクションイベントの絵文字
+	Emoji string `json:"emoji,omitempty"`
+	// 入力中イベントの状態（true: 入力開始、false: 入力終了）
+	Typing bool `json:"typing,omitempty"`
+	// オンライン状態イベントの状態と送信元インスタンス
+	Status     string `json:"status,omitempty"`
+	InstanceID string `json:"instance_id,omitempty"`
+	// 切断要求の対象セッション（空の場合はユーザーの全接続）
+	SessionID string `json:"session_id,omitempty"`
+}
+
+// Bus イベント通知を全インスタンスへ配送するイベントバス
+type Bus interface {
+	// Publish 通知を全インスタンス（自インスタンスを含む）の購読者へ送信
+	Publish(ctx context.Context, n Notification) error
+	// Subscribe 通知を受け取るハンドラーを登録
+	Subscribe(handler func(Notification))
+	// Close バスを停止
+	Close() error
+}
+
+// ErrBusClosed 停止済みのバスへの送信エラー
+var ErrBusClosed = errors.New("realtime: event bus is closed")
+
+// subscribers 購読ハンドラーの管理（各Bus実装で共通）
+type subscribers struct {
+	mu       sync.RWMutex
+	handlers []func(Notification)
+}
+
+func (s *subscribers) add(handler func(Notification)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.handlers = append(s.handlers, handler)
+}
+
+func (s *subscribers) dispatch(n Notification) {
+	s.mu.RLock()
+	handlers := append([]func(Notification){}, s.handlers...)
+	s.mu.RUnlock()
+	for _, handler := range handlers {
+		handler(n)
+	}
+}
+
+// LocalBus 単一インスタンス用のプロセス内イベントバス
+type LocalBus struct {
+	subscribers
+	queue     chan Notification
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+// NewLocalBus LocalBusのコンストラクタ
+func NewLocalBus() *LocalBus {
+	b := &LocalBus{
+		queue: make(chan Notification, 256),
+		done:  make(chan struct{}),
+	}
+	go b.run()
+	return b
+}
+
+// run 通知を送信順に購読者へ配送する
+func (b *LocalBus) run() {
+	for {
+		select {
+		case n := <-b.queue:
+			b.dispatch(n)
+		case <-b.done:
+			return
+		}
+	}
+}
+
+// Publish 通知をキューに積む
+func (b *LocalBus) Publish(ctx context.Context, n Notification) error {
+	select {
+	case b.queue <- n:
+		return nil
+	case <-b.done:
+		return ErrBusClosed
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Subscribe 通知を受け取るハンドラーを登録
+func (b *LocalBus) Subscribe(handler func(Notification)) {
+	b.add(handler)
+}
+
+// Close バスを停止
+func (b *LocalBus) Close() error {
+	b.closeOnce.Do(func() { close(b.done) })
+	return nil
+}
@@ -0,0 +1,127 @@
+package realtime
+
+import (
+	"context"
+	"log"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/websocket"
+)
+
+// クライアントごとの送信バッファ数
+const sendBufferSize = 64
+
+// 接続中クライアントのトークンとセッションを再確認する間隔
+const credentialCheckInterval = 30 * time.Second
+
+// Credential 接続の認証に使用したトークンの情報
+// 接続後のトークンの期限切れ・失効やセッションの削除を検知して切断するために使用する
+type Credential struct {
+	SessionID string    // トークンの発行元セッションID（セッションに紐づかないトークンの場合は空）
+	ExpiresAt time.Time // トークンの有効期限（ゼロ値の場合は期限で切断しない）
+	// Validate トークンとセッションが現在も有効か確認する（nilの場合は再確認しない）
+	Validate func(ctx context.Context) (bool, error)
+}
+
+// Client Hubに接続中のクライアント（WebSocketまたはSSE）
+type Client struct {
+	hub    *Hub
+	conn   *websocket.Conn // SSEの場合はnil
+	send   chan *Frame
+	closed chan struct{} // Hubから登録解除されるとクローズされる
+	userID uuid.UUID
+	cred   Credential
+
+	// 最終ハートビート時刻（UnixNano）。途絶えると離席中とみなす
+	heartbeat atomic.Int64
+}
+
+// newClient Clientのコンストラクタ
+func newClient(h *Hub, conn *websocket.Conn, userID uuid.UUID, cred Credential) *Client {
+	c := &Client{
+		hub:    h,
+		conn:   conn,
+		send:   make(chan *Frame, sendBufferSize),
+		closed: make(chan struct{}),
+		userID: userID,
+		cred:   cred,
+	}
+	c.heartbeat.Store(time.Now().UnixNano())
+	return c
+}
+
+// Subscribe WebSocket以外の経路（SSE）でイベントを受信するクライアントを登録
+// Hubが停止している場合はfalseを返す。受信終了時は必ずCloseを呼ぶこと
+func (h *Hub) Subscribe(userID uuid.UUID, cred Credential) (*Client, bool) {
+	c := newClient(h, nil, userID, cred)
+	if !h.addClient(c) {
+		return nil, false
+	}
+	go c.watchCredential()
+	return c, true
+}
+
+// Events 配信されたイベントを受け取るチャネル（Hubにより切断されるとクローズされる）
+func (c *Client) Events() <-chan *Frame {
+	return c.send
+}
+
+// Close クライアントの登録を解除
+func (c *Client) Close() {
+	c.hub.removeClient(c)
+}
+
+// Touch ハートビートを記録（離席中からの復帰時はオンライン状態を即座に再計算する）
+func (c *Client) Touch() {
+	prev := time.Unix(0, c.heartbeat.Swap(time.Now().UnixNano()))
+	if time.Since(prev) >= presenceAwayAfter {
+		c.hub.refreshPresence(c.userID)
+	}
+}
+
+// lastHeartbeat 最終ハートビート時刻
+func (c *Client) lastHeartbeat() time.Time {
+	return time.Unix(0, c.heartbeat.Load())
+}
+
+// watchCredential トークンの期限切れ・失効やセッションの削除を検知したらクライアントを切断する
+// Hubから登録解除されるまでブロックする
+func (c *Client) watchCredential() {
+	var expired <-chan time.Time
+	if !c.cred.ExpiresAt.IsZero() {
+		timer := time.NewTimer(time.Until(c.cred.ExpiresAt))
+		defer timer.Stop()
+		expired = timer.C
+	}
+	var check <-chan time.Time
+	if c.cred.Validate != nil {
+		ticker := time.NewTicker(credentialCheckInterval)
+		defer ticker.Stop()
+		check = ticker.C
+	}
+
+	for {
+		select {
+		case <-c.closed:
+			return
+		case <-expired:
+			c.Close()
+			return
+		case <-check:
+			ctx, cancel := context.WithTimeout(context.Background(), writeWait)
+			valid, err := c.cred.Validate(ctx)
+			cancel()
+			if err != nil {
+				// 一時的なDBエラーでは切断せず、次回の確認に任せる
+				log.Printf("realtime: credential check error: %v", err)
+				continue
+			}
+			if !valid {
+				c.Close()
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,305 @@
+package realtime
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/chatroom"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/message"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/messageread"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/roommember"
+	"github.com/hideaki1979/cc-chat-app/apps/api/internal/models"
+)
+
+// 通知1件あたりのDB読み込みタイムアウト
+const notificationTimeout = 10 * time.Second
+
+// handleNotification Busから受信した通知をイベントに変換し、自インスタンスの接続へ配信する
+func (h *Hub) handleNotification(n Notification) {
+	ctx, cancel := context.WithTimeout(context.Background(), notificationTimeout)
+	defer cancel()
+
+	if err := h.deliver(ctx, n); err != nil {
+		if ent.IsNotFound(err) {
+			// 通知後に削除された場合は配信不要
+			return
+		}
+		log.Printf("realtime: deliver %s notification error: %v", n.Type, err)
+	}
+}
+
+// deliver 通知の種別に応じて最新の内容をDBから読み込み配信する
+func (h *Hub) deliver(ctx context.Context, n Notification) error {
+	switch n.Type {
+	case EventMessageCreated, EventMessageUpdated, EventMessageDeleted:
+		messageUUID, err := uuid.Parse(n.MessageID)
+		if err != nil {
+			return err
+		}
+		msg, err := h.client.Message.Query().
+			Where(message.ID(messageUUID)).
+			WithSender().
+			WithReplyTo(func(q *ent.MessageQuery) {
+				q.WithSender()
+			}).
+			Only(ctx)
+		if err != nil {
+			return err
+		}
+
+		event := Event{Type: n.Type, RoomID: msg.RoomID.String()}
+		if n.Type == EventMessageDeleted {
+			// 削除済みメッセージの内容は配信しない
+			event.Data = map[string]interface{}{
+				"id":         msg.ID.String(),
+				"deleted_at": msg.DeletedAt,
+			}
+		} else {
+			event.Data = models.ConvertToMessageResponse(msg)
+		}
+		if err := h.BroadcastToRoom(ctx, msg.RoomID, event); err != nil {
+			return err
+		}
+		// システムメッセージは通知しない
+		if n.Type == EventMessageCreated && msg.Type == message.TypeUser {
+			return h.notifyMembers(ctx, msg, event.Data)
+		}
+		return nil
+
+	case EventRoomCreated, EventRoomUpdated:
+		roomUUID, err := uuid.Parse(n.RoomID)
+		if err != nil {
+			return err
+		}
+		room, err := h.client.ChatRoom.Query().
+			Where(chatroom.ID(roomUUID)).
+			WithRoomMembers(func(q *ent.RoomMemberQuery) {
+				q.WithUser()
+			}).
+			Only(ctx)
+		if err != nil {
+			return err
+		}
+
+		if room.DmKey != nil {
+			// DMの表示名は閲覧ユーザーごとに異なるため個別に配信
+			for _, rm := range room.Edges.RoomMembers {
+				response := models.ConvertToChatRoomResponseFor(room, rm.UserID)
+				h.FillPresence(response.Members)
+				if err := h.BroadcastToUsers([]uuid.UUID{rm.UserID}, Event{
+					Type:   n.Type,
+					RoomID: room.ID.String(),
+					Data:   response,
+				}); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+
+		userIDs := make([]uuid.UUID, len(room.Edges.RoomMembers))
+		for i, rm := range room.Edges.RoomMembers {
+			userIDs[i] = rm.UserID
+		}
+		response := models.ConvertToChatRoomResponse(room)
+		h.FillPresence(response.Members)
+		return h.BroadcastToUsers(userIDs, Event{
+			Type:   n.Type,
+			RoomID: room.ID.String(),
+			Data:   response,
+		})
+
+	case EventMemberAdded, EventMemberRemoved:
+		roomUUID, err := uuid.Parse(n.RoomID)
+		if err != nil {
+			return err
+		}
+		userUUID, err := uuid.Parse(n.UserID)
+		if err != nil {
+			return err
+		}
+
+		event := Event{
+			Type:   n.Type,
+			RoomID: n.RoomID,
+			Data:   map[string]string{"user_id": n.UserID},
+		}
+		if err := h.BroadcastToRoom(ctx, roomUUID, event); err != nil {
+			return err
+		}
+		if n.Type == EventMemberRemoved {
+			// 削除されたユーザー本人はメンバーではなくなっているため個別に配信
+			return h.BroadcastToUsers([]uuid.UUID{userUUID}, event)
+		}
+		return nil
+
+	case EventMessageRead:
+		roomUUID, err := uuid.Parse(n.RoomID)
+		if err != nil {
+			return err
+		}
+		userUUID, err := uuid.Parse(n.UserID)
+		if err != nil {
+			return err
+		}
+		read, err := h.client.MessageRead.Query().
+			Where(
+				messageread.RoomID(roomUUID),
+				messageread.UserID(userUUID),
+			).
+			Only(ctx)
+		if err != nil {
+			return err
+		}
+		return h.BroadcastToRoom(ctx, roomUUID, Event{
+			Type:   EventMessageRead,
+			RoomID: n.RoomID,
+			Data: map[string]interface{}{
+				"user_id":    n.UserID,
+				"message_id": read.MessageID.String(),
+				"read_at":    read.ReadAt,
+			},
+		})
+
+	case EventReactionAdded, EventReactionRemoved:
+		messageUUID, err := uuid.Parse(n.MessageID)
+		if err != nil {
+			return err
+		}
+		msg, err := h.client.Message.Query().
+			Where(message.ID(messageUUID)).
+			Select(message.FieldRoomID).
+			Only(ctx)
+		if err != nil {
+			return err
+		}
+		return h.BroadcastToRoom(ctx, msg.RoomID, Event{
+			Type:   n.Type,
+			RoomID: msg.RoomID.String(),
+			Data: map[string]string{
+				"message_id": n.MessageID,
+				"user_id":    n.UserID,
+				"emoji":      n.Emoji,
+			},
+		})
+
+	case EventTyping:
+		roomUUID, err := uuid.Parse(n.RoomID)
+		if err != nil {
+			return err
+		}
+		userUUID, err := uuid.Parse(n.UserID)
+		if err != nil {
+			return err
+		}
+
+		// 入力中のユーザー本人以外のメンバーへ配信
+		members, err := h.client.RoomMember.Query().
+			Where(
+				roommember.RoomID(roomUUID),
+				roommember.UserIDNEQ(userUUID),
+			).
+			Select(roommember.FieldUserID).
+			All(ctx)
+		if err != nil {
+			return err
+		}
+		userIDs := make([]uuid.UUID, len(members))
+		for i, m := range members {
+			userIDs[i] = m.UserID
+		}
+		return h.BroadcastToUsers(userIDs, Event{
+			Type:   EventTyping,
+			RoomID: n.RoomID,
+			Data: map[string]interface{}{
+				"user_id": n.UserID,
+				"typing":  n.Typing,
+			},
+		})
+
+	case EventDisconnect:
+		userUUID, err := uuid.Parse(n.UserID)
+		if err != nil {
+			return err
+		}
+		select {
+		case h.disconnect <- disconnectRequest{userID: userUUID, sessionID: n.SessionID}:
+		case <-h.done:
+		}
+		return nil
+
+	case EventResync:
+		// イベントバスの再接続時に、切断中に失われた可能性のあるイベントを再取得させる
+		return h.BroadcastToAll(Event{Type: EventResync})
+
+	case EventPresence:
+		userUUID, err := uuid.Parse(n.UserID)
+		if err != nil {
+			return err
+		}
+		before, after := h.presence.update(userUUID, n.InstanceID, PresenceStatus(n.Status), time.Now())
+		if before == after {
+			// 全インスタンスを集約した状態に変化がなければ配信不要
+			return nil
+		}
+
+		// 同じルームに所属するユーザー（本人を含む）へ配信
+		members, err := h.client.RoomMember.Query().
+			Where(roommember.HasRoomWith(
+				chatroom.HasRoomMembersWith(roommember.UserID(userUUID)),
+			)).
+			Select(roommember.FieldUserID).
+			All(ctx)
+		if err != nil {
+			return err
+		}
+		seen := make(map[uuid.UUID]bool, len(members))
+		userIDs := make([]uuid.UUID, 0, len(members))
+		for _, m := range members {
+			if !seen[m.UserID] {
+				seen[m.UserID] = true
+				userIDs = append(userIDs, m.UserID)
+			}
+		}
+		return h.BroadcastToUsers(userIDs, Event{
+			Type: EventPresence,
+			Data: map[string]interface{}{
+				"user_id":      n.UserID,
+				"presence":     after,
+				"last_seen_at": h.lastSeenAt(ctx, userUUID),
+			},
+		})
+	}
+	return nil
+}
+
+// notifyMembers 新着メッセージをミュートしていないメンバーへ通知（送信者本人を除く）
+func (h *Hub) notifyMembers(ctx context.Context, msg *ent.Message, data interface{}) error {
+	members, err := h.client.RoomMember.Query().
+		Where(
+			roommember.RoomID(msg.RoomID),
+			roommember.UserIDNEQ(msg.UserID),
+			roommember.Or(
+				roommember.MutedUntilIsNil(),
+				roommember.MutedUntilLTE(time.Now()),
+			),
+		).
+		Select(roommember.FieldUserID).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+
+	userIDs := make([]uuid.UUID, len(members))
+	for i, m := range members {
+		userIDs[i] = m.UserID
+	}
+	return h.BroadcastToUsers(userIDs, Event{
+		Type:   EventNotification,
+		RoomID: msg.RoomID.String(),
+		Data:   data,
+	})
+}
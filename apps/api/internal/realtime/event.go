@@ -0,0 +1,88 @@
+package realtime
+
+import (
+	"encoding/json"
+	"strconv"
+	"time"
+)
+
+// EventType リアルタイムイベント種別
+type EventType string
+
+const (
+	// メッセージ関連イベント
+	EventMessageCreated EventType = "message_created"
+	EventMessageUpdated EventType = "message_updated"
+	EventMessageDeleted EventType = "message_deleted"
+	EventMessageRead    EventType = "message_read"
+
+	// 新着メッセージの通知イベント（ルームをミュートしているメンバーと送信者には配信しない）
+	EventNotification EventType = "notification"
+
+	// リアクション関連イベント
+	EventReactionAdded   EventType = "reaction_added"
+	EventReactionRemoved EventType = "reaction_removed"
+
+	// ルーム・メンバー関連イベント
+	EventRoomCreated   EventType = "room_created"
+	EventRoomUpdated   EventType = "room_updated"
+	EventMemberAdded   EventType = "member_added"
+	EventMemberRemoved EventType = "member_removed"
+
+	// 入力中・オンライン状態のイベント（永続化されず、再送対象外）
+	EventTyping   EventType = "typing"
+	EventPresence EventType = "presence"
+
+	// 再送できる範囲を超えた場合やイベントバスの切断中に通知が失われた場合に、クライアントに再読み込みを要求するイベント
+	EventResync EventType = "resync"
+
+	// 接続の切断要求（インスタンス間の通知のみに使用し、クライアントには配信しない）
+	EventDisconnect EventType = "disconnect"
+)
+
+// Event クライアントへ配信するイベント
+// IDはイベント発生時刻（UnixNano）で、SSEのLast-Event-IDによる再送位置として使用する
+type Event struct {
+	ID     string      `json:"id,omitempty"`
+	Type   EventType   `json:"type"`
+	RoomID string      `json:"room_id,omitempty"`
+	Data   interface{} `json:"data,omitempty"`
+}
+
+// Frame クライアントの送信キューに積まれるエンコード済みイベント
+type Frame struct {
+	id   string
+	data []byte
+}
+
+// ID イベントID
+func (f *Frame) ID() string { return f.id }
+
+// Data JSONエンコード済みのイベント本体
+func (f *Frame) Data() []byte { return f.data }
+
+// EventIDFromTime 時刻からイベントIDを生成
+func EventIDFromTime(t time.Time) string {
+	return strconv.FormatInt(t.UnixNano(), 10)
+}
+
+// ParseEventID イベントIDを時刻に変換
+func ParseEventID(id string) (time.Time, error) {
+	nanos, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.Unix(0, nanos), nil
+}
+
+// Encode イベントを送信用のフレームに変換（IDが未設定の場合は現在時刻を使用）
+func (e Event) Encode() (*Frame, error) {
+	if e.ID == "" {
+		e.ID = EventIDFromTime(time.Now())
+	}
+	data, err := json.Marshal(e)
+	if err != nil {
+		return nil, err
+	}
+	return &Frame{id: e.ID, data: data}, nil
+}
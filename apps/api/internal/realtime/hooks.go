@@ -0,0 +1,316 @@
+package realtime
+
+import (
+	"context"
+	"log"
+
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/chatroom"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/hook"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/messagereaction"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/roommember"
+)
+
+// RegisterHooks Message・ChatRoom・RoomMember・MessageRead・MessageReactionの変更をイベントバスへ通知するEntフックを登録
+func RegisterHooks(client *ent.Client, bus Bus) {
+	client.Message.Use(messageHook(bus))
+	client.ChatRoom.Use(chatRoomHook(bus))
+	client.RoomMember.Use(roomMemberHook(bus))
+	client.MessageRead.Use(messageReadHook(bus))
+	client.MessageReaction.Use(messageReactionHook(bus))
+}
+
+// messageHook メッセージの作成・更新・論理削除を通知
+func messageHook(bus Bus) ent.Hook {
+	return func(next ent.Mutator) ent.Mutator {
+		return hook.MessageFunc(func(ctx context.Context, m *ent.MessageMutation) (ent.Value, error) {
+			if m.Op().Is(ent.OpCreate) {
+				v, err := next.Mutate(ctx, m)
+				if err != nil {
+					return v, err
+				}
+				if msg, ok := v.(*ent.Message); ok {
+					publishAfterCommit(ctx, bus, m, Notification{
+						Type:      EventMessageCreated,
+						RoomID:    msg.RoomID.String(),
+						MessageID: msg.ID.String(),
+					})
+				}
+				return v, nil
+			}
+
+			if !m.Op().Is(ent.OpUpdate | ent.OpUpdateOne) {
+				return next.Mutate(ctx, m)
+			}
+
+			// 更新対象のIDは更新前に取得しておく
+			ids, err := m.IDs(ctx)
+			if err != nil {
+				return nil, err
+			}
+			v, err := next.Mutate(ctx, m)
+			if err != nil {
+				return v, err
+			}
+
+			eventType := EventMessageUpdated
+			if _, deleted := m.DeletedAt(); deleted {
+				eventType = EventMessageDeleted
+			}
+			notifications := make([]Notification, len(ids))
+			for i, id := range ids {
+				notifications[i] = Notification{Type: eventType, MessageID: id.String()}
+			}
+			publishAfterCommit(ctx, bus, m, notifications...)
+			return v, nil
+		})
+	}
+}
+
+// chatRoomHook ルームの作成・更新を通知
+func chatRoomHook(bus Bus) ent.Hook {
+	return func(next ent.Mutator) ent.Mutator {
+		return hook.ChatRoomFunc(func(ctx context.Context, m *ent.ChatRoomMutation) (ent.Value, error) {
+			if m.Op().Is(ent.OpCreate) {
+				v, err := next.Mutate(ctx, m)
+				if err != nil {
+					return v, err
+				}
+				if room, ok := v.(*ent.ChatRoom); ok {
+					publishAfterCommit(ctx, bus, m, Notification{
+						Type:   EventRoomCreated,
+						RoomID: room.ID.String(),
+					})
+				}
+				return v, nil
+			}
+
+			// 最新メッセージの更新はメッセージのイベントで通知済みのため、ルームの更新として通知しない
+			if !m.Op().Is(ent.OpUpdate|ent.OpUpdateOne) || onlyLastMessageChanged(m) {
+				return next.Mutate(ctx, m)
+			}
+
+			ids, err := m.IDs(ctx)
+			if err != nil {
+				return nil, err
+			}
+			v, err := next.Mutate(ctx, m)
+			if err != nil {
+				return v, err
+			}
+
+			notifications := make([]Notification, len(ids))
+			for i, id := range ids {
+				notifications[i] = Notification{Type: EventRoomUpdated, RoomID: id.String()}
+			}
+			publishAfterCommit(ctx, bus, m, notifications...)
+			return v, nil
+		})
+	}
+}
+
+// onlyLastMessageChanged ルームの更新が最新メッセージ（と更新日時）の変更のみか
+func onlyLastMessageChanged(m *ent.ChatRoomMutation) bool {
+	for _, f := range append(m.Fields(), m.ClearedFields()...) {
+		switch f {
+		case chatroom.FieldLastMessageAt, chatroom.FieldLastMessageID, chatroom.FieldUpdatedAt:
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// roomMemberHook メンバーの追加・削除・役割変更を通知
+func roomMemberHook(bus Bus) ent.Hook {
+	return func(next ent.Mutator) ent.Mutator {
+		return hook.RoomMemberFunc(func(ctx context.Context, m *ent.RoomMemberMutation) (ent.Value, error) {
+			switch {
+			case m.Op().Is(ent.OpCreate):
+				v, err := next.Mutate(ctx, m)
+				if err != nil {
+					return v, err
+				}
+				if member, ok := v.(*ent.RoomMember); ok {
+					publishAfterCommit(ctx, bus, m, Notification{
+						Type:   EventMemberAdded,
+						RoomID: member.RoomID.String(),
+						UserID: member.UserID.String(),
+					})
+				}
+				return v, nil
+
+			case m.Op().Is(ent.OpDelete | ent.OpDeleteOne):
+				// 削除後は参照できないため、対象のルームとユーザーを先に取得する
+				ids, err := m.IDs(ctx)
+				if err != nil {
+					return nil, err
+				}
+				members, err := m.Client().RoomMember.Query().
+					Where(roommember.IDIn(ids...)).
+					All(ctx)
+				if err != nil {
+					return nil, err
+				}
+				v, err := next.Mutate(ctx, m)
+				if err != nil {
+					return v, err
+				}
+
+				notifications := make([]Notification, len(members))
+				for i, member := range members {
+					notifications[i] = Notification{
+						Type:   EventMemberRemoved,
+						RoomID: member.RoomID.String(),
+						UserID: member.UserID.String(),
+					}
+				}
+				publishAfterCommit(ctx, bus, m, notifications...)
+				return v, nil
+
+			case m.Op().Is(ent.OpUpdate | ent.OpUpdateOne):
+				// 役割の変更はルームの更新として通知する（アーカイブ・ミュート等の個人設定の変更は通知しない）
+				if _, ok := m.Role(); !ok {
+					return next.Mutate(ctx, m)
+				}
+				ids, err := m.IDs(ctx)
+				if err != nil {
+					return nil, err
+				}
+				members, err := m.Client().RoomMember.Query().
+					Where(roommember.IDIn(ids...)).
+					All(ctx)
+				if err != nil {
+					return nil, err
+				}
+				v, err := next.Mutate(ctx, m)
+				if err != nil {
+					return v, err
+				}
+
+				var notifications []Notification
+				seen := make(map[string]bool, len(members))
+				for _, member := range members {
+					roomID := member.RoomID.String()
+					if seen[roomID] {
+						continue
+					}
+					seen[roomID] = true
+					notifications = append(notifications, Notification{Type: EventRoomUpdated, RoomID: roomID})
+				}
+				publishAfterCommit(ctx, bus, m, notifications...)
+				return v, nil
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+}
+
+// messageReadHook 既読位置の作成・更新を通知
+func messageReadHook(bus Bus) ent.Hook {
+	return func(next ent.Mutator) ent.Mutator {
+		return hook.MessageReadFunc(func(ctx context.Context, m *ent.MessageReadMutation) (ent.Value, error) {
+			v, err := next.Mutate(ctx, m)
+			if err != nil {
+				return v, err
+			}
+			// 既読位置の更新は1件ずつ行うため、作成・単一更新のみ通知する
+			if read, ok := v.(*ent.MessageRead); ok {
+				publishAfterCommit(ctx, bus, m, Notification{
+					Type:      EventMessageRead,
+					RoomID:    read.RoomID.String(),
+					MessageID: read.MessageID.String(),
+					UserID:    read.UserID.String(),
+				})
+			}
+			return v, nil
+		})
+	}
+}
+
+// messageReactionHook リアクションの追加・削除を通知
+func messageReactionHook(bus Bus) ent.Hook {
+	return func(next ent.Mutator) ent.Mutator {
+		return hook.MessageReactionFunc(func(ctx context.Context, m *ent.MessageReactionMutation) (ent.Value, error) {
+			switch {
+			case m.Op().Is(ent.OpCreate):
+				v, err := next.Mutate(ctx, m)
+				if err != nil {
+					return v, err
+				}
+				if reaction, ok := v.(*ent.MessageReaction); ok {
+					publishAfterCommit(ctx, bus, m, reactionNotification(EventReactionAdded, reaction))
+				}
+				return v, nil
+
+			case m.Op().Is(ent.OpDelete | ent.OpDeleteOne):
+				// 削除後は参照できないため、対象のリアクションを先に取得する
+				ids, err := m.IDs(ctx)
+				if err != nil {
+					return nil, err
+				}
+				reactions, err := m.Client().MessageReaction.Query().
+					Where(messagereaction.IDIn(ids...)).
+					All(ctx)
+				if err != nil {
+					return nil, err
+				}
+				v, err := next.Mutate(ctx, m)
+				if err != nil {
+					return v, err
+				}
+
+				notifications := make([]Notification, len(reactions))
+				for i, reaction := range reactions {
+					notifications[i] = reactionNotification(EventReactionRemoved, reaction)
+				}
+				publishAfterCommit(ctx, bus, m, notifications...)
+				return v, nil
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+}
+
+// reactionNotification リアクションの通知を作成
+func reactionNotification(eventType EventType, reaction *ent.MessageReaction) Notification {
+	return Notification{
+		Type:      eventType,
+		MessageID: reaction.MessageID.String(),
+		UserID:    reaction.UserID.String(),
+		Emoji:     reaction.Emoji,
+	}
+}
+
+// publishAfterCommit トランザクション内の変更はコミット後に、それ以外は即座に通知を送信
+// 通知の失敗は変更自体を失敗させず、ログ出力のみ行う
+func publishAfterCommit(ctx context.Context, bus Bus, m interface{ Tx() (*ent.Tx, error) }, notifications ...Notification) {
+	if len(notifications) == 0 {
+		return
+	}
+
+	publish := func() {
+		for _, n := range notifications {
+			if err := bus.Publish(context.WithoutCancel(ctx), n); err != nil {
+				log.Printf("realtime: publish %s notification error: %v", n.Type, err)
+			}
+		}
+	}
+
+	tx, err := m.Tx()
+	if err != nil {
+		// トランザクション外の変更
+		publish()
+		return
+	}
+
+	tx.OnCommit(func(next ent.Committer) ent.Committer {
+		return ent.CommitFunc(func(ctx context.Context, tx *ent.Tx) error {
+			if err := next.Commit(ctx, tx); err != nil {
+				return err
+			}
+			publish()
+			return nil
+		})
+	})
+}
@@ -0,0 +1,240 @@
+package realtime
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/roommember"
+)
+
+// delivery 特定ユーザー群への配信要求
+type delivery struct {
+	userIDs []uuid.UUID // allがtrueの場合は無視される
+	all     bool        // 接続中の全クライアントへ配信する
+	frame   *Frame
+}
+
+// disconnectRequest ユーザーの接続の切断要求
+type disconnectRequest struct {
+	userID    uuid.UUID
+	sessionID string // 空の場合はユーザーの全接続を切断する
+}
+
+// Hub リアルタイム接続を管理し、イベントをルームメンバーへ配信する
+// イベントはBusから受信するため、どのインスタンスで発生した変更も全インスタンスの接続へ届く
+type Hub struct {
+	client     *ent.Client
+	bus        Bus
+	clients    map[uuid.UUID]map[*Client]bool // ユーザーID -> 接続中のクライアント
+	broadcast  chan *delivery
+	register   chan *Client
+	unregister chan *Client
+	disconnect chan disconnectRequest
+	refresh    chan uuid.UUID // オンライン状態の再計算要求
+	done       chan struct{}  // Run終了時にクローズされる
+
+	instanceID      string // オンライン状態の送信元を識別するインスタンスID
+	presence        *presenceState
+	presenceUpdates chan presenceUpdate
+}
+
+// NewHub Hubのコンストラクタ（busからの通知を購読する）
+func NewHub(client *ent.Client, bus Bus) *Hub {
+	h := &Hub{
+		client:     client,
+		bus:        bus,
+		clients:    make(map[uuid.UUID]map[*Client]bool),
+		broadcast:  make(chan *delivery, 256),
+		register:   make(chan *Client),
+		unregister: make(chan *Client),
+		disconnect: make(chan disconnectRequest, 256),
+		refresh:    make(chan uuid.UUID, 256),
+		done:       make(chan struct{}),
+
+		instanceID:      uuid.NewString(),
+		presence:        newPresenceState(),
+		presenceUpdates: make(chan presenceUpdate, 256),
+	}
+	bus.Subscribe(h.handleNotification)
+	return h
+}
+
+// Run 接続の登録・解除と配信を処理するイベントループ（ctxがキャンセルされるまでブロック）
+func (h *Hub) Run(ctx context.Context) {
+	defer close(h.done)
+	go h.runPresence(ctx)
+
+	ticker := time.NewTicker(presenceSweepInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			// シャットダウン時は全クライアントの送信チャネルを閉じて切断させる
+			for _, conns := range h.clients {
+				for c := range conns {
+					close(c.send)
+					close(c.closed)
+				}
+			}
+			h.clients = make(map[uuid.UUID]map[*Client]bool)
+			return
+		case c := <-h.register:
+			if h.clients[c.userID] == nil {
+				h.clients[c.userID] = make(map[*Client]bool)
+				// 最初の接続でオンラインになる
+				h.queuePresence(c.userID, PresenceOnline)
+			}
+			h.clients[c.userID][c] = true
+		case c := <-h.unregister:
+			h.remove(c)
+		case req := <-h.disconnect:
+			for c := range h.clients[req.userID] {
+				if req.sessionID == "" || c.cred.SessionID == req.sessionID {
+					h.remove(c)
+				}
+			}
+		case userID := <-h.refresh:
+			if _, ok := h.clients[userID]; ok {
+				h.queuePresence(userID, h.localPresence(userID))
+			}
+		case <-ticker.C:
+			// 接続中ユーザーの状態を定期的に再通知する（他インスタンスでの有効期限の延長を兼ねる）
+			for userID := range h.clients {
+				h.queuePresence(userID, h.localPresence(userID))
+			}
+		case d := <-h.broadcast:
+			userIDs := d.userIDs
+			if d.all {
+				userIDs = make([]uuid.UUID, 0, len(h.clients))
+				for userID := range h.clients {
+					userIDs = append(userIDs, userID)
+				}
+			}
+			for _, userID := range userIDs {
+				for c := range h.clients[userID] {
+					select {
+					case c.send <- d.frame:
+					default:
+						// 送信バッファが詰まっているクライアントは切断する
+						h.remove(c)
+					}
+				}
+			}
+		}
+	}
+}
+
+// remove クライアントを登録解除し送信チャネルを閉じる
+func (h *Hub) remove(c *Client) {
+	conns, ok := h.clients[c.userID]
+	if !ok || !conns[c] {
+		return
+	}
+	delete(conns, c)
+	close(c.send)
+	close(c.closed)
+	if len(conns) == 0 {
+		delete(h.clients, c.userID)
+		// 最後の接続が切れたらオフラインになる
+		h.queuePresence(c.userID, PresenceOffline)
+	}
+}
+
+// refreshPresence ユーザーの状態の再計算を要求（Runループをブロックしない）
+func (h *Hub) refreshPresence(userID uuid.UUID) {
+	select {
+	case h.refresh <- userID:
+	default:
+	}
+}
+
+// addClient クライアントをHubに登録（Hub停止後はfalseを返す）
+func (h *Hub) addClient(c *Client) bool {
+	select {
+	case h.register <- c:
+		return true
+	case <-h.done:
+		return false
+	}
+}
+
+// removeClient クライアントの登録解除を要求（Hub停止後は何もしない）
+func (h *Hub) removeClient(c *Client) {
+	select {
+	case h.unregister <- c:
+	case <-h.done:
+	}
+}
+
+// DisconnectUser 全インスタンスに接続しているユーザーのクライアントを切断する（hがnilの場合は何もしない）
+// トークンの一括失効後に、失効前に確立した接続でイベントを受信し続けないようにする
+func (h *Hub) DisconnectUser(ctx context.Context, userID uuid.UUID) error {
+	if h == nil {
+		return nil
+	}
+	return h.bus.Publish(ctx, Notification{Type: EventDisconnect, UserID: userID.String()})
+}
+
+// DisconnectSession 全インスタンスから、指定したセッションのトークンで接続したクライアントを切断する（hがnilの場合は何もしない）
+func (h *Hub) DisconnectSession(ctx context.Context, userID, sessionID uuid.UUID) error {
+	if h == nil {
+		return nil
+	}
+	return h.bus.Publish(ctx, Notification{
+		Type:      EventDisconnect,
+		UserID:    userID.String(),
+		SessionID: sessionID.String(),
+	})
+}
+
+// BroadcastToRoom 自インスタンスに接続しているルームの現在のメンバー全員にイベントを配信する
+// メンバー判定はRoomMemberテーブルを参照するため、メンバー以外には配信されない
+func (h *Hub) BroadcastToRoom(ctx context.Context, roomID uuid.UUID, event Event) error {
+	members, err := h.client.RoomMember.Query().
+		Where(roommember.RoomID(roomID)).
+		Select(roommember.FieldUserID).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+
+	userIDs := make([]uuid.UUID, len(members))
+	for i, m := range members {
+		userIDs[i] = m.UserID
+	}
+
+	return h.BroadcastToUsers(userIDs, event)
+}
+
+// BroadcastToUsers 自インスタンスに接続している指定ユーザーの全接続にイベントを配信する
+func (h *Hub) BroadcastToUsers(userIDs []uuid.UUID, event Event) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+	return h.enqueue(&delivery{userIDs: userIDs}, event)
+}
+
+// BroadcastToAll 自インスタンスに接続している全クライアントにイベントを配信する
+func (h *Hub) BroadcastToAll(event Event) error {
+	return h.enqueue(&delivery{all: true}, event)
+}
+
+// enqueue イベントをエンコードして配信キューに積む（キューが詰まっている場合は破棄する）
+func (h *Hub) enqueue(d *delivery, event Event) error {
+	f, err := event.Encode()
+	if err != nil {
+		return err
+	}
+	d.frame = f
+
+	select {
+	case h.broadcast <- d:
+	default:
+		log.Printf("realtime: broadcast queue is full, dropping %s event", event.Type)
+	}
+	return nil
+}
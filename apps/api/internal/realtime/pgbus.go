@@ -0,0 +1,111 @@
+package realtime
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+// PostgreSQLのNOTIFYチャネル名
+const notifyChannel = "chat_events"
+
+// PostgresBus PostgreSQLのLISTEN/NOTIFYを使った複数インスタンス対応のイベントバス
+// 送信は既存のコネクションプールからpg_notifyで行い、受信はLISTEN専用コネクションで行う
+type PostgresBus struct {
+	subscribers
+	db        *sql.DB
+	listener  *pq.Listener
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+// NewPostgresBus PostgresBusのコンストラクタ
+// dbURLはLISTEN専用コネクションの接続に使用する
+func NewPostgresBus(db *sql.DB, dbURL string) (*PostgresBus, error) {
+	listener := pq.NewListener(dbURL, 10*time.Second, time.Minute, func(ev pq.ListenerEventType, err error) {
+		if err != nil {
+			log.Printf("realtime: postgres listener error: %v", err)
+		}
+	})
+	if err := listener.Listen(notifyChannel); err != nil {
+		listener.Close()
+		return nil, err
+	}
+
+	b := &PostgresBus{
+		db:       db,
+		listener: listener,
+		done:     make(chan struct{}),
+	}
+	go b.run()
+	return b, nil
+}
+
+// run LISTENで受信した通知を購読者へ配送する
+func (b *PostgresBus) run() {
+	for {
+		select {
+		case <-b.done:
+			return
+		case pn, ok := <-b.listener.Notify:
+			if !ok {
+				return
+			}
+			// 再接続時はnilが送られる。切断中の通知は失われるため、接続中のクライアントに再読み込みを要求する
+			if pn == nil {
+				log.Printf("realtime: postgres listener reconnected, requesting clients to resync")
+				b.dispatch(Notification{Type: EventResync})
+				continue
+			}
+			var n Notification
+			if err := json.Unmarshal([]byte(pn.Extra), &n); err != nil {
+				log.Printf("realtime: invalid notification payload: %v", err)
+				continue
+			}
+			b.dispatch(n)
+		case <-time.After(90 * time.Second):
+			// 長時間通知がない場合は接続の生存確認を行う
+			go func() {
+				if err := b.listener.Ping(); err != nil {
+					log.Printf("realtime: postgres listener ping error: %v", err)
+				}
+			}()
+		}
+	}
+}
+
+// Publish pg_notifyで全インスタンスへ通知を送信
+func (b *PostgresBus) Publish(ctx context.Context, n Notification) error {
+	select {
+	case <-b.done:
+		return ErrBusClosed
+	default:
+	}
+
+	payload, err := json.Marshal(n)
+	if err != nil {
+		return err
+	}
+	_, err = b.db.ExecContext(ctx, "SELECT pg_notify($1, $2)", notifyChannel, string(payload))
+	return err
+}
+
+// Subscribe 通知を受け取るハンドラーを登録
+func (b *PostgresBus) Subscribe(handler func(Notification)) {
+	b.add(handler)
+}
+
+// Close LISTEN専用コネクションを閉じてバスを停止
+func (b *PostgresBus) Close() error {
+	var err error
+	b.closeOnce.Do(func() {
+		close(b.done)
+		err = b.listener.Close()
+	})
+	return err
+}
@@ -0,0 +1,201 @@
+package realtime
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/user"
+	"github.com/hideaki1979/cc-chat-app/apps/api/internal/models"
+)
+
+// PresenceStatus ユーザーのオンライン状態
+type PresenceStatus string
+
+const (
+	PresenceOnline  PresenceStatus = "online"
+	PresenceAway    PresenceStatus = "away"
+	PresenceOffline PresenceStatus = "offline"
+)
+
+const (
+	// 接続中ユーザーの状態を再計算・再通知する間隔
+	presenceSweepInterval = 30 * time.Second
+	// ハートビートがこの時間途絶えた接続は離席中とみなす
+	presenceAwayAfter = 2 * time.Minute
+	// 他インスタンスからの状態通知の有効期間（インスタンス停止時に自動でオフライン扱いにする）
+	presenceTTL = 3 * presenceSweepInterval
+)
+
+// rank 複数接続の状態を集約する際の優先度
+func (s PresenceStatus) rank() int {
+	switch s {
+	case PresenceOnline:
+		return 2
+	case PresenceAway:
+		return 1
+	}
+	return 0
+}
+
+// presenceUpdate 自インスタンスの接続状態の変化
+type presenceUpdate struct {
+	userID uuid.UUID
+	status PresenceStatus
+}
+
+// presenceEntry インスタンスごとのユーザー状態
+type presenceEntry struct {
+	status    PresenceStatus
+	expiresAt time.Time
+}
+
+// presenceState 全インスタンスから通知されたユーザー状態
+type presenceState struct {
+	mu      sync.RWMutex
+	entries map[uuid.UUID]map[string]presenceEntry // ユーザーID -> インスタンスID -> 状態
+}
+
+func newPresenceState() *presenceState {
+	return &presenceState{entries: make(map[uuid.UUID]map[string]presenceEntry)}
+}
+
+// status 全インスタンスの状態を集約（最も活動的な状態を採用）
+func (p *presenceState) status(userID uuid.UUID, now time.Time) PresenceStatus {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return p.aggregate(userID, now)
+}
+
+func (p *presenceState) aggregate(userID uuid.UUID, now time.Time) PresenceStatus {
+	result := PresenceOffline
+	for _, entry := range p.entries[userID] {
+		if now.After(entry.expiresAt) {
+			continue
+		}
+		if entry.status.rank() > result.rank() {
+			result = entry.status
+		}
+	}
+	return result
+}
+
+// update インスタンスからの状態通知を反映し、更新前後の集約状態を返す
+func (p *presenceState) update(userID uuid.UUID, instanceID string, status PresenceStatus, now time.Time) (before, after PresenceStatus) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	before = p.aggregate(userID, now)
+	if status == PresenceOffline {
+		delete(p.entries[userID], instanceID)
+		if len(p.entries[userID]) == 0 {
+			delete(p.entries, userID)
+		}
+	} else {
+		if p.entries[userID] == nil {
+			p.entries[userID] = make(map[string]presenceEntry)
+		}
+		p.entries[userID][instanceID] = presenceEntry{status: status, expiresAt: now.Add(presenceTTL)}
+	}
+	after = p.aggregate(userID, now)
+	return before, after
+}
+
+// leavesOnline インスタンスの状態をstatusに変えると、集約状態がオンラインでなくなるかどうか
+func (p *presenceState) leavesOnline(userID uuid.UUID, instanceID string, status PresenceStatus, now time.Time) bool {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	if status == PresenceOnline || p.aggregate(userID, now) != PresenceOnline {
+		return false
+	}
+	for id, entry := range p.entries[userID] {
+		if id != instanceID && !now.After(entry.expiresAt) && entry.status == PresenceOnline {
+			return false
+		}
+	}
+	return true
+}
+
+// PresenceOf ユーザーの現在のオンライン状態（hがnilの場合はオフライン）
+func (h *Hub) PresenceOf(userID uuid.UUID) PresenceStatus {
+	if h == nil {
+		return PresenceOffline
+	}
+	return h.presence.status(userID, time.Now())
+}
+
+// FillPresence ルームメンバーのオンライン状態を設定（hがnilの場合はオフライン）
+func (h *Hub) FillPresence(members []models.ChatRoomMember) {
+	for i := range members {
+		userID, err := uuid.Parse(members[i].UserID)
+		if err != nil {
+			continue
+		}
+		members[i].Presence = string(h.PresenceOf(userID))
+	}
+}
+
+// localPresence 自インスタンスの接続から算出したユーザーの状態（Runループ内でのみ呼び出す）
+func (h *Hub) localPresence(userID uuid.UUID) PresenceStatus {
+	status := PresenceOffline
+	for c := range h.clients[userID] {
+		if time.Since(c.lastHeartbeat()) < presenceAwayAfter {
+			return PresenceOnline
+		}
+		status = PresenceAway
+	}
+	return status
+}
+
+// queuePresence 状態の変化を通知キューに積む（Runループをブロックしない）
+func (h *Hub) queuePresence(userID uuid.UUID, status PresenceStatus) {
+	select {
+	case h.presenceUpdates <- presenceUpdate{userID: userID, status: status}:
+	default:
+		log.Printf("realtime: presence queue is full, dropping update for %s", userID)
+	}
+}
+
+// runPresence 自インスタンスの状態変化をBusへ通知し、オフライン・離席中になった場合は最終オンライン日時を更新する
+func (h *Hub) runPresence(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case u := <-h.presenceUpdates:
+			// オンラインでなくなる時のみ記録する（配信時に最新の値を読み込めるよう通知より先に更新）
+			now := time.Now()
+			if h.presence.leavesOnline(u.userID, h.instanceID, u.status, now) {
+				if err := h.client.User.UpdateOneID(u.userID).
+					SetLastSeenAt(now).
+					Exec(ctx); err != nil && ctx.Err() == nil {
+					log.Printf("realtime: update last_seen_at error: %v", err)
+				}
+			}
+
+			if err := h.bus.Publish(ctx, Notification{
+				Type:       EventPresence,
+				UserID:     u.userID.String(),
+				Status:     string(u.status),
+				InstanceID: h.instanceID,
+			}); err != nil {
+				log.Printf("realtime: publish presence error: %v", err)
+			}
+		}
+	}
+}
+
+// lastSeenAt ユーザーの最終オンライン日時をDBから取得
+func (h *Hub) lastSeenAt(ctx context.Context, userID uuid.UUID) *time.Time {
+	u, err := h.client.User.Query().
+		Where(user.ID(userID)).
+		Select(user.FieldLastSeenAt).
+		Only(ctx)
+	if err != nil {
+		return nil
+	}
+	return u.LastSeenAt
+}
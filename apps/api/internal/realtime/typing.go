@@ -0,0 +1,57 @@
+package realtime
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/roommember"
+)
+
+// 同一ルームへの入力開始イベントの最小送信間隔
+const typingThrottle = 2 * time.Second
+
+// ErrNotRoomMember ルームのメンバーではないユーザーによる操作
+var ErrNotRoomMember = errors.New("realtime: user is not a member of the room")
+
+// SetTyping ルームでの入力開始・終了を他のメンバーへ通知する（メンバー以外はErrNotRoomMember）
+// 入力中状態は保存されないため、クライアントは入力中の間typing=trueを定期的に送信し、
+// 受信側は一定時間更新がなければ入力終了とみなす
+func (h *Hub) SetTyping(ctx context.Context, roomID, userID uuid.UUID, typing bool) error {
+	isMember, err := h.client.RoomMember.Query().
+		Where(
+			roommember.RoomID(roomID),
+			roommember.UserID(userID),
+		).
+		Exist(ctx)
+	if err != nil {
+		return err
+	}
+	if !isMember {
+		return ErrNotRoomMember
+	}
+
+	return h.bus.Publish(ctx, Notification{
+		Type:   EventTyping,
+		RoomID: roomID.String(),
+		UserID: userID.String(),
+		Typing: typing,
+	})
+}
+
+// typingLimiter 接続ごとの入力開始イベントの送信間隔制限
+type typingLimiter map[uuid.UUID]time.Time
+
+// allow 入力開始イベントを送信してよいか判定（入力終了は常に許可し、制限をリセットする）
+func (l typingLimiter) allow(roomID uuid.UUID, typing bool, now time.Time) bool {
+	if !typing {
+		delete(l, roomID)
+		return true
+	}
+	if last, ok := l[roomID]; ok && now.Sub(last) < typingThrottle {
+		return false
+	}
+	l[roomID] = now
+	return true
+}
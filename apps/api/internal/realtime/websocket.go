@@ -0,0 +1,130 @@
+package realtime
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/websocket"
+)
+
+const (
+	// クライアントへの書き込みタイムアウト
+	writeWait = 10 * time.Second
+	// クライアントからのPong待機時間（これを超えると切断）
+	pongWait = 60 * time.Second
+	// Ping送信間隔（pongWaitより短くする必要がある）
+	pingPeriod = (pongWait * 9) / 10
+	// クライアントから受け付ける最大メッセージサイズ
+	maxMessageSize = 4096
+)
+
+// ServeClient WebSocket接続をHubに登録し、切断されるまで読み書きを行う
+func (h *Hub) ServeClient(conn *websocket.Conn, userID uuid.UUID, cred Credential) {
+	c := newClient(h, conn, userID, cred)
+	if !h.addClient(c) {
+		conn.Close()
+		return
+	}
+
+	go c.watchCredential()
+	go c.writePump()
+	c.readPump()
+}
+
+// クライアントから送信されるメッセージ種別
+const (
+	clientHeartbeat   = "heartbeat"
+	clientTypingStart = "typing_start"
+	clientTypingStop  = "typing_stop"
+)
+
+// clientMessage クライアントから送信されるメッセージ
+type clientMessage struct {
+	Type   string `json:"type"`
+	RoomID string `json:"room_id,omitempty"`
+}
+
+// readPump クライアントからのメッセージを読み取り、ハートビートと入力中イベントを処理する
+func (c *Client) readPump() {
+	defer func() {
+		c.hub.removeClient(c)
+		c.conn.Close()
+	}()
+
+	c.conn.SetReadLimit(maxMessageSize)
+	_ = c.conn.SetReadDeadline(time.Now().Add(pongWait))
+	c.conn.SetPongHandler(func(string) error {
+		return c.conn.SetReadDeadline(time.Now().Add(pongWait))
+	})
+
+	limiter := typingLimiter{}
+	for {
+		_, data, err := c.conn.ReadMessage()
+		if err != nil {
+			return
+		}
+
+		var msg clientMessage
+		if err := json.Unmarshal(data, &msg); err != nil {
+			continue
+		}
+		switch msg.Type {
+		case clientHeartbeat:
+			c.Touch()
+		case clientTypingStart, clientTypingStop:
+			c.Touch()
+			roomID, err := uuid.Parse(msg.RoomID)
+			if err != nil {
+				continue
+			}
+			typing := msg.Type == clientTypingStart
+			if !limiter.allow(roomID, typing, time.Now()) {
+				continue
+			}
+			c.setTyping(roomID, typing)
+		}
+	}
+}
+
+// setTyping 入力中イベントを送信（メンバーでないルームへの送信は無視する）
+func (c *Client) setTyping(roomID uuid.UUID, typing bool) {
+	ctx, cancel := context.WithTimeout(context.Background(), writeWait)
+	defer cancel()
+
+	if err := c.hub.SetTyping(ctx, roomID, c.userID, typing); err != nil && !errors.Is(err, ErrNotRoomMember) {
+		log.Printf("realtime: typing notification error: %v", err)
+	}
+}
+
+// writePump Hubから受け取ったイベントをクライアントへ書き込む
+func (c *Client) writePump() {
+	ticker := time.NewTicker(pingPeriod)
+	defer func() {
+		ticker.Stop()
+		c.conn.Close()
+	}()
+
+	for {
+		select {
+		case f, ok := <-c.send:
+			_ = c.conn.SetWriteDeadline(time.Now().Add(writeWait))
+			if !ok {
+				// Hubによって送信チャネルが閉じられた
+				_ = c.conn.WriteMessage(websocket.CloseMessage, []byte{})
+				return
+			}
+			if err := c.conn.WriteMessage(websocket.TextMessage, f.Data()); err != nil {
+				return
+			}
+		case <-ticker.C:
+			_ = c.conn.SetWriteDeadline(time.Now().Add(writeWait))
+			if err := c.conn.WriteMessage(websocket.PingMessage, nil); err != nil {
+				return
+			}
+		}
+	}
+}
@@ -14,8 +14,11 @@ import (
 
 	"github.com/hideaki1979/cc-chat-app/apps/api/ent"
 	_ "github.com/hideaki1979/cc-chat-app/apps/api/ent/runtime"
+	"github.com/hideaki1979/cc-chat-app/apps/api/internal/auth"
 	"github.com/hideaki1979/cc-chat-app/apps/api/internal/handlers"
 	"github.com/hideaki1979/cc-chat-app/apps/api/internal/middleware"
+	"github.com/hideaki1979/cc-chat-app/apps/api/internal/migration"
+	"github.com/hideaki1979/cc-chat-app/apps/api/internal/realtime"
 	"github.com/joho/godotenv"
 	"github.com/labstack/echo/v4"
 	echoMiddleware "github.com/labstack/echo/v4/middleware"
@@ -26,8 +29,16 @@ const (
 	defaultPort        = "8080"
 	portEnvKey         = "PORT"
 	healthCheckPath    = "/health"
+	webSocketPath      = "/ws"
+	eventStreamPath    = "/api/events"
+	inviteAcceptPath   = "/api/invites/:token/accept"
+	jwksPath           = "/.well-known/jwks.json"
 	defaultDatabaseURL = ""
 	databaseURLKey     = "DATABASE_URL"
+	eventBusKey        = "EVENT_BUS"
+	editWindowKey      = "MESSAGE_EDIT_WINDOW"
+	emptyRoomPolicyKey = "EMPTY_ROOM_POLICY"
+	maxRoomSizeKey     = "MAX_ROOM_SIZE"
 )
 
 // ヘルスチェック用のハンドラー
@@ -57,6 +68,13 @@ func main() {
 	db.SetMaxOpenConns(100)
 	db.SetConnMaxLifetime(time.Hour)
 
+	// JWTの署名鍵・検証鍵を読み込み
+	keys, err := auth.LoadKeySetFromEnv()
+	if err != nil {
+		log.Fatalf("Invalid JWT key configuration: %v", err)
+	}
+	auth.SetKeySet(keys)
+
 	// EntクライアントをDriverオプションで作成
 	drv := entsql.OpenDB(dialect.Postgres, db)
 	client := ent.NewClient(ent.Driver(drv))
@@ -69,7 +87,27 @@ func main() {
 			log.Fatalf("Failed to create database schema: %v", err)
 		}
 		log.Println("Database schema created successfully")
+		// 追加したカラムの既存データを補完
+		if err := migration.Backfill(ctx, client); err != nil {
+			log.Fatalf("Failed to backfill data: %v", err)
+		}
+	}
+
+	// イベントバス設定（複数インスタンス間の配信はPostgreSQLのLISTEN/NOTIFYを使用）
+	var bus realtime.Bus
+	if os.Getenv(eventBusKey) == "memory" {
+		bus = realtime.NewLocalBus()
+	} else {
+		bus, err = realtime.NewPostgresBus(db, dbURL)
+		if err != nil {
+			log.Fatalf("Failed to start event bus: %v", err)
+		}
 	}
+	defer bus.Close()
+
+	// Message・ChatRoom・RoomMemberの変更をイベントバスへ通知
+	realtime.RegisterHooks(client, bus)
+
 	// Echoのインスタンスを作成
 	e := echo.New()
 
@@ -79,7 +117,12 @@ func main() {
 	// ミドルウェアを設定
 	e.Use(echoMiddleware.LoggerWithConfig(echoMiddleware.LoggerConfig{
 		Skipper: func(c echo.Context) bool {
-			return c.Path() == healthCheckPath
+			// WebSocket/SSE・招待リンクはURLにトークンを含むためログに出力しない
+			switch c.Path() {
+			case healthCheckPath, webSocketPath, eventStreamPath, inviteAcceptPath:
+				return true
+			}
+			return false
 		},
 	}))
 
@@ -103,7 +146,7 @@ func main() {
 		AllowOrigins:     allowOrigins,
 		AllowCredentials: true,
 		AllowMethods:     []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"},
-		AllowHeaders:     []string{"Content-Type", "Authorization"},
+		AllowHeaders:     []string{"Content-Type", "Authorization", "Last-Event-ID"},
 	}))
 
 	// コンテキストにEntクライアントを設定
@@ -114,15 +157,51 @@ func main() {
 		}
 	})
 
+	// リアルタイム配信Hubを起動
+	hubCtx, stopHub := context.WithCancel(context.Background())
+	defer stopHub()
+	hub := realtime.NewHub(client, bus)
+	go hub.Run(hubCtx)
+
+	// メッセージ編集可能時間のデフォルト値（ルームごとの設定がない場合に使用）
+	editWindow, err := handlers.ParseEditWindow(os.Getenv(editWindowKey))
+	if err != nil {
+		log.Fatalf("Invalid %s: %v", editWindowKey, err)
+	}
+
+	// 最後のメンバーが退出したルームの扱い
+	emptyRoomPolicy, err := handlers.ParseEmptyRoomPolicy(os.Getenv(emptyRoomPolicyKey))
+	if err != nil {
+		log.Fatalf("Invalid %s: %v", emptyRoomPolicyKey, err)
+	}
+
+	// ルームの最大メンバー数
+	maxRoomSize, err := handlers.ParseMaxRoomSize(os.Getenv(maxRoomSizeKey))
+	if err != nil {
+		log.Fatalf("Invalid %s: %v", maxRoomSizeKey, err)
+	}
+
+	// アクセストークンの失効状態（DBに保存し、結果を短時間メモリにキャッシュ）
+	revocations := auth.NewDBRevocationStore(client, auth.DefaultRevocationCacheTTL)
+	jwtAuth := middleware.JWTAuth(middleware.WithRevocationStore(revocations))
+	streamJWTAuth := middleware.JWTAuth(middleware.WithStreamTicket("ticket"), middleware.WithRevocationStore(revocations))
+
 	// ハンドラー初期化
-	authHandler := handlers.NewAuthHandler()
-	chatRoomHandler := handlers.NewChatRoomHandler(client)
-	messageHandler := handlers.NewMessageHandler(client)
+	authHandler := handlers.NewAuthHandler(hub, revocations)
+	chatRoomHandler := handlers.NewChatRoomHandler(client, hub, emptyRoomPolicy, maxRoomSize)
+	messageHandler := handlers.NewMessageHandler(client, editWindow)
+	inviteHandler := handlers.NewInviteHandler(client, maxRoomSize)
+	webSocketHandler := handlers.NewWebSocketHandler(hub, allowOrigins)
+	jwksHandler := handlers.NewJWKSHandler(keys)
+	eventStreamHandler := handlers.NewEventStreamHandler(client, hub)
 
 	// ルーティング設定
 	// ヘルスチェック
 	e.GET(healthCheckPath, healthCheck)
 
+	// トークン検証用の公開鍵（JWT認証不要）
+	e.GET(jwksPath, jwksHandler.JWKS)
+
 	// 認証関連のエンドポイント（JWT認証不要）
 	authGroup := e.Group("/auth")
 	authGroup.POST("/register", authHandler.Register)
@@ -132,7 +211,7 @@ func main() {
 
 	// 認証が必要なエンドポイント
 	protectedGroup := e.Group("/api")
-	protectedGroup.Use(middleware.JWTAuth())
+	protectedGroup.Use(jwtAuth)
 	
 	// ユーザー関連
 	protectedGroup.GET("/profile", authHandler.Profile)
@@ -140,13 +219,41 @@ func main() {
 	protectedGroup.GET("/users/search", authHandler.SearchUsers)
 	protectedGroup.POST("/avatar/upload", authHandler.UploadAvatar)
 
+	// ログインセッション（端末）関連
+	protectedGroup.GET("/sessions", authHandler.ListSessions)
+	protectedGroup.DELETE("/sessions/:id", authHandler.RevokeSession)
+	protectedGroup.POST("/sessions/revoke-others", authHandler.RevokeOtherSessions)
+	protectedGroup.POST("/sessions/revoke-all", authHandler.RevokeAllSessions)
+
+	// WebSocket・SSE接続用のチケット発行
+	protectedGroup.POST("/stream-tickets", authHandler.CreateStreamTicket)
+
 	// チャットルーム関連
 	protectedGroup.POST("/chatrooms", chatRoomHandler.CreateChatRoom)
 	protectedGroup.GET("/chatrooms", chatRoomHandler.GetChatRooms)
+	protectedGroup.GET("/chatrooms/discover", chatRoomHandler.DiscoverRooms)
 	protectedGroup.GET("/chatrooms/:id", chatRoomHandler.GetChatRoom)
 	protectedGroup.PUT("/chatrooms/:id", chatRoomHandler.UpdateChatRoom)
 	protectedGroup.POST("/chatrooms/:id/members", chatRoomHandler.AddMember)
 	protectedGroup.DELETE("/chatrooms/:id/members/:user_id", chatRoomHandler.RemoveMember)
+	protectedGroup.POST("/chatrooms/:id/members/bulk", chatRoomHandler.BulkUpdateMembers)
+	protectedGroup.POST("/chatrooms/:id/leave", chatRoomHandler.LeaveRoom)
+	protectedGroup.PUT("/chatrooms/:id/settings", chatRoomHandler.UpdateMemberSettings)
+	protectedGroup.PUT("/chatrooms/:id/members/:user_id/role", chatRoomHandler.UpdateMemberRole)
+	protectedGroup.POST("/chatrooms/:id/transfer-ownership", chatRoomHandler.TransferOwnership)
+	protectedGroup.POST("/dm/:user_id", chatRoomHandler.GetOrCreateDirectMessage)
+
+	// 公開ルームへの参加・参加申請関連のエンドポイント
+	protectedGroup.POST("/chatrooms/:id/join", chatRoomHandler.JoinRoom)
+	protectedGroup.GET("/chatrooms/:id/join-requests", chatRoomHandler.GetJoinRequests)
+	protectedGroup.POST("/chatrooms/:id/join-requests/:request_id/approve", chatRoomHandler.ApproveJoinRequest)
+	protectedGroup.POST("/chatrooms/:id/join-requests/:request_id/reject", chatRoomHandler.RejectJoinRequest)
+
+	// 招待リンク関連のエンドポイント
+	protectedGroup.POST("/chatrooms/:id/invites", inviteHandler.CreateInvite)
+	protectedGroup.GET("/chatrooms/:id/invites", inviteHandler.GetInvites)
+	protectedGroup.DELETE("/chatrooms/:id/invites/:invite_id", inviteHandler.RevokeInvite)
+	e.POST(inviteAcceptPath, inviteHandler.AcceptInvite, jwtAuth)
 
 	// メッセージ関連
 	protectedGroup.POST("/chatrooms/:room_id/messages", messageHandler.SendMessage)
@@ -154,6 +261,19 @@ func main() {
 	protectedGroup.GET("/messages/:id", messageHandler.GetMessage)
 	protectedGroup.PUT("/messages/:id", messageHandler.UpdateMessage)
 	protectedGroup.DELETE("/messages/:id", messageHandler.DeleteMessage)
+	protectedGroup.POST("/messages/:id/read", messageHandler.MarkAsRead)
+	protectedGroup.GET("/messages/:id/reads", messageHandler.GetMessageReaders)
+	protectedGroup.GET("/messages/:id/replies", messageHandler.GetReplies)
+	protectedGroup.GET("/messages/:id/revisions", messageHandler.GetRevisions)
+	protectedGroup.POST("/messages/:id/reactions", messageHandler.AddReaction)
+	protectedGroup.DELETE("/messages/:id/reactions", messageHandler.RemoveReaction)
+
+	// 入力中の通知（SSEクライアント向け）
+	protectedGroup.POST("/chatrooms/:room_id/typing", eventStreamHandler.Typing)
+
+	// WebSocket・SSE（ブラウザはヘッダーを設定できないため、/api/stream-ticketsで発行したチケットもクエリパラメータで許可）
+	e.GET(webSocketPath, webSocketHandler.Connect, streamJWTAuth)
+	e.GET(eventStreamPath, eventStreamHandler.Stream, streamJWTAuth)
 
 	// グレースフルシャットダウンの設定
 	go func() {
@@ -172,7 +292,8 @@ func main() {
 	signal.Notify(quit, os.Interrupt)
 	<-quit
 
-	// サーバーをシャットダウン
+	// リアルタイム接続を切断してからサーバーをシャットダウン
+	stopHub()
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 	if err := e.Shutdown(ctx); err != nil {
@@ -15,6 +15,7 @@ import (
 
 	"github.com/hideaki1979/cc-chat-app/apps/api/ent"
 	_ "github.com/hideaki1979/cc-chat-app/apps/api/ent/runtime"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/session"
 	"github.com/hideaki1979/cc-chat-app/apps/api/ent/user"
 	"github.com/hideaki1979/cc-chat-app/apps/api/internal/auth"
 	"github.com/hideaki1979/cc-chat-app/apps/api/internal/handlers"
@@ -64,7 +65,7 @@ func setupTestServer(client *ent.Client) *echo.Echo {
 	})
 
 	// ハンドラー設定
-	authHandler := handlers.NewAuthHandler()
+	authHandler := handlers.NewAuthHandler(nil, nil)
 	authGroup := e.Group("/auth")
 	authGroup.POST("/register", authHandler.Register)
 	authGroup.POST("/login", authHandler.Login)
@@ -75,6 +76,18 @@ func setupTestServer(client *ent.Client) *echo.Echo {
 // テストデータクリア
 func clearTestData(client *ent.Client) error {
 	ctx := context.Background()
+	if _, err := client.RevokedToken.Delete().Exec(ctx); err != nil {
+		return err
+	}
+	if _, err := client.SecurityEvent.Delete().Exec(ctx); err != nil {
+		return err
+	}
+	if _, err := client.RotatedRefreshToken.Delete().Exec(ctx); err != nil {
+		return err
+	}
+	if _, err := client.Session.Delete().Exec(ctx); err != nil {
+		return err
+	}
 	_, err := client.User.Delete().Exec(ctx)
 	return err
 }
@@ -145,23 +158,29 @@ func TestSecurityImprovements(t *testing.T) {
 		server.ServeHTTP(rec, req)
 		assert.Equal(t, http.StatusOK, rec.Code)
 
-		// DBからリフレッシュトークンハッシュを確認
+		// DBからログインしたセッションのリフレッシュトークンハッシュを確認
 		ctx := context.Background()
-		user, err := client.User.Query().
-			Where(user.EmailEQ("test@example.com")).
-			Only(ctx)
+		loginSession, err := client.Session.Query().
+			Where(session.HasUserWith(user.EmailEQ("test@example.com"))).
+			Order(ent.Desc(session.FieldCreatedAt)).
+			First(ctx)
 		require.NoError(t, err)
 
 		// リフレッシュトークンハッシュがbytes型であることを確認
-		assert.NotNil(t, user.RefreshTokenHash)
-		assert.IsType(t, &[]byte{}, user.RefreshTokenHash)
+		assert.IsType(t, []byte{}, loginSession.RefreshTokenHash)
 
 		// ハッシュが存在し、十分な長さを持つことを確認
-		assert.Equal(t, 32, len(*user.RefreshTokenHash)) // SHA256ハッシュは32バイト
-		
+		assert.Equal(t, 32, len(loginSession.RefreshTokenHash)) // SHA256ハッシュは32バイト
+
 		// 有効期限が設定されていることを確認
-		assert.NotNil(t, user.RefreshTokenExpiresAt)
-		assert.True(t, user.RefreshTokenExpiresAt.After(time.Now()))
+		assert.True(t, loginSession.ExpiresAt.After(time.Now()))
+
+		// 登録時のセッションも残っている（ログインで他の端末のセッションを上書きしない）
+		count, err := client.Session.Query().
+			Where(session.UserID(loginSession.UserID)).
+			Count(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 2, count)
 	})
 
 	t.Run("危険なPredicate関数が使用できないことを確認", func(t *testing.T) {
@@ -184,11 +203,15 @@ func TestSecurityImprovements(t *testing.T) {
 		assert.Equal(t, testUser.ID, foundUser.ID)
 
 		// RefreshTokenHashEQ（完全一致）のみが利用可能であることを確認
-		foundUser2, err := client.User.Query().
-			Where(user.RefreshTokenHashEQ(*testUser.RefreshTokenHash)).
+		testSession, err := client.Session.Query().
+			Where(session.UserID(testUser.ID)).
+			First(ctx)
+		require.NoError(t, err)
+		foundSession, err := client.Session.Query().
+			Where(session.RefreshTokenHashEQ(testSession.RefreshTokenHash)).
 			Only(ctx)
 		require.NoError(t, err)
-		assert.Equal(t, testUser.ID, foundUser2.ID)
+		assert.Equal(t, testUser.ID, foundSession.UserID)
 	})
 
 	t.Run("BCryptパスワード検証の動作確認", func(t *testing.T) {
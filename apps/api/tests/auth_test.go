@@ -0,0 +1,760 @@
+package tests
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	"github.com/google/uuid"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/hook"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/rotatedrefreshtoken"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/securityevent"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/session"
+	"github.com/hideaki1979/cc-chat-app/apps/api/internal/auth"
+	"github.com/hideaki1979/cc-chat-app/apps/api/internal/handlers"
+	"github.com/hideaki1979/cc-chat-app/apps/api/internal/middleware"
+	"github.com/hideaki1979/cc-chat-app/apps/api/internal/models"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMultiDeviceSessions(t *testing.T) {
+	t.Setenv("JWT_SECRET", "test-secret-key-for-session-tests-0123456789")
+
+	client := setupTestDB(t)
+	ctx := context.Background()
+
+	e := echo.New()
+	e.Validator = middleware.NewValidator()
+
+	authHandler := handlers.NewAuthHandler(nil, nil)
+
+	// リクエストを実行し、レスポンスとSet-Cookieのリフレッシュトークンを返す
+	call := func(handler echo.HandlerFunc, body string, refreshToken string) (*httptest.ResponseRecorder, string) {
+		request := httptest.NewRequest(http.MethodPost, "/", bytes.NewBufferString(body))
+		request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		request.Header.Set("User-Agent", "session-test")
+		if refreshToken != "" {
+			request.AddCookie(&http.Cookie{Name: "refresh_token", Value: refreshToken})
+		}
+		recorder := httptest.NewRecorder()
+		c := e.NewContext(request, recorder)
+		c.Set("db", client)
+		require.NoError(t, handler(c))
+
+		for _, cookie := range recorder.Result().Cookies() {
+			if cookie.Name == "refresh_token" {
+				return recorder, cookie.Value
+			}
+		}
+		return recorder, ""
+	}
+
+	recorder, laptopToken := call(authHandler.Register, `{"name":"Session User","email":"session_user@example.com","password":"Password123","device_label":"Laptop"}`, "")
+	require.Equal(t, http.StatusCreated, recorder.Code)
+	var registered models.AuthResponse
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &registered))
+
+	recorder, phoneToken := call(authHandler.Login, `{"email":"session_user@example.com","password":"Password123","device_label":"Phone"}`, "")
+	require.Equal(t, http.StatusOK, recorder.Code)
+
+	sessions := func() []*ent.Session {
+		return client.Session.Query().
+			Order(ent.Asc(session.FieldCreatedAt), ent.Asc(session.FieldDeviceLabel)).
+			AllX(ctx)
+	}
+
+	t.Run("LoginKeepsOtherDevices", func(t *testing.T) {
+		list := sessions()
+		require.Len(t, list, 2)
+		labels := []string{*list[0].DeviceLabel, *list[1].DeviceLabel}
+		assert.ElementsMatch(t, []string{"Laptop", "Phone"}, labels)
+		for _, s := range list {
+			assert.Equal(t, registered.User.ID, s.UserID.String())
+			require.NotNil(t, s.UserAgent)
+			assert.Equal(t, "session-test", *s.UserAgent)
+		}
+	})
+
+	t.Run("RefreshRotatesOnlyItsSession", func(t *testing.T) {
+		recorder, newLaptopToken := call(authHandler.RefreshToken, "", laptopToken)
+		require.Equal(t, http.StatusOK, recorder.Code)
+		require.NotEmpty(t, newLaptopToken)
+		assert.NotEqual(t, laptopToken, newLaptopToken)
+
+		// 他の端末のトークンは引き続き使用できる
+		recorder, newPhoneToken := call(authHandler.RefreshToken, "", phoneToken)
+		require.Equal(t, http.StatusOK, recorder.Code)
+		phoneToken = newPhoneToken
+		laptopToken = newLaptopToken
+		assert.Len(t, sessions(), 2)
+	})
+
+	t.Run("LogoutEndsOnlyItsSession", func(t *testing.T) {
+		recorder, _ := call(authHandler.Logout, "", phoneToken)
+		require.Equal(t, http.StatusOK, recorder.Code)
+
+		list := sessions()
+		require.Len(t, list, 1)
+		assert.Equal(t, "Laptop", *list[0].DeviceLabel)
+
+		recorder, _ = call(authHandler.RefreshToken, "", phoneToken)
+		assert.Equal(t, http.StatusUnauthorized, recorder.Code)
+		recorder, _ = call(authHandler.RefreshToken, "", laptopToken)
+		assert.Equal(t, http.StatusOK, recorder.Code)
+	})
+}
+
+func TestSessionManagement(t *testing.T) {
+	t.Setenv("JWT_SECRET", "test-secret-key-for-session-tests-0123456789")
+
+	client := setupTestDB(t)
+
+	e := echo.New()
+	e.Validator = middleware.NewValidator()
+	e.Use(func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			c.Set("db", client)
+			return next(c)
+		}
+	})
+
+	authHandler := handlers.NewAuthHandler(nil, nil)
+	e.POST("/auth/register", authHandler.Register)
+	e.POST("/auth/login", authHandler.Login)
+	e.POST("/auth/refresh", authHandler.RefreshToken)
+	api := e.Group("/api", middleware.JWTAuth())
+	api.GET("/profile", authHandler.Profile)
+	api.GET("/sessions", authHandler.ListSessions)
+	api.DELETE("/sessions/:id", authHandler.RevokeSession)
+	api.POST("/sessions/revoke-others", authHandler.RevokeOtherSessions)
+
+	do := func(method, path, body, token, refreshToken string) *httptest.ResponseRecorder {
+		request := httptest.NewRequest(method, path, bytes.NewBufferString(body))
+		request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		if token != "" {
+			request.Header.Set("Authorization", "Bearer "+token)
+		}
+		if refreshToken != "" {
+			request.AddCookie(&http.Cookie{Name: "refresh_token", Value: refreshToken})
+		}
+		recorder := httptest.NewRecorder()
+		e.ServeHTTP(recorder, request)
+		return recorder
+	}
+
+	type device struct {
+		token        string
+		refreshToken string
+	}
+	signIn := func(path, body string, status int) device {
+		recorder := do(http.MethodPost, path, body, "", "")
+		require.Equal(t, status, recorder.Code, recorder.Body.String())
+		var response models.AuthResponse
+		require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+		d := device{token: response.Token}
+		for _, cookie := range recorder.Result().Cookies() {
+			if cookie.Name == "refresh_token" {
+				d.refreshToken = cookie.Value
+			}
+		}
+		return d
+	}
+	listSessions := func(token string) []models.SessionInfo {
+		recorder := do(http.MethodGet, "/api/sessions", "", token, "")
+		require.Equal(t, http.StatusOK, recorder.Code, recorder.Body.String())
+		var response models.SessionListResponse
+		require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+		return response.Sessions
+	}
+
+	laptop := signIn("/auth/register", `{"name":"Device User","email":"device_user@example.com","password":"Password123","device_label":"Laptop"}`, http.StatusCreated)
+	phone := signIn("/auth/login", `{"email":"device_user@example.com","password":"Password123","device_label":"Phone"}`, http.StatusOK)
+	tablet := signIn("/auth/login", `{"email":"device_user@example.com","password":"Password123","device_label":"Tablet"}`, http.StatusOK)
+
+	other := signIn("/auth/register", `{"name":"Other User","email":"other_device_user@example.com","password":"Password123"}`, http.StatusCreated)
+
+	t.Run("ListFlagsCurrentSession", func(t *testing.T) {
+		sessions := listSessions(phone.token)
+		require.Len(t, sessions, 3)
+		for _, s := range sessions {
+			require.NotNil(t, s.DeviceLabel)
+			assert.Equal(t, *s.DeviceLabel == "Phone", s.Current, *s.DeviceLabel)
+		}
+	})
+
+	t.Run("CannotRevokeOtherUsersSession", func(t *testing.T) {
+		otherSessions := listSessions(other.token)
+		require.Len(t, otherSessions, 1)
+
+		recorder := do(http.MethodDelete, "/api/sessions/"+otherSessions[0].ID, "", laptop.token, "")
+		assert.Equal(t, http.StatusNotFound, recorder.Code)
+		assert.Len(t, listSessions(other.token), 1)
+
+		recorder = do(http.MethodDelete, "/api/sessions/not-a-uuid", "", laptop.token, "")
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	})
+
+	t.Run("RevokeInvalidatesAccessAndRefreshTokens", func(t *testing.T) {
+		var tabletID string
+		for _, s := range listSessions(laptop.token) {
+			if *s.DeviceLabel == "Tablet" {
+				tabletID = s.ID
+			}
+		}
+		require.NotEmpty(t, tabletID)
+
+		recorder := do(http.MethodDelete, "/api/sessions/"+tabletID, "", laptop.token, "")
+		require.Equal(t, http.StatusNoContent, recorder.Code)
+
+		// 失効したセッションのアクセストークンは有効期限内でも拒否される
+		recorder = do(http.MethodGet, "/api/profile", "", tablet.token, "")
+		assert.Equal(t, http.StatusUnauthorized, recorder.Code)
+		assert.Contains(t, recorder.Body.String(), "SESSION_REVOKED")
+
+		recorder = do(http.MethodPost, "/auth/refresh", "", "", tablet.refreshToken)
+		assert.Equal(t, http.StatusUnauthorized, recorder.Code)
+
+		recorder = do(http.MethodGet, "/api/profile", "", phone.token, "")
+		assert.Equal(t, http.StatusOK, recorder.Code)
+	})
+
+	t.Run("RevokeOtherSessionsKeepsCurrent", func(t *testing.T) {
+		recorder := do(http.MethodPost, "/api/sessions/revoke-others", "", laptop.token, "")
+		require.Equal(t, http.StatusOK, recorder.Code)
+		assert.JSONEq(t, `{"revoked":1}`, recorder.Body.String())
+
+		sessions := listSessions(laptop.token)
+		require.Len(t, sessions, 1)
+		assert.True(t, sessions[0].Current)
+		assert.Equal(t, "Laptop", *sessions[0].DeviceLabel)
+
+		recorder = do(http.MethodGet, "/api/profile", "", phone.token, "")
+		assert.Equal(t, http.StatusUnauthorized, recorder.Code)
+
+		// 他のユーザーのセッションは影響を受けない
+		assert.Len(t, listSessions(other.token), 1)
+	})
+
+	t.Run("RefreshedTokenKeepsSession", func(t *testing.T) {
+		recorder := do(http.MethodPost, "/auth/refresh", "", "", laptop.refreshToken)
+		require.Equal(t, http.StatusOK, recorder.Code)
+		var response models.RefreshTokenResponse
+		require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+
+		sessions := listSessions(response.Token)
+		require.Len(t, sessions, 1)
+		assert.True(t, sessions[0].Current)
+	})
+
+	t.Run("MultibyteDeviceLabel", func(t *testing.T) {
+		// 端末名の上限はバイト数ではなく文字数で判定する
+		label := strings.Repeat("端", 100)
+		d := signIn("/auth/login", `{"email":"other_device_user@example.com","password":"Password123","device_label":"`+label+`"}`, http.StatusOK)
+		var found bool
+		for _, s := range listSessions(d.token) {
+			if s.Current {
+				require.NotNil(t, s.DeviceLabel)
+				assert.Equal(t, label, *s.DeviceLabel)
+				found = true
+			}
+		}
+		assert.True(t, found)
+
+		recorder := do(http.MethodPost, "/auth/login", `{"email":"other_device_user@example.com","password":"Password123","device_label":"`+label+`末"}`, "", "")
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	})
+}
+
+func TestRefreshTokenReuseDetection(t *testing.T) {
+	t.Setenv("JWT_SECRET", "test-secret-key-for-session-tests-0123456789")
+
+	client := setupTestDB(t)
+	ctx := context.Background()
+
+	e := echo.New()
+	e.Validator = middleware.NewValidator()
+	e.Use(func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			c.Set("db", client)
+			return next(c)
+		}
+	})
+
+	authHandler := handlers.NewAuthHandler(nil, nil)
+	e.POST("/auth/login", authHandler.Login)
+	e.POST("/auth/register", authHandler.Register)
+	e.POST("/auth/refresh", authHandler.RefreshToken)
+	e.GET("/api/profile", authHandler.Profile, middleware.JWTAuth())
+
+	// リクエストを実行し、レスポンスとSet-Cookieのリフレッシュトークンを返す
+	do := func(method, path, body, token, refreshToken string) (*httptest.ResponseRecorder, string) {
+		request := httptest.NewRequest(method, path, bytes.NewBufferString(body))
+		request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		if token != "" {
+			request.Header.Set("Authorization", "Bearer "+token)
+		}
+		if refreshToken != "" {
+			request.AddCookie(&http.Cookie{Name: "refresh_token", Value: refreshToken})
+		}
+		recorder := httptest.NewRecorder()
+		e.ServeHTTP(recorder, request)
+		for _, cookie := range recorder.Result().Cookies() {
+			if cookie.Name == "refresh_token" {
+				return recorder, cookie.Value
+			}
+		}
+		return recorder, ""
+	}
+	accessToken := func(recorder *httptest.ResponseRecorder) string {
+		var response models.RefreshTokenResponse
+		require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+		return response.Token
+	}
+
+	recorder, laptopToken := do(http.MethodPost, "/auth/register", `{"name":"Reuse User","email":"reuse_user@example.com","password":"Password123","device_label":"Laptop"}`, "", "")
+	require.Equal(t, http.StatusCreated, recorder.Code)
+	var registered models.AuthResponse
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &registered))
+
+	recorder, phoneToken := do(http.MethodPost, "/auth/login", `{"email":"reuse_user@example.com","password":"Password123","device_label":"Phone"}`, "", "")
+	require.Equal(t, http.StatusOK, recorder.Code)
+
+	laptopSession := client.Session.Query().Where(session.DeviceLabel("Laptop")).OnlyX(ctx)
+
+	// 正規のローテーションを2回行う（使用済みのトークンはファミリーに記録される）
+	stolenToken := laptopToken
+	recorder, laptopToken = do(http.MethodPost, "/auth/refresh", "", "", laptopToken)
+	require.Equal(t, http.StatusOK, recorder.Code)
+	recorder, laptopToken = do(http.MethodPost, "/auth/refresh", "", "", laptopToken)
+	require.Equal(t, http.StatusOK, recorder.Code)
+	laptopAccessToken := accessToken(recorder)
+
+	assert.Equal(t, 2, client.RotatedRefreshToken.Query().
+		Where(rotatedrefreshtoken.SessionID(laptopSession.ID)).
+		CountX(ctx))
+	assert.Zero(t, client.SecurityEvent.Query().CountX(ctx))
+
+	t.Run("ReuseRevokesWholeFamily", func(t *testing.T) {
+		recorder, cleared := do(http.MethodPost, "/auth/refresh", "", "", stolenToken)
+		assert.Equal(t, http.StatusUnauthorized, recorder.Code)
+		assert.Contains(t, recorder.Body.String(), "REFRESH_TOKEN_REUSED")
+		assert.Empty(t, cleared)
+
+		// ファミリーの最新のトークンとアクセストークンも無効になる
+		recorder, _ = do(http.MethodPost, "/auth/refresh", "", "", laptopToken)
+		assert.Equal(t, http.StatusUnauthorized, recorder.Code)
+		recorder, _ = do(http.MethodGet, "/api/profile", "", laptopAccessToken, "")
+		assert.Equal(t, http.StatusUnauthorized, recorder.Code)
+
+		assert.False(t, client.Session.Query().Where(session.ID(laptopSession.ID)).ExistX(ctx))
+		assert.Zero(t, client.RotatedRefreshToken.Query().
+			Where(rotatedrefreshtoken.SessionID(laptopSession.ID)).
+			CountX(ctx))
+	})
+
+	t.Run("RecordsSecurityEvent", func(t *testing.T) {
+		events := client.SecurityEvent.Query().AllX(ctx)
+		require.Len(t, events, 1)
+		assert.Equal(t, securityevent.TypeRefreshTokenReuse, events[0].Type)
+		assert.Equal(t, registered.User.ID, events[0].UserID.String())
+		require.NotNil(t, events[0].SessionID)
+		assert.Equal(t, laptopSession.ID, *events[0].SessionID)
+	})
+
+	t.Run("OtherFamiliesUnaffected", func(t *testing.T) {
+		recorder, _ := do(http.MethodPost, "/auth/refresh", "", "", phoneToken)
+		assert.Equal(t, http.StatusOK, recorder.Code)
+	})
+
+	t.Run("UnknownTokenIsNotReuse", func(t *testing.T) {
+		recorder, _ := do(http.MethodPost, "/auth/refresh", "", "", "unknown-refresh-token")
+		assert.Equal(t, http.StatusUnauthorized, recorder.Code)
+		assert.Contains(t, recorder.Body.String(), "INVALID_REFRESH_TOKEN")
+		assert.Equal(t, 1, client.SecurityEvent.Query().CountX(ctx))
+	})
+
+	t.Run("PrunesExpiredRotations", func(t *testing.T) {
+		recorder, desktopToken := do(http.MethodPost, "/auth/login", `{"email":"reuse_user@example.com","password":"Password123","device_label":"Desktop"}`, "", "")
+		require.Equal(t, http.StatusOK, recorder.Code)
+		desktopSession := client.Session.Query().Where(session.DeviceLabel("Desktop")).OnlyX(ctx)
+
+		// リフレッシュトークンの有効期間より前にローテーションされた記録
+		expired := client.RotatedRefreshToken.Create().
+			SetSessionID(desktopSession.ID).
+			SetTokenHash(auth.HashRefreshToken("expired-rotated-token")).
+			SetRotatedAt(time.Now().Add(-auth.RefreshTokenTTL - time.Hour)).
+			SaveX(ctx)
+
+		recorder, _ = do(http.MethodPost, "/auth/refresh", "", "", desktopToken)
+		require.Equal(t, http.StatusOK, recorder.Code)
+
+		// ローテーション時に期限を過ぎた記録は削除され、今回の記録のみ残る
+		rotated := client.RotatedRefreshToken.Query().
+			Where(rotatedrefreshtoken.SessionID(desktopSession.ID)).
+			AllX(ctx)
+		require.Len(t, rotated, 1)
+		assert.NotEqual(t, expired.ID, rotated[0].ID)
+	})
+
+	t.Run("ConcurrentRotationIsNotReuse", func(t *testing.T) {
+		recorder, tabletToken := do(http.MethodPost, "/auth/login", `{"email":"reuse_user@example.com","password":"Password123","device_label":"Tablet"}`, "", "")
+		require.Equal(t, http.StatusOK, recorder.Code)
+		tabletSession := client.Session.Query().Where(session.DeviceLabel("Tablet")).OnlyX(ctx)
+
+		// 同じトークンによる別のリクエストが、検索後・更新前にローテーションを完了した状態を再現する
+		winnerHash := auth.HashRefreshToken("concurrent-winner-token")
+		var simulateRace atomic.Bool
+		simulateRace.Store(true)
+		client.Session.Use(func(next ent.Mutator) ent.Mutator {
+			return hook.SessionFunc(func(ctx context.Context, m *ent.SessionMutation) (ent.Value, error) {
+				if m.Op().Is(ent.OpUpdate) && simulateRace.CompareAndSwap(true, false) {
+					if err := m.Client().Session.UpdateOneID(tabletSession.ID).
+						SetRefreshTokenHash(winnerHash).
+						SetExpiresAt(auth.GetRefreshTokenExpiry()).
+						Exec(ctx); err != nil {
+						return nil, err
+					}
+				}
+				return next.Mutate(ctx, m)
+			})
+		})
+
+		recorder, cleared := do(http.MethodPost, "/auth/refresh", "", "", tabletToken)
+		assert.Equal(t, http.StatusUnauthorized, recorder.Code)
+		assert.Contains(t, recorder.Body.String(), "INVALID_REFRESH_TOKEN")
+		assert.Empty(t, cleared)
+
+		// 競合に負けただけのため、先にローテーションした側のセッションは失効させない
+		assert.True(t, client.Session.Query().Where(session.ID(tabletSession.ID)).ExistX(ctx))
+		assert.Equal(t, 1, client.SecurityEvent.Query().CountX(ctx))
+	})
+}
+
+func TestAccessTokenRevocation(t *testing.T) {
+	t.Setenv("JWT_SECRET", "test-secret-key-for-session-tests-0123456789")
+
+	client := setupTestDB(t)
+	ctx := context.Background()
+
+	revocations := auth.NewDBRevocationStore(client, auth.DefaultRevocationCacheTTL)
+
+	e := echo.New()
+	e.Validator = middleware.NewValidator()
+	e.Use(func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			c.Set("db", client)
+			return next(c)
+		}
+	})
+
+	authHandler := handlers.NewAuthHandler(nil, revocations)
+	e.POST("/auth/register", authHandler.Register)
+	e.POST("/auth/login", authHandler.Login)
+	e.POST("/auth/logout", authHandler.Logout)
+	e.POST("/auth/refresh", authHandler.RefreshToken)
+	e.GET("/api/profile", authHandler.Profile, middleware.JWTAuth(middleware.WithRevocationStore(revocations)))
+	e.POST("/api/sessions/revoke-all", authHandler.RevokeAllSessions, middleware.JWTAuth(middleware.WithRevocationStore(revocations)))
+
+	do := func(method, path, body, token string) *httptest.ResponseRecorder {
+		request := httptest.NewRequest(method, path, bytes.NewBufferString(body))
+		request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		if token != "" {
+			request.Header.Set("Authorization", "Bearer "+token)
+		}
+		recorder := httptest.NewRecorder()
+		e.ServeHTTP(recorder, request)
+		return recorder
+	}
+	// loginWithRefresh ログインしてアクセストークンとリフレッシュトークンを取得
+	loginWithRefresh := func(body string) (string, string) {
+		recorder := do(http.MethodPost, "/auth/login", body, "")
+		require.Equal(t, http.StatusOK, recorder.Code, recorder.Body.String())
+		var response models.AuthResponse
+		require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+		for _, cookie := range recorder.Result().Cookies() {
+			if cookie.Name == "refresh_token" {
+				return response.Token, cookie.Value
+			}
+		}
+		t.Fatal("refresh_token cookie not set")
+		return "", ""
+	}
+	refresh := func(refreshToken string) *httptest.ResponseRecorder {
+		request := httptest.NewRequest(http.MethodPost, "/auth/refresh", nil)
+		request.AddCookie(&http.Cookie{Name: "refresh_token", Value: refreshToken})
+		recorder := httptest.NewRecorder()
+		e.ServeHTTP(recorder, request)
+		return recorder
+	}
+	signIn := func(path, body string, status int) models.AuthResponse {
+		recorder := do(http.MethodPost, path, body, "")
+		require.Equal(t, status, recorder.Code, recorder.Body.String())
+		var response models.AuthResponse
+		require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+		return response
+	}
+	const loginBody = `{"email":"revoke_user@example.com","password":"Password123"}`
+
+	registered := signIn("/auth/register", `{"name":"Revoke User","email":"revoke_user@example.com","password":"Password123"}`, http.StatusCreated)
+	userUUID := uuid.MustParse(registered.User.ID)
+
+	t.Run("TokensCarryIDAndVersion", func(t *testing.T) {
+		claims, err := auth.ValidateJWT(registered.Token)
+		require.NoError(t, err)
+		assert.NotEmpty(t, claims.ID)
+		assert.Zero(t, claims.TokenVersion)
+
+		other := signIn("/auth/login", loginBody, http.StatusOK)
+		otherClaims, err := auth.ValidateJWT(other.Token)
+		require.NoError(t, err)
+		assert.NotEqual(t, claims.ID, otherClaims.ID)
+	})
+
+	t.Run("LogoutRevokesAccessToken", func(t *testing.T) {
+		current := signIn("/auth/login", loginBody, http.StatusOK)
+		recorder := do(http.MethodGet, "/api/profile", "", current.Token)
+		require.Equal(t, http.StatusOK, recorder.Code)
+
+		recorder = do(http.MethodPost, "/auth/logout", "", current.Token)
+		require.Equal(t, http.StatusOK, recorder.Code)
+
+		recorder = do(http.MethodGet, "/api/profile", "", current.Token)
+		assert.Equal(t, http.StatusUnauthorized, recorder.Code)
+		assert.Contains(t, recorder.Body.String(), "TOKEN_REVOKED")
+
+		// キャッシュを持たない別インスタンスからも失効が確認できる
+		claims, err := auth.ValidateJWT(current.Token)
+		require.NoError(t, err)
+		revoked, err := auth.NewDBRevocationStore(client, 0).IsRevoked(ctx, claims)
+		require.NoError(t, err)
+		assert.True(t, revoked)
+
+		// 他のトークンは影響を受けない
+		recorder = do(http.MethodGet, "/api/profile", "", registered.Token)
+		assert.Equal(t, http.StatusOK, recorder.Code)
+	})
+
+	t.Run("RevokeUserInvalidatesAllTokens", func(t *testing.T) {
+		laptop := signIn("/auth/login", loginBody, http.StatusOK)
+		phoneToken, phoneRefresh := loginWithRefresh(loginBody)
+
+		require.NoError(t, revocations.RevokeUser(ctx, userUUID))
+
+		for _, token := range []string{registered.Token, laptop.Token, phoneToken} {
+			recorder := do(http.MethodGet, "/api/profile", "", token)
+			assert.Equal(t, http.StatusUnauthorized, recorder.Code)
+			assert.Contains(t, recorder.Body.String(), "TOKEN_REVOKED")
+		}
+
+		// セッションも削除され、リフレッシュで新しいトークンを発行できない
+		recorder := refresh(phoneRefresh)
+		assert.Equal(t, http.StatusUnauthorized, recorder.Code, recorder.Body.String())
+		assert.Zero(t, client.Session.Query().Where(session.UserID(userUUID)).CountX(ctx))
+
+		// 再ログイン後のトークンは新しいバージョンで発行される
+		relogin := signIn("/auth/login", loginBody, http.StatusOK)
+		claims, err := auth.ValidateJWT(relogin.Token)
+		require.NoError(t, err)
+		assert.Equal(t, 1, claims.TokenVersion)
+
+		recorder = do(http.MethodGet, "/api/profile", "", relogin.Token)
+		assert.Equal(t, http.StatusOK, recorder.Code)
+	})
+
+	t.Run("RevokeAllSessionsEndpoint", func(t *testing.T) {
+		laptopToken, laptopRefresh := loginWithRefresh(loginBody)
+		phoneToken, phoneRefresh := loginWithRefresh(loginBody)
+
+		recorder := do(http.MethodPost, "/api/sessions/revoke-all", "", laptopToken)
+		require.Equal(t, http.StatusNoContent, recorder.Code, recorder.Body.String())
+
+		for _, token := range []string{laptopToken, phoneToken} {
+			recorder := do(http.MethodGet, "/api/profile", "", token)
+			assert.Equal(t, http.StatusUnauthorized, recorder.Code)
+		}
+		for _, refreshToken := range []string{laptopRefresh, phoneRefresh} {
+			recorder := refresh(refreshToken)
+			assert.Equal(t, http.StatusUnauthorized, recorder.Code, recorder.Body.String())
+		}
+	})
+}
+
+func TestAsymmetricSigningKeys(t *testing.T) {
+	const legacySecret = "test-secret-key-for-session-tests-0123456789"
+	t.Cleanup(func() { auth.SetKeySet(nil) })
+
+	dir := t.TempDir()
+	writeKey := func(kid string, key any) {
+		der, err := x509.MarshalPKCS8PrivateKey(key)
+		require.NoError(t, err)
+		data := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})
+		require.NoError(t, os.WriteFile(filepath.Join(dir, kid+".pem"), data, 0o600))
+	}
+	loadKeys := func(signingKID string) {
+		t.Setenv("JWT_SIGNING_KEY_ID", signingKID)
+		keys, err := auth.LoadKeySetFromEnv()
+		require.NoError(t, err)
+		auth.SetKeySet(keys)
+	}
+	kidOf := func(tokenString string) (string, string) {
+		token, _, err := jwt.NewParser().ParseUnverified(tokenString, &auth.Claims{})
+		require.NoError(t, err)
+		kid, _ := token.Header["kid"].(string)
+		return kid, token.Method.Alg()
+	}
+
+	// 非対称鍵への移行前にHS256で発行されたトークン
+	t.Setenv("JWT_SECRET", legacySecret)
+	t.Setenv("JWT_KEYS_DIR", "")
+	legacyToken, err := auth.GenerateJWT(uuid.NewString(), "legacy@example.com", "", 0)
+	require.NoError(t, err)
+
+	edPublic, edPrivate, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	writeKey("2026-09", edPrivate)
+	rsaPrivate, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	writeKey("2026-10", rsaPrivate)
+
+	t.Setenv("JWT_KEYS_DIR", dir)
+	loadKeys("2026-09")
+
+	oldToken, err := auth.GenerateJWT(uuid.NewString(), "old@example.com", "", 0)
+	require.NoError(t, err)
+
+	t.Run("SignsWithKeyID", func(t *testing.T) {
+		kid, alg := kidOf(oldToken)
+		assert.Equal(t, "2026-09", kid)
+		assert.Equal(t, "EdDSA", alg)
+
+		_, err := auth.ValidateJWT(oldToken)
+		assert.NoError(t, err)
+		// 移行前のHS256トークンも有効期限まで検証できる
+		_, err = auth.ValidateJWT(legacyToken)
+		assert.NoError(t, err)
+	})
+
+	t.Run("JWKSPublishesVerificationKeys", func(t *testing.T) {
+		keys, err := auth.LoadKeySetFromEnv()
+		require.NoError(t, err)
+
+		e := echo.New()
+		e.GET("/.well-known/jwks.json", handlers.NewJWKSHandler(keys).JWKS)
+		recorder := httptest.NewRecorder()
+		e.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/.well-known/jwks.json", nil))
+		require.Equal(t, http.StatusOK, recorder.Code)
+		assert.NotEmpty(t, recorder.Header().Get(echo.HeaderCacheControl))
+		assert.NotContains(t, recorder.Body.String(), legacySecret)
+
+		var jwks auth.JWKS
+		require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &jwks))
+		require.Len(t, jwks.Keys, 2)
+		assert.Equal(t, "2026-09", jwks.Keys[0].Kid)
+		assert.Equal(t, "OKP", jwks.Keys[0].Kty)
+		assert.Equal(t, "2026-10", jwks.Keys[1].Kid)
+		assert.Equal(t, "RSA", jwks.Keys[1].Kty)
+		assert.Equal(t, "RS256", jwks.Keys[1].Alg)
+
+		// 共有鍵を持たない他のサービスがJWKSの公開鍵だけで検証できる
+		x, err := base64.RawURLEncoding.DecodeString(jwks.Keys[0].X)
+		require.NoError(t, err)
+		assert.Equal(t, []byte(edPublic), x)
+		token, err := jwt.Parse(oldToken, func(token *jwt.Token) (interface{}, error) {
+			return ed25519.PublicKey(x), nil
+		}, jwt.WithValidMethods([]string{"EdDSA"}))
+		require.NoError(t, err)
+		assert.True(t, token.Valid)
+	})
+
+	t.Run("RotationKeepsIssuedTokensValid", func(t *testing.T) {
+		loadKeys("2026-10")
+
+		newToken, err := auth.GenerateJWT(uuid.NewString(), "new@example.com", "", 0)
+		require.NoError(t, err)
+		kid, alg := kidOf(newToken)
+		assert.Equal(t, "2026-10", kid)
+		assert.Equal(t, "RS256", alg)
+
+		_, err = auth.ValidateJWT(newToken)
+		assert.NoError(t, err)
+		_, err = auth.ValidateJWT(oldToken)
+		assert.NoError(t, err)
+
+		// 古い鍵を公開鍵のみに置き換えても検証は継続できる
+		der, err := x509.MarshalPKIXPublicKey(edPublic)
+		require.NoError(t, err)
+		data := pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "2026-09.pem"), data, 0o600))
+		loadKeys("2026-10")
+		_, err = auth.ValidateJWT(oldToken)
+		assert.NoError(t, err)
+
+		_, err = auth.LoadKeySetFromDir(dir, "2026-09")
+		assert.Error(t, err, "公開鍵のみの鍵では署名できない")
+
+		// 古い鍵を削除すると、その鍵で署名されたトークンは無効になる
+		require.NoError(t, os.Remove(filepath.Join(dir, "2026-09.pem")))
+		loadKeys("")
+		_, err = auth.ValidateJWT(oldToken)
+		assert.Error(t, err)
+		_, err = auth.ValidateJWT(newToken)
+		assert.NoError(t, err)
+	})
+
+	t.Run("RejectsAlgorithmMismatch", func(t *testing.T) {
+		// RSA鍵のkidを指定したHS256トークン（アルゴリズム混同攻撃）は拒否する
+		claims := &auth.Claims{
+			UserID: uuid.NewString(),
+			RegisteredClaims: jwt.RegisteredClaims{
+				ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+				Issuer:    "chat-app",
+			},
+		}
+		token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+		token.Header["kid"] = "2026-10"
+		forged, err := token.SignedString([]byte(legacySecret))
+		require.NoError(t, err)
+		_, err = auth.ValidateJWT(forged)
+		assert.Error(t, err)
+	})
+
+	t.Run("MissingConfigurationReturnsError", func(t *testing.T) {
+		t.Setenv("JWT_KEYS_DIR", "")
+		t.Setenv("JWT_SECRET", "")
+		_, err := auth.LoadKeySetFromEnv()
+		assert.Error(t, err)
+
+		t.Setenv("JWT_SECRET", "too-short")
+		_, err = auth.LoadKeySetFromEnv()
+		assert.Error(t, err)
+
+		t.Setenv("JWT_KEYS_DIR", t.TempDir())
+		t.Setenv("JWT_SECRET", "")
+		_, err = auth.LoadKeySetFromEnv()
+		assert.Error(t, err)
+
+		// 鍵が読み込めない場合もパニックせずにエラーを返す
+		auth.SetKeySet(nil)
+		_, err = auth.GenerateJWT(uuid.NewString(), "missing@example.com", "", 0)
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,104 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent"
+	"github.com/hideaki1979/cc-chat-app/apps/api/ent/roommember"
+	"github.com/hideaki1979/cc-chat-app/apps/api/internal/migration"
+	"github.com/hideaki1979/cc-chat-app/apps/api/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBackfill(t *testing.T) {
+	client := setupTestDB(t)
+	ctx := context.Background()
+
+	first := createTestUser(t, client, "Backfill First", "backfill_first@example.com")
+	second := createTestUser(t, client, "Backfill Second", "backfill_second@example.com")
+
+	// role追加前に作成されたルーム（マイグレーション後は全員がmemberになる）
+	base := time.Now().Add(-time.Hour)
+	legacyRoom := client.ChatRoom.Create().
+		SetName("Legacy Room").
+		SetIsGroupChat(true).
+		SaveX(ctx)
+	for i, u := range []*ent.User{second, first} {
+		client.RoomMember.Create().
+			SetRoomID(legacyRoom.ID).
+			SetUserID(u.ID).
+			SetJoinedAt(base.Add(time.Duration(1-i) * time.Minute)).
+			ExecX(ctx)
+	}
+
+	// DMはオーナーを持たない
+	dmRoom := client.ChatRoom.Create().
+		SetName("Direct Message").
+		SetDmKey(models.DirectMessageKey(first.ID, second.ID)).
+		SaveX(ctx)
+	for _, u := range []*ent.User{first, second} {
+		client.RoomMember.Create().
+			SetRoomID(dmRoom.ID).
+			SetUserID(u.ID).
+			ExecX(ctx)
+	}
+
+	// 最新メッセージのカラム追加前に送信されたメッセージ（削除済みのメッセージとスレッドの返信は対象外）
+	messages := make([]*ent.Message, 4)
+	for i := range messages {
+		messages[i] = client.Message.Create().
+			SetRoomID(legacyRoom.ID).
+			SetUserID(first.ID).
+			SetContent(fmt.Sprintf("legacy message %d", i)).
+			SetCreatedAt(base.Add(time.Duration(i) * time.Minute)).
+			SaveX(ctx)
+	}
+	client.Message.UpdateOne(messages[2]).SetDeletedAt(time.Now()).ExecX(ctx)
+	messages[3] = client.Message.UpdateOne(messages[3]).SetParentID(messages[0].ID).SaveX(ctx)
+	client.ChatRoom.UpdateOne(legacyRoom).ClearLastMessageAt().ClearLastMessageID().ExecX(ctx)
+
+	require.NoError(t, migration.Backfill(ctx, client))
+
+	t.Run("RoomOwners", func(t *testing.T) {
+		owners := client.RoomMember.Query().
+			Where(roommember.RoleEQ(roommember.RoleOwner)).
+			AllX(ctx)
+		require.Len(t, owners, 1)
+		assert.Equal(t, legacyRoom.ID, owners[0].RoomID)
+		assert.Equal(t, first.ID, owners[0].UserID) // 最も早く参加したメンバー
+
+		// 既にオーナーがいるルームは変更しない
+		require.NoError(t, migration.Backfill(ctx, client))
+		assert.Equal(t, 1, client.RoomMember.Query().
+			Where(roommember.RoleEQ(roommember.RoleOwner)).
+			CountX(ctx))
+	})
+
+	t.Run("LastMessages", func(t *testing.T) {
+		room := client.ChatRoom.GetX(ctx, legacyRoom.ID)
+		require.NotNil(t, room.LastMessageID)
+		assert.Equal(t, messages[1].ID, *room.LastMessageID)
+		require.NotNil(t, room.LastMessageAt)
+		assert.True(t, messages[1].CreatedAt.Equal(*room.LastMessageAt))
+
+		// メッセージのないルームは未設定のまま
+		room = client.ChatRoom.GetX(ctx, dmRoom.ID)
+		assert.Nil(t, room.LastMessageID)
+		assert.Nil(t, room.LastMessageAt)
+	})
+
+	t.Run("ReplyTargets", func(t *testing.T) {
+		// 返信先追加前のスレッドの返信は、スレッドの親を返信先とする
+		reply := client.Message.GetX(ctx, messages[3].ID)
+		require.NotNil(t, reply.ReplyToID)
+		assert.Equal(t, messages[0].ID, *reply.ReplyToID)
+		assert.True(t, messages[3].UpdatedAt.Equal(reply.UpdatedAt))
+
+		// スレッド外のメッセージは対象外
+		assert.Nil(t, client.Message.GetX(ctx, messages[1].ID).ReplyToID)
+	})
+}
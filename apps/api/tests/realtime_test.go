@@ -0,0 +1,576 @@
+package tests
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/websocket"
+	"github.com/hideaki1979/cc-chat-app/apps/api/internal/auth"
+	"github.com/hideaki1979/cc-chat-app/apps/api/internal/handlers"
+	"github.com/hideaki1979/cc-chat-app/apps/api/internal/middleware"
+	"github.com/hideaki1979/cc-chat-app/apps/api/internal/models"
+	"github.com/hideaki1979/cc-chat-app/apps/api/internal/realtime"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebSocketBroadcast(t *testing.T) {
+	t.Setenv("JWT_SECRET", "test-secret-key-for-websocket-tests-0123456789")
+
+	client := setupTestDB(t)
+	ctx := context.Background()
+
+	// テスト用ユーザー作成（memberはルームメンバー、outsiderは非メンバー）
+	member := createTestUser(t, client, "WS Member", "ws_member@example.com")
+
+	outsider := createTestUser(t, client, "WS Outsider", "ws_outsider@example.com")
+
+	peer := createTestUser(t, client, "WS Peer", "ws_peer@example.com")
+
+	chatRoom, err := client.ChatRoom.Create().
+		SetName("WS Room").
+		Save(ctx)
+	require.NoError(t, err)
+
+	for _, u := range []uuid.UUID{member.ID, peer.ID} {
+		_, err = client.RoomMember.Create().
+			SetRoomID(chatRoom.ID).
+			SetUserID(u).
+			Save(ctx)
+		require.NoError(t, err)
+	}
+
+	// イベントバスとHub起動
+	bus := realtime.NewLocalBus()
+	defer bus.Close()
+	realtime.RegisterHooks(client, bus)
+
+	hubCtx, stopHub := context.WithCancel(ctx)
+	defer stopHub()
+	hub := realtime.NewHub(client, bus)
+	go hub.Run(hubCtx)
+
+	// WebSocketサーバー起動
+	e := echo.New()
+	e.Validator = middleware.NewValidator()
+	webSocketHandler := handlers.NewWebSocketHandler(hub, nil)
+	e.GET("/ws", webSocketHandler.Connect, middleware.JWTAuth())
+	server := httptest.NewServer(e)
+	defer server.Close()
+
+	dial := func(t *testing.T, userID, email string) *websocket.Conn {
+		token, err := auth.GenerateJWT(userID, email, "", 0)
+		require.NoError(t, err)
+		url := "ws" + strings.TrimPrefix(server.URL, "http") + "/ws"
+		conn, _, err := websocket.DefaultDialer.Dial(url, http.Header{"Authorization": {"Bearer " + token}})
+		require.NoError(t, err)
+		return conn
+	}
+
+	t.Run("RejectsMissingToken", func(t *testing.T) {
+		url := "ws" + strings.TrimPrefix(server.URL, "http") + "/ws"
+		_, resp, err := websocket.DefaultDialer.Dial(url, nil)
+		assert.Error(t, err)
+		require.NotNil(t, resp)
+		assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	})
+
+	t.Run("DeliversOnlyToMembers", func(t *testing.T) {
+		memberConn := dial(t, member.ID.String(), member.Email)
+		defer memberConn.Close()
+		outsiderConn := dial(t, outsider.ID.String(), outsider.Email)
+		defer outsiderConn.Close()
+
+		// Hubへの登録完了を待つ
+		time.Sleep(100 * time.Millisecond)
+
+		messageHandler := handlers.NewMessageHandler(client, handlers.MessageEditTimeLimit)
+		reqBody, _ := json.Marshal(models.SendMessageRequest{Content: "realtime hello"})
+		request := httptest.NewRequest(http.MethodPost, "/api/chatrooms/"+chatRoom.ID.String()+"/messages", bytes.NewReader(reqBody))
+		request.Header.Set("Content-Type", "application/json")
+		recorder := httptest.NewRecorder()
+
+		c := e.NewContext(request, recorder)
+		c.SetParamNames("room_id")
+		c.SetParamValues(chatRoom.ID.String())
+		c.Set("user_id", member.ID.String())
+		c.Set("db", client)
+
+		require.NoError(t, messageHandler.SendMessage(c))
+		require.Equal(t, http.StatusCreated, recorder.Code)
+
+		// メンバーはイベントを受信する
+		event := readEvent(t, memberConn, realtime.EventMessageCreated)
+		assert.Equal(t, chatRoom.ID.String(), event.RoomID)
+		var data models.MessageResponse
+		require.NoError(t, json.Unmarshal(event.Data, &data))
+		assert.Equal(t, "realtime hello", data.Content)
+
+		// 非メンバーには配信されない
+		_ = outsiderConn.SetReadDeadline(time.Now().Add(300 * time.Millisecond))
+		_, _, err := outsiderConn.ReadMessage()
+		assert.Error(t, err)
+	})
+
+	t.Run("TypingAndPresence", func(t *testing.T) {
+		peerConn := dial(t, peer.ID.String(), peer.Email)
+		defer peerConn.Close()
+		time.Sleep(100 * time.Millisecond)
+
+		client.User.UpdateOneID(member.ID).ClearLastSeenAt().ExecX(ctx)
+		memberConn := dial(t, member.ID.String(), member.Email)
+
+		// 同じルームのメンバーにオンライン状態が配信される
+		readPresence(t, peerConn, member.ID, realtime.PresenceOnline)
+		assert.Equal(t, realtime.PresenceOnline, hub.PresenceOf(member.ID))
+		// オンラインの間は最終オンライン日時を更新しない
+		assert.Nil(t, client.User.GetX(ctx, member.ID).LastSeenAt)
+
+		// 入力中イベントは本人以外のメンバーへ配信される
+		require.NoError(t, memberConn.WriteJSON(map[string]string{
+			"type":    "typing_start",
+			"room_id": chatRoom.ID.String(),
+		}))
+		event := readEvent(t, peerConn, realtime.EventTyping)
+		assert.Equal(t, chatRoom.ID.String(), event.RoomID)
+		var typing map[string]interface{}
+		require.NoError(t, json.Unmarshal(event.Data, &typing))
+		assert.Equal(t, member.ID.String(), typing["user_id"])
+		assert.Equal(t, true, typing["typing"])
+
+		// 切断するとオフラインになり、最終オンライン日時が記録される
+		memberConn.Close()
+		presence := readPresence(t, peerConn, member.ID, realtime.PresenceOffline)
+		assert.NotNil(t, presence["last_seen_at"])
+		assert.Equal(t, realtime.PresenceOffline, hub.PresenceOf(member.ID))
+	})
+
+	t.Run("ResyncAfterBusReconnect", func(t *testing.T) {
+		memberConn := dial(t, member.ID.String(), member.Email)
+		defer memberConn.Close()
+		outsiderConn := dial(t, outsider.ID.String(), outsider.Email)
+		defer outsiderConn.Close()
+		time.Sleep(100 * time.Millisecond)
+
+		// イベントバスの再接続時に送られる通知は、ルームに関係なく接続中の全クライアントへ配信される
+		require.NoError(t, bus.Publish(ctx, realtime.Notification{Type: realtime.EventResync}))
+		readEvent(t, memberConn, realtime.EventResync)
+		readEvent(t, outsiderConn, realtime.EventResync)
+	})
+}
+
+// rawEvent テスト用のイベント（dataは種別ごとにデコードする）
+type rawEvent struct {
+	Type   realtime.EventType `json:"type"`
+	RoomID string             `json:"room_id"`
+	Data   json.RawMessage    `json:"data"`
+}
+
+// readEvent 指定した種別のイベントを受信するまで読み進める
+func readEvent(t *testing.T, conn *websocket.Conn, eventType realtime.EventType) rawEvent {
+	t.Helper()
+	_ = conn.SetReadDeadline(time.Now().Add(2 * time.Second))
+	for {
+		var event rawEvent
+		require.NoError(t, conn.ReadJSON(&event))
+		if event.Type == eventType {
+			return event
+		}
+	}
+}
+
+// readPresence 指定したユーザーが指定の状態になるイベントを受信するまで読み進める
+func readPresence(t *testing.T, conn *websocket.Conn, userID uuid.UUID, status realtime.PresenceStatus) map[string]interface{} {
+	t.Helper()
+	for {
+		event := readEvent(t, conn, realtime.EventPresence)
+		var presence map[string]interface{}
+		require.NoError(t, json.Unmarshal(event.Data, &presence))
+		if presence["user_id"] == userID.String() && presence["presence"] == string(status) {
+			return presence
+		}
+	}
+}
+
+func TestEventStreamReplay(t *testing.T) {
+	t.Setenv("JWT_SECRET", "test-secret-key-for-websocket-tests-0123456789")
+
+	client := setupTestDB(t)
+	ctx := context.Background()
+
+	member := createTestUser(t, client, "SSE Member", "sse_member@example.com")
+
+	chatRoom, err := client.ChatRoom.Create().
+		SetName("SSE Room").
+		Save(ctx)
+	require.NoError(t, err)
+
+	_, err = client.RoomMember.Create().
+		SetRoomID(chatRoom.ID).
+		SetUserID(member.ID).
+		Save(ctx)
+	require.NoError(t, err)
+
+	// 切断中に送信されたメッセージ
+	disconnectedAt := time.Now().Add(-time.Minute)
+	missed, err := client.Message.Create().
+		SetRoomID(chatRoom.ID).
+		SetUserID(member.ID).
+		SetContent("missed while offline").
+		Save(ctx)
+	require.NoError(t, err)
+
+	bus := realtime.NewLocalBus()
+	defer bus.Close()
+
+	hubCtx, stopHub := context.WithCancel(ctx)
+	defer stopHub()
+	hub := realtime.NewHub(client, bus)
+	go hub.Run(hubCtx)
+
+	e := echo.New()
+	eventStreamHandler := handlers.NewEventStreamHandler(client, hub)
+	e.GET("/api/events", eventStreamHandler.Stream, middleware.JWTAuth())
+	server := httptest.NewServer(e)
+	defer server.Close()
+
+	token, err := auth.GenerateJWT(member.ID.String(), member.Email, "", 0)
+	require.NoError(t, err)
+
+	reqCtx, cancel := context.WithTimeout(ctx, 2*time.Second)
+	defer cancel()
+	request, _ := http.NewRequestWithContext(reqCtx, http.MethodGet, server.URL+"/api/events", nil)
+	request.Header.Set("Authorization", "Bearer "+token)
+	request.Header.Set("Last-Event-ID", realtime.EventIDFromTime(disconnectedAt))
+
+	resp, err := http.DefaultClient.Do(request)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+
+	// 最初のイベントとして見逃したメッセージが再送される
+	scanner := bufio.NewScanner(resp.Body)
+	var data string
+	for scanner.Scan() {
+		if line := scanner.Text(); strings.HasPrefix(line, "data: ") {
+			data = strings.TrimPrefix(line, "data: ")
+			break
+		}
+	}
+	require.NotEmpty(t, data)
+
+	var event struct {
+		Type string                 `json:"type"`
+		Data models.MessageResponse `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(data), &event))
+	assert.Equal(t, string(realtime.EventMessageCreated), event.Type)
+	assert.Equal(t, missed.ID.String(), event.Data.ID)
+}
+
+func TestPostgresBusAcrossHubs(t *testing.T) {
+	t.Setenv("JWT_SECRET", "test-secret-key-for-websocket-tests-0123456789")
+
+	client := setupTestDB(t)
+	ctx := context.Background()
+
+	sender := createTestUser(t, client, "PG Sender", "pg_sender@example.com")
+	receiver := createTestUser(t, client, "PG Receiver", "pg_receiver@example.com")
+
+	chatRoom, err := client.ChatRoom.Create().
+		SetName("PG Room").
+		Save(ctx)
+	require.NoError(t, err)
+
+	for _, u := range []uuid.UUID{sender.ID, receiver.ID} {
+		_, err = client.RoomMember.Create().
+			SetRoomID(chatRoom.ID).
+			SetUserID(u).
+			Save(ctx)
+		require.NoError(t, err)
+	}
+
+	db, err := sql.Open("postgres", testDBURL)
+	require.NoError(t, err)
+	defer db.Close()
+
+	// 同じPostgreSQLを共有する2つのインスタンスを想定し、それぞれバスとHubを起動する
+	// 書き込みはインスタンスAのクライアント（フック登録済み）で行う
+	busA, err := realtime.NewPostgresBus(db, testDBURL)
+	require.NoError(t, err)
+	defer busA.Close()
+	busB, err := realtime.NewPostgresBus(db, testDBURL)
+	require.NoError(t, err)
+	defer busB.Close()
+	realtime.RegisterHooks(client, busA)
+
+	hubCtx, stopHubs := context.WithCancel(ctx)
+	defer stopHubs()
+	hubA := realtime.NewHub(client, busA)
+	go hubA.Run(hubCtx)
+	hubB := realtime.NewHub(client, busB)
+	go hubB.Run(hubCtx)
+
+	// 受信者はインスタンスBにのみ接続する
+	e := echo.New()
+	e.Validator = middleware.NewValidator()
+	webSocketHandler := handlers.NewWebSocketHandler(hubB, nil)
+	e.GET("/ws", webSocketHandler.Connect, middleware.JWTAuth())
+	server := httptest.NewServer(e)
+	defer server.Close()
+
+	token, err := auth.GenerateJWT(receiver.ID.String(), receiver.Email, "", 0)
+	require.NoError(t, err)
+	url := "ws" + strings.TrimPrefix(server.URL, "http") + "/ws"
+	conn, _, err := websocket.DefaultDialer.Dial(url, http.Header{"Authorization": {"Bearer " + token}})
+	require.NoError(t, err)
+	defer conn.Close()
+	time.Sleep(100 * time.Millisecond)
+
+	messageHandler := handlers.NewMessageHandler(client, handlers.MessageEditTimeLimit)
+	reqBody, _ := json.Marshal(models.SendMessageRequest{Content: "across instances"})
+	request := httptest.NewRequest(http.MethodPost, "/api/chatrooms/"+chatRoom.ID.String()+"/messages", bytes.NewReader(reqBody))
+	request.Header.Set("Content-Type", "application/json")
+	recorder := httptest.NewRecorder()
+
+	c := e.NewContext(request, recorder)
+	c.SetParamNames("room_id")
+	c.SetParamValues(chatRoom.ID.String())
+	c.Set("user_id", sender.ID.String())
+	c.Set("db", client)
+
+	require.NoError(t, messageHandler.SendMessage(c))
+	require.Equal(t, http.StatusCreated, recorder.Code)
+
+	// インスタンスAでの書き込みがインスタンスBのクライアントへ届く
+	event := readEvent(t, conn, realtime.EventMessageCreated)
+	assert.Equal(t, chatRoom.ID.String(), event.RoomID)
+	var data models.MessageResponse
+	require.NoError(t, json.Unmarshal(event.Data, &data))
+	assert.Equal(t, "across instances", data.Content)
+}
+
+func TestStreamRevocation(t *testing.T) {
+	t.Setenv("JWT_SECRET", "test-secret-key-for-websocket-tests-0123456789")
+
+	client := setupTestDB(t)
+	ctx := context.Background()
+
+	createTestUser(t, client, "Stream User", "stream_user@example.com")
+
+	bus := realtime.NewLocalBus()
+	defer bus.Close()
+
+	hubCtx, stopHub := context.WithCancel(ctx)
+	defer stopHub()
+	hub := realtime.NewHub(client, bus)
+	go hub.Run(hubCtx)
+
+	revocations := auth.NewDBRevocationStore(client, auth.DefaultRevocationCacheTTL)
+
+	e := echo.New()
+	e.Validator = middleware.NewValidator()
+	e.Use(func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			c.Set("db", client)
+			return next(c)
+		}
+	})
+
+	authHandler := handlers.NewAuthHandler(hub, revocations)
+	webSocketHandler := handlers.NewWebSocketHandler(hub, nil)
+	jwtAuth := middleware.JWTAuth(middleware.WithRevocationStore(revocations))
+	e.POST("/auth/login", authHandler.Login)
+	e.POST("/auth/logout", authHandler.Logout)
+	e.DELETE("/api/sessions/:id", authHandler.RevokeSession, jwtAuth)
+	e.POST("/api/sessions/revoke-others", authHandler.RevokeOtherSessions, jwtAuth)
+	e.POST("/api/sessions/revoke-all", authHandler.RevokeAllSessions, jwtAuth)
+	e.POST("/api/stream-tickets", authHandler.CreateStreamTicket, jwtAuth)
+	e.GET("/ws", webSocketHandler.Connect, middleware.JWTAuth(middleware.WithStreamTicket("ticket"), middleware.WithRevocationStore(revocations)))
+	server := httptest.NewServer(e)
+	defer server.Close()
+
+	do := func(method, path, token string) *httptest.ResponseRecorder {
+		request := httptest.NewRequest(method, path, nil)
+		if token != "" {
+			request.Header.Set("Authorization", "Bearer "+token)
+		}
+		recorder := httptest.NewRecorder()
+		e.ServeHTTP(recorder, request)
+		return recorder
+	}
+	login := func(t *testing.T) string {
+		body := `{"email":"stream_user@example.com","password":"` + testPassword + `"}`
+		request := httptest.NewRequest(http.MethodPost, "/auth/login", strings.NewReader(body))
+		request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		recorder := httptest.NewRecorder()
+		e.ServeHTTP(recorder, request)
+		require.Equal(t, http.StatusOK, recorder.Code, recorder.Body.String())
+		var response models.AuthResponse
+		require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+		return response.Token
+	}
+	// ticket 接続用のチケットを発行する
+	ticket := func(t *testing.T, token string) string {
+		recorder := do(http.MethodPost, "/api/stream-tickets", token)
+		require.Equal(t, http.StatusCreated, recorder.Code, recorder.Body.String())
+		var response models.StreamTicketResponse
+		require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+		return response.Ticket
+	}
+	// dialTicket チケットで接続し、失敗した場合はレスポンスのステータスコードを返す
+	dialTicket := func(t *testing.T, query string) (*websocket.Conn, int) {
+		url := "ws" + strings.TrimPrefix(server.URL, "http") + "/ws?" + query
+		conn, resp, err := websocket.DefaultDialer.Dial(url, nil)
+		if err != nil {
+			require.NotNil(t, resp)
+			return nil, resp.StatusCode
+		}
+		return conn, http.StatusSwitchingProtocols
+	}
+	dial := func(t *testing.T, token string) *websocket.Conn {
+		conn, status := dialTicket(t, "ticket="+ticket(t, token))
+		require.Equal(t, http.StatusSwitchingProtocols, status)
+		return conn
+	}
+	// expectClosed サーバーから接続が閉じられるまで読み進める
+	expectClosed := func(t *testing.T, conn *websocket.Conn) {
+		t.Helper()
+		_ = conn.SetReadDeadline(time.Now().Add(2 * time.Second))
+		for {
+			if _, _, err := conn.ReadMessage(); err != nil {
+				var closeErr *websocket.CloseError
+				assert.ErrorAs(t, err, &closeErr)
+				return
+			}
+		}
+	}
+	// expectOpen 接続が閉じられないことを確認する（確認後の接続は使用できない）
+	expectOpen := func(t *testing.T, conn *websocket.Conn) {
+		t.Helper()
+		_ = conn.SetReadDeadline(time.Now().Add(300 * time.Millisecond))
+		for {
+			if _, _, err := conn.ReadMessage(); err != nil {
+				var closeErr *websocket.CloseError
+				assert.False(t, errors.As(err, &closeErr), "connection was closed: %v", err)
+				return
+			}
+		}
+	}
+
+	t.Run("StreamTickets", func(t *testing.T) {
+		token := login(t)
+
+		// アクセストークンはURLで受け付けない
+		_, status := dialTicket(t, "token="+token)
+		assert.Equal(t, http.StatusUnauthorized, status)
+
+		// チケットは1回のみ使用できる
+		single := ticket(t, token)
+		conn, status := dialTicket(t, "ticket="+single)
+		require.Equal(t, http.StatusSwitchingProtocols, status)
+		defer conn.Close()
+		_, status = dialTicket(t, "ticket="+single)
+		assert.Equal(t, http.StatusUnauthorized, status)
+
+		// 有効期限を過ぎたチケットは使用できない
+		claims, err := auth.ValidateJWT(token)
+		require.NoError(t, err)
+		client.StreamTicket.Create().
+			SetTokenHash(auth.HashStreamTicket("expired-ticket")).
+			SetUserID(uuid.MustParse(claims.UserID)).
+			SetTokenID(claims.ID).
+			SetTokenVersion(claims.TokenVersion).
+			SetSessionID(claims.SessionID).
+			SetTokenExpiresAt(claims.ExpiresAt.Time).
+			SetExpiresAt(time.Now().Add(-time.Second)).
+			ExecX(ctx)
+		_, status = dialTicket(t, "ticket=expired-ticket")
+		assert.Equal(t, http.StatusUnauthorized, status)
+	})
+
+	t.Run("LogoutDisconnectsSession", func(t *testing.T) {
+		laptop := login(t)
+		phone := login(t)
+		laptopConn := dial(t, laptop)
+		defer laptopConn.Close()
+		phoneConn := dial(t, phone)
+		defer phoneConn.Close()
+		time.Sleep(100 * time.Millisecond)
+
+		require.Equal(t, http.StatusOK, do(http.MethodPost, "/auth/logout", laptop).Code)
+
+		// ログアウトした端末の接続のみ切断される
+		expectClosed(t, laptopConn)
+		expectOpen(t, phoneConn)
+	})
+
+	t.Run("RevokeSessionDisconnectsSession", func(t *testing.T) {
+		laptop := login(t)
+		phone := login(t)
+		laptopConn := dial(t, laptop)
+		defer laptopConn.Close()
+		phoneConn := dial(t, phone)
+		defer phoneConn.Close()
+		time.Sleep(100 * time.Millisecond)
+
+		claims, err := auth.ValidateJWT(phone)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusNoContent, do(http.MethodDelete, "/api/sessions/"+claims.SessionID, laptop).Code)
+
+		// 失効させたセッションの接続のみ切断される
+		expectClosed(t, phoneConn)
+		expectOpen(t, laptopConn)
+	})
+
+	t.Run("RevokeOtherSessionsKeepsCurrent", func(t *testing.T) {
+		laptop := login(t)
+		phone := login(t)
+		tablet := login(t)
+		laptopConn := dial(t, laptop)
+		defer laptopConn.Close()
+		phoneConn := dial(t, phone)
+		defer phoneConn.Close()
+		tabletConn := dial(t, tablet)
+		defer tabletConn.Close()
+		time.Sleep(100 * time.Millisecond)
+
+		require.Equal(t, http.StatusOK, do(http.MethodPost, "/api/sessions/revoke-others", laptop).Code)
+
+		expectClosed(t, phoneConn)
+		expectClosed(t, tabletConn)
+		expectOpen(t, laptopConn)
+	})
+
+	t.Run("RevokeAllDisconnectsUser", func(t *testing.T) {
+		laptop := login(t)
+		phone := login(t)
+		laptopConn := dial(t, laptop)
+		defer laptopConn.Close()
+		phoneConn := dial(t, phone)
+		defer phoneConn.Close()
+		unused := ticket(t, phone)
+		time.Sleep(100 * time.Millisecond)
+
+		require.Equal(t, http.StatusNoContent, do(http.MethodPost, "/api/sessions/revoke-all", phone).Code)
+
+		expectClosed(t, laptopConn)
+		expectClosed(t, phoneConn)
+
+		// 失効前に発行したチケットでも再接続できない
+		_, status := dialTicket(t, "ticket="+unused)
+		assert.Equal(t, http.StatusUnauthorized, status)
+	})
+}